@@ -16,6 +16,7 @@ import (
 	"github.com/growler/go-imbed/imbed"
 	"github.com/growler/go-slate/server"
 	"errors"
+	"strings"
 )
 
 var (
@@ -42,8 +43,47 @@ func setParams(params *slate.Params, opts generateOptions) error {
 	} else if opts.noRtl {
 		params.RTL = new(bool)
 	}
+	if opts.export && opts.noExport {
+		return errors.New("both --export and --no-export set")
+	} else if opts.export {
+		params.Export = new(bool)
+		*params.Export = true
+	} else if opts.noExport {
+		params.Export = new(bool)
+	}
 	params.LogoFile = opts.logoFile
 	params.StyleFile = opts.styleFile
+	params.OpenAPI = opts.openAPI
+	params.AsyncAPI = opts.asyncAPI
+	params.Split = opts.split
+	params.Latest = opts.latest
+	params.IncludePaths = opts.includePaths
+	params.Variant = opts.variant
+	params.Strict = opts.strict
+	for _, v := range opts.vars {
+		i := strings.IndexByte(v, '=')
+		if i <= 0 {
+			return fmt.Errorf("invalid --set %s, expected key=value", v)
+		}
+		if params.Vars == nil {
+			params.Vars = make(map[string]string)
+		}
+		params.Vars[v[:i]] = v[i+1:]
+	}
+	for _, v := range opts.versions {
+		name, dir := v, v
+		if i := strings.IndexByte(v, '='); i >= 0 {
+			name, dir = v[:i], v[i+1:]
+		}
+		params.Versions = append(params.Versions, slate.Version{Name: name, Source: dir})
+	}
+	for _, r := range opts.gitRefs {
+		name, ref := strings.Replace(r, "/", "-", -1), r
+		if i := strings.IndexByte(r, '='); i >= 0 {
+			name, ref = r[:i], r[i+1:]
+		}
+		params.Versions = append(params.Versions, slate.Version{Name: name, Ref: ref})
+	}
 	for _, s := range opts.noMinify {
 		switch s {
 		case "all":
@@ -80,13 +120,25 @@ func cmdVersion() *cobra.Command {
 }
 
 type generateOptions struct {
-	noMinify []string
-	search    bool
-	noSearch  bool
-	rtl       bool
-	noRtl     bool
-	styleFile string
-	logoFile  string
+	noMinify     []string
+	search       bool
+	noSearch     bool
+	rtl          bool
+	noRtl        bool
+	styleFile    string
+	logoFile     string
+	openAPI      string
+	asyncAPI     string
+	export       bool
+	noExport     bool
+	split        string
+	versions     []string
+	gitRefs      []string
+	latest       string
+	includePaths []string
+	variant      string
+	vars         []string
+	strict       bool
 }
 
 func genOpts(cmd *cobra.Command, opts *generateOptions) {
@@ -96,6 +148,18 @@ func genOpts(cmd *cobra.Command, opts *generateOptions) {
 	cmd.Flags().StringVarP(&opts.logoFile, "logo", "l", "", "supply a logo image `file` to use (overrides option in source file)")
 	cmd.Flags().BoolVar(&opts.noSearch, "search", false, "enable Slate search block (overrides option in source file)")
 	cmd.Flags().BoolVar(&opts.noSearch, "no-search", false, "disable Slate search block (overrides option in source file)")
+	cmd.Flags().StringVar(&opts.openAPI, "openapi", "", "render operations from an API spec `file` relative to the source directory (overrides option in source file)")
+	cmd.Flags().StringVar(&opts.asyncAPI, "asyncapi", "", "render events from an AsyncAPI spec `file` relative to the source directory (overrides option in source file)")
+	cmd.Flags().BoolVar(&opts.export, "export", false, "write collection.json (Postman) and openapi.json built from shell code samples (overrides option in source file)")
+	cmd.Flags().BoolVar(&opts.noExport, "no-export", false, "do not write collection.json and openapi.json (overrides option in source file)")
+	cmd.Flags().StringVar(&opts.split, "split", "", "split documentation to a page per `h1|includes`, or `none` (overrides option in source file)")
+	cmd.Flags().StringArrayVar(&opts.versions, "version", nil, "render a documentation version from a subdirectory of the source directory, `name=directory` (overrides versions.yaml, may be repeated)")
+	cmd.Flags().StringArrayVar(&opts.gitRefs, "git-ref", nil, "render a documentation version from the source directory at a git `ref`, or name=ref (overrides versions.yaml, may be repeated)")
+	cmd.Flags().StringVar(&opts.latest, "latest", "", "the latest documentation `version` (overrides versions.yaml)")
+	cmd.Flags().StringArrayVarP(&opts.includePaths, "include-path", "I", nil, "search `directory` for include files not found in the source directory, before include_paths of the source file (may be repeated)")
+	cmd.Flags().StringVar(&opts.variant, "variant", "", "render content tagged for this `audience`, along with untagged content (default public)")
+	cmd.Flags().StringArrayVar(&opts.vars, "set", nil, "set a template variable, `key=value` (overrides vars option in source file and SLATE_VAR_ environment variables, may be repeated)")
+	cmd.Flags().BoolVar(&opts.strict, "strict", false, "fail on undefined template variables and broken intra-document links")
 	cmd.Flags().StringSliceVar(&opts.noMinify, "no-minify", []string{}, "disable compaction, comma-separated list of `css|js|html|all`")
 }
 
@@ -156,7 +220,8 @@ func cmdPackage() *cobra.Command {
 		Short: "produces an embeddable package with rendered documentation content and HTTP handler",
 		Long: `
 Produces an embeddable package with rendered documentation content using github.com/growler/go-imbed tool.
-The package will also contain HTTP handler to serve content with standard Go http server.
+The package will also contain HTTP handler to serve content with standard Go http server. Placeholders
+like %%api_host%% are kept to be substituted at request time with github.com/growler/go-slate/placeholder.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -164,6 +229,7 @@ The package will also contain HTTP handler to serve content with standard Go htt
 			if err := setParams(&params, opts); err != nil {
 				return err
 			}
+			params.Placeholders = true
 			if workDir == "" {
 				tmpDir, err := ioutil.TempDir(os.TempDir(), ".go-slate")
 				if err != nil {
@@ -264,6 +330,112 @@ $
 	return cmd
 }
 
+func importCommand(use, short string, opts *slate.ImportOptions, fn func(string, string, slate.ImportOptions) error) *cobra.Command {
+	return &cobra.Command{
+		Use: use,
+		Short: short,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target := "."
+			if len(args) > 1 {
+				target = args[1]
+			}
+			return fn(args[0], target, *opts)
+		},
+	}
+}
+
+func cmdImport() *cobra.Command {
+	var opts slate.ImportOptions
+	cmd := &cobra.Command{
+		Use: "import",
+		Short: "generates documentation source from an API specification",
+		Long: `
+Generates documentation source (index.html.md and include files) from an API specification,
+placing it to the specified directory (or the current one). Existing files will not be
+overwritten unless --overwrite is set.
+
+$ go-slate import openapi petstore.yaml apidoc
+$ go-slate site apidoc site
+`,
+	}
+	cmd.PersistentFlags().StringSliceVarP(&opts.Langs, "language-tabs", "L", nil, "language `tabs` to produce code samples for, comma-separated (default shell,python,javascript,go)")
+	cmd.PersistentFlags().BoolVarP(&opts.Overwrite, "overwrite", "w", false, "overwrite existing files")
+	har := importCommand("har [HAR file] [directory]", "imports API calls recorded in a HAR file as an include file", &opts, slate.ImportHAR)
+	har.Flags().StringVarP(&opts.Include, "include", "i", "", "include `name` to write examples to (default HAR file name)")
+	cmd.AddCommand(
+		importCommand("openapi [spec file] [directory]", "imports an OpenAPI 3 or Swagger 2.0 spec", &opts, slate.ImportOpenAPI),
+		importCommand("asyncapi [spec file] [directory]", "imports an AsyncAPI 2 spec", &opts, slate.ImportAsyncAPI),
+		importCommand("openrpc [spec file] [directory]", "imports an OpenRPC (JSON-RPC 2.0) document", &opts, slate.ImportOpenRPC),
+		importCommand("postman [collection file] [directory]", "imports a Postman v2.1 collection", &opts, slate.ImportPostman),
+		importCommand("graphql [schema file] [directory]", "imports a GraphQL schema (SDL)", &opts, slate.ImportGraphQL),
+		har,
+		importCommand("proto [proto file] [directory]", "imports gRPC services, messages and enums from a .proto file", &opts, slate.ImportProto),
+	)
+	return cmd
+}
+
+func cmdI18n() *cobra.Command {
+	var format, locale string
+	var overwrite bool
+	extract := &cobra.Command{
+		Use: "extract [source directory] [translation file]",
+		Short: "extracts translatable strings to an XLIFF or PO file",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 || args[1] == "-" {
+				if format == "" {
+					format = "po"
+				}
+				return slate.ExtractTranslation(args[0], os.Stdout, format, locale)
+			}
+			if format == "" {
+				switch strings.ToLower(filepath.Ext(args[1])) {
+				case ".xlf", ".xliff":
+					format = "xliff"
+				default:
+					format = "po"
+				}
+			}
+			f, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			if err = slate.ExtractTranslation(args[0], f, format, locale); err != nil {
+				f.Close()
+				return err
+			}
+			return f.Close()
+		},
+	}
+	extract.Flags().StringVarP(&format, "format", "f", "", "translation file `format`, xliff or po (default inferred from the file extension, po)")
+	extract.Flags().StringVarP(&locale, "locale", "l", "", "target `locale` of the translation")
+	merge := &cobra.Command{
+		Use: "merge [source directory] [translation file]",
+		Short: "produces translated documentation source from an XLIFF or PO file",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return slate.MergeTranslation(args[0], args[1], locale, overwrite)
+		},
+	}
+	merge.Flags().StringVarP(&locale, "locale", "l", "", "`locale` of the translation (default the target language of the file)")
+	merge.Flags().BoolVarP(&overwrite, "overwrite", "w", false, "overwrite existing files")
+	cmd := &cobra.Command{
+		Use: "i18n",
+		Short: "extracts strings for translation and merges translations back",
+		Long: `
+Extracts headings, paragraphs and table cells of the documentation source (but not code)
+to an XLIFF 1.2 or gettext PO file, and merges the translated file back, producing
+index.<locale>.html.md and translated include files under includes/<locale>/.
+
+$ go-slate i18n extract apidoc apidoc.ja.po --locale ja
+$ go-slate i18n merge apidoc apidoc.ja.po
+`,
+	}
+	cmd.AddCommand(extract, merge)
+	return cmd
+}
+
 func init() {
 	var timings bool
 	var startTs time.Time
@@ -337,6 +509,8 @@ the typical usage would be:
 		cmdPackage(),
 		cmdExtract(),
 		cmdServer(),
+		cmdImport(),
+		cmdI18n(),
 	)
 	cmd.PersistentFlags().BoolVarP(&timings, "time", "t", false, "prints command execution time")
 }
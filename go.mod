@@ -17,6 +17,6 @@ require (
 	github.com/wellington/go-libsass v0.9.2
 	golang.org/x/net v0.0.0-20210226172049-e18ecbb05110 // indirect
 	golang.org/x/sys v0.0.0-20210309074719-68d13333faf2 // indirect
-	golang.org/x/text v0.3.5 // indirect
+	golang.org/x/text v0.3.5
 	gopkg.in/yaml.v2 v2.4.0
 )
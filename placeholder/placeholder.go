@@ -0,0 +1,148 @@
+// Copyright 2017 Alexey Naidyonov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE.md file.
+
+// Package placeholder substitutes placeholders of documentation rendered by go-slate,
+// like %%api_host%% or %%api_host:-api.example.com%% (with a default value), at request
+// time, so served documentation can show values of the environment or of the user.
+//
+//	docs := http.HandlerFunc(apidoc.HTTPHandlerWithPrefix("/help"))
+//	http.Handle("/help/", placeholder.Handler(docs, placeholder.Merge(
+//	    placeholder.Static(map[string]string{"api_host": host}),
+//	    placeholder.FromContext("api_key", apiKeyContextKey),
+//	)))
+package placeholder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Regexp matches a placeholder, the first group is the name and the second
+// one is the default value. The default is separated with ":-" rather than "|",
+// which would split a placeholder of a markdown table cell.
+var Regexp = regexp.MustCompile(`%%([A-Za-z0-9_.-]+)(?::-(.*?))?%%`)
+
+// Values returns placeholder values for a request
+type Values func(r *http.Request) map[string]string
+
+// Static returns the same values for every request
+func Static(values map[string]string) Values {
+	return func(*http.Request) map[string]string {
+		return values
+	}
+}
+
+// FromContext returns the value of the request context key as the value of the
+// placeholder name, if set
+func FromContext(name string, key interface{}) Values {
+	return func(r *http.Request) map[string]string {
+		return fromContext(r.Context(), name, key)
+	}
+}
+
+func fromContext(ctx context.Context, name string, key interface{}) map[string]string {
+	switch v := ctx.Value(key).(type) {
+	case nil:
+		return nil
+	case string:
+		return map[string]string{name: v}
+	default:
+		return map[string]string{name: fmt.Sprint(v)}
+	}
+}
+
+// Merge returns values of all the arguments, later ones override former ones
+func Merge(values ...Values) Values {
+	return func(r *http.Request) map[string]string {
+		ret := make(map[string]string)
+		for _, v := range values {
+			for k, val := range v(r) {
+				ret[k] = val
+			}
+		}
+		return ret
+	}
+}
+
+// Replace substitutes placeholders of an HTML page with HTML-escaped values.
+// Placeholders without a value are replaced with their default values, if any,
+// and kept otherwise.
+func Replace(page []byte, values map[string]string) []byte {
+	return Regexp.ReplaceAllFunc(page, func(m []byte) []byte {
+		sub := Regexp.FindSubmatch(m)
+		if v, ok := values[string(sub[1])]; ok {
+			return []byte(html.EscapeString(v))
+		} else if sub[2] != nil {
+			return sub[2]
+		}
+		return m
+	})
+}
+
+// Handler returns a handler serving HTML pages of the next handler with
+// placeholders substituted, other files are served as they are
+func Handler(next http.Handler, values Values) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !strings.HasSuffix(r.URL.Path, "/") && !strings.HasSuffix(r.URL.Path, ".html") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// the page has to be read as a whole and uncompressed
+		req := r.Clone(r.Context())
+		for _, h := range []string{"Accept-Encoding", "Range", "If-Range", "If-Modified-Since", "If-None-Match"} {
+			req.Header.Del(h)
+		}
+		rec := &recorder{header: make(http.Header)}
+		next.ServeHTTP(rec, req)
+		body := rec.body.Bytes()
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		if rec.header.Get("Content-Type") == "" && len(body) > 0 {
+			rec.header.Set("Content-Type", http.DetectContentType(body))
+		}
+		if rec.status == http.StatusOK && strings.HasPrefix(rec.header.Get("Content-Type"), "text/html") {
+			body = Replace(body, values(r))
+			rec.header.Set("Content-Length", strconv.Itoa(len(body)))
+			rec.header.Set("Cache-Control", "private, no-cache")
+			rec.header.Del("ETag")
+			rec.header.Del("Last-Modified")
+		}
+		for k, v := range rec.header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(rec.status)
+		w.Write(body)
+	})
+}
+
+// recorder holds a response of the next handler
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *recorder) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(data)
+}
@@ -0,0 +1,68 @@
+package placeholder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReplace(t *testing.T) {
+	page := []byte(`<p>%%api_key%% %%api_host:-api.example.com%% %%user:-&lt;anonymous&gt;%% %%unknown%%</p>`)
+	got := string(Replace(page, map[string]string{"api_key": `<"meow">`}))
+	want := `<p>&lt;&#34;meow&#34;&gt; api.example.com &lt;anonymous&gt; %%unknown%%</p>`
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var received http.Header
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		w.Header().Set("ETag", `"abc"`)
+		w.Header().Set("Last-Modified", "Sat, 17 Oct 2026 00:00:00 GMT")
+		if r.URL.Path == "/docs/slate.css" {
+			w.Header().Set("Content-Type", "text/css")
+			w.Write([]byte("/* %%api_host%% */"))
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><td>%%api_host:-api.example.com%%</td></html>"))
+	})
+	h := Handler(next, Static(map[string]string{"api_host": "kittn.example.com"}))
+
+	req := httptest.NewRequest(http.MethodGet, "/docs/", nil)
+	for _, k := range []string{"Accept-Encoding", "Range", "If-Range", "If-Modified-Since", "If-None-Match"} {
+		req.Header.Set(k, "x")
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	for _, k := range []string{"Accept-Encoding", "Range", "If-Range", "If-Modified-Since", "If-None-Match"} {
+		if received.Get(k) != "" {
+			t.Errorf("%s is passed to the next handler", k)
+		}
+	}
+	if body := rec.Body.String(); body != "<html><td>kittn.example.com</td></html>" {
+		t.Errorf("unexpected page %s", body)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "private, no-cache" {
+		t.Errorf("expected private Cache-Control, got %q", cc)
+	}
+	if rec.Header().Get("ETag") != "" || rec.Header().Get("Last-Modified") != "" {
+		t.Errorf("validators of the page are kept: %v", rec.Header())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/docs/slate.css", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if received.Get("Accept-Encoding") != "gzip" {
+		t.Errorf("Accept-Encoding of other files is stripped")
+	}
+	if body := rec.Body.String(); body != "/* %%api_host%% */" {
+		t.Errorf("other files are substituted: %s", body)
+	}
+	if rec.Header().Get("ETag") == "" || rec.Header().Get("Cache-Control") != "" {
+		t.Errorf("headers of other files are changed: %v", rec.Header())
+	}
+}
@@ -79,12 +79,19 @@ func Serve(src string, params slate.Params, addr, tlsCert, tlsKey string, mon bo
 		if err != nil {
 			return err
 		}
-		filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
-			if err == nil {
-				watcher.Add(path)
+		for _, dir := range slate.WatchPaths(src, params) {
+			if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+				// watch the directory of a file, as editors often replace files on save
+				watcher.Add(filepath.Dir(dir))
+				continue
 			}
-			return nil
-		})
+			filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+				if err == nil {
+					watcher.Add(path)
+				}
+				return nil
+			})
+		}
 		defer watcher.Close()
 		go monitor(watcher, src, params, &lock, &httpFs)
 		http.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
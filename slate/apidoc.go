@@ -0,0 +1,422 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// apiSection is a top level documentation section generated from an API spec
+type apiSection struct {
+	Kind  string // introduction, security, tag or schemas
+	Name  string // tag name for tag sections
+	Title string
+	Text  []byte
+}
+
+const (
+	introductionSection = "introduction"
+	securitySection     = "security"
+	tagSection          = "tag"
+	schemasSection      = "schemas"
+)
+
+const defaultServer = "http://example.com"
+
+// sections renders the spec as a list of markdown sections,
+// producing code samples for the languages listed in langs
+func (s *apiSpec) sections(langs []string) []apiSection {
+	var ret []apiSection
+	w := &apiWriter{spec: s, langs: langs}
+	if s.Description != "" || s.Version != "" || len(s.Servers) > 0 {
+		ret = append(ret, apiSection{Kind: introductionSection, Title: "Introduction", Text: w.introduction()})
+	}
+	if len(s.Security) > 0 {
+		ret = append(ret, apiSection{Kind: securitySection, Title: "Authentication", Text: w.security()})
+	}
+	for _, tag := range s.tags() {
+		ret = append(ret, apiSection{Kind: tagSection, Name: tag.Name, Title: tag.Name, Text: w.tag(tag)})
+	}
+	if len(s.Schemas) > 0 {
+		ret = append(ret, apiSection{Kind: schemasSection, Title: "Schemas", Text: w.schemas()})
+	}
+	return ret
+}
+
+type apiWriter struct {
+	spec  *apiSpec
+	langs []string
+	buf   bytes.Buffer
+}
+
+func (w *apiWriter) text() []byte {
+	ret := make([]byte, w.buf.Len())
+	copy(ret, w.buf.Bytes())
+	w.buf.Reset()
+	return ret
+}
+
+func (w *apiWriter) paragraph(text string) {
+	if text = strings.TrimSpace(text); text != "" {
+		w.buf.WriteString(text)
+		w.buf.WriteString("\n\n")
+	}
+}
+
+func (w *apiWriter) code(lang, text string) {
+	fmt.Fprintf(&w.buf, "```%s\n%s\n```\n\n", lang, strings.TrimRight(text, "\n"))
+}
+
+func (w *apiWriter) table(header []string, rows [][]string) {
+	w.buf.WriteString(strings.Join(header, " | "))
+	w.buf.WriteByte('\n')
+	for i, h := range header {
+		if i > 0 {
+			w.buf.WriteString(" | ")
+		}
+		w.buf.WriteString(strings.Repeat("-", len(h)))
+	}
+	w.buf.WriteByte('\n')
+	for _, row := range rows {
+		for i := range row {
+			row[i] = tableCell(row[i])
+		}
+		w.buf.WriteString(strings.Join(row, " | "))
+		w.buf.WriteByte('\n')
+	}
+	w.buf.WriteByte('\n')
+}
+
+// tableCell makes text suitable for a single markdown table cell
+func tableCell(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	text = strings.Replace(text, "|", `\|`, -1)
+	if text == "" {
+		return " "
+	}
+	return text
+}
+
+func (w *apiWriter) introduction() []byte {
+	s := w.spec
+	w.buf.WriteString("# Introduction\n\n")
+	w.paragraph(s.Description)
+	if s.Version != "" {
+		fmt.Fprintf(&w.buf, "API version: `%s`\n\n", s.Version)
+	}
+	if len(s.Servers) > 0 {
+		w.buf.WriteString("Base URLs:\n\n")
+		for _, srv := range s.Servers {
+			fmt.Fprintf(&w.buf, "* <%s>\n", srv)
+		}
+		w.buf.WriteByte('\n')
+	}
+	return w.text()
+}
+
+func (w *apiWriter) security() []byte {
+	w.buf.WriteString("# Authentication\n\n")
+	for _, sc := range w.spec.Security {
+		var what string
+		switch sc.Type {
+		case "apiKey":
+			what = fmt.Sprintf("API key passed in %s `%s`", sc.In, sc.ParamName)
+		case "http":
+			what = fmt.Sprintf("HTTP %s authentication", sc.Scheme)
+		case "oauth2":
+			what = "OAuth 2.0"
+		case "openIdConnect":
+			what = "OpenID Connect"
+		default:
+			what = sc.Type
+		}
+		fmt.Fprintf(&w.buf, "* `%s`: %s", sc.Name, what)
+		if d := strings.TrimSpace(sc.Description); d != "" {
+			fmt.Fprintf(&w.buf, ". %s", strings.Join(strings.Fields(d), " "))
+		}
+		w.buf.WriteByte('\n')
+	}
+	w.buf.WriteByte('\n')
+	return w.text()
+}
+
+func (w *apiWriter) tag(tag apiTag) []byte {
+	fmt.Fprintf(&w.buf, "# %s\n\n", tag.Name)
+	w.paragraph(tag.Description)
+	for _, op := range w.spec.Operations {
+		if op.tag() == tag.Name {
+			w.operation(op)
+		}
+	}
+	for _, ev := range w.spec.Events {
+		if ev.tag() == tag.Name {
+			w.event(ev)
+		}
+	}
+	for _, m := range w.spec.Methods {
+		if m.tag() == tag.Name {
+			w.method(m)
+		}
+	}
+	return w.text()
+}
+
+func (w *apiWriter) server() string {
+	if len(w.spec.Servers) == 0 {
+		return defaultServer
+	}
+	srv := w.spec.Servers[0]
+	if strings.HasPrefix(srv, "/") {
+		return defaultServer + srv
+	}
+	return srv
+}
+
+// sampleRequest builds an example request for the operation
+func (w *apiWriter) sampleRequest(op *apiOperation) *sampleRequest {
+	req := &sampleRequest{Method: op.Method}
+	path := op.Path
+	query := url.Values{}
+	for _, p := range op.Parameters {
+		value := p.Example
+		if value == nil && p.Schema != nil {
+			value = p.Schema.Example
+		}
+		switch p.In {
+		case "path":
+			if value != nil {
+				path = strings.Replace(path, "{"+p.Name+"}", url.PathEscape(formatValue(value)), -1)
+			}
+		case "query":
+			if value != nil {
+				query.Add(p.Name, formatValue(value))
+			} else if p.Required {
+				query.Add(p.Name, "{"+p.Name+"}")
+			}
+		case "header":
+			if value != nil || p.Required {
+				v := formatValue(value)
+				if v == "" {
+					v = "{" + p.Name + "}"
+				}
+				req.Headers = append(req.Headers, sampleHeader{p.Name, v})
+			}
+		}
+	}
+	if len(op.Security) > 0 {
+		if sc := w.spec.securityScheme(op.Security[0]); sc != nil {
+			switch {
+			case sc.Type == "apiKey" && sc.In == "header":
+				req.Headers = append(req.Headers, sampleHeader{sc.ParamName, "{api-key}"})
+			case sc.Type == "apiKey" && sc.In == "query":
+				query.Add(sc.ParamName, "{api-key}")
+			case sc.Type == "http" && sc.Scheme == "basic":
+				req.Headers = append(req.Headers, sampleHeader{"Authorization", "Basic {credentials}"})
+			case sc.Type == "http" || sc.Type == "oauth2" || sc.Type == "openIdConnect":
+				req.Headers = append(req.Headers, sampleHeader{"Authorization", "Bearer {access-token}"})
+			}
+		}
+	}
+	if resp := successResponse(op); resp != nil && resp.ContentType != "" {
+		req.Headers = append(req.Headers, sampleHeader{"Accept", resp.ContentType})
+	}
+	if b := op.RequestBody; b != nil && b.ContentType != "" {
+		req.Headers = append(req.Headers, sampleHeader{"Content-Type", b.ContentType})
+		req.Body = formatBody(b.ContentType, bodyExample(b.Example, b.Schema))
+	}
+	req.URL = w.server() + path
+	if len(query) > 0 {
+		req.URL += "?" + strings.NewReplacer("%7B", "{", "%7D", "}").Replace(query.Encode())
+	}
+	return req
+}
+
+func bodyExample(example interface{}, schema *apiSchema) interface{} {
+	if example != nil {
+		return example
+	}
+	return schema.example()
+}
+
+// formatBody formats an example value according to the content type
+func formatBody(contentType string, v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	switch {
+	case isJSONContentType(contentType):
+		return formatJSON(v)
+	case contentType == "application/x-www-form-urlencoded", contentType == "multipart/form-data":
+		if m, ok := v.(yaml.MapSlice); ok {
+			var parts []string
+			for _, item := range m {
+				parts = append(parts, url.QueryEscape(fmt.Sprint(item.Key))+"="+url.QueryEscape(formatValue(item.Value)))
+			}
+			return strings.Join(parts, "&")
+		}
+	}
+	return formatValue(v)
+}
+
+func isJSONContentType(ct string) bool {
+	if i := strings.IndexByte(ct, ';'); i >= 0 {
+		ct = ct[:i]
+	}
+	ct = strings.TrimSpace(ct)
+	return ct == "application/json" || strings.HasSuffix(ct, "+json")
+}
+
+// successResponse returns the first successful response of the operation
+func successResponse(op *apiOperation) *apiResponse {
+	for _, r := range op.Responses {
+		if strings.HasPrefix(r.Status, "2") {
+			return r
+		}
+	}
+	for _, r := range op.Responses {
+		if r.Status == "default" {
+			return r
+		}
+	}
+	return nil
+}
+
+func (w *apiWriter) operation(op *apiOperation) {
+	fmt.Fprintf(&w.buf, "## %s\n\n", op.title())
+	writeCodeSamples(&w.buf, w.langs, w.sampleRequest(op))
+	if resp := successResponse(op); resp != nil {
+		if example := bodyExample(resp.Example, resp.Schema); example != nil {
+			if isJSONContentType(resp.ContentType) {
+				w.buf.WriteString("> The above command returns JSON structured like this:\n\n")
+				w.code("json", formatJSON(example))
+			} else {
+				w.buf.WriteString("> The above command returns a response like this:\n\n")
+				w.code("", formatBody(resp.ContentType, example))
+			}
+		}
+	}
+	if op.Deprecated {
+		w.buf.WriteString("<aside class=\"warning\">This endpoint is deprecated.</aside>\n\n")
+	}
+	w.paragraph(op.Description)
+	w.buf.WriteString("### HTTP Request\n\n")
+	fmt.Fprintf(&w.buf, "`%s %s%s`\n\n", op.Method, w.server(), op.Path)
+	if op.RequestBody != nil && len(op.Consumes) > 0 {
+		fmt.Fprintf(&w.buf, "Accepts: %s\n\n", codeList(op.Consumes))
+	}
+	if len(op.Produces) > 0 {
+		fmt.Fprintf(&w.buf, "Produces: %s\n\n", codeList(op.Produces))
+	}
+	for _, loc := range []struct{ in, title string }{
+		{"path", "URL Parameters"},
+		{"query", "Query Parameters"},
+		{"header", "Header Parameters"},
+		{"cookie", "Cookie Parameters"},
+	} {
+		params := op.parameters(loc.in)
+		if len(params) == 0 {
+			continue
+		}
+		fmt.Fprintf(&w.buf, "### %s\n\n", loc.title)
+		rows := make([][]string, len(params))
+		for i, p := range params {
+			rows[i] = []string{p.Name, p.Schema.typeName(), fmt.Sprint(p.Required), describe(p.Description, p.Schema, p.Deprecated)}
+		}
+		w.table([]string{"Parameter", "Type", "Required", "Description"}, rows)
+	}
+	if b := op.RequestBody; b != nil {
+		w.buf.WriteString("### Request Body\n\n")
+		w.paragraph(b.Description)
+		if b.ContentType != "" {
+			fmt.Fprintf(&w.buf, "Content type: `%s`\n\n", b.ContentType)
+		}
+		if b.Schema != nil && b.Schema.Name != "" {
+			fmt.Fprintf(&w.buf, "Schema: %s\n\n", b.Schema.typeName())
+		} else {
+			w.properties(b.Schema)
+		}
+	}
+	if len(op.Security) > 0 {
+		names := make([]string, len(op.Security))
+		for i, name := range op.Security {
+			names[i] = "<code>" + name + "</code>"
+		}
+		fmt.Fprintf(&w.buf, "<aside class=\"notice\">This endpoint requires authentication: %s.</aside>\n\n", strings.Join(names, ", "))
+	}
+	if len(op.Responses) > 0 {
+		w.buf.WriteString("### Responses\n\n")
+		rows := make([][]string, len(op.Responses))
+		for i, r := range op.Responses {
+			rows[i] = []string{r.Status, httpStatusText(r.Status), r.Description, r.Schema.typeName()}
+		}
+		w.table([]string{"Status", "Meaning", "Description", "Schema"}, rows)
+	}
+}
+
+func codeList(items []string) string {
+	ret := make([]string, len(items))
+	for i, item := range items {
+		ret[i] = "`" + item + "`"
+	}
+	return strings.Join(ret, ", ")
+}
+
+// describe returns a description of a field extended with
+// enumeration values and deprecation notice
+func describe(text string, s *apiSchema, deprecated bool) string {
+	var parts []string
+	if deprecated || s != nil && s.Deprecated {
+		parts = append(parts, "*Deprecated.*")
+	}
+	if text = strings.TrimSpace(text); text != "" {
+		parts = append(parts, text)
+	} else if s != nil && s.Name == "" && s.Description != "" {
+		parts = append(parts, s.Description)
+	}
+	if s != nil && len(s.Enum) > 0 {
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = formatValue(v)
+		}
+		parts = append(parts, "Allowed values: "+codeList(values)+".")
+	}
+	return strings.Join(parts, " ")
+}
+
+// properties writes a table of object properties
+func (w *apiWriter) properties(s *apiSchema) {
+	if s == nil || len(s.Properties) == 0 {
+		return
+	}
+	rows := make([][]string, len(s.Properties))
+	for i, p := range s.Properties {
+		rows[i] = []string{p.Name, p.Schema.typeName(), fmt.Sprint(p.Required), describe("", p.Schema, false)}
+	}
+	w.table([]string{"Field", "Type", "Required", "Description"}, rows)
+}
+
+func (w *apiWriter) schemas() []byte {
+	w.buf.WriteString("# Schemas\n\n")
+	for _, s := range w.spec.Schemas {
+		fmt.Fprintf(&w.buf, "## %s {#%s}\n\n", s.Name, schemaAnchor(s.Name))
+		if example := s.example(); example != nil {
+			w.code("json", formatJSON(example))
+		}
+		if s.Deprecated {
+			w.buf.WriteString("<aside class=\"warning\">This schema is deprecated.</aside>\n\n")
+		}
+		w.paragraph(s.Description)
+		if len(s.Properties) > 0 {
+			w.properties(s)
+		} else {
+			named := *s
+			named.Name = ""
+			fmt.Fprintf(&w.buf, "Type: %s\n\n", named.typeName())
+			w.paragraph(describe("", &apiSchema{Enum: s.Enum}, false))
+		}
+	}
+	return w.text()
+}
@@ -0,0 +1,135 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+	"github.com/russross/blackfriday/v2"
+)
+
+// renderAPISpec merges sections generated from an API spec into the document.
+// The spec file is either given explicitly (file) or referenced by the document
+// preamble (source), both relative to the documentation source directory unless absolute.
+//
+// Sections are placed at markers in the document, e.g. for marker openapi:
+//
+//	<!-- openapi: tag Kittens -->    operations tagged Kittens
+//	<!-- openapi: tags -->           all the operations not placed elsewhere
+//	<!-- openapi: schemas -->        schemas
+//	<!-- openapi: security -->       authentication methods
+//	<!-- openapi: introduction -->   API description and base URLs
+//	<!-- openapi -->                 all the operations and schemas not placed elsewhere
+//
+// Operations and schemas not placed by a marker are appended to the document. Markers
+// within content not for the variant audience consume their sections as well, and tags,
+// operations and events with an x-audience extension not for the variant are left out.
+func renderAPISpec(fs slate.FileSystem, marker, file, source string, langs []string, variant string, ast *blackfriday.Node) error {
+	name := source
+	if file != "" {
+		name = file
+	}
+	var (
+		data []byte
+		err  error
+	)
+	switch {
+	case name == "":
+		return nil
+	case filepath.IsAbs(name):
+		data, err = ioutil.ReadFile(name)
+	default:
+		data, err = readFile(fs, name)
+	}
+	if err != nil {
+		return err
+	}
+	api, err := loadAPISpec(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", name, err)
+	}
+	dropped := api.dropAudiences(variant)
+	if len(langs) == 0 {
+		langs = []string{"shell"}
+	}
+	if err = mergeAPISections(ast, marker, api.sections(langs), dropped); err != nil {
+		return fmt.Errorf("%s: %s", name, err)
+	}
+	return nil
+}
+
+// mergeAPISections replaces markers named marker with the matching sections,
+// markers of dropped tags are removed
+func mergeAPISections(ast *blackfriday.Node, marker string, sections []apiSection, dropped map[string]bool) error {
+	placed := make([]bool, len(sections))
+	// collects markdown of the sections of the kinds listed
+	// which have not been placed yet
+	remaining := func(kinds ...string) []byte {
+		var buf bytes.Buffer
+		for i, sec := range sections {
+			for _, k := range kinds {
+				if !placed[i] && sec.Kind == k {
+					placed[i] = true
+					buf.Write(sec.Text)
+					buf.WriteByte('\n')
+				}
+			}
+		}
+		return buf.Bytes()
+	}
+	var tagsMarkers, allMarkers []*blackfriday.Node
+	for node := ast.FirstChild; node != nil; {
+		next := node.Next
+		name, arg, ok := directive(node)
+		if !ok || name != marker {
+			node = next
+			continue
+		}
+		var kind, tag string
+		switch {
+		case arg == "":
+			allMarkers = append(allMarkers, node)
+		case arg == "tags":
+			tagsMarkers = append(tagsMarkers, node)
+		case strings.HasPrefix(arg, "tag "):
+			kind, tag = tagSection, strings.TrimSpace(arg[4:])
+		case arg == introductionSection || arg == securitySection || arg == schemasSection:
+			kind = arg
+		default:
+			return fmt.Errorf("unknown %s marker %q", marker, arg)
+		}
+		if kind != "" {
+			n := -1
+			for i, sec := range sections {
+				if sec.Kind == kind && sec.Name == tag {
+					n = i
+					break
+				}
+			}
+			if n < 0 && kind == tagSection && dropped[tag] {
+				node.Unlink()
+				node = next
+				continue
+			} else if n < 0 {
+				return fmt.Errorf("nothing to place at %s marker %q", marker, arg)
+			}
+			placed[n] = true
+			replaceNode(node, parseMarkdown(sections[n].Text))
+		}
+		node = next
+	}
+	for _, node := range tagsMarkers {
+		replaceNode(node, parseMarkdown(remaining(tagSection)))
+	}
+	for _, node := range allMarkers {
+		replaceNode(node, parseMarkdown(remaining(tagSection, schemasSection)))
+	}
+	rest := parseMarkdown(remaining(tagSection, schemasSection))
+	for c := rest.FirstChild; c != nil; c = rest.FirstChild {
+		ast.AppendChild(c)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package slate
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var (
+	headingIDRE = regexp.MustCompile(`<h\d id="([^"]+)"`)
+	tocLinkRE   = regexp.MustCompile(`<a href="#([^"]+)" class="toc-h\d toc-link"`)
+)
+
+func TestGeneratedSectionIDs(t *testing.T) {
+	html := renderTestDoc(t, map[string]string{
+		"spec.yaml":         audienceTestSpec,
+		"index.html.md":     "---\nopenapi: spec.yaml\nincludes:\n  - pets\n---\n\n# Pets\n\n## List pets\n\n<!-- openapi: tag pets -->\n",
+		"includes/_pets.md": "# Pets\n\n## List pets\n",
+	}, Params{})
+	ids := make(map[string]bool)
+	for _, m := range headingIDRE.FindAllStringSubmatch(html, -1) {
+		if ids[m[1]] {
+			t.Errorf("heading ID %s is not unique", m[1])
+		}
+		ids[m[1]] = true
+	}
+	for _, id := range []string{"pets", "pets-1", "pets-2", "list-pets", "list-pets-1", "list-pets-2"} {
+		if !ids[id] {
+			t.Errorf("heading ID %s is missing", id)
+		}
+	}
+	for _, m := range tocLinkRE.FindAllStringSubmatch(html, -1) {
+		if !ids[m[1]] {
+			t.Errorf("table of contents links to missing heading #%s", m[1])
+		}
+	}
+}
+
+func TestAPISpecParamRelativeToSource(t *testing.T) {
+	html := renderTestDoc(t, map[string]string{
+		"specs/pets.yaml": audienceTestSpec,
+		"index.html.md":   "# Intro\n\n<!-- openapi -->\n",
+	}, Params{OpenAPI: "specs/pets.yaml"})
+	if !strings.Contains(html, "List pets") {
+		t.Errorf("expected operations of the spec relative to the source directory:\n%s", html)
+	}
+}
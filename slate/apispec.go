@@ -0,0 +1,648 @@
+package slate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// apiSpec is a format-neutral description of an API. Spec readers
+// (OpenAPI and friends) convert their documents to apiSpec, which is
+// then rendered to Slate markdown.
+type apiSpec struct {
+	Title       string
+	Version     string
+	Description string
+	Servers     []string
+	Tags        []apiTag
+	Operations  []*apiOperation
+	Events      []*apiEvent
+	Methods     []*apiMethod
+	Schemas     []*apiSchema
+	Security    []*apiSecurityScheme
+}
+
+type apiTag struct {
+	Name        string
+	Description string
+	Audience    []string // x-audience extension, see dropAudiences
+}
+
+type apiOperation struct {
+	ID          string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Tags        []string
+	Deprecated  bool
+	Audience    []string
+	Parameters  []*apiParameter
+	RequestBody *apiBody
+	Responses   []*apiResponse
+	Security    []string
+	Consumes    []string // request content types
+	Produces    []string // response content types
+}
+
+type apiParameter struct {
+	Name        string
+	In          string // path, query, header or cookie
+	Description string
+	Required    bool
+	Deprecated  bool
+	Schema      *apiSchema
+	Example     interface{}
+}
+
+type apiBody struct {
+	Description string
+	Required    bool
+	ContentType string
+	Schema      *apiSchema
+	Example     interface{}
+}
+
+type apiResponse struct {
+	Status      string
+	Description string
+	ContentType string
+	Schema      *apiSchema
+	Example     interface{}
+}
+
+type apiSecurityScheme struct {
+	Name        string
+	Type        string // apiKey, http, oauth2, openIdConnect
+	Scheme      string // for http: basic, bearer
+	In          string // for apiKey: header, query or cookie
+	ParamName   string // for apiKey: header or parameter name
+	Description string
+}
+
+type apiSchema struct {
+	Name        string // set for named (reusable) schemas only
+	Type        string
+	Format      string
+	Description string
+	Properties  []*apiProperty
+	Items       *apiSchema
+	Variants    []*apiSchema // oneOf/anyOf alternatives
+	Enum        []interface{}
+	Example     interface{}
+	Default     interface{}
+	Deprecated  bool
+	Audience    []string // x-audience extension of named schemas, see dropAudiences
+}
+
+type apiProperty struct {
+	Name     string
+	Required bool
+	Schema   *apiSchema
+}
+
+// tag returns the tag the operation is documented under
+func (op *apiOperation) tag() string {
+	if len(op.Tags) > 0 {
+		return op.Tags[0]
+	}
+	return defaultTag
+}
+
+const defaultTag = "Operations"
+
+// title returns a human readable operation heading
+func (op *apiOperation) title() string {
+	if op.Summary != "" {
+		return op.Summary
+	}
+	if op.ID != "" {
+		return op.ID
+	}
+	return op.Method + " " + op.Path
+}
+
+// parameters returns operation parameters located in in
+func (op *apiOperation) parameters(in string) []*apiParameter {
+	var ret []*apiParameter
+	for _, p := range op.Parameters {
+		if p.In == in {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// tags returns the list of tags in order of their appearance, adding
+// tags which are used by operations, events or methods but not declared explicitly
+func (s *apiSpec) tags() []apiTag {
+	var ret []apiTag
+	used := make(map[string]bool)
+	for _, op := range s.Operations {
+		used[op.tag()] = true
+	}
+	for _, ev := range s.Events {
+		used[ev.tag()] = true
+	}
+	for _, m := range s.Methods {
+		used[m.tag()] = true
+	}
+	seen := make(map[string]bool)
+	for _, t := range s.Tags {
+		if used[t.Name] && !seen[t.Name] {
+			seen[t.Name] = true
+			ret = append(ret, t)
+		}
+	}
+	for _, op := range s.Operations {
+		if t := op.tag(); !seen[t] {
+			seen[t] = true
+			ret = append(ret, apiTag{Name: t})
+		}
+	}
+	for _, ev := range s.Events {
+		if t := ev.tag(); !seen[t] {
+			seen[t] = true
+			ret = append(ret, apiTag{Name: t})
+		}
+	}
+	for _, m := range s.Methods {
+		if t := m.tag(); !seen[t] {
+			seen[t] = true
+			ret = append(ret, apiTag{Name: t})
+		}
+	}
+	return ret
+}
+
+func (s *apiSpec) securityScheme(name string) *apiSecurityScheme {
+	for _, sc := range s.Security {
+		if sc.Name == name {
+			return sc
+		}
+	}
+	return nil
+}
+
+// typeName returns a short description of a schema type suitable for a table cell
+func (s *apiSchema) typeName() string {
+	if s == nil {
+		return ""
+	}
+	if s.Name != "" {
+		return "[" + s.Name + "](#" + schemaAnchor(s.Name) + ")"
+	}
+	switch {
+	case s.Type == "array":
+		return "[" + s.Items.typeName() + "]"
+	case len(s.Variants) > 0:
+		names := make([]string, len(s.Variants))
+		for i, v := range s.Variants {
+			names[i] = v.typeName()
+		}
+		return strings.Join(names, " or ")
+	case s.Type == "":
+		return "any"
+	case s.Format != "":
+		return s.Type + "(" + s.Format + ")"
+	default:
+		return s.Type
+	}
+}
+
+// schemaAnchor returns the anchor of a named schema, keeping the case
+// of the name, as schemas like Pet and pet are distinct
+func schemaAnchor(name string) string {
+	return "schema-" + name
+}
+
+const maxExampleDepth = 8
+
+// example returns an example value of the schema, either taken
+// from the spec or synthesized from the schema definition
+func (s *apiSchema) example() interface{} {
+	return s.exampleAt(0, make(map[*apiSchema]bool))
+}
+
+// exampleAt synthesizes an example, skipping recursive references
+// to the named schemas being expanded
+func (s *apiSchema) exampleAt(depth int, expanding map[*apiSchema]bool) interface{} {
+	if s == nil || depth > maxExampleDepth || expanding[s] {
+		return nil
+	}
+	switch {
+	case s.Example != nil:
+		return s.Example
+	case s.Default != nil:
+		return s.Default
+	case len(s.Enum) > 0:
+		return s.Enum[0]
+	}
+	if s.Name != "" {
+		expanding[s] = true
+		defer delete(expanding, s)
+	}
+	if len(s.Variants) > 0 {
+		return s.Variants[0].exampleAt(depth+1, expanding)
+	}
+	switch s.Type {
+	case "array":
+		if v := s.Items.exampleAt(depth+1, expanding); v != nil {
+			return []interface{}{v}
+		}
+		return []interface{}{}
+	case "object", "":
+		if len(s.Properties) == 0 {
+			if s.Type == "" {
+				return nil
+			}
+			return yaml.MapSlice{}
+		}
+		ret := make(yaml.MapSlice, 0, len(s.Properties))
+		for _, p := range s.Properties {
+			if v := p.Schema.exampleAt(depth+1, expanding); v != nil {
+				ret = append(ret, yaml.MapItem{Key: p.Name, Value: v})
+			}
+		}
+		return ret
+	case "integer":
+		return 0
+	case "number":
+		return 0.0
+	case "boolean":
+		return true
+	case "string":
+		switch s.Format {
+		case "date-time":
+			return "2017-07-21T17:32:28Z"
+		case "date":
+			return "2017-07-21"
+		case "email":
+			return "user@example.com"
+		case "uuid":
+			return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+		case "uri", "url":
+			return "https://example.com"
+		case "byte":
+			return "U3dhZ2dlciByb2Nrcw=="
+		case "binary":
+			return "<binary>"
+		}
+		return "string"
+	}
+	return nil
+}
+
+// jsonObject is an ordered JSON object
+type jsonObject yaml.MapSlice
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, item := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshalJSON(fmt.Sprint(item.Key), "")
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		val, err := marshalJSON(jsonValue(item.Value), "")
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshalJSON works as json.MarshalIndent, but does not escape HTML characters
+func marshalJSON(v interface{}, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", indent)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// jsonValue converts a value decoded from YAML to a value
+// which can be marshalled to JSON, preserving keys order
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		return jsonObject(v)
+	case map[interface{}]interface{}:
+		keys := make([]string, 0, len(v))
+		vals := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			ks := fmt.Sprint(k)
+			keys = append(keys, ks)
+			vals[ks] = val
+		}
+		sort.Strings(keys)
+		ret := make(jsonObject, len(keys))
+		for i, k := range keys {
+			ret[i] = yaml.MapItem{Key: k, Value: vals[k]}
+		}
+		return ret
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		ret := make(jsonObject, len(keys))
+		for i, k := range keys {
+			ret[i] = yaml.MapItem{Key: k, Value: v[k]}
+		}
+		return ret
+	case []interface{}:
+		ret := make([]interface{}, len(v))
+		for i := range v {
+			ret[i] = jsonValue(v[i])
+		}
+		return ret
+	default:
+		return v
+	}
+}
+
+// formatJSON returns an indented JSON representation of v
+func formatJSON(v interface{}) string {
+	data, err := marshalJSON(jsonValue(v), "  ")
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// parseJSON decodes a JSON document keeping the order of object keys
+func parseJSON(data []byte) (interface{}, error) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	// JSON is a subset of YAML flow style, and decoding into MapSlice
+	// keeps keys ordered
+	var m yaml.MapSlice
+	if err := yaml.Unmarshal(append(append([]byte(`{"v": `), data...), '}'), &m); err == nil && len(m) == 1 {
+		return m[0].Value, nil
+	}
+	return v, nil
+}
+
+// formatValue returns a short textual representation of a scalar value
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case yaml.MapSlice, []interface{}, map[interface{}]interface{}:
+		data, err := marshalJSON(jsonValue(v), "")
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Helpers to navigate generic documents decoded into yaml.MapSlice
+
+func specMap(v interface{}) yaml.MapSlice {
+	m, _ := v.(yaml.MapSlice)
+	return m
+}
+
+func specList(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	return l
+}
+
+func specGet(m yaml.MapSlice, key string) interface{} {
+	for _, item := range m {
+		if fmt.Sprint(item.Key) == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+func specString(m yaml.MapSlice, key string) string {
+	switch v := specGet(m, key).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case yaml.MapSlice, []interface{}:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func specBool(m yaml.MapSlice, key string) bool {
+	switch v := specGet(m, key).(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	}
+	return false
+}
+
+func specStrings(m yaml.MapSlice, key string) []string {
+	var ret []string
+	for _, v := range specList(specGet(m, key)) {
+		ret = append(ret, fmt.Sprint(v))
+	}
+	return ret
+}
+
+// specDocument is a generic (YAML or JSON) spec document with
+// support for local JSON references
+type specDocument struct {
+	root yaml.MapSlice
+}
+
+func parseSpecDocument(data []byte) (*specDocument, error) {
+	var root yaml.MapSlice
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	if len(root) == 0 {
+		return nil, fmt.Errorf("empty spec document")
+	}
+	return &specDocument{root: root}, nil
+}
+
+// ref returns the target of a local JSON reference (#/a/b/c)
+func (d *specDocument) ref(ref string) (interface{}, bool) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, false
+	}
+	var cur interface{} = d.root
+	for _, p := range strings.Split(ref[2:], "/") {
+		p = strings.Replace(strings.Replace(p, "~1", "/", -1), "~0", "~", -1)
+		m, ok := cur.(yaml.MapSlice)
+		if !ok {
+			return nil, false
+		}
+		if cur = specGet(m, p); cur == nil {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// resolve follows $ref chains and returns the referenced object
+func (d *specDocument) resolve(v interface{}) yaml.MapSlice {
+	m := specMap(v)
+	for i := 0; i < 16; i++ {
+		ref := specString(m, "$ref")
+		if ref == "" {
+			return m
+		}
+		target, ok := d.ref(ref)
+		if !ok {
+			return m
+		}
+		m = specMap(target)
+	}
+	return m
+}
+
+// schemaReader converts JSON schema objects to apiSchema, sharing
+// named schemas located under prefix (e.g. #/components/schemas/)
+type schemaReader struct {
+	doc    *specDocument
+	prefix string
+	named  map[string]*apiSchema
+}
+
+func newSchemaReader(doc *specDocument, prefix string) *schemaReader {
+	return &schemaReader{
+		doc:    doc,
+		prefix: prefix,
+		named:  make(map[string]*apiSchema),
+	}
+}
+
+// readAll reads all the named schemas located at the reader prefix
+func (r *schemaReader) readAll() []*apiSchema {
+	v, _ := r.doc.ref(strings.TrimSuffix(r.prefix, "/"))
+	defs := specMap(v)
+	for _, item := range defs {
+		r.schema(yaml.MapSlice{{Key: "$ref", Value: r.prefix + fmt.Sprint(item.Key)}})
+	}
+	ret := make([]*apiSchema, 0, len(defs))
+	for _, item := range defs {
+		ret = append(ret, r.named[fmt.Sprint(item.Key)])
+	}
+	return ret
+}
+
+func (r *schemaReader) schema(v interface{}) *apiSchema {
+	m := specMap(v)
+	if m == nil {
+		return nil
+	}
+	if ref := specString(m, "$ref"); ref != "" {
+		if strings.HasPrefix(ref, r.prefix) {
+			name := strings.TrimPrefix(ref, r.prefix)
+			if s, ok := r.named[name]; ok {
+				return s
+			}
+			s := &apiSchema{Name: name}
+			r.named[name] = s
+			if target, ok := r.doc.ref(ref); ok {
+				r.fill(s, specMap(target))
+			}
+			return s
+		}
+		if target, ok := r.doc.ref(ref); ok {
+			return r.schema(target)
+		}
+		// external references are not resolved
+		parts := strings.Split(ref, "/")
+		return &apiSchema{Type: parts[len(parts)-1]}
+	}
+	s := &apiSchema{}
+	r.fill(s, m)
+	return s
+}
+
+func (r *schemaReader) fill(s *apiSchema, m yaml.MapSlice) {
+	s.Type = specString(m, "type")
+	s.Format = specString(m, "format")
+	s.Description = specString(m, "description")
+	s.Example = specGet(m, "example")
+	s.Default = specGet(m, "default")
+	s.Enum = specList(specGet(m, "enum"))
+	s.Deprecated = specBool(m, "deprecated")
+	s.Audience = specAudience(m)
+	if t, ok := specGet(m, "type").([]interface{}); ok {
+		// JSON schema type lists, e.g. [string, "null"]
+		for _, tt := range t {
+			if tt != "null" {
+				s.Type = fmt.Sprint(tt)
+				break
+			}
+		}
+	}
+	for _, part := range specList(specGet(m, "allOf")) {
+		sub := r.schema(part)
+		if sub == nil {
+			continue
+		}
+		if s.Type == "" {
+			s.Type = sub.Type
+		}
+		if s.Description == "" {
+			s.Description = sub.Description
+		}
+		s.Properties = append(s.Properties, sub.Properties...)
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		for _, part := range specList(specGet(m, key)) {
+			if sub := r.schema(part); sub != nil {
+				s.Variants = append(s.Variants, sub)
+			}
+		}
+	}
+	if items := specGet(m, "items"); items != nil {
+		s.Items = r.schema(items)
+		if s.Type == "" {
+			s.Type = "array"
+		}
+	}
+	required := make(map[string]bool)
+	for _, name := range specStrings(m, "required") {
+		required[name] = true
+	}
+	for _, item := range specMap(specGet(m, "properties")) {
+		name := fmt.Sprint(item.Key)
+		s.Properties = append(s.Properties, &apiProperty{
+			Name:     name,
+			Required: required[name],
+			Schema:   r.schema(item.Value),
+		})
+	}
+	if s.Type == "" && len(s.Properties) > 0 {
+		s.Type = "object"
+	}
+}
@@ -0,0 +1,230 @@
+package slate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// apiEvent is a publish or subscribe operation on an AsyncAPI channel
+type apiEvent struct {
+	Channel     string
+	Action      string // publish or subscribe
+	ID          string
+	Summary     string
+	Description string
+	Tags        []string
+	Audience    []string
+	Parameters  []*apiParameter
+	Messages    []*apiMessage
+}
+
+type apiMessage struct {
+	Name           string
+	Title          string
+	Summary        string
+	Description    string
+	ContentType    string
+	Headers        *apiSchema
+	Payload        *apiSchema
+	HeadersExample interface{}
+	Example        interface{}
+}
+
+const defaultEventTag = "Events"
+
+func (ev *apiEvent) tag() string {
+	if len(ev.Tags) > 0 {
+		return ev.Tags[0]
+	}
+	return defaultEventTag
+}
+
+func (ev *apiEvent) title() string {
+	if ev.Summary != "" {
+		return ev.Summary
+	}
+	if ev.ID != "" {
+		return ev.ID
+	}
+	return strings.ToUpper(ev.Action) + " " + ev.Channel
+}
+
+func (m *apiMessage) title() string {
+	switch {
+	case m.Title != "":
+		return m.Title
+	case m.Name != "":
+		return m.Name
+	case m.Payload != nil && m.Payload.Name != "":
+		return m.Payload.Name
+	}
+	return "Message"
+}
+
+// readAsyncAPI converts an AsyncAPI 2.x document to apiSpec
+func readAsyncAPI(doc *specDocument) (*apiSpec, error) {
+	spec := &apiSpec{}
+	info := specMap(specGet(doc.root, "info"))
+	spec.Title = specString(info, "title")
+	spec.Version = specString(info, "version")
+	spec.Description = specString(info, "description")
+	for _, item := range specMap(specGet(doc.root, "servers")) {
+		srv := specMap(item.Value)
+		url := specString(srv, "url")
+		for _, v := range specMap(specGet(srv, "variables")) {
+			url = strings.Replace(url, "{"+fmt.Sprint(v.Key)+"}", specString(specMap(v.Value), "default"), -1)
+		}
+		if protocol := specString(srv, "protocol"); protocol != "" && !strings.Contains(url, "://") {
+			url = protocol + "://" + url
+		}
+		spec.Servers = append(spec.Servers, url)
+	}
+	for _, t := range specList(specGet(doc.root, "tags")) {
+		t := specMap(t)
+		spec.Tags = append(spec.Tags, apiTag{
+			Name:        specString(t, "name"),
+			Description: specString(t, "description"),
+			Audience:    specAudience(t),
+		})
+	}
+	components := specMap(specGet(doc.root, "components"))
+	for _, item := range specMap(specGet(components, "securitySchemes")) {
+		m := doc.resolve(item.Value)
+		sc := &apiSecurityScheme{
+			Name:        fmt.Sprint(item.Key),
+			Type:        specString(m, "type"),
+			Scheme:      strings.ToLower(specString(m, "scheme")),
+			In:          specString(m, "in"),
+			ParamName:   specString(m, "name"),
+			Description: specString(m, "description"),
+		}
+		if sc.Type == "httpApiKey" {
+			sc.Type = "apiKey"
+		}
+		spec.Security = append(spec.Security, sc)
+	}
+	schemas := newSchemaReader(doc, "#/components/schemas/")
+	for _, item := range specMap(specGet(doc.root, "channels")) {
+		channel := fmt.Sprint(item.Key)
+		ch := doc.resolve(item.Value)
+		var params []*apiParameter
+		for _, p := range specMap(specGet(ch, "parameters")) {
+			m := doc.resolve(p.Value)
+			params = append(params, &apiParameter{
+				Name:        fmt.Sprint(p.Key),
+				In:          "channel",
+				Description: specString(m, "description"),
+				Required:    true,
+				Schema:      schemas.schema(specGet(m, "schema")),
+			})
+		}
+		for _, action := range []string{"subscribe", "publish"} {
+			m := specMap(specGet(ch, action))
+			if m == nil {
+				continue
+			}
+			ev := &apiEvent{
+				Channel:     channel,
+				Action:      action,
+				ID:          specString(m, "operationId"),
+				Summary:     specString(m, "summary"),
+				Description: specString(m, "description"),
+				Audience:    specAudience(m),
+				Parameters:  params,
+			}
+			if ev.Description == "" {
+				ev.Description = specString(ch, "description")
+			}
+			for _, t := range specList(specGet(m, "tags")) {
+				ev.Tags = append(ev.Tags, specString(specMap(t), "name"))
+			}
+			msg := specGet(m, "message")
+			if variants := specList(specGet(doc.resolve(msg), "oneOf")); variants != nil {
+				for _, v := range variants {
+					ev.Messages = append(ev.Messages, readAsyncAPIMessage(doc, schemas, v))
+				}
+			} else if msg != nil {
+				ev.Messages = append(ev.Messages, readAsyncAPIMessage(doc, schemas, msg))
+			}
+			spec.Events = append(spec.Events, ev)
+		}
+	}
+	spec.Schemas = schemas.readAll()
+	return spec, nil
+}
+
+func readAsyncAPIMessage(doc *specDocument, schemas *schemaReader, v interface{}) *apiMessage {
+	m := doc.resolve(v)
+	msg := &apiMessage{
+		Name:        specString(m, "name"),
+		Title:       specString(m, "title"),
+		Summary:     specString(m, "summary"),
+		Description: specString(m, "description"),
+		ContentType: specString(m, "contentType"),
+		Headers:     schemas.schema(specGet(m, "headers")),
+		Payload:     schemas.schema(specGet(m, "payload")),
+	}
+	if ref := specString(specMap(v), "$ref"); msg.Name == "" && ref != "" {
+		msg.Name = ref[strings.LastIndex(ref, "/")+1:]
+	}
+	if examples := specList(specGet(m, "examples")); len(examples) > 0 {
+		ex := specMap(examples[0])
+		msg.Example = specGet(ex, "payload")
+		msg.HeadersExample = specGet(ex, "headers")
+	}
+	return msg
+}
+
+func (w *apiWriter) event(ev *apiEvent) {
+	fmt.Fprintf(&w.buf, "## %s\n\n", ev.title())
+	for _, msg := range ev.Messages {
+		if example := bodyExample(msg.HeadersExample, msg.Headers); example != nil {
+			fmt.Fprintf(&w.buf, "> Example headers of %s:\n\n", msg.title())
+			w.code("json", formatJSON(example))
+		}
+		if example := bodyExample(msg.Example, msg.Payload); example != nil {
+			fmt.Fprintf(&w.buf, "> Example payload of %s:\n\n", msg.title())
+			if msg.ContentType == "" || isJSONContentType(msg.ContentType) {
+				w.code("json", formatJSON(example))
+			} else {
+				w.code("", formatBody(msg.ContentType, example))
+			}
+		}
+	}
+	w.paragraph(ev.Description)
+	w.buf.WriteString("### Channel\n\n")
+	fmt.Fprintf(&w.buf, "`%s %s`\n\n", strings.ToUpper(ev.Action), ev.Channel)
+	if ev.Action == "subscribe" {
+		w.buf.WriteString("Messages are published to the channel by the application and received by subscribers.\n\n")
+	} else {
+		w.buf.WriteString("Messages are sent to the channel by clients and received by the application.\n\n")
+	}
+	if len(ev.Parameters) > 0 {
+		w.buf.WriteString("### Channel Parameters\n\n")
+		rows := make([][]string, len(ev.Parameters))
+		for i, p := range ev.Parameters {
+			rows[i] = []string{p.Name, p.Schema.typeName(), describe(p.Description, p.Schema, false)}
+		}
+		w.table([]string{"Parameter", "Type", "Description"}, rows)
+	}
+	for _, msg := range ev.Messages {
+		fmt.Fprintf(&w.buf, "### Message %s\n\n", msg.title())
+		w.paragraph(msg.Summary)
+		w.paragraph(msg.Description)
+		if msg.ContentType != "" {
+			fmt.Fprintf(&w.buf, "Content type: `%s`\n\n", msg.ContentType)
+		}
+		if msg.Headers != nil && len(msg.Headers.Properties) > 0 {
+			w.buf.WriteString("Headers:\n\n")
+			w.properties(msg.Headers)
+		}
+		if p := msg.Payload; p != nil && p.Name != "" {
+			fmt.Fprintf(&w.buf, "Payload: %s\n\n", p.typeName())
+		} else if p != nil && len(p.Properties) > 0 {
+			w.buf.WriteString("Payload:\n\n")
+			w.properties(p)
+		} else if p != nil {
+			fmt.Fprintf(&w.buf, "Payload: %s\n\n", p.typeName())
+		}
+	}
+}
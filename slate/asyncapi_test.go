@@ -0,0 +1,69 @@
+package slate
+
+import (
+	"strings"
+	"testing"
+)
+
+const asyncAPITestSpec = `asyncapi: 2.0.0
+info: {title: Kittn Events, version: '1.0'}
+servers:
+  production: {url: events.kittn.com, protocol: mqtt}
+channels:
+  kittens/{id}/adopted:
+    parameters:
+      id: {description: ID of the kitten, schema: {type: integer}}
+    subscribe:
+      operationId: kittenAdopted
+      summary: Kitten adopted
+      tags: [{name: Kittens}]
+      message: {$ref: "#/components/messages/Adopted"}
+  feeding:
+    publish:
+      summary: Feed a kitten
+      message:
+        payload: {type: object, properties: {portion: {type: integer}}}
+components:
+  messages:
+    Adopted:
+      name: Adopted
+      contentType: application/json
+      payload: {$ref: "#/components/schemas/Adoption"}
+  schemas:
+    Adoption:
+      type: object
+      properties:
+        owner: {type: string, example: Jane}
+`
+
+func TestAsyncAPIRendering(t *testing.T) {
+	html := renderTestDoc(t, map[string]string{
+		"events.yaml":   asyncAPITestSpec,
+		"index.html.md": "---\nasyncapi: events.yaml\n---\n\n# Kittens\n\n<!-- asyncapi: tag Kittens -->\n\n# Reference\n\n<!-- asyncapi -->\n",
+	}, Params{})
+	kittens, reference := strings.Index(html, ">Kittens</h1>"), strings.Index(html, ">Reference</h1>")
+	expected := []string{
+		">Kitten adopted</h2>",
+		"Example payload of Adopted",
+		"&#34;owner&#34;",
+		"<code>SUBSCRIBE kittens/{id}/adopted</code>",
+		"<td>id</td>",
+		"Message Adopted</h3>",
+		">Events</h1>",
+		">Feed a kitten</h2>",
+		"<code>PUBLISH feeding</code>",
+		">Adoption</h2>",
+	}
+	pos := 0
+	for _, s := range expected {
+		i := strings.Index(html[pos:], s)
+		if i < 0 {
+			t.Fatalf("expected %s after position %d in:\n%s", s, pos, html)
+		}
+		pos += i
+	}
+	adopted := strings.Index(html, ">Kitten adopted</h2>")
+	if kittens < 0 || reference < 0 || adopted < kittens || adopted > reference {
+		t.Errorf("expected events tagged Kittens at the marker of the tag:\n%s", html)
+	}
+}
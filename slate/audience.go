@@ -0,0 +1,273 @@
+package slate
+
+import (
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// defaultVariant is the audience documentation is built for unless set with --variant
+const defaultVariant = "public"
+
+// audienceDirective tags the next block, or the section of a heading, with
+// audiences it is rendered for, like <!-- audience: internal, partners -->
+const audienceDirective = "audience"
+
+// audiences parses a comma or space separated list of audiences
+func audiences(arg string) []string {
+	return strings.FieldsFunc(arg, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+// visible reports whether content tagged with audiences is rendered for the variant,
+// untagged content is rendered for every variant
+func visible(tags []string, variant string) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	for _, t := range tags {
+		if t == variant {
+			return true
+		}
+	}
+	return false
+}
+
+// specAudience returns audiences of the x-audience extension of an API spec object,
+// either a list or a comma separated string
+func specAudience(m yaml.MapSlice) []string {
+	if s, ok := specGet(m, "x-audience").(string); ok {
+		return audiences(s)
+	}
+	return specStrings(m, "x-audience")
+}
+
+// dropAudiences removes tags, along with their operations and events, and operations,
+// events and schemas with audiences not for the variant, returning names of tags removed entirely
+func (s *apiSpec) dropAudiences(variant string) map[string]bool {
+	hidden := make(map[string]bool)
+	for _, t := range s.Tags {
+		if !visible(t.Audience, variant) {
+			hidden[t.Name] = true
+		}
+	}
+	before := make(map[string]bool)
+	for _, t := range s.tags() {
+		before[t.Name] = true
+	}
+	var ops, removedOps []*apiOperation
+	for _, op := range s.Operations {
+		if visible(op.Audience, variant) && !hidden[op.tag()] {
+			ops = append(ops, op)
+		} else {
+			removedOps = append(removedOps, op)
+		}
+	}
+	s.Operations = ops
+	var events, removedEvents []*apiEvent
+	for _, ev := range s.Events {
+		if visible(ev.Audience, variant) && !hidden[ev.tag()] {
+			events = append(events, ev)
+		} else {
+			removedEvents = append(removedEvents, ev)
+		}
+	}
+	s.Events = events
+	s.dropUnreferenced(variant, removedOps, removedEvents)
+	for _, t := range s.tags() {
+		delete(before, t.Name)
+	}
+	return before
+}
+
+// schemaRefs marks named schemas the schema refers to, itself included
+func schemaRefs(sc *apiSchema, refs map[*apiSchema]bool) {
+	if sc == nil || refs[sc] {
+		return
+	}
+	if sc.Name != "" {
+		refs[sc] = true
+	}
+	schemaRefs(sc.Items, refs)
+	for _, v := range sc.Variants {
+		schemaRefs(v, refs)
+	}
+	for _, p := range sc.Properties {
+		schemaRefs(p.Schema, refs)
+	}
+}
+
+// operationRefs marks named schemas and security schemes operations and events refer to
+func operationRefs(ops []*apiOperation, events []*apiEvent, refs map[*apiSchema]bool, security map[string]bool) {
+	for _, op := range ops {
+		for _, p := range op.Parameters {
+			schemaRefs(p.Schema, refs)
+		}
+		if op.RequestBody != nil {
+			schemaRefs(op.RequestBody.Schema, refs)
+		}
+		for _, r := range op.Responses {
+			schemaRefs(r.Schema, refs)
+		}
+		for _, name := range op.Security {
+			security[name] = true
+		}
+	}
+	for _, ev := range events {
+		for _, p := range ev.Parameters {
+			schemaRefs(p.Schema, refs)
+		}
+		for _, m := range ev.Messages {
+			schemaRefs(m.Headers, refs)
+			schemaRefs(m.Payload, refs)
+		}
+	}
+}
+
+// dropUnreferenced removes schemas and security schemes only removed operations and events
+// refer to, and schemas with audiences not for the variant along with schemas only they refer
+// to. Schemas nothing refers to are kept. Schemas with other audiences still referred to are
+// rendered inline, without a name.
+func (s *apiSpec) dropUnreferenced(variant string, ops []*apiOperation, events []*apiEvent) {
+	removed, removedSecurity := make(map[*apiSchema]bool), make(map[string]bool)
+	operationRefs(ops, events, removed, removedSecurity)
+	hidden := make(map[*apiSchema]bool)
+	for _, sc := range s.Schemas {
+		if !visible(sc.Audience, variant) {
+			hidden[sc] = true
+			schemaRefs(sc, removed)
+		}
+	}
+	kept, keptSecurity := make(map[*apiSchema]bool), make(map[string]bool)
+	operationRefs(s.Operations, s.Events, kept, keptSecurity)
+	for _, m := range s.Methods {
+		for _, p := range append(m.Params, m.Result) {
+			if p != nil {
+				schemaRefs(p.Schema, kept)
+			}
+		}
+	}
+	for _, sc := range s.Schemas {
+		if !removed[sc] {
+			schemaRefs(sc, kept)
+		}
+	}
+	var schemas []*apiSchema
+	for _, sc := range s.Schemas {
+		switch {
+		case hidden[sc]:
+			if kept[sc] {
+				sc.Name = ""
+			}
+		case !removed[sc] || kept[sc]:
+			schemas = append(schemas, sc)
+		}
+	}
+	s.Schemas = schemas
+	var security []*apiSecurityScheme
+	for _, sc := range s.Security {
+		if !removedSecurity[sc.Name] || keptSecurity[sc.Name] {
+			security = append(security, sc)
+		}
+	}
+	s.Security = security
+}
+
+// audienceEnd closes a range of content opened by an audience directive which is not
+// for the variant. Dropped content is kept in the document as a range until API spec
+// markers within it consume their sections, and removed with dropAudienceRanges then.
+const audienceEnd = "audience_end"
+
+// audienceRangeEnd returns a node closing an audience range
+func audienceRangeEnd() *blackfriday.Node {
+	end := blackfriday.NewNode(blackfriday.HTMLBlock)
+	end.Literal = []byte("<!-- " + audienceEnd + " -->")
+	return end
+}
+
+// insertAfter inserts node after the sibling
+func insertAfter(sibling, node *blackfriday.Node) {
+	if sibling.Next != nil {
+		sibling.Next.InsertBefore(node)
+	} else {
+		sibling.Parent.AppendChild(node)
+	}
+}
+
+// dropAudiences removes audience directives for the variant, and turns blocks and sections
+// tagged by others to ranges to drop, see audienceEnd. A directive followed by a heading tags
+// the section up to the next heading of the same or a higher level.
+func dropAudiences(ast *blackfriday.Node, variant string) {
+	var directives []*blackfriday.Node
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering {
+			return blackfriday.GoToNext
+		}
+		if name, _, ok := directive(node); ok {
+			if name == audienceDirective {
+				directives = append(directives, node)
+			}
+			return blackfriday.SkipChildren
+		}
+		return blackfriday.GoToNext
+	})
+	for _, d := range directives {
+		_, arg, _ := directive(d)
+		next := d.Next
+		if next == nil || visible(audiences(arg), variant) {
+			d.Unlink()
+			continue
+		}
+		last := next
+		if next.Type == blackfriday.Heading {
+			for last.Next != nil && !(last.Next.Type == blackfriday.Heading && last.Next.Level <= next.Level) {
+				if name, _, _ := directive(last.Next); name == audienceEnd {
+					break // the end of an enclosing range
+				}
+				last = last.Next
+			}
+		}
+		insertAfter(last, audienceRangeEnd())
+	}
+}
+
+// audienceRange wraps a document not for the variant, like an include file, to a range to drop
+func audienceRange(doc *blackfriday.Node) *blackfriday.Node {
+	start := blackfriday.NewNode(blackfriday.HTMLBlock)
+	start.Literal = []byte("<!-- " + audienceDirective + ": -->")
+	if doc.FirstChild != nil {
+		doc.FirstChild.InsertBefore(start)
+	} else {
+		doc.AppendChild(start)
+	}
+	doc.AppendChild(audienceRangeEnd())
+	return doc
+}
+
+// dropAudienceRanges removes ranges of content not for the variant, see dropAudiences,
+// along with content generated for API spec markers within them
+func dropAudienceRanges(ast *blackfriday.Node) {
+	for node := ast.FirstChild; node != nil; {
+		name, _, ok := directive(node)
+		if !ok || name != audienceDirective {
+			dropAudienceRanges(node)
+			node = node.Next
+			continue
+		}
+		for depth := 0; node != nil; {
+			following := node.Next
+			name, _, _ := directive(node)
+			node.Unlink()
+			node = following
+			if name == audienceDirective {
+				depth++
+			} else if name == audienceEnd {
+				if depth--; depth == 0 {
+					break
+				}
+			}
+		}
+	}
+}
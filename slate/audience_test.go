@@ -0,0 +1,117 @@
+package slate
+
+import (
+	"strings"
+	"testing"
+)
+
+const audienceTestSpec = `openapi: 3.0.0
+info: {title: Pets, version: "1"}
+tags:
+  - name: pets
+  - name: admin
+  - name: ops
+    x-audience: internal
+paths:
+  /pets:
+    get: {summary: List pets, tags: [pets], responses: {"200": {description: ok}}}
+    post: {summary: Create secret pet, tags: [pets], x-audience: [internal], responses: {"200": {description: ok}}}
+  /admin/purge:
+    post: {summary: Purge all pets, tags: [admin], responses: {"200": {description: ok}}}
+  /ops/restart:
+    post: {summary: Restart cluster, tags: [ops], responses: {"200": {description: ok}}}
+`
+
+var internalOperations = []string{"Create secret pet", "Purge all pets", "Restart cluster"}
+
+func TestAudienceSpecMarkers(t *testing.T) {
+	for name, files := range map[string]map[string]string{
+		"include": {
+			"index.html.md":      "---\nopenapi: spec.yaml\nincludes:\n  - admin\n---\n\n# Intro\n\n<!-- openapi: tag pets -->\n\n<!-- openapi: tags -->\n",
+			"includes/_admin.md": "---\naudience: [internal]\n---\n\n# Admin\n\n<!-- openapi: tag admin -->\n",
+		},
+		"inline include": {
+			"index.html.md":      "---\nopenapi: spec.yaml\n---\n\n# Intro\n\n<!-- include: admin -->\n\n<!-- openapi -->\n",
+			"includes/_admin.md": "---\naudience: [internal]\n---\n\n# Admin\n\n<!-- openapi: tag admin -->\n",
+		},
+		"section": {
+			"index.html.md": "---\nopenapi: spec.yaml\n---\n\n# Intro\n\n<!-- audience: internal -->\n\n# Admin\n\n<!-- openapi: tag admin -->\n\n# Pets\n\n<!-- openapi: tag pets -->\n",
+		},
+		"block": {
+			"index.html.md": "---\nopenapi: spec.yaml\n---\n\n# Intro\n\n<!-- audience: internal -->\n<!-- openapi: tag admin -->\n\n<!-- openapi: tags -->\n",
+		},
+	} {
+		files["spec.yaml"] = audienceTestSpec
+		public := renderTestDoc(t, files, Params{})
+		if !strings.Contains(public, "List pets") {
+			t.Errorf("%s: public operation is missing", name)
+		}
+		for _, op := range internalOperations {
+			if strings.Contains(public, op) {
+				t.Errorf("%s: internal operation %q leaks to the public variant", name, op)
+			}
+		}
+		internal := renderTestDoc(t, files, Params{Variant: "internal"})
+		for _, op := range append(internalOperations, "List pets") {
+			if !strings.Contains(internal, op) {
+				t.Errorf("%s: operation %q is missing in the internal variant", name, op)
+			}
+		}
+	}
+}
+
+func TestAudienceSchemas(t *testing.T) {
+	files := map[string]string{
+		"index.html.md": "---\nopenapi: spec.yaml\n---\n\n# Intro\n\n<!-- openapi -->\n",
+		"spec.yaml": `openapi: 3.0.0
+info: {title: Pets, version: "1"}
+paths:
+  /pets:
+    get:
+      summary: List pets
+      security: [{api_key: []}]
+      responses: {"200": {description: ok, content: {application/json: {schema: {$ref: "#/components/schemas/Pet"}}}}}
+  /admin/purge:
+    post:
+      summary: Purge all pets
+      x-audience: internal
+      security: [{admin_token: []}]
+      requestBody: {content: {application/json: {schema: {$ref: "#/components/schemas/PurgeOrder"}}}}
+      responses: {"200": {description: ok}}
+components:
+  securitySchemes:
+    api_key: {type: apiKey, in: header, name: X-Api-Key}
+    admin_token: {type: http, scheme: bearer}
+  schemas:
+    Pet:
+      properties:
+        name: {type: string}
+        owner: {$ref: "#/components/schemas/StaffNote"}
+    PurgeOrder:
+      properties:
+        reason: {$ref: "#/components/schemas/PurgeReason"}
+    PurgeReason: {type: string, enum: [sick, gone]}
+    StaffNote: {type: string, x-audience: internal}
+    AuditLog: {type: object, x-audience: [internal], properties: {entry: {$ref: "#/components/schemas/AuditEntry"}}}
+    AuditEntry: {type: string}
+    Breed: {type: string}
+`,
+	}
+	public := renderTestDoc(t, files, Params{})
+	for _, s := range []string{"schema-Pet", "schema-Breed", "X-Api-Key"} {
+		if !strings.Contains(public, s) {
+			t.Errorf("%s is missing in the public variant", s)
+		}
+	}
+	for _, s := range []string{"PurgeOrder", "PurgeReason", "StaffNote", "AuditLog", "AuditEntry", "admin_token"} {
+		if strings.Contains(public, s) {
+			t.Errorf("%s leaks to the public variant", s)
+		}
+	}
+	internal := renderTestDoc(t, files, Params{Variant: "internal"})
+	for _, s := range []string{"schema-Pet", "schema-PurgeOrder", "schema-PurgeReason", "schema-StaffNote", "schema-AuditLog", "schema-AuditEntry", "admin_token"} {
+		if !strings.Contains(internal, s) {
+			t.Errorf("%s is missing in the internal variant", s)
+		}
+	}
+}
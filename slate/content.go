@@ -9,6 +9,7 @@ import (
 	chroma_html "github.com/alecthomas/chroma/formatters/html"
 	"github.com/alecthomas/chroma/lexers"
 	"github.com/alecthomas/chroma/styles"
+	"github.com/growler/go-slate/placeholder"
 	"github.com/growler/go-slate/slate/internal/slate"
 	"github.com/russross/blackfriday/v2"
 	"github.com/spf13/afero"
@@ -17,24 +18,34 @@ import (
 	"gopkg.in/yaml.v2"
 	"html"
 	"io/ioutil"
-	"path"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"sort"
+	"strings"
 	"text/template"
 )
 
 type ContentParams struct {
-	Title      string   `yaml:"title,omitempty"`
-	Search     bool     `yaml:"search,omitempty"`
-	Highlight  string   `yaml:"highlight_style,omitempty"`
-	Langs      []string `yaml:"language_tabs,omitempty"`
-	TocFooters []string `yaml:"toc_footers,omitempty"`
-	Includes   []string `yaml:"includes,omitempty"`
-	Style      string   `yaml:"style,omitempty"`
-	Logo       string   `yaml:"logo,omitempty"`
-	RTLEnabled bool     `yaml:"enable_rtl,omitempty"`
-	HTMLHead   string   `yaml:"html_head,omitempty"`
+	Title        string            `yaml:"title,omitempty"`
+	Search       bool              `yaml:"search,omitempty"`
+	Highlight    string            `yaml:"highlight_style,omitempty"`
+	Langs        []string          `yaml:"language_tabs,omitempty"`
+	TocFooters   []string          `yaml:"toc_footers,omitempty"`
+	Includes     []string          `yaml:"includes,omitempty"`
+	IncludePaths []string          `yaml:"include_paths,omitempty"`
+	Style        string            `yaml:"style,omitempty"`
+	Logo         string            `yaml:"logo,omitempty"`
+	RTLEnabled   bool              `yaml:"enable_rtl,omitempty"`
+	HTMLHead     string            `yaml:"html_head,omitempty"`
+	OpenAPI      string            `yaml:"openapi,omitempty"`
+	AsyncAPI     string            `yaml:"asyncapi,omitempty"`
+	Export       bool              `yaml:"export,omitempty"`
+	Split        string            `yaml:"split,omitempty"`
+	Locale       string            `yaml:"locale,omitempty"`
+	UIStrings    map[string]string `yaml:"ui_strings,omitempty"`
+	Vars         map[string]string `yaml:"vars,omitempty"`
+	SlugStyle    string            `yaml:"slug_style,omitempty"`
 }
 
 type chromaTypes struct {
@@ -96,11 +107,14 @@ func (p *ContentParams) StyleCSS() string {
 }
 
 type content struct {
-	html   []byte
-	Params ContentParams
+	pages   []*page
+	exports []importedFile
+	locale  string // locale of a translated document
+	dir     string // direction of the document script, ltr or rtl
+	Params  ContentParams
 }
 
-func load(fs slate.FileSystem, params Params) (*content, error) {
+func load(fs slate.FileSystem, params Params, place placement) (*content, error) {
 	tmplFile, err := fs.Open("layouts/layout.tmpl")
 	if err != nil {
 		return nil, err
@@ -123,14 +137,188 @@ func load(fs slate.FileSystem, params Params) (*content, error) {
 	if tmpl, err = tmpl.Parse(string(tmplSrc)); err != nil {
 		return nil, err
 	}
-	file, err := fs.Open("index.html.md")
+	source, err := readFile(fs, "index.html.md")
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
-	buf := bytes.Buffer{}
-	preamble := bytes.Buffer{}
-	lineReader := bufio.NewScanner(file)
+	sourceName := "index.html.md"
+	preamble, _ := splitPreamble(source)
+	ret := &content{}
+	if err = yaml.Unmarshal(preamble, &ret.Params); err != nil {
+		return nil, err
+	}
+	mainLocale := ret.Params.Locale
+	if mainLocale == "" {
+		mainLocale = defaultLocale
+	}
+	locale := mainLocale
+	if place.Locale != "" {
+		// translated document preamble overrides options of the default one
+		sourceName = "index." + place.Locale + ".html.md"
+		if source, err = readFile(fs, sourceName); err != nil {
+			return nil, err
+		}
+		preamble, _ = splitPreamble(source)
+		if err = yaml.Unmarshal(preamble, &ret.Params); err != nil {
+			return nil, err
+		}
+		locale = place.Locale
+	}
+	ret.dir = localeDir(locale)
+	if params.Split != "" {
+		ret.Params.Split = params.Split
+	}
+	if err = checkSplitMode(ret.Params.Split); err != nil {
+		return nil, err
+	}
+	if err = checkSlugStyle(ret.Params.SlugStyle); err != nil {
+		return nil, err
+	}
+	vars := newMarkdownVars(&ret.Params, params)
+	body, err := vars.body(sourceName, source)
+	if err != nil {
+		return nil, err
+	}
+	ast := parseMarkdown(body)
+	includes, err := newIncludeResolver(fs, params, &ret.Params, place)
+	if err != nil {
+		return nil, err
+	}
+	includes.vars = vars
+	parseXrefs(ast)
+	includes.links.add(sourceName, source, ast)
+	dropAudiences(ast, includes.variant)
+	if err = includes.expand(ast, []string{sourceName}); err != nil {
+		return nil, err
+	}
+	for _, entry := range ret.Params.Includes {
+		names, docs, err := includes.readAll(entry, []string{sourceName})
+		if err != nil {
+			return nil, err
+		}
+		for i, inc := range docs {
+			if names[i] != "" {
+				ast.AppendChild(pageBreak(names[i]))
+			}
+			for c := inc.FirstChild; c != nil; c = inc.FirstChild {
+				c.Unlink()
+				ast.AppendChild(c)
+			}
+		}
+	}
+	ret.Params.Langs = includes.langs(ret.Params.Langs)
+	if params.LogoFile != "" {
+		ret.Params.Logo = params.LogoFile
+	}
+	if params.Search != nil {
+		ret.Params.Search = *params.Search
+	}
+	if params.RTL != nil {
+		ret.Params.RTLEnabled = *params.RTL
+	}
+	if params.Export != nil {
+		ret.Params.Export = *params.Export
+	}
+	htmlRenderer := blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{})
+	if err = renderAPISpec(fs, "openapi", params.OpenAPI, ret.Params.OpenAPI, ret.Params.Langs, includes.variant, ast); err != nil {
+		return nil, err
+	}
+	if err = renderAPISpec(fs, "asyncapi", params.AsyncAPI, ret.Params.AsyncAPI, ret.Params.Langs, includes.variant, ast); err != nil {
+		return nil, err
+	}
+	dropAudienceRanges(ast)
+	assignHeadingIDs(htmlRenderer, ast, ret.Params.SlugStyle)
+	if err = includes.links.resolveXrefs(ast); err != nil {
+		return nil, err
+	}
+	if err = includes.links.check(ast, params.Strict); err != nil {
+		return nil, err
+	}
+	if ret.Params.Export {
+		if ret.exports, err = exportFiles(ret.Params.Title, ast); err != nil {
+			return nil, err
+		}
+		for i := range ret.exports {
+			// exports are not served through placeholder substitution
+			ret.exports[i].data = placeholder.Replace(ret.exports[i].data, nil)
+		}
+	}
+	ret.pages = splitPages(ast, ret.Params.Split)
+	for _, p := range ret.pages {
+		if inc := includes.named(p.include); inc != nil && inc.Title != "" {
+			p.title = inc.Title
+		}
+	}
+	anchors := linkPages(ret.pages)
+	for i, p := range ret.pages {
+		var locales []*localeLink
+		if len(place.Locales) > 0 {
+			root := ""
+			if place.Locale != "" {
+				root = "../"
+			}
+			for _, l := range append([]string{""}, place.Locales...) {
+				link := &localeLink{Locale: l, URL: root + l + "/" + p.name}
+				if l == "" {
+					link.Locale, link.URL = mainLocale, root+p.name
+				}
+				link.Name = localeStrings(link.Locale, nil)["language"]
+				locales = append(locales, link)
+			}
+		}
+		toc := produceTOC(htmlRenderer, ast, func(id string) string {
+			return p.anchor(anchors, id)
+		})
+		var con, buf bytes.Buffer
+		for _, node := range p.nodes {
+			con.Write(produceHTML(htmlRenderer, node))
+		}
+		data := map[string]interface{}{
+			"Params":   &ret.Params,
+			"TOC":      string(toc),
+			"Content":  con.String(),
+			"Root":     place.Root,
+			"Version":  place.Version,
+			"Versions": place.Versions,
+			"Latest":   place.Latest,
+			"Outdated": place.Version != place.Latest,
+			"Locale":   locale,
+			"Locales":  locales,
+			"Dir":      ret.dir,
+			"Strings":  localeStrings(locale, ret.Params.UIStrings),
+			"Variant":  includes.variant,
+			"Include":  includes.named(p.include),
+			"Includes": includes.files,
+		}
+		if i > 0 {
+			data["Prev"] = ret.pages[i-1].link()
+		}
+		if i < len(ret.pages)-1 {
+			data["Next"] = ret.pages[i+1].link()
+		}
+		if err = tmpl.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		p.html = buf.Bytes()
+		if !params.Placeholders {
+			p.html = placeholder.Replace(p.html, nil)
+		}
+	}
+	return ret, nil
+}
+
+func parseMarkdown(data []byte) *blackfriday.Node {
+	parser := blackfriday.New(blackfriday.WithExtensions(
+		blackfriday.CommonExtensions | blackfriday.AutoHeadingIDs,
+	))
+	return parser.Parse(data)
+}
+
+// splitPreamble splits a source file to the YAML preamble enclosed
+// in "---" lines and the markdown body
+func splitPreamble(data []byte) (preamble, body []byte) {
+	var pre, buf bytes.Buffer
+	lineReader := bufio.NewScanner(bytes.NewReader(data))
 	state := 0
 	for lineReader.Scan() {
 		line := lineReader.Text()
@@ -147,58 +335,65 @@ func load(fs slate.FileSystem, params Params) (*content, error) {
 				state = 2
 				continue
 			} else {
-				preamble.WriteString(line)
-				preamble.WriteByte('\n')
+				pre.WriteString(line)
+				pre.WriteByte('\n')
 				continue
 			}
 		}
 		buf.WriteString(line)
 		buf.WriteByte('\n')
 	}
-	ret := &content{}
-	if err = yaml.Unmarshal(preamble.Bytes(), &ret.Params); err != nil {
+	return pre.Bytes(), buf.Bytes()
+}
+
+func readFile(fs slate.FileSystem, name string) ([]byte, error) {
+	file, err := fs.Open(name)
+	if err != nil {
 		return nil, err
 	}
-	for _, include := range ret.Params.Includes {
-		inc, err := fs.Open(path.Join("includes", "_"+include+".md"))
-		if err != nil {
-			return nil, err
-		}
-		data, err := ioutil.ReadAll(inc)
-		inc.Close()
-		if err != nil {
-			return nil, err
+	defer file.Close()
+	return ioutil.ReadAll(file)
+}
+
+var directiveRE = regexp.MustCompile(`^<!--\s*([a-z_]+)(?::\s*(.*?))?\s*-->$`)
+
+// directive recognizes a block consisting of a single HTML comment
+// like <!-- name: argument -->, used to place generated content
+func directive(node *blackfriday.Node) (name, arg string, ok bool) {
+	var literal []byte
+	switch node.Type {
+	case blackfriday.HTMLBlock:
+		literal = node.Literal
+	case blackfriday.Paragraph:
+		for c := node.FirstChild; c != nil; c = c.Next {
+			if c.Type == blackfriday.HTMLSpan && literal == nil {
+				literal = c.Literal
+			} else if c.Type != blackfriday.Text || len(bytes.TrimSpace(c.Literal)) > 0 {
+				return "", "", false
+			}
 		}
-		buf.Write(data)
-		buf.WriteByte('\n')
+	default:
+		return "", "", false
 	}
-	if params.LogoFile != "" {
-		ret.Params.Logo = params.LogoFile
+	m := directiveRE.FindSubmatch(bytes.TrimSpace(literal))
+	if m == nil {
+		return "", "", false
 	}
-	if params.Search != nil {
-		ret.Params.Search = *params.Search
-	}
-	if params.RTL != nil {
-		ret.Params.RTLEnabled = *params.RTL
+	return string(m[1]), strings.TrimSpace(string(m[2])), true
+}
+
+// replaceNode replaces node with the top level nodes of doc
+func replaceNode(node *blackfriday.Node, doc *blackfriday.Node) {
+	for c := doc.FirstChild; c != nil; c = doc.FirstChild {
+		c.Unlink()
+		node.InsertBefore(c)
 	}
-	parser := blackfriday.New(blackfriday.WithExtensions(
-		blackfriday.CommonExtensions | blackfriday.AutoHeadingIDs,
-	))
-	htmlRenderer := blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{})
-	ast := parser.Parse(buf.Bytes())
-	toc := produceTOC(htmlRenderer, ast)
-	con := produceHTML(htmlRenderer, ast)
-	buf.Reset()
-	err = tmpl.Execute(&buf, map[string]interface{}{
-		"Params":  &ret.Params,
-		"TOC":     string(toc),
-		"Content": string(con),
-	})
-	ret.html = buf.Bytes()
-	return ret, nil
+	node.Unlink()
 }
 
-func produceTOC(r blackfriday.Renderer, ast *blackfriday.Node) []byte {
+// produceTOC renders the table of contents, link returns the link
+// to a heading by its id
+func produceTOC(r blackfriday.Renderer, ast *blackfriday.Node, link func(id string) string) []byte {
 	buf := bytes.Buffer{}
 
 	headings := make([]*blackfriday.Node, 0, 8)
@@ -237,7 +432,7 @@ func produceTOC(r blackfriday.Renderer, ast *blackfriday.Node) []byte {
 		}
 		currentLevel = h.Level
 		title := html.EscapeString(string(h.Title))
-		fmt.Fprintf(&buf, "<li>\n<a href=\"#%s\" class=\"toc-h%d toc-link\" data-title=\"%s\">%s</a>\n", h.HeadingID, h.Level, title, title)
+		fmt.Fprintf(&buf, "<li>\n<a href=\"%s\" class=\"toc-h%d toc-link\" data-title=\"%s\">%s</a>\n", link(h.HeadingID), h.Level, title, title)
 	}
 	for i := 1; i < currentLevel; i++ {
 		fmt.Fprint(&buf, "</li>\n</ul>\n")
@@ -254,7 +449,8 @@ func produceHTML(r blackfriday.Renderer, ast *blackfriday.Node) []byte {
 		switch node.Type {
 		case blackfriday.CodeBlock:
 			lang := string(node.Info)
-			code := string(node.Literal)
+			code, placeholders := protectPlaceholders(string(node.Literal))
+			start := buf.Len()
 			fmt.Fprintf(&buf, "\n<pre class=\"highlight %s tab-%s\"><code>", lang, lang)
 			lexer := lexers.Get(lang)
 			if lexer == nil {
@@ -273,6 +469,11 @@ func produceHTML(r blackfriday.Renderer, ast *blackfriday.Node) []byte {
 				fmt.Fprintln(&buf, html.EscapeString(code))
 			}
 			fmt.Fprint(&buf, "</code></pre>\n")
+			if placeholders != nil {
+				block := placeholders.Replace(string(buf.Bytes()[start:]))
+				buf.Truncate(start)
+				buf.WriteString(block)
+			}
 			return blackfriday.GoToNext
 		default:
 			return r.RenderNode(&buf, node, entering)
@@ -281,7 +482,36 @@ func produceHTML(r blackfriday.Renderer, ast *blackfriday.Node) []byte {
 	return buf.Bytes()
 }
 
+// protectPlaceholders replaces runtime placeholders of a code sample with tokens
+// a lexer would not split, and returns a replacer of tokens with the placeholders
+func protectPlaceholders(code string) (string, *strings.Replacer) {
+	var pairs []string
+	code = placeholder.Regexp.ReplaceAllStringFunc(code, func(m string) string {
+		token := fmt.Sprintf("SLATEPLACEHOLDER%dX", len(pairs)/2)
+		pairs = append(pairs, token, html.EscapeString(m))
+		return token
+	})
+	if pairs == nil {
+		return code, nil
+	}
+	return code, strings.NewReplacer(pairs...)
+}
+
 func (c *content) produce(target *afero.Afero, minifyHTML bool) error {
+	for _, p := range c.pages {
+		if err := producePage(target, p.name, p.html, minifyHTML); err != nil {
+			return err
+		}
+	}
+	for _, f := range c.exports {
+		if err := target.WriteFile(f.name, f.data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func producePage(target *afero.Afero, name string, data []byte, minifyHTML bool) error {
 	out, err := target.TempFile(".", ".slate")
 	if err != nil {
 		return err
@@ -297,12 +527,12 @@ func (c *content) produce(target *afero.Afero, minifyHTML bool) error {
 		if minifyHTML {
 			m.AddFunc("text/html", minify_html.Minify)
 		}
-		result, err = m.Bytes("text/html", c.html)
+		result, err = m.Bytes("text/html", data)
 		if err != nil {
 			return err
 		}
 	} else {
-		result = c.html
+		result = data
 	}
 	if _, err = out.Write(result); err != nil {
 		return err
@@ -310,8 +540,5 @@ func (c *content) produce(target *afero.Afero, minifyHTML bool) error {
 	if err = out.Close(); err != nil {
 		return err
 	}
-	if err = target.Rename(outName, "index.html"); err != nil {
-		return err
-	}
-	return nil
+	return target.Rename(outName, name)
 }
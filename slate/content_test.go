@@ -0,0 +1,65 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+)
+
+// renderTestDoc renders a document of source files to HTML of all its pages
+func renderTestDoc(t *testing.T, files map[string]string, params Params) string {
+	t.Helper()
+	doc, err := loadTestDoc(t, files, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var html strings.Builder
+	for _, p := range doc.pages {
+		html.Write(p.html)
+	}
+	return html.String()
+}
+
+// loadTestDoc loads a document of source files
+func loadTestDoc(t *testing.T, files map[string]string, params Params) (*content, error) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestFiles(t, dir, files)
+	fs, err := slate.NewUnionFS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return load(fs, params, placement{Source: dir})
+}
+
+// writeTestFiles writes files with slash separated names to the directory
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, text := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPlaceholdersInTables(t *testing.T) {
+	doc := map[string]string{"index.html.md": "# Keys\n\nKey | Value\n--- | ---\nx | %%api_key:-meow%%\n"}
+	if html := renderTestDoc(t, doc, Params{}); !strings.Contains(html, "<td>meow</td>") {
+		t.Errorf("expected the default value of the placeholder:\n%s", html)
+	}
+	if html := renderTestDoc(t, doc, Params{Placeholders: true}); !strings.Contains(html, "<td>%%api_key:-meow%%</td>") {
+		t.Errorf("expected the placeholder to be kept:\n%s", html)
+	}
+}
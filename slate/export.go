@@ -0,0 +1,523 @@
+package slate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// exportedRequest is a request found in a shell code sample of the document
+type exportedRequest struct {
+	Folder   string // enclosing H1 section
+	Name     string // enclosing H2 section
+	Request  *sampleRequest
+	Response string // JSON response example following the request, if any
+}
+
+// nodeText returns the plain text of a node, like a heading
+func nodeText(node *blackfriday.Node) string {
+	var buf bytes.Buffer
+	node.Walk(func(n *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && (n.Type == blackfriday.Text || n.Type == blackfriday.Code) {
+			buf.Write(n.Literal)
+		}
+		return blackfriday.GoToNext
+	})
+	return strings.TrimSpace(buf.String())
+}
+
+func isShellLang(lang string) bool {
+	return lang == "shell" || lang == "bash" || lang == "sh"
+}
+
+// exportRequests collects curl commands from shell code blocks
+func exportRequests(ast *blackfriday.Node) []*exportedRequest {
+	var ret []*exportedRequest
+	var folder, name string
+	var last []*exportedRequest // requests of the current section awaiting a response example
+	for node := ast.FirstChild; node != nil; node = node.Next {
+		switch node.Type {
+		case blackfriday.Heading:
+			switch node.Level {
+			case 1:
+				folder, name = nodeText(node), ""
+			case 2:
+				name = nodeText(node)
+			}
+			if node.Level < 3 {
+				last = nil
+			}
+		case blackfriday.CodeBlock:
+			lang := string(node.Info)
+			if isShellLang(lang) {
+				for _, cmd := range shellCommands(string(node.Literal)) {
+					if req := parseCurl(cmd); req != nil {
+						er := &exportedRequest{Folder: folder, Name: name, Request: req}
+						if er.Name == "" {
+							er.Name = req.method() + " " + req.URL
+						}
+						ret = append(ret, er)
+						last = append(last, er)
+					}
+				}
+			} else if lang == "json" && len(last) > 0 {
+				for _, er := range last {
+					if er.Response == "" {
+						er.Response = strings.TrimSpace(string(node.Literal))
+					}
+				}
+				last = nil
+			}
+		}
+	}
+	return ret
+}
+
+// shellCommands splits a shell script to commands, joining continued lines.
+// Lines starting with an option are taken as continued too, as samples
+// often omit trailing backslashes.
+func shellCommands(script string) []string {
+	var ret []string
+	var cur bytes.Buffer
+	for _, line := range strings.Split(script, "\n") {
+		if n := len(ret); n > 0 && cur.Len() == 0 && strings.HasPrefix(strings.TrimSpace(line), "-") {
+			ret[n-1] += " " + strings.TrimSpace(line)
+			continue
+		}
+		if strings.HasSuffix(line, "\\") {
+			cur.WriteString(strings.TrimSuffix(line, "\\"))
+			cur.WriteByte(' ')
+			continue
+		}
+		cur.WriteString(line)
+		if cmd := strings.TrimSpace(cur.String()); cmd != "" && !strings.HasPrefix(cmd, "#") {
+			ret = append(ret, cmd)
+		}
+		cur.Reset()
+	}
+	if cmd := strings.TrimSpace(cur.String()); cmd != "" {
+		ret = append(ret, cmd)
+	}
+	return ret
+}
+
+// shellWords splits a command to words following shell quoting rules;
+// words stop at unquoted ;, |, & and redirections
+func shellWords(cmd string) []string {
+	var words []string
+	var word bytes.Buffer
+	inWord := false
+	for i := 0; i < len(cmd); i++ {
+		c := cmd[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case c == ';' || c == '|' || c == '&' || c == '>' || c == '<':
+			if inWord {
+				words = append(words, word.String())
+			}
+			return words
+		case c == '\'':
+			inWord = true
+			j := strings.IndexByte(cmd[i+1:], '\'')
+			if j < 0 {
+				j = len(cmd) - i - 1
+			}
+			word.WriteString(cmd[i+1 : i+1+j])
+			i += j + 1
+		case c == '"':
+			inWord = true
+			for i++; i < len(cmd) && cmd[i] != '"'; i++ {
+				if cmd[i] == '\\' && i+1 < len(cmd) && strings.IndexByte("\\\"$`\n", cmd[i+1]) >= 0 {
+					i++
+				}
+				word.WriteByte(cmd[i])
+			}
+		case c == '\\' && i+1 < len(cmd):
+			inWord = true
+			i++
+			word.WriteByte(cmd[i])
+		default:
+			inWord = true
+			word.WriteByte(c)
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+// parseCurl converts a curl command line to a request, or returns
+// nil if the command is not a curl invocation
+func parseCurl(cmd string) *sampleRequest {
+	args := shellWords(cmd)
+	if len(args) == 0 || args[0] != "curl" {
+		return nil
+	}
+	req := &sampleRequest{}
+	var data []string
+	var form []string
+	get := false
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		value := func() string {
+			if i+1 < len(args) {
+				i++
+				return args[i]
+			}
+			return ""
+		}
+		switch {
+		case arg == "-X" || arg == "--request":
+			req.Method = strings.ToUpper(value())
+		case strings.HasPrefix(arg, "-X") && len(arg) > 2:
+			req.Method = strings.ToUpper(arg[2:])
+		case arg == "-H" || arg == "--header":
+			h := value()
+			if i := strings.IndexByte(h, ':'); i > 0 {
+				req.Headers = append(req.Headers, sampleHeader{strings.TrimSpace(h[:i]), strings.TrimSpace(h[i+1:])})
+			}
+		case arg == "-d" || arg == "--data" || arg == "--data-raw" || arg == "--data-binary" || arg == "--data-ascii":
+			data = append(data, value())
+		case arg == "--data-urlencode":
+			v := value()
+			if i := strings.IndexByte(v, '='); i >= 0 {
+				v = v[:i+1] + url.QueryEscape(v[i+1:])
+			}
+			data = append(data, v)
+		case arg == "--json":
+			data = append(data, value())
+			req.Headers = append(req.Headers, sampleHeader{"Content-Type", "application/json"}, sampleHeader{"Accept", "application/json"})
+		case arg == "-F" || arg == "--form":
+			form = append(form, value())
+		case arg == "-u" || arg == "--user":
+			req.Headers = append(req.Headers, sampleHeader{"Authorization", "Basic " + base64.StdEncoding.EncodeToString([]byte(value()))})
+		case arg == "-G" || arg == "--get":
+			get = true
+		case arg == "-I" || arg == "--head":
+			req.Method = "HEAD"
+		case arg == "--url":
+			req.URL = value()
+		case arg == "-A" || arg == "--user-agent" || arg == "-e" || arg == "--referer" ||
+			arg == "-o" || arg == "--output" || arg == "-b" || arg == "--cookie" || arg == "-w" || arg == "--write-out" ||
+			arg == "-m" || arg == "--max-time" || arg == "--connect-timeout" || arg == "--retry" || arg == "-x" || arg == "--proxy":
+			value()
+		case strings.HasPrefix(arg, "-"):
+		default:
+			if req.URL == "" {
+				req.URL = arg
+			}
+		}
+	}
+	if req.URL == "" {
+		return nil
+	}
+	if !strings.Contains(req.URL, "://") {
+		req.URL = "http://" + req.URL
+	}
+	switch {
+	case get && len(data) > 0:
+		sep := "?"
+		if strings.Contains(req.URL, "?") {
+			sep = "&"
+		}
+		req.URL += sep + strings.Join(data, "&")
+	case len(data) > 0:
+		req.Body = strings.Join(data, "&")
+		if req.Method == "" {
+			req.Method = "POST"
+		}
+		if req.header("Content-Type") == "" {
+			req.Headers = append(req.Headers, sampleHeader{"Content-Type", "application/x-www-form-urlencoded"})
+		}
+	case len(form) > 0:
+		req.Body = strings.Join(form, "&")
+		if req.Method == "" {
+			req.Method = "POST"
+		}
+		if req.header("Content-Type") == "" {
+			req.Headers = append(req.Headers, sampleHeader{"Content-Type", "multipart/form-data"})
+		}
+	}
+	req.Method = req.method()
+	return req
+}
+
+func (r *sampleRequest) header(name string) string {
+	for _, h := range r.Headers {
+		if strings.EqualFold(h.Name, name) {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+// jsonExample parses a JSON example, returning nil if it is not valid JSON
+func jsonExample(text string) interface{} {
+	v, err := parseJSON([]byte(text))
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+func postmanURL(raw string) yaml.MapSlice {
+	ret := yaml.MapSlice{{Key: "raw", Value: raw}}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ret
+	}
+	ret = append(ret,
+		yaml.MapItem{Key: "protocol", Value: u.Scheme},
+		yaml.MapItem{Key: "host", Value: strings.Split(u.Hostname(), ".")},
+	)
+	if port := u.Port(); port != "" {
+		ret = append(ret, yaml.MapItem{Key: "port", Value: port})
+	}
+	ret = append(ret, yaml.MapItem{Key: "path", Value: strings.Split(strings.Trim(u.Path, "/"), "/")})
+	if u.RawQuery != "" {
+		var query []interface{}
+		for _, kv := range strings.Split(u.RawQuery, "&") {
+			k, v := kv, ""
+			if i := strings.IndexByte(kv, '='); i >= 0 {
+				k, v = kv[:i], kv[i+1:]
+			}
+			query = append(query, yaml.MapSlice{{Key: "key", Value: k}, {Key: "value", Value: v}})
+		}
+		ret = append(ret, yaml.MapItem{Key: "query", Value: query})
+	}
+	return ret
+}
+
+func postmanHeaders(headers []sampleHeader) []interface{} {
+	ret := make([]interface{}, len(headers))
+	for i, h := range headers {
+		ret[i] = yaml.MapSlice{{Key: "key", Value: h.Name}, {Key: "value", Value: h.Value}}
+	}
+	return ret
+}
+
+// exportPostman builds a Postman v2.1 collection, with a folder per H1 section
+func exportPostman(title string, reqs []*exportedRequest) ([]byte, error) {
+	var items []interface{}
+	folders := make(map[string]int)
+	for _, er := range reqs {
+		r := er.Request
+		request := yaml.MapSlice{
+			{Key: "method", Value: r.Method},
+			{Key: "header", Value: postmanHeaders(r.Headers)},
+			{Key: "url", Value: postmanURL(r.URL)},
+		}
+		if r.Body != "" {
+			body := yaml.MapSlice{{Key: "mode", Value: "raw"}, {Key: "raw", Value: r.Body}}
+			if isJSONContentType(r.header("Content-Type")) {
+				body = append(body, yaml.MapItem{Key: "options", Value: yaml.MapSlice{{Key: "raw", Value: yaml.MapSlice{{Key: "language", Value: "json"}}}}})
+			}
+			request = append(request, yaml.MapItem{Key: "body", Value: body})
+		}
+		item := yaml.MapSlice{{Key: "name", Value: er.Name}, {Key: "request", Value: request}}
+		var responses []interface{}
+		if er.Response != "" {
+			responses = append(responses, yaml.MapSlice{
+				{Key: "name", Value: "Example"},
+				{Key: "originalRequest", Value: request},
+				{Key: "status", Value: "OK"},
+				{Key: "code", Value: 200},
+				{Key: "_postman_previewlanguage", Value: "json"},
+				{Key: "header", Value: postmanHeaders([]sampleHeader{{"Content-Type", "application/json"}})},
+				{Key: "body", Value: er.Response},
+			})
+		}
+		item = append(item, yaml.MapItem{Key: "response", Value: responses})
+		if er.Folder == "" {
+			items = append(items, item)
+			continue
+		}
+		n, ok := folders[er.Folder]
+		if !ok {
+			n = len(items)
+			folders[er.Folder] = n
+			items = append(items, yaml.MapSlice{{Key: "name", Value: er.Folder}, {Key: "item", Value: []interface{}{}}})
+		}
+		folder := items[n].(yaml.MapSlice)
+		folder[1].Value = append(folder[1].Value.([]interface{}), item)
+	}
+	return marshalJSON(jsonValue(yaml.MapSlice{
+		{Key: "info", Value: yaml.MapSlice{
+			{Key: "name", Value: title},
+			{Key: "schema", Value: "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},
+		}},
+		{Key: "item", Value: items},
+	}), "  ")
+}
+
+// exportOpenAPI builds a minimal OpenAPI 3 document with an operation per request
+func exportOpenAPI(title string, reqs []*exportedRequest) ([]byte, error) {
+	var servers []string
+	paths := yaml.MapSlice{}
+	pathIndex := make(map[string]int)
+	var tags []string
+	for _, er := range reqs {
+		r := er.Request
+		u, err := url.Parse(r.URL)
+		if err != nil {
+			continue
+		}
+		server := u.Scheme + "://" + u.Host
+		found := false
+		for _, s := range servers {
+			found = found || s == server
+		}
+		if !found {
+			servers = append(servers, server)
+		}
+		var params []interface{}
+		segments := strings.Split(u.Path, "/")
+		for i, seg := range segments {
+			if strings.HasPrefix(seg, ":") {
+				seg = "{" + seg[1:] + "}"
+				segments[i] = seg
+			}
+			if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+				params = append(params, yaml.MapSlice{
+					{Key: "name", Value: seg[1 : len(seg)-1]},
+					{Key: "in", Value: "path"},
+					{Key: "required", Value: true},
+					{Key: "schema", Value: yaml.MapSlice{{Key: "type", Value: "string"}}},
+				})
+			}
+		}
+		path := strings.Join(segments, "/")
+		if path == "" {
+			path = "/"
+		}
+		op := yaml.MapSlice{{Key: "summary", Value: er.Name}}
+		if er.Folder != "" {
+			op = append(op, yaml.MapItem{Key: "tags", Value: []string{er.Folder}})
+			found := false
+			for _, t := range tags {
+				found = found || t == er.Folder
+			}
+			if !found {
+				tags = append(tags, er.Folder)
+			}
+		}
+		query := u.Query()
+		keys := make([]string, 0, len(query))
+		for k := range query {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			params = append(params, yaml.MapSlice{
+				{Key: "name", Value: k},
+				{Key: "in", Value: "query"},
+				{Key: "schema", Value: yaml.MapSlice{{Key: "type", Value: "string"}}},
+				{Key: "example", Value: query.Get(k)},
+			})
+		}
+		for _, h := range r.Headers {
+			switch strings.ToLower(h.Name) {
+			case "accept", "content-type", "authorization":
+				continue
+			}
+			params = append(params, yaml.MapSlice{
+				{Key: "name", Value: h.Name},
+				{Key: "in", Value: "header"},
+				{Key: "schema", Value: yaml.MapSlice{{Key: "type", Value: "string"}}},
+				{Key: "example", Value: h.Value},
+			})
+		}
+		if len(params) > 0 {
+			op = append(op, yaml.MapItem{Key: "parameters", Value: params})
+		}
+		if r.Body != "" {
+			ct := r.header("Content-Type")
+			if i := strings.IndexByte(ct, ';'); i >= 0 {
+				ct = ct[:i]
+			}
+			var example interface{} = r.Body
+			if isJSONContentType(ct) {
+				if v := jsonExample(r.Body); v != nil {
+					example = v
+				}
+			}
+			op = append(op, yaml.MapItem{Key: "requestBody", Value: yaml.MapSlice{
+				{Key: "content", Value: yaml.MapSlice{{Key: ct, Value: yaml.MapSlice{{Key: "example", Value: example}}}}},
+			}})
+		}
+		response := yaml.MapSlice{{Key: "description", Value: "Successful response"}}
+		if v := jsonExample(er.Response); v != nil {
+			response = append(response, yaml.MapItem{Key: "content", Value: yaml.MapSlice{
+				{Key: "application/json", Value: yaml.MapSlice{{Key: "example", Value: v}}},
+			}})
+		}
+		op = append(op, yaml.MapItem{Key: "responses", Value: yaml.MapSlice{{Key: "200", Value: response}}})
+		n, ok := pathIndex[path]
+		if !ok {
+			n = len(paths)
+			pathIndex[path] = n
+			paths = append(paths, yaml.MapItem{Key: path, Value: yaml.MapSlice{}})
+		}
+		item := paths[n].Value.(yaml.MapSlice)
+		method := strings.ToLower(r.Method)
+		if specGet(item, method) == nil {
+			paths[n].Value = append(item, yaml.MapItem{Key: method, Value: op})
+		}
+	}
+	doc := yaml.MapSlice{
+		{Key: "openapi", Value: "3.0.3"},
+		{Key: "info", Value: yaml.MapSlice{{Key: "title", Value: title}, {Key: "version", Value: "1.0.0"}}},
+	}
+	if len(servers) > 0 {
+		list := make([]interface{}, len(servers))
+		for i, s := range servers {
+			list[i] = yaml.MapSlice{{Key: "url", Value: s}}
+		}
+		doc = append(doc, yaml.MapItem{Key: "servers", Value: list})
+	}
+	if len(tags) > 0 {
+		list := make([]interface{}, len(tags))
+		for i, t := range tags {
+			list[i] = yaml.MapSlice{{Key: "name", Value: t}}
+		}
+		doc = append(doc, yaml.MapItem{Key: "tags", Value: list})
+	}
+	doc = append(doc, yaml.MapItem{Key: "paths", Value: paths})
+	return marshalJSON(jsonValue(doc), "  ")
+}
+
+// exportFiles builds the Postman collection and OpenAPI document
+// files from the shell code samples of the document
+func exportFiles(title string, ast *blackfriday.Node) ([]importedFile, error) {
+	reqs := exportRequests(ast)
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+	if title == "" {
+		title = "API Reference"
+	}
+	collection, err := exportPostman(title, reqs)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting Postman collection: %s", err)
+	}
+	openapi, err := exportOpenAPI(title, reqs)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting OpenAPI document: %s", err)
+	}
+	return []importedFile{
+		{name: "collection.json", data: collection},
+		{name: "openapi.json", data: openapi},
+	}, nil
+}
@@ -0,0 +1,187 @@
+package slate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseCurl(t *testing.T) {
+	tests := []struct {
+		script  string
+		method  string
+		url     string
+		headers []sampleHeader
+		body    string
+	}{
+		{
+			// continued lines without backslashes, as in the Kittn docs
+			"curl \"http://example.com/api/kittens/2\"\n  -X DELETE\n  -H \"Authorization: meowmeowmeow\"\n",
+			"DELETE", "http://example.com/api/kittens/2",
+			[]sampleHeader{{"Authorization", "meowmeowmeow"}}, "",
+		},
+		{
+			"curl -X POST https://api.example.com/kittens \\\n  -H 'Content-Type: application/json' \\\n  -d '{\"name\": \"Max\"}'\n",
+			"POST", "https://api.example.com/kittens",
+			[]sampleHeader{{"Content-Type", "application/json"}}, `{"name": "Max"}`,
+		},
+		{
+			"curl api.example.com/kittens -d name=Max -d breed=calico\n",
+			"POST", "http://api.example.com/kittens",
+			[]sampleHeader{{"Content-Type", "application/x-www-form-urlencoded"}}, "name=Max&breed=calico",
+		},
+		{
+			"curl -G https://api.example.com/kittens?limit=5 --data-urlencode 'q=fluffy cat'\n",
+			"GET", "https://api.example.com/kittens?limit=5&q=fluffy+cat", nil, "",
+		},
+		{
+			"curl -F photo=@max.jpg -F name=Max https://api.example.com/kittens/2/photos\n",
+			"POST", "https://api.example.com/kittens/2/photos",
+			[]sampleHeader{{"Content-Type", "multipart/form-data"}}, "photo=@max.jpg&name=Max",
+		},
+	}
+	for _, test := range tests {
+		cmds := shellCommands(test.script)
+		if len(cmds) != 1 {
+			t.Errorf("%q: expected a single command, got %q", test.script, cmds)
+			continue
+		}
+		req := parseCurl(cmds[0])
+		if req == nil {
+			t.Errorf("%q: not taken for a curl command", test.script)
+			continue
+		}
+		if req.Method != test.method || req.URL != test.url || req.Body != test.body || len(req.Headers) != len(test.headers) {
+			t.Errorf("%q: unexpected request %+v", test.script, req)
+			continue
+		}
+		for i, h := range test.headers {
+			if req.Headers[i] != h {
+				t.Errorf("%q: expected header %v, got %v", test.script, h, req.Headers[i])
+			}
+		}
+	}
+	if req := parseCurl("echo curl http://example.com"); req != nil {
+		t.Errorf("expected no request of echo, got %+v", req)
+	}
+}
+
+func TestExportPathParameters(t *testing.T) {
+	reqs := []*exportedRequest{
+		{Folder: "Kittens", Name: "Get a Kitten", Request: &sampleRequest{Method: "GET", URL: "https://api.example.com/kittens/:id"}},
+		{Folder: "Kittens", Name: "Get a Photo", Request: &sampleRequest{Method: "GET", URL: "https://api.example.com/kittens/{id}/photos/{photo}"}},
+	}
+	data, err := exportOpenAPI("Kittn", reqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Paths map[string]map[string]struct {
+			Parameters []struct{ Name, In string }
+		}
+	}
+	if err = json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if params := doc.Paths["/kittens/{id}"]["get"].Parameters; len(params) != 1 || params[0].Name != "id" || params[0].In != "path" {
+		t.Errorf("expected path parameter id of /kittens/{id}, got %+v", params)
+	}
+	if params := doc.Paths["/kittens/{id}/photos/{photo}"]["get"].Parameters; len(params) != 2 || params[1].Name != "photo" {
+		t.Errorf("expected path parameters id and photo, got %+v", params)
+	}
+}
+
+func TestExportKittn(t *testing.T) {
+	// no source files, the document is the embedded Kittn one
+	export := true
+	doc, err := loadTestDoc(t, nil, Params{Export: &export})
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string][]byte)
+	for _, f := range doc.exports {
+		files[f.name] = f.data
+	}
+	var collection struct {
+		Item []struct {
+			Name string
+			Item []struct {
+				Name    string
+				Request struct {
+					Method string
+					Header []struct{ Key, Value string }
+					URL    struct{ Raw string }
+				}
+				Response []struct{ Body string }
+			}
+		}
+	}
+	if err = json.Unmarshal(files["collection.json"], &collection); err != nil {
+		t.Fatalf("collection.json: %s", err)
+	}
+	var kittens []string
+	for _, folder := range collection.Item {
+		if folder.Name != "Kittens" {
+			continue
+		}
+		for _, item := range folder.Item {
+			r := item.Request
+			kittens = append(kittens, item.Name+": "+r.Method+" "+r.URL.Raw)
+			if len(r.Header) != 1 || r.Header[0].Key != "Authorization" || r.Header[0].Value != "meowmeowmeow" {
+				t.Errorf("%s: expected the Authorization header, got %+v", item.Name, r.Header)
+			}
+			if len(item.Response) != 1 || item.Response[0].Body == "" {
+				t.Errorf("%s: expected a response example", item.Name)
+			}
+		}
+	}
+	expected := []string{
+		"Get All Kittens: GET http://example.com/api/kittens",
+		"Get a Specific Kitten: GET http://example.com/api/kittens/2",
+		"Delete a Specific Kitten: DELETE http://example.com/api/kittens/2",
+	}
+	if len(kittens) != len(expected) {
+		t.Fatalf("expected requests %q in folder Kittens, got %q", expected, kittens)
+	}
+	for i := range expected {
+		if kittens[i] != expected[i] {
+			t.Errorf("expected request %q, got %q", expected[i], kittens[i])
+		}
+	}
+	var openapi struct {
+		Servers []struct{ URL string }
+		Paths   map[string]map[string]struct {
+			Summary   string
+			Tags      []string
+			Responses map[string]struct {
+				Content map[string]struct{ Example interface{} }
+			}
+		}
+	}
+	if err = json.Unmarshal(files["openapi.json"], &openapi); err != nil {
+		t.Fatalf("openapi.json: %s", err)
+	}
+	found := false
+	for _, s := range openapi.Servers {
+		found = found || s.URL == "http://example.com"
+	}
+	if !found {
+		t.Errorf("expected server http://example.com, got %+v", openapi.Servers)
+	}
+	for _, op := range []struct{ path, method, summary string }{
+		{"/api/kittens", "get", "Get All Kittens"},
+		{"/api/kittens/2", "get", "Get a Specific Kitten"},
+		{"/api/kittens/2", "delete", "Delete a Specific Kitten"},
+	} {
+		o, ok := openapi.Paths[op.path][op.method]
+		if !ok {
+			t.Errorf("%s %s is missing", op.method, op.path)
+			continue
+		}
+		if o.Summary != op.summary || len(o.Tags) != 1 || o.Tags[0] != "Kittens" {
+			t.Errorf("%s %s: unexpected summary %q or tags %q", op.method, op.path, o.Summary, o.Tags)
+		}
+		if o.Responses["200"].Content["application/json"].Example == nil {
+			t.Errorf("%s %s: expected a response example", op.method, op.path)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+)
+
+// gitFS is a read-only file system holding a source directory as it is
+// at a git ref, over the embedded Slate files, like slate.NewUnionFS
+type gitFS struct {
+	repo  string // repository work tree
+	mtime time.Time
+	files map[string]*gitFileInfo
+	dirs  map[string][]os.FileInfo
+}
+
+type gitFileInfo struct {
+	name   string
+	object string
+	size   int64
+	dir    bool
+	mtime  time.Time
+}
+
+func (fi *gitFileInfo) Name() string       { return fi.name }
+func (fi *gitFileInfo) Size() int64        { return fi.size }
+func (fi *gitFileInfo) ModTime() time.Time { return fi.mtime }
+func (fi *gitFileInfo) IsDir() bool        { return fi.dir }
+func (fi *gitFileInfo) Sys() interface{}   { return nil }
+func (fi *gitFileInfo) Mode() os.FileMode {
+	if fi.dir {
+		return os.ModeDir | 0555
+	}
+	return 0444
+}
+
+// git runs a git command in the dir and returns its output
+func git(dir string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return nil, fmt.Errorf("git %s: %s", args[0], err)
+	}
+	return out, nil
+}
+
+// newGitFS returns the file system of the source directory src, which must
+// be located within a git work tree, at the ref
+func newGitFS(src, ref string) (slate.FileSystem, error) {
+	return readGitTree(src, ref)
+}
+
+// readGitTree reads the tree of the directory src at the ref
+func readGitTree(src, ref string) (*gitFS, error) {
+	out, err := git(src, "rev-parse", "--show-toplevel", "--show-prefix")
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	fs := &gitFS{
+		repo:  lines[0],
+		files: make(map[string]*gitFileInfo),
+		dirs:  make(map[string][]os.FileInfo),
+	}
+	prefix := ""
+	if len(lines) > 1 {
+		prefix = lines[1]
+	}
+	out, err = git(fs.repo, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		return nil, fmt.Errorf("unknown git ref %s", ref)
+	}
+	commit := strings.TrimSpace(string(out))
+	if out, err = git(fs.repo, "show", "-s", "--format=%ct", commit); err != nil {
+		return nil, err
+	}
+	ts, _ := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	fs.mtime = time.Unix(ts, 0)
+	args := []string{"ls-tree", "-r", "-l", "-z", "--full-tree", commit}
+	if prefix != "" {
+		args = append(args, "--", prefix)
+	}
+	if out, err = git(fs.repo, args...); err != nil {
+		return nil, err
+	}
+	for _, entry := range strings.Split(string(out), "\x00") {
+		// <mode> SP <type> SP <object> SP <size> TAB <path>
+		tab := strings.IndexByte(entry, '\t')
+		if tab < 0 {
+			continue
+		}
+		fields := strings.Fields(entry[:tab])
+		if len(fields) != 4 || fields[1] != "blob" || fields[0] == "120000" {
+			continue
+		}
+		name := strings.TrimPrefix(entry[tab+1:], prefix)
+		size, _ := strconv.ParseInt(fields[3], 10, 64)
+		fs.add(name, &gitFileInfo{name: path.Base(name), object: fields[2], size: size, mtime: fs.mtime})
+	}
+	if _, ok := fs.dirs[""]; !ok {
+		return nil, fmt.Errorf("%s does not exist at git ref %s", src, ref)
+	}
+	return fs, nil
+}
+
+// add adds a file and its parent directories
+func (fs *gitFS) add(name string, fi *gitFileInfo) {
+	fs.files[name] = fi
+	dir := path.Dir(name)
+	if dir == "." {
+		dir = ""
+	}
+	if _, ok := fs.dirs[dir]; !ok && dir != "" {
+		fs.add(dir, &gitFileInfo{name: path.Base(dir), dir: true, mtime: fs.mtime})
+	}
+	fs.dirs[dir] = append(fs.dirs[dir], fi)
+}
+
+func gitCleanPath(name string) string {
+	name = path.Clean("/" + filepath.ToSlash(name))
+	return strings.TrimPrefix(name, "/")
+}
+
+func (fs *gitFS) Stat(name string) (os.FileInfo, error) {
+	name = gitCleanPath(name)
+	if name == "" {
+		return &gitFileInfo{name: ".", dir: true, mtime: fs.mtime}, nil
+	}
+	if fi, ok := fs.files[name]; ok {
+		return fi, nil
+	}
+	return slate.FS().Stat(name)
+}
+
+func (fs *gitFS) Open(name string) (slate.File, error) {
+	name = gitCleanPath(name)
+	fi, ok := fs.files[name]
+	if name == "" || ok && fi.dir {
+		return fs.openDir(name)
+	} else if !ok {
+		return slate.FS().Open(name)
+	}
+	data, err := git(fs.repo, "cat-file", "blob", fi.object)
+	if err != nil {
+		return nil, err
+	}
+	return &gitFile{Reader: bytes.NewReader(data), info: fi}, nil
+}
+
+// openDir returns a directory listing files at the ref along with
+// the embedded files which are not overridden
+func (fs *gitFS) openDir(name string) (slate.File, error) {
+	info, _ := fs.Stat(name)
+	entries := append([]os.FileInfo(nil), fs.dirs[name]...)
+	if dir, err := slate.FS().Open(name); err == nil {
+		embedded, _ := dir.Readdir(-1)
+		dir.Close()
+		for _, fi := range embedded {
+			if _, ok := fs.files[path.Join(name, fi.Name())]; !ok {
+				entries = append(entries, fi)
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return &gitDir{info: info, entries: entries}, nil
+}
+
+func (fs *gitFS) Walk(root string, walkFunc filepath.WalkFunc) error {
+	root = gitCleanPath(root)
+	info, err := fs.Stat(root)
+	if err != nil {
+		return walkFunc(root, nil, err)
+	}
+	return fs.walk(root, info, walkFunc)
+}
+
+func (fs *gitFS) walk(name string, info os.FileInfo, walkFunc filepath.WalkFunc) error {
+	err := walkFunc(name, info, nil)
+	if err != nil || !info.IsDir() {
+		if err == filepath.SkipDir && info.IsDir() {
+			return nil
+		}
+		return err
+	}
+	dir, err := fs.Open(name)
+	if err != nil {
+		return walkFunc(name, info, err)
+	}
+	entries, err := dir.Readdir(-1)
+	dir.Close()
+	if err != nil {
+		return walkFunc(name, info, err)
+	}
+	for _, fi := range entries {
+		if err = fs.walk(path.Join(name, fi.Name()), fi, walkFunc); err != nil && err != filepath.SkipDir {
+			return err
+		}
+	}
+	return nil
+}
+
+// gitDisk reads include paths within the git work tree as they are at a git ref
+type gitDisk struct {
+	tree *gitFS // the whole work tree
+	repo string // the work tree with symlinks resolved
+}
+
+// newGitDisk returns include paths of a source directory read at the ref, failing
+// if some of the paths is outside of the git work tree
+func newGitDisk(src, ref string, paths []string) (*gitDisk, error) {
+	out, err := git(src, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	tree, err := readGitTree(strings.TrimSpace(string(out)), ref)
+	if err != nil {
+		return nil, err
+	}
+	d := &gitDisk{tree: tree, repo: realPath(tree.repo)}
+	for _, p := range paths {
+		if _, ok := d.rel(p); !ok {
+			return nil, fmt.Errorf("include path %s is outside of the git work tree %s, it can't be read at git ref %s", p, tree.repo, ref)
+		}
+	}
+	return d, nil
+}
+
+// realPath returns the absolute path with symlinks resolved, if it exists
+func realPath(name string) string {
+	if abs, err := filepath.Abs(name); err == nil {
+		name = abs
+	}
+	if real, err := filepath.EvalSymlinks(name); err == nil {
+		name = real
+	}
+	return name
+}
+
+// rel returns the path of a file relative to the work tree
+func (d *gitDisk) rel(name string) (string, bool) {
+	rel, err := filepath.Rel(d.repo, realPath(name))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return gitCleanPath(rel), true
+}
+
+func (d *gitDisk) Stat(name string) (os.FileInfo, error) {
+	rel, ok := d.rel(name)
+	switch fi := d.tree.files[rel]; {
+	case ok && rel == "":
+		return &gitFileInfo{name: ".", dir: true, mtime: d.tree.mtime}, nil
+	case ok && fi != nil:
+		return fi, nil
+	}
+	return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+}
+
+func (d *gitDisk) ReadFile(name string) ([]byte, error) {
+	fi, err := d.Stat(name)
+	if err != nil {
+		return nil, err
+	} else if fi.IsDir() {
+		return nil, &os.PathError{Op: "read", Path: name, Err: os.ErrInvalid}
+	}
+	return git(d.tree.repo, "cat-file", "blob", fi.(*gitFileInfo).object)
+}
+
+func (d *gitDisk) ReadDir(name string) ([]os.FileInfo, error) {
+	rel, ok := d.rel(name)
+	if fi, err := d.Stat(name); err != nil || !ok || !fi.IsDir() {
+		return nil, &os.PathError{Op: "readdir", Path: name, Err: os.ErrNotExist}
+	}
+	entries := append([]os.FileInfo(nil), d.tree.dirs[rel]...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+type gitFile struct {
+	*bytes.Reader
+	info *gitFileInfo
+}
+
+func (f *gitFile) Close() error                             { return nil }
+func (f *gitFile) Stat() (os.FileInfo, error)               { return f.info, nil }
+func (f *gitFile) Readdir(count int) ([]os.FileInfo, error) { return nil, os.ErrInvalid }
+
+type gitDir struct {
+	info    os.FileInfo
+	entries []os.FileInfo
+}
+
+func (d *gitDir) Close() error                                 { return nil }
+func (d *gitDir) Read([]byte) (int, error)                     { return 0, io.EOF }
+func (d *gitDir) Seek(offset int64, whence int) (int64, error) { return 0, os.ErrInvalid }
+func (d *gitDir) Stat() (os.FileInfo, error)                   { return d.info, nil }
+func (d *gitDir) Readdir(count int) ([]os.FileInfo, error) {
+	if count <= 0 {
+		ret := d.entries
+		d.entries = nil
+		return ret, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(d.entries) {
+		count = len(d.entries)
+	}
+	ret := d.entries[:count]
+	d.entries = d.entries[count:]
+	return ret, nil
+}
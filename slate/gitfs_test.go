@@ -0,0 +1,70 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGitRefIncludePaths(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	repo, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repo)
+	write := func(name, text string) {
+		name = filepath.Join(repo, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	run := func(args ...string) {
+		args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+		if _, err := git(repo, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("docs/index.html.md", "---\ninclude_paths: [../shared]\nincludes: [errors]\n---\n\n# Intro\n")
+	write("shared/_errors.md", "# Errors\n\nOld errors.\n")
+	run("init", "-q")
+	run("add", "-A")
+	run("commit", "-q", "-m", "v1")
+	run("tag", "v1")
+	write("shared/_errors.md", "# Errors\n\nNew errors.\n")
+
+	src := filepath.Join(repo, "docs")
+	fs, err := newGitFS(src, "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := load(fs, Params{}, placement{Source: src, Ref: "v1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var html strings.Builder
+	for _, p := range doc.pages {
+		html.Write(p.html)
+	}
+	if !strings.Contains(html.String(), "Old errors.") {
+		t.Errorf("expected the include path read at the ref:\n%s", html.String())
+	}
+
+	outside, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outside)
+	_, err = load(fs, Params{IncludePaths: []string{outside}}, placement{Source: src, Ref: "v1"})
+	if err == nil || !strings.Contains(err.Error(), "outside of the git work tree") {
+		t.Errorf("expected an error for an include path outside of the work tree, got %v", err)
+	}
+}
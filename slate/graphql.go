@@ -0,0 +1,867 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// gqlSchema is a GraphQL schema parsed from SDL
+type gqlSchema struct {
+	Description  string
+	Query        string
+	Mutation     string
+	Subscription string
+	Types        []*gqlType
+	byName       map[string]*gqlType
+}
+
+type gqlType struct {
+	Kind        string // type, interface, input, enum, union or scalar
+	Name        string
+	Description string
+	Interfaces  []string
+	Fields      []*gqlField
+	Values      []*gqlField // enum values
+	Members     []string    // union members
+}
+
+// gqlField is a field, an argument, an input field or an enum value
+type gqlField struct {
+	Name              string
+	Description       string
+	Type              string // type reference, e.g. [Kitten!]!
+	Default           string
+	Args              []*gqlField
+	Deprecated        bool
+	DeprecationReason string
+}
+
+var gqlBuiltinScalars = map[string]bool{"Int": true, "Float": true, "String": true, "Boolean": true, "ID": true}
+
+// gqlNamedType strips list and non-null wrappers from a type reference
+func gqlNamedType(ref string) string {
+	return strings.Trim(ref, "[]!")
+}
+
+func gqlTypeAnchor(name string) string {
+	return "type-" + strings.ToLower(name)
+}
+
+type gqlToken struct {
+	kind byte // 'n' for names, 's' for strings, 'v' for numbers, otherwise punctuator
+	val  string
+	line int
+}
+
+func gqlTokenize(src []byte) ([]gqlToken, error) {
+	var toks []gqlToken
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == ',' || c == 0xef || c == 0xbb || c == 0xbf:
+			i++
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			j := i
+			for j < len(src) && (src[j] == '_' || src[j] >= 'a' && src[j] <= 'z' || src[j] >= 'A' && src[j] <= 'Z' || src[j] >= '0' && src[j] <= '9') {
+				j++
+			}
+			toks = append(toks, gqlToken{'n', string(src[i:j]), line})
+			i = j
+		case c == '-' || c >= '0' && c <= '9':
+			j := i + 1
+			for j < len(src) && (src[j] >= '0' && src[j] <= '9' || src[j] == '.' || src[j] == 'e' || src[j] == 'E' || src[j] == '+' || src[j] == '-') {
+				j++
+			}
+			toks = append(toks, gqlToken{'v', string(src[i:j]), line})
+			i = j
+		case bytes.HasPrefix(src[i:], []byte(`"""`)):
+			end := bytes.Index(src[i+3:], []byte(`"""`))
+			for end >= 0 && src[i+3+end-1] == '\\' {
+				next := bytes.Index(src[i+3+end+3:], []byte(`"""`))
+				if next < 0 {
+					end = -1
+					break
+				}
+				end += 3 + next
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated block string", line)
+			}
+			raw := string(src[i+3 : i+3+end])
+			toks = append(toks, gqlToken{'s', gqlBlockString(strings.Replace(raw, `\"""`, `"""`, -1)), line})
+			line += strings.Count(raw, "\n")
+			i += end + 6
+		case c == '"':
+			j := i + 1
+			var buf bytes.Buffer
+			for ; j < len(src) && src[j] != '"'; j++ {
+				if src[j] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated string", line)
+				}
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+					switch src[j] {
+					case 'n':
+						buf.WriteByte('\n')
+					case 't':
+						buf.WriteByte('\t')
+					default:
+						buf.WriteByte(src[j])
+					}
+					continue
+				}
+				buf.WriteByte(src[j])
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			toks = append(toks, gqlToken{'s', buf.String(), line})
+			i = j + 1
+		case bytes.HasPrefix(src[i:], []byte("...")):
+			toks = append(toks, gqlToken{'.', "...", line})
+			i += 3
+		case strings.IndexByte("!$()&:=@[]{}|", c) >= 0:
+			toks = append(toks, gqlToken{c, string(c), line})
+			i++
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	return toks, nil
+}
+
+// gqlBlockString removes common indentation and leading and
+// trailing blank lines of a block string
+func gqlBlockString(raw string) string {
+	lines := strings.Split(raw, "\n")
+	indent := -1
+	for _, l := range lines[1:] {
+		trimmed := strings.TrimLeft(l, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if n := len(l) - len(trimmed); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	for i := 1; i < len(lines) && indent > 0; i++ {
+		if len(lines[i]) >= indent {
+			lines[i] = lines[i][indent:]
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+type gqlParser struct {
+	toks []gqlToken
+	pos  int
+}
+
+func (p *gqlParser) peek() gqlToken {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return gqlToken{}
+}
+
+func (p *gqlParser) next() gqlToken {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *gqlParser) is(kind byte) bool {
+	return p.peek().kind == kind
+}
+
+func (p *gqlParser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if p.pos < len(p.toks) {
+		line = p.toks[p.pos].line
+	} else if len(p.toks) > 0 {
+		line = p.toks[len(p.toks)-1].line
+	}
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *gqlParser) expect(kind byte) (gqlToken, error) {
+	t := p.peek()
+	if t.kind != kind {
+		if t.kind == 0 {
+			return t, p.errorf("unexpected end of schema")
+		}
+		return t, p.errorf("unexpected %q", t.val)
+	}
+	p.pos++
+	return t, nil
+}
+
+func (p *gqlParser) name() (string, error) {
+	t, err := p.expect('n')
+	return t.val, err
+}
+
+func (p *gqlParser) description() string {
+	if p.is('s') {
+		return p.next().val
+	}
+	return ""
+}
+
+// parseGraphQLSchema parses GraphQL schema definition language
+func parseGraphQLSchema(src []byte) (*gqlSchema, error) {
+	toks, err := gqlTokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &gqlParser{toks: toks}
+	s := &gqlSchema{byName: make(map[string]*gqlType)}
+	for p.pos < len(p.toks) {
+		desc := p.description()
+		kw, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		extend := kw == "extend"
+		if extend {
+			if kw, err = p.name(); err != nil {
+				return nil, err
+			}
+		}
+		switch kw {
+		case "schema":
+			if desc != "" {
+				s.Description = desc
+			}
+			if _, err = p.directives(); err != nil {
+				return nil, err
+			}
+			if _, err = p.expect('{'); err != nil {
+				return nil, err
+			}
+			for !p.is('}') {
+				op, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+				if _, err = p.expect(':'); err != nil {
+					return nil, err
+				}
+				typ, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+				switch op {
+				case "query":
+					s.Query = typ
+				case "mutation":
+					s.Mutation = typ
+				case "subscription":
+					s.Subscription = typ
+				}
+			}
+			p.next()
+		case "directive":
+			if err = p.directiveDefinition(); err != nil {
+				return nil, err
+			}
+		case "scalar", "type", "interface", "input", "enum", "union":
+			t, err := p.typeDefinition(kw, desc)
+			if err != nil {
+				return nil, err
+			}
+			if prev, ok := s.byName[t.Name]; ok && extend {
+				prev.Interfaces = append(prev.Interfaces, t.Interfaces...)
+				prev.Fields = append(prev.Fields, t.Fields...)
+				prev.Values = append(prev.Values, t.Values...)
+				prev.Members = append(prev.Members, t.Members...)
+			} else if ok {
+				return nil, fmt.Errorf("type %s is defined twice", t.Name)
+			} else {
+				s.byName[t.Name] = t
+				s.Types = append(s.Types, t)
+			}
+		default:
+			p.pos--
+			return nil, p.errorf("unexpected %q", kw)
+		}
+	}
+	for _, root := range []struct {
+		name *string
+		def  string
+	}{{&s.Query, "Query"}, {&s.Mutation, "Mutation"}, {&s.Subscription, "Subscription"}} {
+		if *root.name == "" && s.byName[root.def] != nil {
+			*root.name = root.def
+		}
+	}
+	return s, nil
+}
+
+func (p *gqlParser) typeDefinition(kind, desc string) (*gqlType, error) {
+	var err error
+	t := &gqlType{Kind: kind, Description: desc}
+	if t.Name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if p.peek().kind == 'n' && p.peek().val == "implements" {
+		p.next()
+		if p.is('&') {
+			p.next()
+		}
+		for {
+			iface, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			t.Interfaces = append(t.Interfaces, iface)
+			if !p.is('&') {
+				break
+			}
+			p.next()
+		}
+	}
+	if _, err = p.directives(); err != nil {
+		return nil, err
+	}
+	switch kind {
+	case "union":
+		if p.is('=') {
+			p.next()
+			if p.is('|') {
+				p.next()
+			}
+			for {
+				m, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+				t.Members = append(t.Members, m)
+				if !p.is('|') {
+					break
+				}
+				p.next()
+			}
+		}
+	case "enum":
+		if p.is('{') {
+			p.next()
+			for !p.is('}') {
+				v := &gqlField{Description: p.description()}
+				if v.Name, err = p.name(); err != nil {
+					return nil, err
+				}
+				if err = p.deprecation(v); err != nil {
+					return nil, err
+				}
+				t.Values = append(t.Values, v)
+			}
+			p.next()
+		}
+	case "type", "interface", "input":
+		if p.is('{') {
+			p.next()
+			for !p.is('}') {
+				f, err := p.field(kind != "input")
+				if err != nil {
+					return nil, err
+				}
+				t.Fields = append(t.Fields, f)
+			}
+			p.next()
+		}
+	}
+	return t, nil
+}
+
+// field parses a field or an input value definition
+func (p *gqlParser) field(withArgs bool) (*gqlField, error) {
+	var err error
+	f := &gqlField{Description: p.description()}
+	if f.Name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if withArgs && p.is('(') {
+		p.next()
+		for !p.is(')') {
+			arg, err := p.field(false)
+			if err != nil {
+				return nil, err
+			}
+			f.Args = append(f.Args, arg)
+		}
+		p.next()
+	}
+	if _, err = p.expect(':'); err != nil {
+		return nil, err
+	}
+	if f.Type, err = p.typeRef(); err != nil {
+		return nil, err
+	}
+	if p.is('=') {
+		p.next()
+		if f.Default, err = p.value(); err != nil {
+			return nil, err
+		}
+	}
+	return f, p.deprecation(f)
+}
+
+func (p *gqlParser) typeRef() (string, error) {
+	var ret string
+	if p.is('[') {
+		p.next()
+		inner, err := p.typeRef()
+		if err != nil {
+			return "", err
+		}
+		if _, err = p.expect(']'); err != nil {
+			return "", err
+		}
+		ret = "[" + inner + "]"
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return "", err
+		}
+		ret = name
+	}
+	if p.is('!') {
+		p.next()
+		ret += "!"
+	}
+	return ret, nil
+}
+
+// value parses a constant value and returns its GraphQL representation
+func (p *gqlParser) value() (string, error) {
+	t := p.next()
+	switch t.kind {
+	case 'n', 'v':
+		return t.val, nil
+	case 's':
+		return fmt.Sprintf("%q", t.val), nil
+	case '$':
+		name, err := p.name()
+		return "$" + name, err
+	case '[', '{':
+		closing := byte(']')
+		if t.kind == '{' {
+			closing = '}'
+		}
+		var parts []string
+		for !p.is(closing) {
+			var item string
+			if t.kind == '{' {
+				key, err := p.name()
+				if err != nil {
+					return "", err
+				}
+				if _, err = p.expect(':'); err != nil {
+					return "", err
+				}
+				item = key + ": "
+			}
+			v, err := p.value()
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, item+v)
+		}
+		p.next()
+		return string(t.kind) + strings.Join(parts, ", ") + string(closing), nil
+	}
+	p.pos--
+	return "", p.errorf("unexpected %q", t.val)
+}
+
+// directives parses a list of directives and returns their arguments by directive name
+func (p *gqlParser) directives() (map[string]map[string]string, error) {
+	var ret map[string]map[string]string
+	for p.is('@') {
+		p.next()
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		args := make(map[string]string)
+		if p.is('(') {
+			p.next()
+			for !p.is(')') {
+				arg, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+				if _, err = p.expect(':'); err != nil {
+					return nil, err
+				}
+				if p.is('s') {
+					args[arg] = p.next().val
+				} else if args[arg], err = p.value(); err != nil {
+					return nil, err
+				}
+			}
+			p.next()
+		}
+		if ret == nil {
+			ret = make(map[string]map[string]string)
+		}
+		ret[name] = args
+	}
+	return ret, nil
+}
+
+func (p *gqlParser) deprecation(f *gqlField) error {
+	dirs, err := p.directives()
+	if err != nil {
+		return err
+	}
+	if args, ok := dirs["deprecated"]; ok {
+		f.Deprecated = true
+		f.DeprecationReason = args["reason"]
+		if f.DeprecationReason == "" {
+			f.DeprecationReason = "No longer supported"
+		}
+	}
+	return nil
+}
+
+func (p *gqlParser) directiveDefinition() error {
+	if _, err := p.expect('@'); err != nil {
+		return err
+	}
+	if _, err := p.name(); err != nil {
+		return err
+	}
+	if p.is('(') {
+		p.next()
+		for !p.is(')') {
+			if _, err := p.field(false); err != nil {
+				return err
+			}
+		}
+		p.next()
+	}
+	if p.peek().val == "repeatable" {
+		p.next()
+	}
+	if on, err := p.name(); err != nil {
+		return err
+	} else if on != "on" {
+		p.pos--
+		return p.errorf("unexpected %q", on)
+	}
+	if p.is('|') {
+		p.next()
+	}
+	for {
+		if _, err := p.name(); err != nil {
+			return err
+		}
+		if !p.is('|') {
+			return nil
+		}
+		p.next()
+	}
+}
+
+const gqlExampleDepth = 1
+
+// gqlWriter renders a GraphQL schema as markdown
+type gqlWriter struct {
+	apiWriter
+	schema   *gqlSchema
+	endpoint string
+}
+
+// typeRef renders a type reference linking to the named type documentation
+func (w *gqlWriter) typeRef(ref string) string {
+	name := gqlNamedType(ref)
+	if _, ok := w.schema.byName[name]; !ok {
+		return ref
+	}
+	i := strings.Index(ref, name)
+	return ref[:i] + "[" + name + "](#" + gqlTypeAnchor(name) + ")" + ref[i+len(name):]
+}
+
+func (w *gqlWriter) describe(f *gqlField) string {
+	var parts []string
+	if f.Deprecated {
+		parts = append(parts, "*Deprecated: "+f.DeprecationReason+".*")
+	}
+	if f.Description != "" {
+		parts = append(parts, f.Description)
+	}
+	if len(f.Args) > 0 {
+		args := make([]string, len(f.Args))
+		for i, a := range f.Args {
+			args[i] = "`" + a.Name + ": " + a.Type
+			if a.Default != "" {
+				args[i] += " = " + a.Default
+			}
+			args[i] += "`"
+		}
+		parts = append(parts, "Arguments: "+strings.Join(args, ", ")+".")
+	}
+	return strings.Join(parts, " ")
+}
+
+// exampleArg returns an example literal for an argument of type ref. Expanding holds
+// input types being expanded, recursive references to them are left empty.
+func (w *gqlWriter) exampleArg(ref string, expanding map[string]bool) string {
+	if strings.HasPrefix(ref, "[") {
+		if expanding[gqlNamedType(ref)] {
+			return "[]"
+		}
+		return "[" + w.exampleArg(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSuffix(ref, "!"), "["), "]"), expanding) + "]"
+	}
+	name := gqlNamedType(ref)
+	switch name {
+	case "Int":
+		return "10"
+	case "Float":
+		return "1.5"
+	case "Boolean":
+		return "true"
+	case "ID":
+		return `"1"`
+	}
+	t := w.schema.byName[name]
+	switch {
+	case t != nil && t.Kind == "enum" && len(t.Values) > 0:
+		return t.Values[0].Name
+	case t != nil && t.Kind == "input" && expanding[name]:
+		return "{}"
+	case t != nil && t.Kind == "input":
+		expanding[name] = true
+		defer delete(expanding, name)
+		var fields []string
+		for _, f := range t.Fields {
+			if strings.HasSuffix(f.Type, "!") && f.Default == "" {
+				fields = append(fields, f.Name+": "+w.exampleArg(f.Type, expanding))
+			}
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return `"string"`
+}
+
+// exampleValue returns an example result value of the type ref
+func (w *gqlWriter) exampleValue(ref string) interface{} {
+	if strings.HasPrefix(ref, "[") {
+		return []interface{}{w.exampleValue(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSuffix(ref, "!"), "["), "]"))}
+	}
+	switch name := gqlNamedType(ref); name {
+	case "Int":
+		return 10
+	case "Float":
+		return 1.5
+	case "Boolean":
+		return true
+	case "ID":
+		return "1"
+	case "String":
+		return "string"
+	default:
+		if t := w.schema.byName[name]; t != nil && t.Kind == "enum" && len(t.Values) > 0 {
+			return t.Values[0].Name
+		}
+		return "string"
+	}
+}
+
+// selection writes a selection set for the type ref and returns the
+// matching example result
+func (w *gqlWriter) selection(buf *bytes.Buffer, ref string, indent string, depth int) interface{} {
+	name := gqlNamedType(ref)
+	t := w.schema.byName[name]
+	if t == nil || t.Kind == "enum" || t.Kind == "scalar" {
+		return w.exampleValue(ref)
+	}
+	buf.WriteString(" {\n")
+	result := yaml.MapSlice{}
+	if t.Kind == "union" {
+		fmt.Fprintf(buf, "%s  __typename\n", indent)
+		result = append(result, yaml.MapItem{Key: "__typename", Value: name})
+		for i, m := range t.Members {
+			fmt.Fprintf(buf, "%s  ... on %s", indent, m)
+			v := w.selection(buf, m, indent+"  ", depth)
+			buf.WriteByte('\n')
+			if i == 0 {
+				result[0].Value = m
+				if fields, ok := v.(yaml.MapSlice); ok {
+					result = append(result, fields...)
+				}
+			}
+		}
+	} else {
+		for _, f := range t.Fields {
+			fieldType := w.schema.byName[gqlNamedType(f.Type)]
+			scalar := fieldType == nil || fieldType.Kind == "enum" || fieldType.Kind == "scalar"
+			if f.Deprecated || !scalar && (depth >= gqlExampleDepth || len(f.Args) > 0) {
+				continue
+			}
+			fmt.Fprintf(buf, "%s  %s", indent, f.Name)
+			v := w.selection(buf, f.Type, indent+"  ", depth+1)
+			buf.WriteByte('\n')
+			if strings.HasPrefix(f.Type, "[") && !scalar {
+				v = []interface{}{v}
+			}
+			result = append(result, yaml.MapItem{Key: f.Name, Value: v})
+		}
+	}
+	fmt.Fprintf(buf, "%s}", indent)
+	return result
+}
+
+// operation documents a field of a root operation type
+func (w *gqlWriter) operation(kind string, f *gqlField) {
+	fmt.Fprintf(&w.buf, "## %s\n\n", f.Name)
+	var op bytes.Buffer
+	fmt.Fprintf(&op, "%s {\n  %s", kind, f.Name)
+	var args []string
+	for _, a := range f.Args {
+		if strings.HasSuffix(a.Type, "!") && a.Default == "" {
+			args = append(args, a.Name+": "+w.exampleArg(a.Type, make(map[string]bool)))
+		}
+	}
+	if len(args) > 0 {
+		fmt.Fprintf(&op, "(%s)", strings.Join(args, ", "))
+	}
+	result := w.selection(&op, f.Type, "  ", 0)
+	if strings.HasPrefix(f.Type, "[") {
+		if _, ok := result.([]interface{}); !ok {
+			result = []interface{}{result}
+		}
+	}
+	op.WriteString("\n}")
+	w.code("graphql", op.String())
+	body := formatJSON(yaml.MapSlice{{Key: "query", Value: op.String()}})
+	writeCodeSamples(&w.buf, w.langs, &sampleRequest{
+		Method:  "POST",
+		URL:     w.endpoint,
+		Headers: []sampleHeader{{"Content-Type", "application/json"}},
+		Body:    body,
+	})
+	w.buf.WriteString("> The above " + kind + " returns JSON structured like this:\n\n")
+	w.code("json", formatJSON(yaml.MapSlice{{Key: "data", Value: yaml.MapSlice{{Key: f.Name, Value: result}}}}))
+	if f.Deprecated {
+		fmt.Fprintf(&w.buf, "<aside class=\"warning\">Deprecated: %s.</aside>\n\n", f.DeprecationReason)
+	}
+	w.paragraph(f.Description)
+	if len(f.Args) > 0 {
+		w.buf.WriteString("### Arguments\n\n")
+		rows := make([][]string, len(f.Args))
+		for i, a := range f.Args {
+			rows[i] = []string{a.Name, w.typeRef(a.Type), a.Default, w.describe(a)}
+		}
+		w.table([]string{"Argument", "Type", "Default", "Description"}, rows)
+	}
+	fmt.Fprintf(&w.buf, "### Returns\n\n%s\n\n", w.typeRef(f.Type))
+}
+
+func (w *gqlWriter) typeSection(t *gqlType) {
+	fmt.Fprintf(&w.buf, "## %s {#%s}\n\n", t.Name, gqlTypeAnchor(t.Name))
+	w.paragraph(t.Description)
+	if len(t.Interfaces) > 0 {
+		refs := make([]string, len(t.Interfaces))
+		for i, iface := range t.Interfaces {
+			refs[i] = w.typeRef(iface)
+		}
+		fmt.Fprintf(&w.buf, "Implements: %s\n\n", strings.Join(refs, ", "))
+	}
+	switch t.Kind {
+	case "union":
+		refs := make([]string, len(t.Members))
+		for i, m := range t.Members {
+			refs[i] = w.typeRef(m)
+		}
+		fmt.Fprintf(&w.buf, "Possible types: %s\n\n", strings.Join(refs, ", "))
+	case "enum":
+		rows := make([][]string, len(t.Values))
+		for i, v := range t.Values {
+			rows[i] = []string{"`" + v.Name + "`", w.describe(v)}
+		}
+		w.table([]string{"Value", "Description"}, rows)
+	case "input":
+		rows := make([][]string, len(t.Fields))
+		for i, f := range t.Fields {
+			rows[i] = []string{f.Name, w.typeRef(f.Type), f.Default, w.describe(f)}
+		}
+		w.table([]string{"Field", "Type", "Default", "Description"}, rows)
+	case "type", "interface":
+		rows := make([][]string, len(t.Fields))
+		for i, f := range t.Fields {
+			rows[i] = []string{f.Name, w.typeRef(f.Type), w.describe(f)}
+		}
+		w.table([]string{"Field", "Type", "Description"}, rows)
+	}
+}
+
+// ImportGraphQL converts a GraphQL schema (SDL) to Slate source files
+// (index.html.md and includes) in the target directory
+func ImportGraphQL(schema string, target string, opts ImportOptions) error {
+	data, err := ioutil.ReadFile(schema)
+	if err != nil {
+		return err
+	}
+	s, err := parseGraphQLSchema(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", schema, err)
+	}
+	langs := []string{"graphql", "shell"}
+	if len(opts.Langs) > 0 {
+		langs = append([]string{"graphql"}, opts.Langs...)
+	}
+	doc := newImportedDoc("", langs)
+	w := &gqlWriter{apiWriter: apiWriter{langs: langs[1:]}, schema: s, endpoint: defaultServer + "/graphql"}
+	if s.Description != "" {
+		fmt.Fprintf(&doc.body, "# Introduction\n\n%s\n\n", s.Description)
+	}
+	for _, root := range []struct{ kind, typ, title string }{
+		{"query", s.Query, "Queries"},
+		{"mutation", s.Mutation, "Mutations"},
+		{"subscription", s.Subscription, "Subscriptions"},
+	} {
+		if t := s.byName[root.typ]; t != nil && len(t.Fields) > 0 {
+			fmt.Fprintf(&w.buf, "# %s\n\n", root.title)
+			for _, f := range t.Fields {
+				w.operation(root.kind, f)
+			}
+			doc.addInclude(root.title, w.text())
+		}
+	}
+	for _, kind := range []struct{ kind, title string }{
+		{"type", "Objects"},
+		{"interface", "Interfaces"},
+		{"union", "Unions"},
+		{"enum", "Enums"},
+		{"input", "Input Types"},
+		{"scalar", "Scalars"},
+	} {
+		for _, t := range s.Types {
+			if t.Kind != kind.kind || t.Name == s.Query || t.Name == s.Mutation || t.Name == s.Subscription {
+				continue
+			}
+			if w.buf.Len() == 0 {
+				fmt.Fprintf(&w.buf, "# %s\n\n", kind.title)
+			}
+			w.typeSection(t)
+		}
+		if w.buf.Len() > 0 {
+			doc.addInclude(kind.title, w.text())
+		}
+	}
+	return doc.write(target, opts.Overwrite)
+}
@@ -0,0 +1,75 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const graphqlTestSchema = `
+"""Kittens"""
+type Query {
+  "Lists kittens"
+  kittens(filter: Filter!, first: Int = 10): [Kitten!]!
+  kitten(id: ID!): Kitten
+}
+
+type Kitten {
+  id: ID!
+  name: String!
+  breed: Breed
+  friends: [Kitten!]! @deprecated(reason: "use links")
+}
+
+enum Breed { SIAMESE PERSIAN }
+
+input Filter {
+  name: String
+  breed: Breed!
+  and: [Filter!]!
+  not: Filter!
+}
+`
+
+func TestParseGraphQLSchema(t *testing.T) {
+	s, err := parseGraphQLSchema([]byte(graphqlTestSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Query != "Query" {
+		t.Errorf("query type %q, expected Query", s.Query)
+	}
+	for name, kind := range map[string]string{"Query": "type", "Kitten": "type", "Breed": "enum", "Filter": "input"} {
+		if typ := s.byName[name]; typ == nil || typ.Kind != kind {
+			t.Errorf("type %s is not parsed as %s", name, kind)
+		}
+	}
+	kittens := s.byName["Query"].Fields[0]
+	if kittens.Name != "kittens" || kittens.Type != "[Kitten!]!" || len(kittens.Args) != 2 || kittens.Args[1].Default != "10" {
+		t.Errorf("unexpected field %+v", kittens)
+	}
+}
+
+func TestImportGraphQLRecursiveInput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	schema := filepath.Join(dir, "schema.graphql")
+	if err = ioutil.WriteFile(schema, []byte(graphqlTestSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ImportGraphQL(schema, filepath.Join(dir, "doc"), ImportOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "doc", "includes", "_queries.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "kittens(filter: {breed: SIAMESE, and: [], not: {}})"; !strings.Contains(string(data), expected) {
+		t.Errorf("expected %s in\n%s", expected, data)
+	}
+}
@@ -0,0 +1,234 @@
+package slate
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// harSkippedHeaders lists request headers which are set by browsers
+// and proxies and are of no interest in documentation
+var harSkippedHeaders = map[string]bool{
+	"accept-encoding":           true,
+	"accept-language":           true,
+	"cache-control":             true,
+	"connection":                true,
+	"content-length":            true,
+	"cookie":                    true,
+	"dnt":                       true,
+	"host":                      true,
+	"origin":                    true,
+	"pragma":                    true,
+	"referer":                   true,
+	"te":                        true,
+	"upgrade-insecure-requests": true,
+	"user-agent":                true,
+}
+
+// harSecretHeader reports whether a header, a query or a form parameter may carry credentials
+func harSecretHeader(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range []string{"auth", "token", "key", "secret", "session", "password", "signature"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// harMaskSecret replaces a credential with a placeholder, keeping
+// the authorization scheme
+func harMaskSecret(value string) string {
+	if i := strings.IndexByte(value, ' '); i > 0 {
+		return value[:i] + " {credentials}"
+	}
+	return "{credentials}"
+}
+
+// harMaskQuery masks values of query parameters carrying credentials,
+// keeping the order and the encoding of the others
+func harMaskQuery(query string) string {
+	params := strings.Split(query, "&")
+	for i, p := range params {
+		kv := strings.SplitN(p, "=", 2)
+		if name, err := url.QueryUnescape(kv[0]); err == nil && len(kv) == 2 && harSecretHeader(name) {
+			params[i] = kv[0] + "=" + harMaskSecret("")
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// harSampleRequest builds a sample request from a HAR entry request
+func harSampleRequest(r yaml.MapSlice) *sampleRequest {
+	req := &sampleRequest{Method: specString(r, "method"), URL: specString(r, "url")}
+	if u, err := url.Parse(req.URL); err == nil {
+		u.Fragment = ""
+		u.RawQuery = harMaskQuery(u.RawQuery)
+		req.URL = u.String()
+	}
+	for _, h := range specList(specGet(r, "headers")) {
+		h := specMap(h)
+		name := specString(h, "name")
+		if strings.HasPrefix(name, ":") || strings.HasPrefix(strings.ToLower(name), "sec-") || harSkippedHeaders[strings.ToLower(name)] {
+			continue
+		}
+		value := specString(h, "value")
+		if harSecretHeader(name) {
+			value = harMaskSecret(value)
+		}
+		req.Headers = append(req.Headers, sampleHeader{name, value})
+	}
+	post := specMap(specGet(r, "postData"))
+	req.Body = specString(post, "text")
+	if req.Body == "" {
+		var form []string
+		for _, p := range specList(specGet(post, "params")) {
+			p := specMap(p)
+			name, value := specString(p, "name"), url.QueryEscape(specString(p, "value"))
+			if harSecretHeader(name) {
+				value = harMaskSecret("")
+			}
+			form = append(form, url.QueryEscape(name)+"="+value)
+		}
+		req.Body = strings.Join(form, "&")
+	}
+	if mimeType := specString(post, "mimeType"); isJSONContentType(mimeType) {
+		if v, err := parseJSON([]byte(req.Body)); err == nil && v != nil {
+			req.Body = formatJSON(v)
+		}
+	}
+	return req
+}
+
+// harInteresting reports whether a HAR entry is an API call rather
+// than a page, script, stylesheet or image load
+func harInteresting(entry yaml.MapSlice) bool {
+	switch specString(entry, "_resourceType") {
+	case "xhr", "fetch":
+		return true
+	case "":
+	default:
+		return false
+	}
+	req := specMap(specGet(entry, "request"))
+	content := specMap(specGet(specMap(specGet(entry, "response")), "content"))
+	return isJSONContentType(specString(content, "mimeType")) || specGet(req, "postData") != nil
+}
+
+func (w *apiWriter) harEntry(entry yaml.MapSlice) {
+	r := specMap(specGet(entry, "request"))
+	req := harSampleRequest(r)
+	u, _ := url.Parse(req.URL)
+	path := req.URL
+	if u != nil {
+		path = u.Path
+	}
+	fmt.Fprintf(&w.buf, "## %s %s\n\n", req.method(), path)
+	writeCodeSamples(&w.buf, w.langs, req)
+	resp := specMap(specGet(entry, "response"))
+	content := specMap(specGet(resp, "content"))
+	text := specString(content, "text")
+	if specString(content, "encoding") == "base64" {
+		if data, err := base64.StdEncoding.DecodeString(text); err == nil {
+			text = string(data)
+		}
+	}
+	mimeType := specString(content, "mimeType")
+	if v, err := parseJSON([]byte(text)); isJSONContentType(mimeType) && err == nil && v != nil {
+		w.buf.WriteString("> The above command returns JSON structured like this:\n\n")
+		w.code("json", formatJSON(v))
+	} else if strings.HasPrefix(mimeType, "text/") && strings.TrimSpace(text) != "" {
+		w.buf.WriteString("> The above command returns a response like this:\n\n")
+		w.code("", text)
+	}
+	w.buf.WriteString("### HTTP Request\n\n")
+	if u != nil {
+		base := *u
+		base.RawQuery = ""
+		fmt.Fprintf(&w.buf, "`%s %s`\n\n", req.method(), base.String())
+	}
+	if query := specList(specGet(r, "queryString")); len(query) > 0 {
+		w.buf.WriteString("### Query Parameters\n\n")
+		rows := make([][]string, len(query))
+		for i, q := range query {
+			q := specMap(q)
+			name, value := specString(q, "name"), specString(q, "value")
+			if harSecretHeader(name) {
+				value = harMaskSecret("")
+			}
+			rows[i] = []string{name, "`" + value + "`"}
+		}
+		w.table([]string{"Parameter", "Example"}, rows)
+	}
+	status := specString(resp, "status")
+	if statusText := specString(resp, "statusText"); statusText != "" {
+		status += " " + statusText
+	} else {
+		status += " " + httpStatusText(status)
+	}
+	fmt.Fprintf(&w.buf, "Response status: `%s`\n\n", strings.TrimSpace(status))
+}
+
+// harLangs returns language tabs for HAR examples: the ones requested,
+// or the language tabs of the document in the target directory
+func harLangs(target string, opts ImportOptions) []string {
+	if len(opts.Langs) > 0 {
+		return opts.Langs
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(target, "index.html.md")); err == nil {
+		var params ContentParams
+		preamble, _ := splitPreamble(data)
+		if yaml.Unmarshal(preamble, &params) == nil && len(params.Langs) > 0 {
+			return params.Langs
+		}
+	}
+	return opts.langs()
+}
+
+// ImportHAR converts API calls recorded in a HAR file to request and response
+// examples written to the include file includes/_<name>.md in the target directory.
+// The include name defaults to the HAR file name.
+func ImportHAR(har string, target string, opts ImportOptions) error {
+	data, err := ioutil.ReadFile(har)
+	if err != nil {
+		return err
+	}
+	doc, err := parseSpecDocument(data)
+	if err != nil {
+		return fmt.Errorf("%s: error parsing HAR: %s", har, err)
+	}
+	name := opts.Include
+	if name == "" {
+		name = includeName(strings.TrimSuffix(filepath.Base(har), filepath.Ext(har)))
+	}
+	w := &apiWriter{langs: harLangs(target, opts)}
+	fmt.Fprintf(&w.buf, "# %s\n\n", strings.Title(strings.Replace(name, "_", " ", -1)))
+	seen := make(map[string]bool)
+	count := 0
+	for _, e := range specList(specGet(specMap(specGet(doc.root, "log")), "entries")) {
+		entry := specMap(e)
+		if !harInteresting(entry) {
+			continue
+		}
+		r := specMap(specGet(entry, "request"))
+		key := specString(r, "method") + " " + specString(r, "url")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		w.harEntry(entry)
+		count++
+	}
+	if count == 0 {
+		return fmt.Errorf("%s: no API calls found", har)
+	}
+	return writeImportedFiles(target, opts.Overwrite, []importedFile{{
+		name: filepath.Join("includes", "_"+name+".md"),
+		data: w.text(),
+	}})
+}
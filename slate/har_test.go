@@ -0,0 +1,48 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestImportHARMasksSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	har := filepath.Join(dir, "kittens.har")
+	if err = ioutil.WriteFile(har, []byte(`{"log": {"entries": [{
+		"request": {
+			"method": "POST",
+			"url": "https://api.example.com/kittens?api_key=s3cr3t&page=2&access_token=t0k3n",
+			"headers": [{"name": "Authorization", "value": "Bearer t0k3n"}, {"name": "Content-Type", "value": "application/x-www-form-urlencoded"}],
+			"queryString": [{"name": "api_key", "value": "s3cr3t"}, {"name": "page", "value": "2"}, {"name": "access_token", "value": "t0k3n"}],
+			"postData": {"mimeType": "application/x-www-form-urlencoded", "params": [{"name": "name", "value": "fluffy"}, {"name": "password", "value": "hunter2"}]}
+		},
+		"response": {"status": 200, "content": {"mimeType": "application/json", "text": "{\"id\": 1}"}}
+	}]}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ImportHAR(har, dir, ImportOptions{Langs: []string{"shell"}}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "includes", "_kittens.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := string(data)
+	for _, secret := range []string{"s3cr3t", "t0k3n", "hunter2"} {
+		if strings.Contains(text, secret) {
+			t.Errorf("secret %s is published:\n%s", secret, text)
+		}
+	}
+	for _, s := range []string{"api_key={credentials}&page=2&access_token={credentials}", "name=fluffy&password={credentials}", "page | `2`"} {
+		if !strings.Contains(text, s) {
+			t.Errorf("%q is missing:\n%s", s, text)
+		}
+	}
+}
@@ -0,0 +1,563 @@
+package slate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+	"github.com/russross/blackfriday/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// translationUnit is a translatable segment of a source file
+type translationUnit struct {
+	File   string
+	Kind   string // heading, paragraph, table cell, title or toc footer
+	Source string
+	Target string
+	node   *blackfriday.Node
+}
+
+// translatable reports whether a node has text to translate, rather
+// than just code, links or numbers
+func translatable(node *blackfriday.Node) bool {
+	found := false
+	node.Walk(func(n *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && n.Type == blackfriday.Text && strings.IndexFunc(string(n.Literal), unicode.IsLetter) >= 0 {
+			found = true
+			return blackfriday.Terminate
+		}
+		return blackfriday.GoToNext
+	})
+	return found
+}
+
+// segments returns translatable segments of a markdown document: headings,
+// paragraphs and table cells, but not code blocks or HTML blocks
+func segments(file string, ast *blackfriday.Node) []*translationUnit {
+	var ret []*translationUnit
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering {
+			return blackfriday.GoToNext
+		}
+		var kind string
+		switch node.Type {
+		case blackfriday.Heading:
+			kind = "heading"
+		case blackfriday.Paragraph:
+			if _, _, ok := directive(node); ok {
+				return blackfriday.SkipChildren
+			}
+			kind = "paragraph"
+		case blackfriday.TableCell:
+			kind = "table cell"
+		default:
+			return blackfriday.GoToNext
+		}
+		if translatable(node) {
+			ret = append(ret, &translationUnit{File: file, Kind: kind, Source: markdownInline(node), node: node})
+		}
+		return blackfriday.SkipChildren
+	})
+	return ret
+}
+
+// translationSource is a source file of a document to translate
+type translationSource struct {
+	name     string
+	params   ContentParams // preamble of index.html.md
+	preamble bool          // whether the file has a preamble
+	front    yaml.MapSlice // front matter of an include file
+	ast      *blackfriday.Node
+	units    []*translationUnit
+}
+
+// readTranslationSources reads index.html.md of the source directory and its includes
+func readTranslationSources(src string) ([]*translationSource, error) {
+	fs, err := slate.NewUnionFS(src)
+	if err != nil {
+		return nil, err
+	}
+	data, err := readFile(fs, "index.html.md")
+	if err != nil {
+		return nil, err
+	}
+	preamble, body := splitPreamble(data)
+	index := &translationSource{name: "index.html.md", preamble: true, ast: parseMarkdown(body)}
+	if err = yaml.Unmarshal(preamble, &index.params); err != nil {
+		return nil, err
+	}
+	if index.params.Title != "" {
+		index.units = append(index.units, &translationUnit{File: index.name, Kind: "title", Source: index.params.Title})
+	}
+	for _, footer := range index.params.TocFooters {
+		index.units = append(index.units, &translationUnit{File: index.name, Kind: "toc footer", Source: footer})
+	}
+	index.units = append(index.units, segments(index.name, index.ast)...)
+	ret := []*translationSource{index}
+	// includes of the preamble followed by ones placed with include directives
+	includes, err := newIncludeResolver(fs, Params{}, &index.params, placement{Source: src})
+	if err != nil {
+		return nil, err
+	}
+	queue := append(append([]string(nil), index.params.Includes...), inlineIncludes(index.ast)...)
+	seen := make(map[string]bool)
+	for len(queue) > 0 {
+		entry := queue[0]
+		queue = queue[1:]
+		names, err := includes.glob(entry, []string{index.name})
+		if err != nil {
+			return nil, err
+		}
+		for _, include := range names {
+			if seen[include] {
+				continue
+			}
+			seen[include] = true
+			name, disk := includes.find(include)
+			if disk {
+				// includes found in include paths are translated there
+				continue
+			}
+			data, err := readFile(fs, name)
+			if err != nil {
+				return nil, err
+			}
+			params, body, err := parseInclude(name, data)
+			if err != nil {
+				return nil, err
+			}
+			src := &translationSource{name: name, ast: parseMarkdown(body)}
+			preamble, _ := splitPreamble(data)
+			if err = yaml.Unmarshal(preamble, &src.front); err != nil {
+				return nil, err
+			}
+			if params.Title != "" {
+				src.units = append(src.units, &translationUnit{File: name, Kind: "title", Source: params.Title})
+			}
+			src.units = append(src.units, segments(name, src.ast)...)
+			ret = append(ret, src)
+			queue = append(queue, inlineIncludes(src.ast)...)
+		}
+	}
+	return ret, nil
+}
+
+// inlineIncludes returns names of include files placed with include directives
+func inlineIncludes(ast *blackfriday.Node) []string {
+	var ret []string
+	for _, node := range includeDirectives(ast) {
+		if _, include, _ := directive(node); include != "" {
+			ret = append(ret, include)
+		}
+	}
+	return ret
+}
+
+// uniqueUnits returns units of the sources with duplicates within a file removed
+func uniqueUnits(sources []*translationSource) []*translationUnit {
+	var ret []*translationUnit
+	for _, src := range sources {
+		seen := make(map[string]bool)
+		for _, u := range src.units {
+			if !seen[u.Source] {
+				seen[u.Source] = true
+				ret = append(ret, u)
+			}
+		}
+	}
+	return ret
+}
+
+// ExtractTranslation writes translatable segments of the document in the source directory
+// (headings, paragraphs and table cells of index.html.md and its includes, along with
+// the title and TOC footers) to XLIFF 1.2 or gettext PO, depending on format ("xliff" or "po").
+// The target locale is optional.
+func ExtractTranslation(src string, w io.Writer, format string, locale string) error {
+	sources, err := readTranslationSources(src)
+	if err != nil {
+		return err
+	}
+	sourceLocale := sources[0].params.Locale
+	if sourceLocale == "" {
+		sourceLocale = defaultLocale
+	}
+	units := uniqueUnits(sources)
+	switch format {
+	case "xliff":
+		return writeXLIFF(w, units, sourceLocale, locale)
+	case "po":
+		return writePO(w, units, locale)
+	}
+	return fmt.Errorf("unknown translation format %s, expected xliff or po", format)
+}
+
+// MergeTranslation produces translated sources of the document in the source directory:
+// index.<locale>.html.md and includes/<locale>/_<name>.md for includes with translated
+// segments. The locale defaults to the target language of the translation file.
+func MergeTranslation(src string, translation string, locale string, overwrite bool) error {
+	data, err := ioutil.ReadFile(translation)
+	if err != nil {
+		return err
+	}
+	var units []*translationUnit
+	var fileLocale string
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("<")) {
+		units, fileLocale, err = readXLIFF(data)
+	} else {
+		units, fileLocale, err = readPO(data)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", translation, err)
+	}
+	if locale == "" {
+		locale = fileLocale
+	}
+	if locale == "" {
+		return fmt.Errorf("%s: no target language, locale must be set", translation)
+	} else if !localeSourceRE.MatchString("index." + locale + ".html.md") {
+		return fmt.Errorf("invalid locale %s", locale)
+	}
+	translated := make(map[string]string)
+	for _, u := range units {
+		if u.Target != "" {
+			translated[u.File+"\x00"+u.Source] = u.Target
+		}
+	}
+	sources, err := readTranslationSources(src)
+	if err != nil {
+		return err
+	}
+	r := blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{})
+	style := sources[0].params.SlugStyle
+	var files []importedFile
+	for i, source := range sources {
+		assignHeadingIDs(r, source.ast, style)
+		// include files keep their front matter, a translated document only
+		// overrides options of index.html.md
+		preamble := append(yaml.MapSlice{}, source.front...)
+		count := 0
+		for _, u := range source.units {
+			target, ok := translated[u.File+"\x00"+u.Source]
+			if !ok {
+				continue
+			}
+			count++
+			switch u.Kind {
+			case "title":
+				preamble = setMapItem(preamble, "title", target)
+			case "toc footer":
+				// handled below, as footers are replaced all together
+			default:
+				replaceInline(u.node, target)
+			}
+		}
+		if source.preamble {
+			var footers []string
+			changed := false
+			for _, footer := range source.params.TocFooters {
+				if target, ok := translated[source.name+"\x00"+footer]; ok {
+					footer, changed = target, true
+				}
+				footers = append(footers, footer)
+			}
+			if changed {
+				preamble = setMapItem(preamble, "toc_footers", footers)
+			}
+		}
+		if i > 0 && count == 0 {
+			// untranslated includes fall back to the default locale
+			continue
+		}
+		keepHeadingIDs(r, source.ast, style)
+		var buf bytes.Buffer
+		if len(preamble) > 0 {
+			data, err := yaml.Marshal(preamble)
+			if err != nil {
+				return err
+			}
+			buf.WriteString("---\n")
+			buf.Write(data)
+			buf.WriteString("---\n\n")
+		}
+		buf.WriteString(renderMarkdown(source.ast))
+		name := "index." + locale + ".html.md"
+		if i > 0 {
+			name = path.Join("includes", locale, strings.TrimPrefix(source.name, "includes/"))
+		}
+		files = append(files, importedFile{name: name, data: buf.Bytes()})
+	}
+	return writeImportedFiles(src, overwrite, files)
+}
+
+// keepHeadingIDs clears IDs of headings which the slug style generates from their text
+// anyway, so that only explicit IDs and those of translated headings are written out.
+// Translated headings keep IDs of the source, which links of the document refer to.
+func keepHeadingIDs(r blackfriday.Renderer, ast *blackfriday.Node, style string) {
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && node.Type == blackfriday.Heading {
+			if node.HeadingID == headingSlug(r, node, style) {
+				node.HeadingID = ""
+			}
+			return blackfriday.SkipChildren
+		}
+		return blackfriday.GoToNext
+	})
+}
+
+// setMapItem sets a value of a YAML map, keeping the order of keys
+func setMapItem(m yaml.MapSlice, key string, value interface{}) yaml.MapSlice {
+	for i, item := range m {
+		if item.Key == key {
+			m[i].Value = value
+			return m
+		}
+	}
+	return append(m, yaml.MapItem{Key: key, Value: value})
+}
+
+// replaceInline replaces inline content of a block with translated markdown
+func replaceInline(node *blackfriday.Node, text string) {
+	for c := node.FirstChild; c != nil; c = node.FirstChild {
+		c.Unlink()
+	}
+	doc := parseMarkdown([]byte(text))
+	if block := doc.FirstChild; block != nil && block.Next == nil && (block.Type == blackfriday.Paragraph || block.Type == blackfriday.Heading) {
+		for c := block.FirstChild; c != nil; c = block.FirstChild {
+			c.Unlink()
+			node.AppendChild(c)
+		}
+		return
+	}
+	t := blackfriday.NewNode(blackfriday.Text)
+	t.Literal = []byte(strings.Replace(text, "\n", " ", -1))
+	node.AppendChild(t)
+}
+
+type xliffDocument struct {
+	XMLName xml.Name    `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string      `xml:"version,attr"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string      `xml:"original,attr"`
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr,omitempty"`
+	Datatype       string      `xml:"datatype,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+type xliffUnit struct {
+	ID     string `xml:"id,attr"`
+	Source string `xml:"source"`
+	Target string `xml:"target,omitempty"`
+	Note   string `xml:"note,omitempty"`
+}
+
+func writeXLIFF(w io.Writer, units []*translationUnit, sourceLocale, targetLocale string) error {
+	doc := xliffDocument{Version: "1.2"}
+	for _, u := range units {
+		if len(doc.Files) == 0 || doc.Files[len(doc.Files)-1].Original != u.File {
+			doc.Files = append(doc.Files, xliffFile{
+				Original:       u.File,
+				SourceLanguage: sourceLocale,
+				TargetLanguage: targetLocale,
+				Datatype:       "x-markdown",
+			})
+		}
+		f := &doc.Files[len(doc.Files)-1]
+		f.Units = append(f.Units, xliffUnit{ID: strconv.Itoa(len(f.Units) + 1), Source: u.Source, Note: u.Kind})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func readXLIFF(data []byte) ([]*translationUnit, string, error) {
+	var doc xliffDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, "", fmt.Errorf("error parsing XLIFF: %s", err)
+	}
+	var ret []*translationUnit
+	var locale string
+	for _, f := range doc.Files {
+		if f.TargetLanguage != "" {
+			locale = f.TargetLanguage
+		}
+		for _, u := range f.Units {
+			ret = append(ret, &translationUnit{File: f.Original, Kind: u.Note, Source: u.Source, Target: strings.TrimSpace(u.Target)})
+		}
+	}
+	return ret, locale, nil
+}
+
+// poQuote quotes a PO string, splitting multi-line strings
+func poQuote(s string) string {
+	quote := func(s string) string {
+		s = strings.Replace(s, `\`, `\\`, -1)
+		s = strings.Replace(s, `"`, `\"`, -1)
+		s = strings.Replace(s, "\t", `\t`, -1)
+		return `"` + strings.Replace(s, "\n", `\n`, -1) + `"`
+	}
+	if !strings.Contains(strings.TrimSuffix(s, "\n"), "\n") {
+		return quote(s)
+	}
+	lines := strings.SplitAfter(s, "\n")
+	ret := []string{`""`}
+	for _, l := range lines {
+		if l != "" {
+			ret = append(ret, quote(l))
+		}
+	}
+	return strings.Join(ret, "\n")
+}
+
+func poUnquote(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	var buf strings.Builder
+	s = s[1 : len(s)-1]
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			buf.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			buf.WriteByte('\n')
+		case 't':
+			buf.WriteByte('\t')
+		case 'r':
+			buf.WriteByte('\r')
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	return buf.String(), nil
+}
+
+func writePO(w io.Writer, units []*translationUnit, locale string) error {
+	var buf bytes.Buffer
+	buf.WriteString("msgid \"\"\nmsgstr \"\"\n")
+	buf.WriteString("\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+	if locale != "" {
+		fmt.Fprintf(&buf, "\"Language: %s\\n\"\n", locale)
+	}
+	buf.WriteString("\"X-Generator: go-slate " + GoSlateVersion + "\\n\"\n")
+	for _, u := range units {
+		fmt.Fprintf(&buf, "\n#. %s\nmsgctxt %s\nmsgid %s\nmsgstr \"\"\n", u.Kind, poQuote(u.File), poQuote(u.Source))
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func readPO(data []byte) ([]*translationUnit, string, error) {
+	var ret []*translationUnit
+	var locale string
+	var unit *translationUnit
+	var field *string
+	fuzzy := false
+	// flush completes the current entry, the header entry has an empty msgid
+	flush := func() {
+		if unit != nil && unit.Source == "" {
+			for _, line := range strings.Split(unit.Target, "\n") {
+				if strings.HasPrefix(line, "Language:") {
+					locale = strings.TrimSpace(strings.TrimPrefix(line, "Language:"))
+				}
+			}
+		} else if unit != nil {
+			if fuzzy {
+				unit.Target = ""
+			}
+			ret = append(ret, unit)
+		}
+		unit, field, fuzzy = nil, nil, false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1<<20)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		// a comment or a keyword following msgstr starts the next entry
+		inTarget := unit != nil && field == &unit.Target
+		if strings.HasPrefix(line, "#") {
+			if inTarget {
+				flush()
+			}
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				fuzzy = true
+			}
+			continue
+		}
+		if strings.HasPrefix(line, `"`) {
+			if field == nil {
+				return nil, "", fmt.Errorf("line %d: unexpected string", n)
+			}
+			s, err := poUnquote(line)
+			if err != nil {
+				return nil, "", fmt.Errorf("line %d: %s", n, err)
+			}
+			*field += s
+			continue
+		}
+		keyword := line
+		if i := strings.IndexAny(line, " \t"); i > 0 {
+			keyword = line[:i]
+		}
+		switch {
+		case keyword == "msgctxt" || keyword == "msgid":
+			if inTarget {
+				flush()
+			}
+			if unit == nil {
+				unit = &translationUnit{}
+			}
+			field = &unit.Source
+			if keyword == "msgctxt" {
+				field = &unit.File
+			}
+		case keyword == "msgstr" || strings.HasPrefix(keyword, "msgstr["):
+			if unit == nil {
+				return nil, "", fmt.Errorf("line %d: msgstr without msgid", n)
+			}
+			field = &unit.Target
+			if strings.HasPrefix(keyword, "msgstr[") && keyword != "msgstr[0]" {
+				var plural string
+				field = &plural
+			}
+		case keyword == "msgid_plural":
+			var plural string
+			field = &plural
+		default:
+			return nil, "", fmt.Errorf("line %d: unexpected %s", n, keyword)
+		}
+		s, err := poUnquote(line[len(keyword):])
+		if err != nil {
+			return nil, "", fmt.Errorf("line %d: %s", n, err)
+		}
+		*field += s
+	}
+	flush()
+	return ret, locale, scanner.Err()
+}
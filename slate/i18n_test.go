@@ -0,0 +1,41 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMergeHeadingIDs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate-i18n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	index := "---\ntitle: API\nslug_style: github\n---\n\n# Kittens & Puppies\n\nSee [errors](#api-errors).\n\n## Get All Kittens\n\n## Errors {#api-errors}\n"
+	po := "msgid \"\"\nmsgstr \"\"\n\"Language: de\\n\"\n\n" +
+		"msgctxt \"index.html.md\"\nmsgid \"Kittens & Puppies\"\nmsgstr \"Kätzchen & Welpen\"\n\n" +
+		"msgctxt \"index.html.md\"\nmsgid \"Errors\"\nmsgstr \"Fehler\"\n"
+	if err = ioutil.WriteFile(filepath.Join(dir, "index.html.md"), []byte(index), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "de.po"), []byte(po), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = MergeTranslation(dir, filepath.Join(dir, "de.po"), "", false); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "index.de.html.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// translated headings keep the IDs of the source under its slug style,
+	// untranslated ones are left to the slug style
+	for _, heading := range []string{"# Kätzchen & Welpen {#kittens--puppies}\n", "## Get All Kittens\n", "## Fehler {#api-errors}\n"} {
+		if !strings.Contains(string(data), heading) {
+			t.Errorf("expected %q in:\n%s", heading, data)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Import options
+type ImportOptions struct {
+	Langs     []string // language tabs to produce code samples for
+	Overwrite bool     // overwrite existing files
+	Include   string   // include name for importers producing a single include file
+}
+
+var defaultImportLangs = []string{"shell", "python", "javascript", "go"}
+
+func (opts *ImportOptions) langs() []string {
+	if len(opts.Langs) == 0 {
+		return defaultImportLangs
+	}
+	return opts.Langs
+}
+
+// ImportOpenAPI converts an OpenAPI 3 or Swagger 2.0 spec to Slate source files
+// (index.html.md and includes) in the target directory
+func ImportOpenAPI(spec string, target string, opts ImportOptions) error {
+	return importAPISpec(spec, target, opts, "an OpenAPI 3 or Swagger 2.0 spec", "openapi", "swagger")
+}
+
+// ImportAsyncAPI converts an AsyncAPI 2 spec to Slate source files
+// (index.html.md and includes) in the target directory
+func ImportAsyncAPI(spec string, target string, opts ImportOptions) error {
+	return importAPISpec(spec, target, opts, "an AsyncAPI 2 spec", "asyncapi")
+}
+
+// ImportOpenRPC converts an OpenRPC 1.x document describing a JSON-RPC 2.0
+// service to Slate source files (index.html.md and includes) in the target directory
+func ImportOpenRPC(spec string, target string, opts ImportOptions) error {
+	return importAPISpec(spec, target, opts, "an OpenRPC 1.x document", "openrpc")
+}
+
+// importAPISpec imports a spec of one of the formats, see specFormat, described as what
+func importAPISpec(spec string, target string, opts ImportOptions, what string, formats ...string) error {
+	data, err := ioutil.ReadFile(spec)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseSpecDocument(data)
+	if err != nil {
+		return fmt.Errorf("%s: error parsing API spec: %s", spec, err)
+	}
+	format, ok := specFormat(parsed), false
+	for _, f := range formats {
+		ok = ok || f == format
+	}
+	if !ok && format == "" {
+		return fmt.Errorf("%s: not %s", spec, what)
+	} else if !ok {
+		return fmt.Errorf("%s: not %s, but %s", spec, what, format)
+	}
+	api, err := readAPISpec(parsed)
+	if err != nil {
+		return fmt.Errorf("%s: %s", spec, err)
+	}
+	doc := newImportedDoc(api.Title, opts.langs())
+	for _, sec := range api.sections(opts.langs()) {
+		switch sec.Kind {
+		case introductionSection, securitySection:
+			doc.body.Write(sec.Text)
+		default:
+			doc.addInclude(sec.Title, sec.Text)
+		}
+	}
+	return doc.write(target, opts.Overwrite)
+}
+
+// importedDoc is a documentation source produced by an importer
+type importedDoc struct {
+	params   ContentParams
+	body     bytes.Buffer
+	includes []importedFile
+}
+
+type importedFile struct {
+	name string
+	data []byte
+}
+
+func newImportedDoc(title string, langs []string) *importedDoc {
+	if title == "" {
+		title = "API Reference"
+	}
+	return &importedDoc{
+		params: ContentParams{
+			Title:      title,
+			Search:     true,
+			Langs:      langs,
+			TocFooters: []string{"<a href='https://github.com/lord/slate'>Documentation Powered by Slate</a>"},
+		},
+	}
+}
+
+// includeName converts a section title to an include name
+func includeName(title string) string {
+	var buf bytes.Buffer
+	sep := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if sep && buf.Len() > 0 {
+				buf.WriteByte('_')
+			}
+			buf.WriteRune(r)
+			sep = false
+		} else {
+			sep = true
+		}
+	}
+	if buf.Len() == 0 {
+		return "section"
+	}
+	return buf.String()
+}
+
+// addInclude adds an include file with a unique name derived from title
+func (d *importedDoc) addInclude(title string, data []byte) {
+	base := includeName(title)
+	name := base
+	for n := 2; ; n++ {
+		unique := true
+		for _, inc := range d.params.Includes {
+			if inc == name {
+				unique = false
+				break
+			}
+		}
+		if unique {
+			break
+		}
+		name = fmt.Sprintf("%s_%d", base, n)
+	}
+	d.params.Includes = append(d.params.Includes, name)
+	d.includes = append(d.includes, importedFile{name: name, data: data})
+}
+
+func (d *importedDoc) index() ([]byte, error) {
+	preamble, err := yaml.Marshal(&d.params)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(preamble)
+	buf.WriteString("---\n\n")
+	buf.Write(d.body.Bytes())
+	return buf.Bytes(), nil
+}
+
+// write writes the documentation source to the target directory
+func (d *importedDoc) write(target string, overwrite bool) error {
+	index, err := d.index()
+	if err != nil {
+		return err
+	}
+	files := []importedFile{{name: "index.html.md", data: index}}
+	for _, inc := range d.includes {
+		files = append(files, importedFile{name: filepath.Join("includes", "_"+inc.name+".md"), data: inc.data})
+	}
+	return writeImportedFiles(target, overwrite, files)
+}
+
+// writeImportedFiles writes files to the target directory, refusing
+// to overwrite any existing file unless overwrite is set
+func writeImportedFiles(target string, overwrite bool, files []importedFile) error {
+	if !overwrite {
+		for _, f := range files {
+			name := filepath.Join(target, f.name)
+			if _, err := os.Stat(name); err == nil {
+				return &os.PathError{Op: "import", Path: name, Err: os.ErrExist}
+			}
+		}
+	}
+	for _, f := range files {
+		name := filepath.Join(target, f.name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(name, f.data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestImportSpecFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	specs := map[string]string{
+		"openapi":  "openapi: 3.0.0\ninfo: {title: Pets, version: '1'}\npaths: {}\n",
+		"swagger":  "swagger: '2.0'\ninfo: {title: Pets, version: '1'}\npaths: {}\n",
+		"asyncapi": "asyncapi: 2.0.0\ninfo: {title: Pets, version: '1'}\nchannels: {}\n",
+		"openrpc":  "openrpc: 1.2.6\ninfo: {title: Pets, version: '1'}\nmethods: []\n",
+		"other":    "title: Pets\n",
+	}
+	importers := map[string]func(string, string, ImportOptions) error{
+		"openapi":  ImportOpenAPI,
+		"asyncapi": ImportAsyncAPI,
+		"openrpc":  ImportOpenRPC,
+	}
+	accepts := map[string][]string{
+		"openapi":  {"openapi", "swagger"},
+		"asyncapi": {"asyncapi"},
+		"openrpc":  {"openrpc"},
+	}
+	for format, text := range specs {
+		spec := filepath.Join(dir, format+".yaml")
+		if err = ioutil.WriteFile(spec, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+		for name, importer := range importers {
+			accepted := false
+			for _, f := range accepts[name] {
+				accepted = accepted || f == format
+			}
+			err := importer(spec, filepath.Join(dir, name+"-"+format), ImportOptions{})
+			if accepted && err != nil {
+				t.Errorf("import %s of %s spec: %s", name, format, err)
+			} else if !accepted && (err == nil || !strings.Contains(err.Error(), "not ")) {
+				t.Errorf("import %s of %s spec: expected a format error, got %v", name, format, err)
+			}
+		}
+	}
+}
+
+func TestImportSchemaAnchors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	spec := filepath.Join(dir, "pets.yaml")
+	text := `openapi: 3.0.0
+info: {title: Pets, version: '1'}
+paths: {}
+components:
+  schemas:
+    Pet: {type: object, properties: {owner: {$ref: "#/components/schemas/pet"}}}
+    pet: {type: string}
+`
+	if err = ioutil.WriteFile(spec, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(dir, "doc")
+	if err = ImportOpenAPI(spec, target, ImportOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	var schemas string
+	err = filepath.Walk(target, func(name string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, err := ioutil.ReadFile(name)
+		schemas += string(data)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"## Pet {#schema-Pet}", "## pet {#schema-pet}", "[pet](#schema-pet)"} {
+		if !strings.Contains(schemas, s) {
+			t.Errorf("expected %q in:\n%s", s, schemas)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package slate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+	"github.com/russross/blackfriday/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// includeDirective places an include file inline, like <!-- include: errors -->
+const includeDirective = "include"
+
+// includeChain formats a chain of files including each other
+func includeChain(chain []string) string {
+	return strings.Join(chain, " > ")
+}
+
+// includeDisk reads files of include paths
+type includeDisk interface {
+	Stat(name string) (os.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+	ReadDir(name string) ([]os.FileInfo, error)
+}
+
+// osDisk reads include paths from the operating system file system
+type osDisk struct{}
+
+func (osDisk) Stat(name string) (os.FileInfo, error)      { return os.Stat(name) }
+func (osDisk) ReadFile(name string) ([]byte, error)       { return ioutil.ReadFile(name) }
+func (osDisk) ReadDir(name string) ([]os.FileInfo, error) { return ioutil.ReadDir(name) }
+
+// includeResolver finds and reads include files of a document
+type includeResolver struct {
+	fs       slate.FileSystem
+	disk     includeDisk
+	paths    []string // include search paths on disk
+	locale   string
+	variant  string // audience include files are read for
+	vars     *markdownVars
+	files    []*includeParams // include files read, in order
+	dropping int              // depth of include files being read which are not for the variant
+	links    linkSources
+}
+
+// newIncludeResolver returns a resolver searching include paths set by params
+// first, then ones of the preamble, relative to the source directory. Include paths
+// of a source read at a git ref are read at the ref as well, and must be within the
+// git work tree.
+func newIncludeResolver(fs slate.FileSystem, params Params, content *ContentParams, place placement) (*includeResolver, error) {
+	r := &includeResolver{fs: fs, disk: osDisk{}, locale: place.Locale, variant: params.Variant, links: make(linkSources)}
+	if r.variant == "" {
+		r.variant = defaultVariant
+	}
+	r.paths = append(r.paths, params.IncludePaths...)
+	for _, p := range content.IncludePaths {
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(place.Source, p)
+		}
+		r.paths = append(r.paths, p)
+	}
+	if place.Ref != "" && len(r.paths) > 0 {
+		disk, err := newGitDisk(place.Source, place.Ref, r.paths)
+		if err != nil {
+			return nil, err
+		}
+		r.disk = disk
+	}
+	return r, nil
+}
+
+// bundled reports whether a file is one of the embedded Slate files
+func bundled(fi os.FileInfo) bool {
+	_, ok := fi.Sys().(*slate.Asset)
+	return ok
+}
+
+// includeFile returns the file of an include within a directory, includes/_errors.md
+// for errors and includes/endpoints/_users.md for endpoints/users
+func includeFile(join func(...string) string, dir, include string) string {
+	return join(dir, path.Dir(include), "_"+path.Base(include)+".md")
+}
+
+// find returns the file of an include. The source directory comes first, then include
+// paths in order, then bundled files; within each of them a file translated to the locale
+// comes first. Files of include paths are located on disk, see includeDisk, rather than in the source.
+func (r *includeResolver) find(include string) (name string, disk bool) {
+	names := func(join func(...string) string, dir string) []string {
+		if r.locale != "" {
+			return []string{includeFile(join, join(dir, r.locale), include), includeFile(join, dir, include)}
+		}
+		return []string{includeFile(join, dir, include)}
+	}
+	for _, name := range names(path.Join, "includes") {
+		if fi, err := r.fs.Stat(name); err == nil && !fi.IsDir() && !bundled(fi) {
+			return name, false
+		}
+	}
+	for _, dir := range r.paths {
+		for _, name := range names(filepath.Join, dir) {
+			if fi, err := r.disk.Stat(name); err == nil && !fi.IsDir() {
+				return name, true
+			}
+		}
+	}
+	return includeFile(path.Join, "includes", include), false
+}
+
+// load returns the file of an include and its content
+func (r *includeResolver) load(include string) (string, []byte, error) {
+	file, disk := r.find(include)
+	var data []byte
+	var err error
+	if disk {
+		data, err = r.disk.ReadFile(file)
+	} else {
+		data, err = readFile(r.fs, file)
+	}
+	return file, data, err
+}
+
+// hasMeta reports whether an includes entry is a glob pattern
+func hasMeta(entry string) bool {
+	return strings.ContainsAny(entry, "*?[")
+}
+
+// isDir reports whether an includes entry is a directory of the source
+// includes directory or of an include path
+func (r *includeResolver) isDir(entry string) bool {
+	if fi, err := r.fs.Stat(path.Join("includes", entry)); err == nil && fi.IsDir() && !bundled(fi) {
+		return true
+	}
+	for _, dir := range r.paths {
+		if fi, err := r.disk.Stat(filepath.Join(dir, entry)); err == nil && fi.IsDir() {
+			return true
+		}
+	}
+	return false
+}
+
+// glob expands an includes entry being a glob pattern, like endpoints/*, or a directory
+// to names of include files it matches in the source includes directory and include paths.
+// Names are ordered by weight of the front matter, or else by numeric prefix of the file
+// name, then by name; files with neither come last. Other entries are returned as is.
+func (r *includeResolver) glob(entry string, chain []string) ([]string, error) {
+	pattern := entry
+	if !hasMeta(entry) {
+		if entry == "" || !r.isDir(entry) {
+			return []string{entry}, nil
+		}
+		pattern = path.Join(entry, "*")
+	}
+	dir, base := path.Split(pattern)
+	dir = strings.TrimSuffix(dir, "/")
+	if _, err := path.Match(base, ""); err != nil || hasMeta(dir) {
+		return nil, fmt.Errorf("invalid include pattern %s in %s, only file names may be patterns", entry, includeChain(chain))
+	}
+	seen := make(map[string]bool)
+	var names []string
+	add := func(files []os.FileInfo) {
+		for _, fi := range files {
+			name := fi.Name()
+			if fi.IsDir() || bundled(fi) || !strings.HasPrefix(name, "_") || !strings.HasSuffix(name, ".md") {
+				continue
+			}
+			name = strings.TrimSuffix(name[1:], ".md")
+			if ok, _ := path.Match(base, name); ok && !seen[name] {
+				seen[name] = true
+				names = append(names, path.Join(dir, name))
+			}
+		}
+	}
+	if d, err := r.fs.Open(path.Join("includes", dir)); err == nil {
+		files, _ := d.Readdir(-1)
+		d.Close()
+		add(files)
+	}
+	for _, p := range r.paths {
+		files, _ := r.disk.ReadDir(filepath.Join(p, dir))
+		add(files)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no include files match %s in %s", entry, includeChain(chain))
+	}
+	type order struct {
+		name   string
+		weight int
+		ok     bool
+	}
+	orders := make([]order, len(names))
+	for i, name := range names {
+		file, data, err := r.load(name)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %s (included from %s)", file, err, includeChain(chain))
+		}
+		o := order{name: name}
+		params, _, err := parseInclude(file, data)
+		if err != nil {
+			return nil, err
+		}
+		if params.Weight != nil {
+			o.weight, o.ok = *params.Weight, true
+		} else if m := numericPrefixRE.FindString(path.Base(name)); m != "" {
+			o.weight, _ = strconv.Atoi(m)
+			o.ok = true
+		}
+		orders[i] = o
+	}
+	sort.SliceStable(orders, func(i, j int) bool {
+		a, b := orders[i], orders[j]
+		if a.ok != b.ok {
+			return a.ok
+		} else if a.weight != b.weight {
+			return a.weight < b.weight
+		}
+		return a.name < b.name
+	})
+	for i, o := range orders {
+		names[i] = o.name
+	}
+	return names, nil
+}
+
+// numericPrefixRE matches a numeric prefix of an include name, like 010-users
+var numericPrefixRE = regexp.MustCompile(`^[0-9]+`)
+
+// includeParams is the front matter of an include file, available to the layout
+type includeParams struct {
+	Name       string   `yaml:"-"` // include name, like endpoints/users
+	File       string   `yaml:"-"`
+	Title      string   `yaml:"title,omitempty"`         // title of the page of the include when split per include
+	Weight     *int     `yaml:"weight,omitempty"`        // position among files matched by an includes pattern
+	Langs      []string `yaml:"language_tabs,omitempty"` // added to language tabs of the document
+	Audience   []string `yaml:"audience,omitempty"`
+	Deprecated bool     `yaml:"deprecated,omitempty"`
+}
+
+// parseInclude splits an include file to the front matter and the markdown body
+func parseInclude(file string, data []byte) (*includeParams, []byte, error) {
+	preamble, body := splitPreamble(data)
+	params := &includeParams{File: file}
+	if err := yaml.Unmarshal(preamble, params); err != nil {
+		return nil, nil, fmt.Errorf("error parsing %s front matter: %s", file, err)
+	}
+	return params, body, nil
+}
+
+// read reads and parses an include file, expanding include directives within it.
+// The chain holds files including it, outermost first. Include files not for the
+// variant audience are read as a range to drop, see audienceEnd, and not recorded.
+func (r *includeResolver) read(include string, chain []string) (ast *blackfriday.Node, dropped bool, err error) {
+	if include == "" {
+		return nil, false, fmt.Errorf("empty include name in %s", includeChain(chain))
+	}
+	file, data, err := r.load(include)
+	chain = append(chain[:len(chain):len(chain)], file)
+	for _, f := range chain[:len(chain)-1] {
+		if f == file {
+			return nil, false, fmt.Errorf("include cycle: %s", includeChain(chain))
+		}
+	}
+	if os.IsNotExist(err) {
+		return nil, false, fmt.Errorf("%s does not exist (included from %s)", file, includeChain(chain[:len(chain)-1]))
+	} else if err != nil {
+		return nil, false, fmt.Errorf("error reading %s: %s (included from %s)", file, err, includeChain(chain[:len(chain)-1]))
+	}
+	params, _, err := parseInclude(file, data)
+	if err != nil {
+		return nil, false, err
+	}
+	dropped = !visible(params.Audience, r.variant)
+	if dropped {
+		r.dropping++
+		defer func() { r.dropping-- }()
+	}
+	body, err := r.vars.body(file, data)
+	if err != nil {
+		return nil, false, err
+	}
+	params.Name = include
+	if r.dropping == 0 {
+		r.add(params)
+	}
+	ast = parseMarkdown(body)
+	parseXrefs(ast)
+	r.links.add(file, data, ast)
+	dropAudiences(ast, r.variant)
+	if err = r.expand(ast, chain); err != nil {
+		return nil, false, err
+	}
+	if dropped {
+		return audienceRange(ast), true, nil
+	}
+	return ast, false, nil
+}
+
+// add records an include file read, unless already done
+func (r *includeResolver) add(params *includeParams) {
+	for _, f := range r.files {
+		if f.File == params.File {
+			return
+		}
+	}
+	r.files = append(r.files, params)
+}
+
+// named returns the first include file read of the name
+func (r *includeResolver) named(name string) *includeParams {
+	for _, f := range r.files {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// langs returns language tabs followed by ones added by include files
+func (r *includeResolver) langs(langs []string) []string {
+	seen := make(map[string]bool)
+	for _, l := range langs {
+		seen[l] = true
+	}
+	for _, f := range r.files {
+		for _, l := range f.Langs {
+			if !seen[l] {
+				seen[l] = true
+				langs = append(langs, l)
+			}
+		}
+	}
+	return langs
+}
+
+// readAll reads include files an includes entry expands to, see glob, and returns
+// their names and content. Names of ones not for the variant audience are empty.
+func (r *includeResolver) readAll(entry string, chain []string) ([]string, []*blackfriday.Node, error) {
+	names, err := r.glob(entry, chain)
+	if err != nil {
+		return nil, nil, err
+	}
+	docs := make([]*blackfriday.Node, len(names))
+	for i, name := range names {
+		var dropped bool
+		if docs[i], dropped, err = r.read(name, chain); err != nil {
+			return nil, nil, err
+		} else if dropped {
+			names[i] = ""
+		}
+	}
+	return names, docs, nil
+}
+
+// expand replaces include directives of a document with the content
+// of include files, recursively
+func (r *includeResolver) expand(ast *blackfriday.Node, chain []string) error {
+	for _, node := range includeDirectives(ast) {
+		_, entry, _ := directive(node)
+		_, docs, err := r.readAll(entry, chain)
+		if err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			for c := doc.FirstChild; c != nil; c = doc.FirstChild {
+				c.Unlink()
+				node.InsertBefore(c)
+			}
+		}
+		node.Unlink()
+	}
+	return nil
+}
+
+// includeDirectives returns include directive nodes of a document
+func includeDirectives(ast *blackfriday.Node) []*blackfriday.Node {
+	var ret []*blackfriday.Node
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering {
+			return blackfriday.GoToNext
+		}
+		if name, _, ok := directive(node); ok {
+			if name == includeDirective {
+				ret = append(ret, node)
+			}
+			return blackfriday.SkipChildren
+		}
+		return blackfriday.GoToNext
+	})
+	return ret
+}
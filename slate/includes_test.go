@@ -0,0 +1,152 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+)
+
+func TestIncludeDirectives(t *testing.T) {
+	files := map[string]string{
+		"index.html.md":        "# Introduction\n\n<!-- include: kittens -->\n\n# Errors\n",
+		"includes/_kittens.md": "# Kittens\n\n<!-- include: toys -->\n",
+		"includes/_toys.md":    "## Toys\n",
+	}
+	html := renderTestDoc(t, files, Params{})
+	intro, kittens, toys, errors := strings.Index(html, ">Introduction</h1>"), strings.Index(html, ">Kittens</h1>"),
+		strings.Index(html, ">Toys</h2>"), strings.Index(html, ">Errors</h1>")
+	if intro < 0 || !(intro < kittens && kittens < toys && toys < errors) {
+		t.Errorf("expected included files in place of the directives:\n%s", html)
+	}
+	files["includes/_toys.md"] = "## Toys\n\n<!-- include: kittens -->\n"
+	_, err := loadTestDoc(t, files, Params{})
+	if expected := "include cycle: index.html.md > includes/_kittens.md > includes/_toys.md > includes/_kittens.md"; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+	files["includes/_toys.md"] = "## Toys\n\n<!-- include: balls -->\n"
+	_, err = loadTestDoc(t, files, Params{})
+	if expected := "includes/_balls.md does not exist (included from index.html.md > includes/_kittens.md > includes/_toys.md)"; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
+
+func TestIncludeSearchPaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "src")
+	writeTestFiles(t, dir, map[string]string{
+		"src/index.html.md":            "---\ninclude_paths:\n  - shared\nincludes:\n  - auth\n  - errors\n  - pagination\n---\n",
+		"src/includes/_auth.md":        "# Auth of the source\n",
+		"src/shared/_auth.md":          "# Auth of the preamble path\n",
+		"src/shared/_errors.md":        "# Errors of the preamble path\n",
+		"src/shared/_pagination.md":    "# Pagination of the preamble path\n",
+		"src/shared/de/_pagination.md": "# Pagination translated\n",
+		"common/_errors.md":            "# Errors of the flag path\n",
+	})
+	fs, err := slate.NewUnionFS(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := Params{IncludePaths: []string{filepath.Join(dir, "common")}}
+	doc, err := load(fs, params, placement{Source: src})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var html strings.Builder
+	for _, p := range doc.pages {
+		html.Write(p.html)
+	}
+	// the source comes first, then paths set by params, then ones of the preamble
+	for _, s := range []string{"Auth of the source", "Errors of the flag path", "Pagination of the preamble path"} {
+		if !strings.Contains(html.String(), s) {
+			t.Errorf("expected %s in:\n%s", s, html.String())
+		}
+	}
+	// within a path, a file translated to the locale comes first
+	r, err := newIncludeResolver(fs, params, &ContentParams{IncludePaths: []string{"shared"}}, placement{Source: src, Locale: "de"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, disk := r.find("pagination"); name != filepath.Join(src, "shared", "de", "_pagination.md") || !disk {
+		t.Errorf("expected the translated file of the include path, got %s", name)
+	}
+	if name, disk := r.find("errors"); name != filepath.Join(dir, "common", "_errors.md") || !disk {
+		t.Errorf("expected the file of the flag path, got %s", name)
+	}
+}
+
+func TestIncludeGlobOrder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestFiles(t, dir, map[string]string{
+		"includes/endpoints/_users.md":       "---\nweight: 2\n---\n# Users\n",
+		"includes/endpoints/_010-kittens.md": "# Kittens\n",
+		"includes/endpoints/_002-auth.md":    "# Auth\n",
+		"includes/endpoints/_zoo.md":         "# Zoo\n",
+		"includes/endpoints/_apple.md":       "# Apple\n",
+		"includes/endpoints/notes.md":        "# Notes\n",
+	})
+	fs, err := slate.NewUnionFS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := newIncludeResolver(fs, Params{}, &ContentParams{}, placement{Source: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// weight or numeric prefix first, ties broken by name, then the others by name
+	expected := "endpoints/002-auth endpoints/users endpoints/010-kittens endpoints/apple endpoints/zoo"
+	for _, entry := range []string{"endpoints/*", "endpoints"} {
+		names, err := r.glob(entry, []string{"index.html.md"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(names, " ") != expected {
+			t.Errorf("%s: expected %s, got %s", entry, expected, strings.Join(names, " "))
+		}
+	}
+	if names, err := r.glob("endpoints/0*", []string{"index.html.md"}); err != nil || strings.Join(names, " ") != "endpoints/002-auth endpoints/010-kittens" {
+		t.Errorf("expected includes with the prefix 0, got %q, %v", names, err)
+	}
+	if _, err = r.glob("*/users", []string{"index.html.md"}); err == nil || !strings.HasPrefix(err.Error(), "invalid include pattern */users") {
+		t.Errorf("expected an invalid pattern error, got %v", err)
+	}
+	if _, err = r.glob("endpoints/x*", []string{"index.html.md"}); err == nil || err.Error() != "no include files match endpoints/x* in index.html.md" {
+		t.Errorf("expected a no match error, got %v", err)
+	}
+}
+
+func TestIncludeFrontMatter(t *testing.T) {
+	files := map[string]string{
+		"index.html.md":        "---\nlanguage_tabs:\n  - shell\nincludes:\n  - kittens\n  - admin\n---\n\n# Introduction\n",
+		"includes/_kittens.md": "---\ntitle: Kittens API\nlanguage_tabs:\n  - ruby\n---\n# Kittens\n",
+		"includes/_admin.md":   "---\naudience: [internal]\n---\n# Administration\n",
+	}
+	html := renderTestDoc(t, files, Params{})
+	if strings.Contains(html, "title: Kittens API") || !strings.Contains(html, ">Kittens</h1>") {
+		t.Errorf("expected the front matter to be stripped:\n%s", html)
+	}
+	if !strings.Contains(html, `data-language-name="shell"`) || !strings.Contains(html, `data-language-name="ruby"`) {
+		t.Errorf("expected language tabs of the include to be added:\n%s", html)
+	}
+	if strings.Contains(html, "Administration") {
+		t.Errorf("expected the internal include to be left out:\n%s", html)
+	}
+	if html = renderTestDoc(t, files, Params{Variant: "internal"}); !strings.Contains(html, ">Administration</h1>") {
+		t.Errorf("expected the internal include in the internal variant:\n%s", html)
+	}
+	files["includes/_admin.md"] = "---\naudience: [internal\n---\n# Administration\n"
+	if _, err := loadTestDoc(t, files, Params{}); err == nil || !strings.HasPrefix(err.Error(), "error parsing includes/_admin.md front matter:") {
+		t.Errorf("expected a front matter error, got %v", err)
+	}
+}
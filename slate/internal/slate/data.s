@@ -18849,602 +18849,602 @@ DATA ·d+150744(SB)/8,$"\xff\x07\x00\x00\xff\xff\x09\xe4"
 DATA ·d+150752(SB)/8,$"\x98\xe5\x8a\xcc\x00\x00\x00\x00"
 DATA ·d+150760(SB)/8,$"\x3c\x21\x64\x6f\x63\x74\x79\x70"
 DATA ·d+150768(SB)/8,$"\x65\x20\x68\x74\x6d\x6c\x3e\x0a"
-DATA ·d+150776(SB)/8,$"\x3c\x68\x74\x6d\x6c\x3e\x0a\x3c"
-DATA ·d+150784(SB)/8,$"\x68\x65\x61\x64\x3e\x0a\x20\x20"
-DATA ·d+150792(SB)/8,$"\x20\x20\x3c\x6d\x65\x74\x61\x20"
-DATA ·d+150800(SB)/8,$"\x63\x68\x61\x72\x73\x65\x74\x3d"
-DATA ·d+150808(SB)/8,$"\x22\x75\x74\x66\x2d\x38\x22\x3e"
-DATA ·d+150816(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x6d\x65"
-DATA ·d+150824(SB)/8,$"\x74\x61\x20\x63\x6f\x6e\x74\x65"
-DATA ·d+150832(SB)/8,$"\x6e\x74\x3d\x22\x49\x45\x3d\x65"
-DATA ·d+150840(SB)/8,$"\x64\x67\x65\x2c\x63\x68\x72\x6f"
-DATA ·d+150848(SB)/8,$"\x6d\x65\x3d\x31\x22\x20\x68\x74"
-DATA ·d+150856(SB)/8,$"\x74\x70\x2d\x65\x71\x75\x69\x76"
-DATA ·d+150864(SB)/8,$"\x3d\x22\x58\x2d\x55\x41\x2d\x43"
-DATA ·d+150872(SB)/8,$"\x6f\x6d\x70\x61\x74\x69\x62\x6c"
-DATA ·d+150880(SB)/8,$"\x65\x22\x3e\x0a\x20\x20\x20\x20"
-DATA ·d+150888(SB)/8,$"\x3c\x6d\x65\x74\x61\x20\x6e\x61"
-DATA ·d+150896(SB)/8,$"\x6d\x65\x3d\x22\x76\x69\x65\x77"
-DATA ·d+150904(SB)/8,$"\x70\x6f\x72\x74\x22\x20\x63\x6f"
-DATA ·d+150912(SB)/8,$"\x6e\x74\x65\x6e\x74\x3d\x22\x77"
-DATA ·d+150920(SB)/8,$"\x69\x64\x74\x68\x3d\x64\x65\x76"
-DATA ·d+150928(SB)/8,$"\x69\x63\x65\x2d\x77\x69\x64\x74"
-DATA ·d+150936(SB)/8,$"\x68\x2c\x20\x69\x6e\x69\x74\x69"
-DATA ·d+150944(SB)/8,$"\x61\x6c\x2d\x73\x63\x61\x6c\x65"
-DATA ·d+150952(SB)/8,$"\x3d\x31\x2c\x20\x6d\x61\x78\x69"
-DATA ·d+150960(SB)/8,$"\x6d\x75\x6d\x2d\x73\x63\x61\x6c"
-DATA ·d+150968(SB)/8,$"\x65\x3d\x31\x22\x3e\x0a\x20\x20"
-DATA ·d+150976(SB)/8,$"\x20\x20\x3c\x74\x69\x74\x6c\x65"
-DATA ·d+150984(SB)/8,$"\x3e\x7b\x7b\x20\x2e\x50\x61\x72"
-DATA ·d+150992(SB)/8,$"\x61\x6d\x73\x2e\x54\x69\x74\x6c"
-DATA ·d+151000(SB)/8,$"\x65\x20\x7d\x7d\x3c\x2f\x74\x69"
-DATA ·d+151008(SB)/8,$"\x74\x6c\x65\x3e\x0a\x20\x20\x20"
-DATA ·d+151016(SB)/8,$"\x20\x7b\x7b\x2d\x20\x2e\x50\x61"
-DATA ·d+151024(SB)/8,$"\x72\x61\x6d\x73\x2e\x48\x54\x4d"
-DATA ·d+151032(SB)/8,$"\x4c\x48\x65\x61\x64\x20\x7d\x7d"
-DATA ·d+151040(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x6c\x69"
-DATA ·d+151048(SB)/8,$"\x6e\x6b\x20\x68\x72\x65\x66\x3d"
-DATA ·d+151056(SB)/8,$"\x22\x73\x74\x79\x6c\x65\x73\x68"
-DATA ·d+151064(SB)/8,$"\x65\x65\x74\x73\x2f\x73\x63\x72"
-DATA ·d+151072(SB)/8,$"\x65\x65\x6e\x2e\x63\x73\x73\x22"
-DATA ·d+151080(SB)/8,$"\x20\x72\x65\x6c\x3d\x22\x73\x74"
-DATA ·d+151088(SB)/8,$"\x79\x6c\x65\x73\x68\x65\x65\x74"
-DATA ·d+151096(SB)/8,$"\x22\x20\x6d\x65\x64\x69\x61\x3d"
-DATA ·d+151104(SB)/8,$"\x22\x73\x63\x72\x65\x65\x6e\x22"
-DATA ·d+151112(SB)/8,$"\x20\x2f\x3e\x0a\x20\x20\x20\x20"
-DATA ·d+151120(SB)/8,$"\x3c\x6c\x69\x6e\x6b\x20\x68\x72"
-DATA ·d+151128(SB)/8,$"\x65\x66\x3d\x22\x73\x74\x79\x6c"
-DATA ·d+151136(SB)/8,$"\x65\x73\x68\x65\x65\x74\x73\x2f"
-DATA ·d+151144(SB)/8,$"\x70\x72\x69\x6e\x74\x2e\x63\x73"
-DATA ·d+151152(SB)/8,$"\x73\x22\x20\x72\x65\x6c\x3d\x22"
-DATA ·d+151160(SB)/8,$"\x73\x74\x79\x6c\x65\x73\x68\x65"
-DATA ·d+151168(SB)/8,$"\x65\x74\x22\x20\x6d\x65\x64\x69"
-DATA ·d+151176(SB)/8,$"\x61\x3d\x22\x70\x72\x69\x6e\x74"
-DATA ·d+151184(SB)/8,$"\x22\x20\x2f\x3e\x0a\x20\x20\x20"
-DATA ·d+151192(SB)/8,$"\x20\x3c\x73\x74\x79\x6c\x65\x20"
-DATA ·d+151200(SB)/8,$"\x6d\x65\x64\x69\x61\x3d\x22\x73"
-DATA ·d+151208(SB)/8,$"\x63\x72\x65\x65\x6e\x22\x3e\x7b"
-DATA ·d+151216(SB)/8,$"\x7b\x2e\x50\x61\x72\x61\x6d\x73"
-DATA ·d+151224(SB)/8,$"\x2e\x53\x74\x79\x6c\x65\x43\x53"
-DATA ·d+151232(SB)/8,$"\x53\x7d\x7d\x0a\x20\x20\x20\x20"
-DATA ·d+151240(SB)/8,$"\x3c\x2f\x73\x74\x79\x6c\x65\x3e"
-DATA ·d+151248(SB)/8,$"\x0a\x20\x20\x20\x20\x7b\x7b\x2d"
-DATA ·d+151256(SB)/8,$"\x20\x69\x66\x20\x2e\x50\x61\x72"
-DATA ·d+151264(SB)/8,$"\x61\x6d\x73\x2e\x53\x65\x61\x72"
-DATA ·d+151272(SB)/8,$"\x63\x68\x20\x7d\x7d\x0a\x20\x20"
-DATA ·d+151280(SB)/8,$"\x20\x20\x3c\x73\x63\x72\x69\x70"
-DATA ·d+151288(SB)/8,$"\x74\x20\x73\x72\x63\x3d\x22\x6a"
-DATA ·d+151296(SB)/8,$"\x61\x76\x61\x73\x63\x72\x69\x70"
-DATA ·d+151304(SB)/8,$"\x74\x73\x2f\x61\x6c\x6c\x2e\x6a"
-DATA ·d+151312(SB)/8,$"\x73\x22\x3e\x3c\x2f\x73\x63\x72"
-DATA ·d+151320(SB)/8,$"\x69\x70\x74\x3e\x0a\x20\x20\x20"
-DATA ·d+151328(SB)/8,$"\x20\x7b\x7b\x2d\x20\x65\x6c\x73"
-DATA ·d+151336(SB)/8,$"\x65\x20\x7d\x7d\x0a\x20\x20\x20"
-DATA ·d+151344(SB)/8,$"\x20\x3c\x73\x63\x72\x69\x70\x74"
-DATA ·d+151352(SB)/8,$"\x20\x73\x72\x63\x3d\x22\x6a\x61"
-DATA ·d+151360(SB)/8,$"\x76\x61\x73\x63\x72\x69\x70\x74"
-DATA ·d+151368(SB)/8,$"\x73\x2f\x61\x6c\x6c\x5f\x6e\x6f"
-DATA ·d+151376(SB)/8,$"\x73\x65\x61\x72\x63\x68\x2e\x6a"
-DATA ·d+151384(SB)/8,$"\x73\x22\x3e\x3c\x2f\x73\x63\x72"
-DATA ·d+151392(SB)/8,$"\x69\x70\x74\x3e\x0a\x20\x20\x20"
-DATA ·d+151400(SB)/8,$"\x20\x7b\x7b\x2d\x20\x65\x6e\x64"
-DATA ·d+151408(SB)/8,$"\x20\x7d\x7d\x0a\x3c\x2f\x68\x65"
-DATA ·d+151416(SB)/8,$"\x61\x64\x3e\x0a\x3c\x62\x6f\x64"
-DATA ·d+151424(SB)/8,$"\x79\x20\x63\x6c\x61\x73\x73\x3d"
-DATA ·d+151432(SB)/8,$"\x22\x69\x6e\x64\x65\x78\x22\x20"
-DATA ·d+151440(SB)/8,$"\x64\x61\x74\x61\x2d\x6c\x61\x6e"
-DATA ·d+151448(SB)/8,$"\x67\x75\x61\x67\x65\x73\x3d\x22"
-DATA ·d+151456(SB)/8,$"\x7b\x7b\x20\x2e\x50\x61\x72\x61"
-DATA ·d+151464(SB)/8,$"\x6d\x73\x2e\x4c\x61\x6e\x67\x73"
-DATA ·d+151472(SB)/8,$"\x20\x7c\x20\x6a\x73\x6f\x6e\x20"
-DATA ·d+151480(SB)/8,$"\x7c\x20\x68\x74\x6d\x6c\x20\x7d"
-DATA ·d+151488(SB)/8,$"\x7d\x22\x3e\x0a\x3c\x61\x20\x68"
-DATA ·d+151496(SB)/8,$"\x72\x65\x66\x3d\x22\x23\x22\x20"
-DATA ·d+151504(SB)/8,$"\x69\x64\x3d\x22\x6e\x61\x76\x2d"
-DATA ·d+151512(SB)/8,$"\x62\x75\x74\x74\x6f\x6e\x22\x3e"
-DATA ·d+151520(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x73\x70"
-DATA ·d+151528(SB)/8,$"\x61\x6e\x3e\x0a\x20\x20\x20\x20"
-DATA ·d+151536(SB)/8,$"\x20\x20\x20\x20\x4e\x41\x56\x0a"
-DATA ·d+151544(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+151552(SB)/8,$"\x3c\x69\x6d\x67\x20\x73\x72\x63"
-DATA ·d+151560(SB)/8,$"\x3d\x22\x69\x6d\x61\x67\x65\x73"
-DATA ·d+151568(SB)/8,$"\x2f\x6e\x61\x76\x62\x61\x72\x2e"
-DATA ·d+151576(SB)/8,$"\x70\x6e\x67\x22\x2f\x3e\x0a\x20"
-DATA ·d+151584(SB)/8,$"\x20\x20\x20\x3c\x2f\x73\x70\x61"
-DATA ·d+151592(SB)/8,$"\x6e\x3e\x0a\x3c\x2f\x61\x3e\x0a"
-DATA ·d+151600(SB)/8,$"\x3c\x64\x69\x76\x20\x63\x6c\x61"
-DATA ·d+151608(SB)/8,$"\x73\x73\x3d\x22\x74\x6f\x63\x2d"
-DATA ·d+151616(SB)/8,$"\x77\x72\x61\x70\x70\x65\x72\x22"
-DATA ·d+151624(SB)/8,$"\x3e\x0a\x20\x20\x20\x20\x7b\x7b"
-DATA ·d+151632(SB)/8,$"\x2d\x20\x69\x66\x20\x6e\x6f\x74"
-DATA ·d+151640(SB)/8,$"\x20\x2e\x50\x61\x72\x61\x6d\x73"
-DATA ·d+151648(SB)/8,$"\x2e\x4c\x6f\x67\x6f\x20\x7d\x7d"
-DATA ·d+151656(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x69\x6d"
-DATA ·d+151664(SB)/8,$"\x67\x20\x73\x72\x63\x3d\x22\x69"
-DATA ·d+151672(SB)/8,$"\x6d\x61\x67\x65\x73\x2f\x6c\x6f"
-DATA ·d+151680(SB)/8,$"\x67\x6f\x2e\x70\x6e\x67\x22\x20"
-DATA ·d+151688(SB)/8,$"\x63\x6c\x61\x73\x73\x3d\x22\x6c"
-DATA ·d+151696(SB)/8,$"\x6f\x67\x6f\x22\x20\x61\x6c\x74"
-DATA ·d+151704(SB)/8,$"\x3d\x22\x4c\x6f\x67\x6f\x22\x20"
-DATA ·d+151712(SB)/8,$"\x2f\x3e\x0a\x20\x20\x20\x20\x7b"
-DATA ·d+151720(SB)/8,$"\x7b\x2d\x20\x65\x6c\x73\x65\x20"
-DATA ·d+151728(SB)/8,$"\x7d\x7d\x0a\x20\x20\x20\x20\x20"
-DATA ·d+151736(SB)/8,$"\x20\x20\x7b\x7b\x2d\x20\x69\x66"
-DATA ·d+151744(SB)/8,$"\x20\x6e\x65\x20\x2e\x50\x61\x72"
-DATA ·d+151752(SB)/8,$"\x61\x6d\x73\x2e\x4c\x6f\x67\x6f"
-DATA ·d+151760(SB)/8,$"\x20\x22\x6e\x6f\x6e\x65\x22\x20"
-DATA ·d+151768(SB)/8,$"\x7d\x7d\x0a\x20\x20\x20\x20\x20"
-DATA ·d+151776(SB)/8,$"\x20\x20\x20\x20\x20\x20\x3c\x69"
-DATA ·d+151784(SB)/8,$"\x6d\x67\x20\x73\x72\x63\x3d\x22"
-DATA ·d+151792(SB)/8,$"\x69\x6d\x61\x67\x65\x73\x2f\x7b"
-DATA ·d+151800(SB)/8,$"\x7b\x20\x2e\x50\x61\x72\x61\x6d"
+DATA ·d+150776(SB)/8,$"\x3c\x68\x74\x6d\x6c\x20\x6c\x61"
+DATA ·d+150784(SB)/8,$"\x6e\x67\x3d\x22\x7b\x7b\x20\x2e"
+DATA ·d+150792(SB)/8,$"\x4c\x6f\x63\x61\x6c\x65\x20\x7d"
+DATA ·d+150800(SB)/8,$"\x7d\x22\x20\x64\x69\x72\x3d\x22"
+DATA ·d+150808(SB)/8,$"\x7b\x7b\x20\x2e\x44\x69\x72\x20"
+DATA ·d+150816(SB)/8,$"\x7d\x7d\x22\x3e\x0a\x3c\x68\x65"
+DATA ·d+150824(SB)/8,$"\x61\x64\x3e\x0a\x20\x20\x20\x20"
+DATA ·d+150832(SB)/8,$"\x3c\x6d\x65\x74\x61\x20\x63\x68"
+DATA ·d+150840(SB)/8,$"\x61\x72\x73\x65\x74\x3d\x22\x75"
+DATA ·d+150848(SB)/8,$"\x74\x66\x2d\x38\x22\x3e\x0a\x20"
+DATA ·d+150856(SB)/8,$"\x20\x20\x20\x3c\x6d\x65\x74\x61"
+DATA ·d+150864(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
+DATA ·d+150872(SB)/8,$"\x3d\x22\x49\x45\x3d\x65\x64\x67"
+DATA ·d+150880(SB)/8,$"\x65\x2c\x63\x68\x72\x6f\x6d\x65"
+DATA ·d+150888(SB)/8,$"\x3d\x31\x22\x20\x68\x74\x74\x70"
+DATA ·d+150896(SB)/8,$"\x2d\x65\x71\x75\x69\x76\x3d\x22"
+DATA ·d+150904(SB)/8,$"\x58\x2d\x55\x41\x2d\x43\x6f\x6d"
+DATA ·d+150912(SB)/8,$"\x70\x61\x74\x69\x62\x6c\x65\x22"
+DATA ·d+150920(SB)/8,$"\x3e\x0a\x20\x20\x20\x20\x3c\x6d"
+DATA ·d+150928(SB)/8,$"\x65\x74\x61\x20\x6e\x61\x6d\x65"
+DATA ·d+150936(SB)/8,$"\x3d\x22\x76\x69\x65\x77\x70\x6f"
+DATA ·d+150944(SB)/8,$"\x72\x74\x22\x20\x63\x6f\x6e\x74"
+DATA ·d+150952(SB)/8,$"\x65\x6e\x74\x3d\x22\x77\x69\x64"
+DATA ·d+150960(SB)/8,$"\x74\x68\x3d\x64\x65\x76\x69\x63"
+DATA ·d+150968(SB)/8,$"\x65\x2d\x77\x69\x64\x74\x68\x2c"
+DATA ·d+150976(SB)/8,$"\x20\x69\x6e\x69\x74\x69\x61\x6c"
+DATA ·d+150984(SB)/8,$"\x2d\x73\x63\x61\x6c\x65\x3d\x31"
+DATA ·d+150992(SB)/8,$"\x2c\x20\x6d\x61\x78\x69\x6d\x75"
+DATA ·d+151000(SB)/8,$"\x6d\x2d\x73\x63\x61\x6c\x65\x3d"
+DATA ·d+151008(SB)/8,$"\x31\x22\x3e\x0a\x20\x20\x20\x20"
+DATA ·d+151016(SB)/8,$"\x3c\x74\x69\x74\x6c\x65\x3e\x7b"
+DATA ·d+151024(SB)/8,$"\x7b\x20\x77\x69\x74\x68\x20\x2e"
+DATA ·d+151032(SB)/8,$"\x49\x6e\x63\x6c\x75\x64\x65\x20"
+DATA ·d+151040(SB)/8,$"\x7d\x7d\x7b\x7b\x20\x77\x69\x74"
+DATA ·d+151048(SB)/8,$"\x68\x20\x2e\x54\x69\x74\x6c\x65"
+DATA ·d+151056(SB)/8,$"\x20\x7d\x7d\x7b\x7b\x20\x2e\x20"
+DATA ·d+151064(SB)/8,$"\x7d\x7d\x20\x2d\x20\x7b\x7b\x20"
+DATA ·d+151072(SB)/8,$"\x65\x6e\x64\x20\x7d\x7d\x7b\x7b"
+DATA ·d+151080(SB)/8,$"\x20\x65\x6e\x64\x20\x7d\x7d\x7b"
+DATA ·d+151088(SB)/8,$"\x7b\x20\x2e\x50\x61\x72\x61\x6d"
+DATA ·d+151096(SB)/8,$"\x73\x2e\x54\x69\x74\x6c\x65\x20"
+DATA ·d+151104(SB)/8,$"\x7d\x7d\x3c\x2f\x74\x69\x74\x6c"
+DATA ·d+151112(SB)/8,$"\x65\x3e\x0a\x20\x20\x20\x20\x7b"
+DATA ·d+151120(SB)/8,$"\x7b\x2d\x20\x2e\x50\x61\x72\x61"
+DATA ·d+151128(SB)/8,$"\x6d\x73\x2e\x48\x54\x4d\x4c\x48"
+DATA ·d+151136(SB)/8,$"\x65\x61\x64\x20\x7d\x7d\x0a\x20"
+DATA ·d+151144(SB)/8,$"\x20\x20\x20\x3c\x6c\x69\x6e\x6b"
+DATA ·d+151152(SB)/8,$"\x20\x68\x72\x65\x66\x3d\x22\x7b"
+DATA ·d+151160(SB)/8,$"\x7b\x20\x2e\x52\x6f\x6f\x74\x20"
+DATA ·d+151168(SB)/8,$"\x7d\x7d\x73\x74\x79\x6c\x65\x73"
+DATA ·d+151176(SB)/8,$"\x68\x65\x65\x74\x73\x2f\x73\x63"
+DATA ·d+151184(SB)/8,$"\x72\x65\x65\x6e\x2e\x63\x73\x73"
+DATA ·d+151192(SB)/8,$"\x22\x20\x72\x65\x6c\x3d\x22\x73"
+DATA ·d+151200(SB)/8,$"\x74\x79\x6c\x65\x73\x68\x65\x65"
+DATA ·d+151208(SB)/8,$"\x74\x22\x20\x6d\x65\x64\x69\x61"
+DATA ·d+151216(SB)/8,$"\x3d\x22\x73\x63\x72\x65\x65\x6e"
+DATA ·d+151224(SB)/8,$"\x22\x20\x2f\x3e\x0a\x20\x20\x20"
+DATA ·d+151232(SB)/8,$"\x20\x3c\x6c\x69\x6e\x6b\x20\x68"
+DATA ·d+151240(SB)/8,$"\x72\x65\x66\x3d\x22\x7b\x7b\x20"
+DATA ·d+151248(SB)/8,$"\x2e\x52\x6f\x6f\x74\x20\x7d\x7d"
+DATA ·d+151256(SB)/8,$"\x73\x74\x79\x6c\x65\x73\x68\x65"
+DATA ·d+151264(SB)/8,$"\x65\x74\x73\x2f\x70\x72\x69\x6e"
+DATA ·d+151272(SB)/8,$"\x74\x2e\x63\x73\x73\x22\x20\x72"
+DATA ·d+151280(SB)/8,$"\x65\x6c\x3d\x22\x73\x74\x79\x6c"
+DATA ·d+151288(SB)/8,$"\x65\x73\x68\x65\x65\x74\x22\x20"
+DATA ·d+151296(SB)/8,$"\x6d\x65\x64\x69\x61\x3d\x22\x70"
+DATA ·d+151304(SB)/8,$"\x72\x69\x6e\x74\x22\x20\x2f\x3e"
+DATA ·d+151312(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x73\x74"
+DATA ·d+151320(SB)/8,$"\x79\x6c\x65\x20\x6d\x65\x64\x69"
+DATA ·d+151328(SB)/8,$"\x61\x3d\x22\x73\x63\x72\x65\x65"
+DATA ·d+151336(SB)/8,$"\x6e\x22\x3e\x7b\x7b\x2e\x50\x61"
+DATA ·d+151344(SB)/8,$"\x72\x61\x6d\x73\x2e\x53\x74\x79"
+DATA ·d+151352(SB)/8,$"\x6c\x65\x43\x53\x53\x7d\x7d\x0a"
+DATA ·d+151360(SB)/8,$"\x20\x20\x20\x20\x3c\x2f\x73\x74"
+DATA ·d+151368(SB)/8,$"\x79\x6c\x65\x3e\x0a\x20\x20\x20"
+DATA ·d+151376(SB)/8,$"\x20\x7b\x7b\x2d\x20\x69\x66\x20"
+DATA ·d+151384(SB)/8,$"\x2e\x50\x61\x72\x61\x6d\x73\x2e"
+DATA ·d+151392(SB)/8,$"\x53\x65\x61\x72\x63\x68\x20\x7d"
+DATA ·d+151400(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x3c\x73"
+DATA ·d+151408(SB)/8,$"\x63\x72\x69\x70\x74\x20\x73\x72"
+DATA ·d+151416(SB)/8,$"\x63\x3d\x22\x7b\x7b\x20\x2e\x52"
+DATA ·d+151424(SB)/8,$"\x6f\x6f\x74\x20\x7d\x7d\x6a\x61"
+DATA ·d+151432(SB)/8,$"\x76\x61\x73\x63\x72\x69\x70\x74"
+DATA ·d+151440(SB)/8,$"\x73\x2f\x61\x6c\x6c\x2e\x6a\x73"
+DATA ·d+151448(SB)/8,$"\x22\x3e\x3c\x2f\x73\x63\x72\x69"
+DATA ·d+151456(SB)/8,$"\x70\x74\x3e\x0a\x20\x20\x20\x20"
+DATA ·d+151464(SB)/8,$"\x7b\x7b\x2d\x20\x65\x6c\x73\x65"
+DATA ·d+151472(SB)/8,$"\x20\x7d\x7d\x0a\x20\x20\x20\x20"
+DATA ·d+151480(SB)/8,$"\x3c\x73\x63\x72\x69\x70\x74\x20"
+DATA ·d+151488(SB)/8,$"\x73\x72\x63\x3d\x22\x7b\x7b\x20"
+DATA ·d+151496(SB)/8,$"\x2e\x52\x6f\x6f\x74\x20\x7d\x7d"
+DATA ·d+151504(SB)/8,$"\x6a\x61\x76\x61\x73\x63\x72\x69"
+DATA ·d+151512(SB)/8,$"\x70\x74\x73\x2f\x61\x6c\x6c\x5f"
+DATA ·d+151520(SB)/8,$"\x6e\x6f\x73\x65\x61\x72\x63\x68"
+DATA ·d+151528(SB)/8,$"\x2e\x6a\x73\x22\x3e\x3c\x2f\x73"
+DATA ·d+151536(SB)/8,$"\x63\x72\x69\x70\x74\x3e\x0a\x20"
+DATA ·d+151544(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x65"
+DATA ·d+151552(SB)/8,$"\x6e\x64\x20\x7d\x7d\x0a\x3c\x2f"
+DATA ·d+151560(SB)/8,$"\x68\x65\x61\x64\x3e\x0a\x3c\x62"
+DATA ·d+151568(SB)/8,$"\x6f\x64\x79\x20\x63\x6c\x61\x73"
+DATA ·d+151576(SB)/8,$"\x73\x3d\x22\x69\x6e\x64\x65\x78"
+DATA ·d+151584(SB)/8,$"\x22\x20\x64\x61\x74\x61\x2d\x6c"
+DATA ·d+151592(SB)/8,$"\x61\x6e\x67\x75\x61\x67\x65\x73"
+DATA ·d+151600(SB)/8,$"\x3d\x22\x7b\x7b\x20\x2e\x50\x61"
+DATA ·d+151608(SB)/8,$"\x72\x61\x6d\x73\x2e\x4c\x61\x6e"
+DATA ·d+151616(SB)/8,$"\x67\x73\x20\x7c\x20\x6a\x73\x6f"
+DATA ·d+151624(SB)/8,$"\x6e\x20\x7c\x20\x68\x74\x6d\x6c"
+DATA ·d+151632(SB)/8,$"\x20\x7d\x7d\x22\x3e\x0a\x3c\x61"
+DATA ·d+151640(SB)/8,$"\x20\x68\x72\x65\x66\x3d\x22\x23"
+DATA ·d+151648(SB)/8,$"\x22\x20\x69\x64\x3d\x22\x6e\x61"
+DATA ·d+151656(SB)/8,$"\x76\x2d\x62\x75\x74\x74\x6f\x6e"
+DATA ·d+151664(SB)/8,$"\x22\x3e\x0a\x20\x20\x20\x20\x3c"
+DATA ·d+151672(SB)/8,$"\x73\x70\x61\x6e\x3e\x0a\x20\x20"
+DATA ·d+151680(SB)/8,$"\x20\x20\x20\x20\x20\x20\x7b\x7b"
+DATA ·d+151688(SB)/8,$"\x20\x2e\x53\x74\x72\x69\x6e\x67"
+DATA ·d+151696(SB)/8,$"\x73\x2e\x6e\x61\x76\x20\x7d\x7d"
+DATA ·d+151704(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+151712(SB)/8,$"\x20\x3c\x69\x6d\x67\x20\x73\x72"
+DATA ·d+151720(SB)/8,$"\x63\x3d\x22\x69\x6d\x61\x67\x65"
+DATA ·d+151728(SB)/8,$"\x73\x2f\x6e\x61\x76\x62\x61\x72"
+DATA ·d+151736(SB)/8,$"\x2e\x70\x6e\x67\x22\x2f\x3e\x0a"
+DATA ·d+151744(SB)/8,$"\x20\x20\x20\x20\x3c\x2f\x73\x70"
+DATA ·d+151752(SB)/8,$"\x61\x6e\x3e\x0a\x3c\x2f\x61\x3e"
+DATA ·d+151760(SB)/8,$"\x0a\x3c\x64\x69\x76\x20\x63\x6c"
+DATA ·d+151768(SB)/8,$"\x61\x73\x73\x3d\x22\x74\x6f\x63"
+DATA ·d+151776(SB)/8,$"\x2d\x77\x72\x61\x70\x70\x65\x72"
+DATA ·d+151784(SB)/8,$"\x22\x3e\x0a\x20\x20\x20\x20\x7b"
+DATA ·d+151792(SB)/8,$"\x7b\x2d\x20\x69\x66\x20\x6e\x6f"
+DATA ·d+151800(SB)/8,$"\x74\x20\x2e\x50\x61\x72\x61\x6d"
 DATA ·d+151808(SB)/8,$"\x73\x2e\x4c\x6f\x67\x6f\x20\x7d"
-DATA ·d+151816(SB)/8,$"\x7d\x22\x20\x63\x6c\x61\x73\x73"
-DATA ·d+151824(SB)/8,$"\x3d\x22\x6c\x6f\x67\x6f\x22\x20"
-DATA ·d+151832(SB)/8,$"\x61\x6c\x74\x3d\x22\x4c\x6f\x67"
-DATA ·d+151840(SB)/8,$"\x6f\x22\x20\x2f\x3e\x0a\x20\x20"
-DATA ·d+151848(SB)/8,$"\x20\x20\x20\x20\x20\x7b\x7b\x2d"
-DATA ·d+151856(SB)/8,$"\x20\x65\x6e\x64\x20\x7d\x7d\x0a"
-DATA ·d+151864(SB)/8,$"\x20\x20\x20\x20\x7b\x7b\x2d\x20"
-DATA ·d+151872(SB)/8,$"\x65\x6e\x64\x20\x7d\x7d\x0a\x20"
-DATA ·d+151880(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x69"
-DATA ·d+151888(SB)/8,$"\x66\x20\x67\x74\x20\x28\x6c\x65"
-DATA ·d+151896(SB)/8,$"\x6e\x20\x2e\x50\x61\x72\x61\x6d"
-DATA ·d+151904(SB)/8,$"\x73\x2e\x4c\x61\x6e\x67\x73\x29"
-DATA ·d+151912(SB)/8,$"\x20\x31\x20\x7d\x7d\x0a\x20\x20"
-DATA ·d+151920(SB)/8,$"\x20\x20\x3c\x64\x69\x76\x20\x63"
-DATA ·d+151928(SB)/8,$"\x6c\x61\x73\x73\x3d\x22\x6c\x61"
-DATA ·d+151936(SB)/8,$"\x6e\x67\x2d\x73\x65\x6c\x65\x63"
-DATA ·d+151944(SB)/8,$"\x74\x6f\x72\x22\x3e\x0a\x20\x20"
-DATA ·d+151952(SB)/8,$"\x20\x20\x20\x20\x20\x7b\x7b\x2d"
-DATA ·d+151960(SB)/8,$"\x20\x72\x61\x6e\x67\x65\x20\x2e"
-DATA ·d+151968(SB)/8,$"\x50\x61\x72\x61\x6d\x73\x2e\x4c"
-DATA ·d+151976(SB)/8,$"\x61\x6e\x67\x73\x20\x7d\x7d\x0a"
-DATA ·d+151984(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+151992(SB)/8,$"\x20\x20\x20\x3c\x61\x20\x68\x72"
-DATA ·d+152000(SB)/8,$"\x65\x66\x3d\x22\x23\x22\x20\x64"
-DATA ·d+152008(SB)/8,$"\x61\x74\x61\x2d\x6c\x61\x6e\x67"
-DATA ·d+152016(SB)/8,$"\x75\x61\x67\x65\x2d\x6e\x61\x6d"
-DATA ·d+152024(SB)/8,$"\x65\x3d\x22\x7b\x7b\x20\x2e\x20"
-DATA ·d+152032(SB)/8,$"\x7d\x7d\x22\x3e\x7b\x7b\x2e\x7d"
-DATA ·d+152040(SB)/8,$"\x7d\x3c\x2f\x61\x3e\x0a\x20\x20"
-DATA ·d+152048(SB)/8,$"\x20\x20\x20\x20\x20\x7b\x7b\x2d"
-DATA ·d+152056(SB)/8,$"\x20\x65\x6e\x64\x20\x7d\x7d\x0a"
-DATA ·d+152064(SB)/8,$"\x20\x20\x20\x20\x3c\x2f\x64\x69"
-DATA ·d+152072(SB)/8,$"\x76\x3e\x0a\x20\x20\x20\x20\x7b"
-DATA ·d+152080(SB)/8,$"\x7b\x2d\x20\x65\x6e\x64\x20\x7d"
-DATA ·d+152088(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x7b\x7b"
-DATA ·d+152096(SB)/8,$"\x2d\x20\x69\x66\x20\x2e\x50\x61"
-DATA ·d+152104(SB)/8,$"\x72\x61\x6d\x73\x2e\x53\x65\x61"
-DATA ·d+152112(SB)/8,$"\x72\x63\x68\x20\x7d\x7d\x0a\x20"
-DATA ·d+152120(SB)/8,$"\x20\x20\x20\x3c\x64\x69\x76\x20"
-DATA ·d+152128(SB)/8,$"\x63\x6c\x61\x73\x73\x3d\x22\x73"
-DATA ·d+152136(SB)/8,$"\x65\x61\x72\x63\x68\x22\x3e\x0a"
-DATA ·d+152144(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+152152(SB)/8,$"\x3c\x69\x6e\x70\x75\x74\x20\x74"
-DATA ·d+152160(SB)/8,$"\x79\x70\x65\x3d\x22\x74\x65\x78"
-DATA ·d+152168(SB)/8,$"\x74\x22\x20\x63\x6c\x61\x73\x73"
-DATA ·d+152176(SB)/8,$"\x3d\x22\x73\x65\x61\x72\x63\x68"
-DATA ·d+152184(SB)/8,$"\x22\x20\x69\x64\x3d\x22\x69\x6e"
-DATA ·d+152192(SB)/8,$"\x70\x75\x74\x2d\x73\x65\x61\x72"
-DATA ·d+152200(SB)/8,$"\x63\x68\x22\x20\x70\x6c\x61\x63"
-DATA ·d+152208(SB)/8,$"\x65\x68\x6f\x6c\x64\x65\x72\x3d"
-DATA ·d+152216(SB)/8,$"\x22\x53\x65\x61\x72\x63\x68\x22"
-DATA ·d+152224(SB)/8,$"\x3e\x0a\x20\x20\x20\x20\x3c\x2f"
-DATA ·d+152232(SB)/8,$"\x64\x69\x76\x3e\x0a\x20\x20\x20"
-DATA ·d+152240(SB)/8,$"\x20\x3c\x75\x6c\x20\x63\x6c\x61"
-DATA ·d+152248(SB)/8,$"\x73\x73\x3d\x22\x73\x65\x61\x72"
-DATA ·d+152256(SB)/8,$"\x63\x68\x2d\x72\x65\x73\x75\x6c"
-DATA ·d+152264(SB)/8,$"\x74\x73\x22\x3e\x3c\x2f\x75\x6c"
-DATA ·d+152272(SB)/8,$"\x3e\x0a\x20\x20\x20\x20\x7b\x7b"
-DATA ·d+152280(SB)/8,$"\x2d\x20\x65\x6e\x64\x20\x7d\x7d"
-DATA ·d+152288(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x64\x69"
-DATA ·d+152296(SB)/8,$"\x76\x20\x69\x64\x3d\x22\x74\x6f"
-DATA ·d+152304(SB)/8,$"\x63\x22\x20\x63\x6c\x61\x73\x73"
-DATA ·d+152312(SB)/8,$"\x3d\x22\x74\x6f\x63\x2d\x6c\x69"
-DATA ·d+152320(SB)/8,$"\x73\x74\x2d\x68\x31\x22\x3e\x0a"
-DATA ·d+152328(SB)/8,$"\x20\x20\x20\x20\x7b\x7b\x2d\x20"
-DATA ·d+152336(SB)/8,$"\x2e\x54\x4f\x43\x20\x7d\x7d\x0a"
-DATA ·d+152344(SB)/8,$"\x20\x20\x20\x20\x3c\x2f\x64\x69"
-DATA ·d+152352(SB)/8,$"\x76\x3e\x0a\x20\x20\x20\x20\x3c"
-DATA ·d+152360(SB)/8,$"\x75\x6c\x20\x63\x6c\x61\x73\x73"
-DATA ·d+152368(SB)/8,$"\x3d\x22\x74\x6f\x63\x2d\x66\x6f"
-DATA ·d+152376(SB)/8,$"\x6f\x74\x65\x72\x22\x3e\x0a\x20"
-DATA ·d+152384(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x7b"
-DATA ·d+152392(SB)/8,$"\x7b\x20\x72\x61\x6e\x67\x65\x20"
-DATA ·d+152400(SB)/8,$"\x2e\x50\x61\x72\x61\x6d\x73\x2e"
-DATA ·d+152408(SB)/8,$"\x54\x6f\x63\x46\x6f\x6f\x74\x65"
-DATA ·d+152416(SB)/8,$"\x72\x73\x20\x7d\x7d\x0a\x20\x20"
-DATA ·d+152424(SB)/8,$"\x20\x20\x20\x20\x20\x20\x3c\x6c"
-DATA ·d+152432(SB)/8,$"\x69\x3e\x7b\x7b\x2e\x7d\x7d\x3c"
-DATA ·d+152440(SB)/8,$"\x2f\x6c\x69\x3e\x0a\x20\x20\x20"
-DATA ·d+152448(SB)/8,$"\x20\x20\x20\x20\x20\x7b\x7b\x65"
-DATA ·d+152456(SB)/8,$"\x6e\x64\x7d\x7d\x0a\x20\x20\x20"
-DATA ·d+152464(SB)/8,$"\x20\x3c\x2f\x75\x6c\x3e\x0a\x3c"
-DATA ·d+152472(SB)/8,$"\x2f\x64\x69\x76\x3e\x0a\x3c\x64"
-DATA ·d+152480(SB)/8,$"\x69\x76\x20\x63\x6c\x61\x73\x73"
-DATA ·d+152488(SB)/8,$"\x3d\x22\x70\x61\x67\x65\x2d\x77"
-DATA ·d+152496(SB)/8,$"\x72\x61\x70\x70\x65\x72\x22\x3e"
-DATA ·d+152504(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x64\x69"
-DATA ·d+152512(SB)/8,$"\x76\x20\x63\x6c\x61\x73\x73\x3d"
-DATA ·d+152520(SB)/8,$"\x22\x64\x61\x72\x6b\x2d\x62\x6f"
-DATA ·d+152528(SB)/8,$"\x78\x22\x3e\x3c\x2f\x64\x69\x76"
-DATA ·d+152536(SB)/8,$"\x3e\x0a\x20\x20\x20\x20\x3c\x64"
-DATA ·d+152544(SB)/8,$"\x69\x76\x20\x63\x6c\x61\x73\x73"
-DATA ·d+152552(SB)/8,$"\x3d\x22\x63\x6f\x6e\x74\x65\x6e"
-DATA ·d+152560(SB)/8,$"\x74\x22\x3e\x0a\x20\x20\x20\x20"
-DATA ·d+152568(SB)/8,$"\x7b\x7b\x2d\x20\x2e\x43\x6f\x6e"
-DATA ·d+152576(SB)/8,$"\x74\x65\x6e\x74\x20\x7d\x7d\x0a"
-DATA ·d+152584(SB)/8,$"\x20\x20\x20\x20\x3c\x2f\x64\x69"
-DATA ·d+152592(SB)/8,$"\x76\x3e\x0a\x20\x20\x20\x20\x7b"
-DATA ·d+152600(SB)/8,$"\x7b\x2d\x20\x69\x66\x20\x67\x74"
-DATA ·d+152608(SB)/8,$"\x20\x28\x6c\x65\x6e\x20\x2e\x50"
-DATA ·d+152616(SB)/8,$"\x61\x72\x61\x6d\x73\x2e\x4c\x61"
-DATA ·d+152624(SB)/8,$"\x6e\x67\x73\x29\x20\x31\x20\x7d"
-DATA ·d+152632(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x3c\x64"
-DATA ·d+152640(SB)/8,$"\x69\x76\x20\x63\x6c\x61\x73\x73"
-DATA ·d+152648(SB)/8,$"\x3d\x22\x64\x61\x72\x6b\x2d\x62"
-DATA ·d+152656(SB)/8,$"\x6f\x78\x22\x3e\x0a\x20\x20\x20"
-DATA ·d+152664(SB)/8,$"\x20\x20\x20\x20\x20\x3c\x64\x69"
-DATA ·d+152672(SB)/8,$"\x76\x20\x63\x6c\x61\x73\x73\x3d"
-DATA ·d+152680(SB)/8,$"\x22\x6c\x61\x6e\x67\x2d\x73\x65"
-DATA ·d+152688(SB)/8,$"\x6c\x65\x63\x74\x6f\x72\x22\x3e"
-DATA ·d+152696(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+152704(SB)/8,$"\x20\x7b\x7b\x2d\x20\x72\x61\x6e"
-DATA ·d+152712(SB)/8,$"\x67\x65\x20\x2e\x50\x61\x72\x61"
-DATA ·d+152720(SB)/8,$"\x6d\x73\x2e\x4c\x61\x6e\x67\x73"
-DATA ·d+152728(SB)/8,$"\x20\x7d\x7d\x0a\x20\x20\x20\x20"
-DATA ·d+152736(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+152744(SB)/8,$"\x3c\x61\x20\x68\x72\x65\x66\x3d"
-DATA ·d+152752(SB)/8,$"\x22\x23\x22\x20\x64\x61\x74\x61"
-DATA ·d+152760(SB)/8,$"\x2d\x6c\x61\x6e\x67\x75\x61\x67"
-DATA ·d+152768(SB)/8,$"\x65\x2d\x6e\x61\x6d\x65\x3d\x22"
-DATA ·d+152776(SB)/8,$"\x7b\x7b\x20\x2e\x20\x7d\x7d\x22"
-DATA ·d+152784(SB)/8,$"\x3e\x7b\x7b\x2e\x7d\x7d\x3c\x2f"
-DATA ·d+152792(SB)/8,$"\x61\x3e\x0a\x20\x20\x20\x20\x20"
-DATA ·d+152800(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x65"
-DATA ·d+152808(SB)/8,$"\x6e\x64\x20\x7d\x7d\x0a\x20\x20"
-DATA ·d+152816(SB)/8,$"\x20\x20\x20\x20\x20\x20\x3c\x2f"
-DATA ·d+152824(SB)/8,$"\x64\x69\x76\x3e\x0a\x20\x20\x20"
-DATA ·d+152832(SB)/8,$"\x20\x3c\x2f\x64\x69\x76\x3e\x0a"
-DATA ·d+152840(SB)/8,$"\x20\x20\x20\x20\x7b\x7b\x2d\x20"
-DATA ·d+152848(SB)/8,$"\x65\x6e\x64\x20\x7d\x7d\x0a\x3c"
-DATA ·d+152856(SB)/8,$"\x2f\x64\x69\x76\x3e\x0a\x3c\x2f"
-DATA ·d+152864(SB)/8,$"\x62\x6f\x64\x79\x3e\x0a\x3c\x2f"
-DATA ·d+152872(SB)/8,$"\x68\x74\x6d\x6c\x3e\x0a\x00\x00"
-DATA ·d+152880(SB)/8,$"\x40\x66\x6f\x6e\x74\x2d\x66\x61"
-DATA ·d+152888(SB)/8,$"\x63\x65\x20\x7b\x0a\x20\x20\x66"
-DATA ·d+152896(SB)/8,$"\x6f\x6e\x74\x2d\x66\x61\x6d\x69"
-DATA ·d+152904(SB)/8,$"\x6c\x79\x3a\x20\x27\x73\x6c\x61"
-DATA ·d+152912(SB)/8,$"\x74\x65\x27\x3b\x0a\x20\x20\x73"
-DATA ·d+152920(SB)/8,$"\x72\x63\x3a\x66\x6f\x6e\x74\x2d"
-DATA ·d+152928(SB)/8,$"\x75\x72\x6c\x28\x27\x73\x6c\x61"
-DATA ·d+152936(SB)/8,$"\x74\x65\x2e\x65\x6f\x74\x3f\x2d"
-DATA ·d+152944(SB)/8,$"\x73\x79\x76\x31\x34\x6d\x27\x29"
-DATA ·d+152952(SB)/8,$"\x3b\x0a\x20\x20\x73\x72\x63\x3a"
-DATA ·d+152960(SB)/8,$"\x66\x6f\x6e\x74\x2d\x75\x72\x6c"
-DATA ·d+152968(SB)/8,$"\x28\x27\x73\x6c\x61\x74\x65\x2e"
-DATA ·d+152976(SB)/8,$"\x65\x6f\x74\x3f\x23\x69\x65\x66"
-DATA ·d+152984(SB)/8,$"\x69\x78\x2d\x73\x79\x76\x31\x34"
-DATA ·d+152992(SB)/8,$"\x6d\x27\x29\x20\x66\x6f\x72\x6d"
-DATA ·d+153000(SB)/8,$"\x61\x74\x28\x27\x65\x6d\x62\x65"
-DATA ·d+153008(SB)/8,$"\x64\x64\x65\x64\x2d\x6f\x70\x65"
-DATA ·d+153016(SB)/8,$"\x6e\x74\x79\x70\x65\x27\x29\x2c"
-DATA ·d+153024(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
-DATA ·d+153032(SB)/8,$"\x74\x2d\x75\x72\x6c\x28\x27\x73"
-DATA ·d+153040(SB)/8,$"\x6c\x61\x74\x65\x2e\x77\x6f\x66"
-DATA ·d+153048(SB)/8,$"\x66\x32\x3f\x2d\x73\x79\x76\x31"
-DATA ·d+153056(SB)/8,$"\x34\x6d\x27\x29\x20\x66\x6f\x72"
-DATA ·d+153064(SB)/8,$"\x6d\x61\x74\x28\x27\x77\x6f\x66"
-DATA ·d+153072(SB)/8,$"\x66\x32\x27\x29\x2c\x0a\x20\x20"
-DATA ·d+153080(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x75"
-DATA ·d+153088(SB)/8,$"\x72\x6c\x28\x27\x73\x6c\x61\x74"
-DATA ·d+153096(SB)/8,$"\x65\x2e\x77\x6f\x66\x66\x3f\x2d"
-DATA ·d+153104(SB)/8,$"\x73\x79\x76\x31\x34\x6d\x27\x29"
-DATA ·d+153112(SB)/8,$"\x20\x66\x6f\x72\x6d\x61\x74\x28"
-DATA ·d+153120(SB)/8,$"\x27\x77\x6f\x66\x66\x27\x29\x2c"
-DATA ·d+153128(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
-DATA ·d+153136(SB)/8,$"\x74\x2d\x75\x72\x6c\x28\x27\x73"
-DATA ·d+153144(SB)/8,$"\x6c\x61\x74\x65\x2e\x74\x74\x66"
-DATA ·d+153152(SB)/8,$"\x3f\x2d\x73\x79\x76\x31\x34\x6d"
-DATA ·d+153160(SB)/8,$"\x27\x29\x20\x66\x6f\x72\x6d\x61"
-DATA ·d+153168(SB)/8,$"\x74\x28\x27\x74\x72\x75\x65\x74"
-DATA ·d+153176(SB)/8,$"\x79\x70\x65\x27\x29\x2c\x0a\x20"
-DATA ·d+153184(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
-DATA ·d+153192(SB)/8,$"\x75\x72\x6c\x28\x27\x73\x6c\x61"
-DATA ·d+153200(SB)/8,$"\x74\x65\x2e\x73\x76\x67\x3f\x2d"
-DATA ·d+153208(SB)/8,$"\x73\x79\x76\x31\x34\x6d\x23\x73"
-DATA ·d+153216(SB)/8,$"\x6c\x61\x74\x65\x27\x29\x20\x66"
-DATA ·d+153224(SB)/8,$"\x6f\x72\x6d\x61\x74\x28\x27\x73"
-DATA ·d+153232(SB)/8,$"\x76\x67\x27\x29\x3b\x0a\x20\x20"
-DATA ·d+153240(SB)/8,$"\x66\x6f\x6e\x74\x2d\x77\x65\x69"
-DATA ·d+153248(SB)/8,$"\x67\x68\x74\x3a\x20\x6e\x6f\x72"
-DATA ·d+153256(SB)/8,$"\x6d\x61\x6c\x3b\x0a\x20\x20\x66"
-DATA ·d+153264(SB)/8,$"\x6f\x6e\x74\x2d\x73\x74\x79\x6c"
-DATA ·d+153272(SB)/8,$"\x65\x3a\x20\x6e\x6f\x72\x6d\x61"
-DATA ·d+153280(SB)/8,$"\x6c\x3b\x0a\x7d\x0a\x0a\x25\x69"
-DATA ·d+153288(SB)/8,$"\x63\x6f\x6e\x20\x7b\x0a\x20\x20"
-DATA ·d+153296(SB)/8,$"\x66\x6f\x6e\x74\x2d\x66\x61\x6d"
-DATA ·d+153304(SB)/8,$"\x69\x6c\x79\x3a\x20\x27\x73\x6c"
-DATA ·d+153312(SB)/8,$"\x61\x74\x65\x27\x3b\x0a\x20\x20"
-DATA ·d+153320(SB)/8,$"\x73\x70\x65\x61\x6b\x3a\x20\x6e"
-DATA ·d+153328(SB)/8,$"\x6f\x6e\x65\x3b\x0a\x20\x20\x66"
-DATA ·d+153336(SB)/8,$"\x6f\x6e\x74\x2d\x73\x74\x79\x6c"
-DATA ·d+153344(SB)/8,$"\x65\x3a\x20\x6e\x6f\x72\x6d\x61"
-DATA ·d+153352(SB)/8,$"\x6c\x3b\x0a\x20\x20\x66\x6f\x6e"
-DATA ·d+153360(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
-DATA ·d+153368(SB)/8,$"\x3a\x20\x6e\x6f\x72\x6d\x61\x6c"
-DATA ·d+153376(SB)/8,$"\x3b\x0a\x20\x20\x66\x6f\x6e\x74"
-DATA ·d+153384(SB)/8,$"\x2d\x76\x61\x72\x69\x61\x6e\x74"
-DATA ·d+153392(SB)/8,$"\x3a\x20\x6e\x6f\x72\x6d\x61\x6c"
-DATA ·d+153400(SB)/8,$"\x3b\x0a\x20\x20\x74\x65\x78\x74"
-DATA ·d+153408(SB)/8,$"\x2d\x74\x72\x61\x6e\x73\x66\x6f"
-DATA ·d+153416(SB)/8,$"\x72\x6d\x3a\x20\x6e\x6f\x6e\x65"
-DATA ·d+153424(SB)/8,$"\x3b\x0a\x20\x20\x6c\x69\x6e\x65"
-DATA ·d+153432(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
-DATA ·d+153440(SB)/8,$"\x20\x31\x3b\x0a\x7d\x0a\x0a\x25"
-DATA ·d+153448(SB)/8,$"\x69\x63\x6f\x6e\x2d\x65\x78\x63"
-DATA ·d+153456(SB)/8,$"\x6c\x61\x6d\x61\x74\x69\x6f\x6e"
-DATA ·d+153464(SB)/8,$"\x2d\x73\x69\x67\x6e\x20\x7b\x0a"
-DATA ·d+153472(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
-DATA ·d+153480(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x3b"
-DATA ·d+153488(SB)/8,$"\x0a\x20\x20\x63\x6f\x6e\x74\x65"
-DATA ·d+153496(SB)/8,$"\x6e\x74\x3a\x20\x22\x5c\x65\x36"
-DATA ·d+153504(SB)/8,$"\x30\x30\x22\x3b\x0a\x7d\x0a\x25"
-DATA ·d+153512(SB)/8,$"\x69\x63\x6f\x6e\x2d\x69\x6e\x66"
-DATA ·d+153520(SB)/8,$"\x6f\x2d\x73\x69\x67\x6e\x20\x7b"
-DATA ·d+153528(SB)/8,$"\x0a\x20\x20\x40\x65\x78\x74\x65"
-DATA ·d+153536(SB)/8,$"\x6e\x64\x20\x25\x69\x63\x6f\x6e"
-DATA ·d+153544(SB)/8,$"\x3b\x0a\x20\x20\x63\x6f\x6e\x74"
-DATA ·d+153552(SB)/8,$"\x65\x6e\x74\x3a\x20\x22\x5c\x65"
-DATA ·d+153560(SB)/8,$"\x36\x30\x32\x22\x3b\x0a\x7d\x0a"
-DATA ·d+153568(SB)/8,$"\x25\x69\x63\x6f\x6e\x2d\x6f\x6b"
-DATA ·d+153576(SB)/8,$"\x2d\x73\x69\x67\x6e\x20\x7b\x0a"
-DATA ·d+153584(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
-DATA ·d+153592(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x3b"
-DATA ·d+153600(SB)/8,$"\x0a\x20\x20\x63\x6f\x6e\x74\x65"
-DATA ·d+153608(SB)/8,$"\x6e\x74\x3a\x20\x22\x5c\x65\x36"
-DATA ·d+153616(SB)/8,$"\x30\x36\x22\x3b\x0a\x7d\x0a\x25"
-DATA ·d+153624(SB)/8,$"\x69\x63\x6f\x6e\x2d\x73\x65\x61"
-DATA ·d+153632(SB)/8,$"\x72\x63\x68\x20\x7b\x0a\x20\x20"
-DATA ·d+153640(SB)/8,$"\x40\x65\x78\x74\x65\x6e\x64\x20"
-DATA ·d+153648(SB)/8,$"\x25\x69\x63\x6f\x6e\x3b\x0a\x20"
-DATA ·d+153656(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
-DATA ·d+153664(SB)/8,$"\x3a\x20\x22\x5c\x65\x36\x30\x37"
-DATA ·d+153672(SB)/8,$"\x22\x3b\x0a\x7d\x0a\x00\x00\x00"
-DATA ·d+153680(SB)/8,$"\x2f\x2a\x21\x20\x6e\x6f\x72\x6d"
-DATA ·d+153688(SB)/8,$"\x61\x6c\x69\x7a\x65\x2e\x63\x73"
-DATA ·d+153696(SB)/8,$"\x73\x20\x76\x33\x2e\x30\x2e\x32"
-DATA ·d+153704(SB)/8,$"\x20\x7c\x20\x4d\x49\x54\x20\x4c"
-DATA ·d+153712(SB)/8,$"\x69\x63\x65\x6e\x73\x65\x20\x7c"
-DATA ·d+153720(SB)/8,$"\x20\x67\x69\x74\x2e\x69\x6f\x2f"
-DATA ·d+153728(SB)/8,$"\x6e\x6f\x72\x6d\x61\x6c\x69\x7a"
-DATA ·d+153736(SB)/8,$"\x65\x20\x2a\x2f\x0a\x0a\x2f\x2a"
-DATA ·d+153744(SB)/8,$"\x2a\x0a\x20\x2a\x20\x31\x2e\x20"
-DATA ·d+153752(SB)/8,$"\x53\x65\x74\x20\x64\x65\x66\x61"
-DATA ·d+153760(SB)/8,$"\x75\x6c\x74\x20\x66\x6f\x6e\x74"
-DATA ·d+153768(SB)/8,$"\x20\x66\x61\x6d\x69\x6c\x79\x20"
-DATA ·d+153776(SB)/8,$"\x74\x6f\x20\x73\x61\x6e\x73\x2d"
-DATA ·d+153784(SB)/8,$"\x73\x65\x72\x69\x66\x2e\x0a\x20"
-DATA ·d+153792(SB)/8,$"\x2a\x20\x32\x2e\x20\x50\x72\x65"
-DATA ·d+153800(SB)/8,$"\x76\x65\x6e\x74\x20\x69\x4f\x53"
-DATA ·d+153808(SB)/8,$"\x20\x74\x65\x78\x74\x20\x73\x69"
-DATA ·d+153816(SB)/8,$"\x7a\x65\x20\x61\x64\x6a\x75\x73"
-DATA ·d+153824(SB)/8,$"\x74\x20\x61\x66\x74\x65\x72\x20"
-DATA ·d+153832(SB)/8,$"\x6f\x72\x69\x65\x6e\x74\x61\x74"
-DATA ·d+153840(SB)/8,$"\x69\x6f\x6e\x20\x63\x68\x61\x6e"
-DATA ·d+153848(SB)/8,$"\x67\x65\x2c\x20\x77\x69\x74\x68"
-DATA ·d+153856(SB)/8,$"\x6f\x75\x74\x20\x64\x69\x73\x61"
-DATA ·d+153864(SB)/8,$"\x62\x6c\x69\x6e\x67\x0a\x20\x2a"
-DATA ·d+153872(SB)/8,$"\x20\x20\x20\x20\x75\x73\x65\x72"
-DATA ·d+153880(SB)/8,$"\x20\x7a\x6f\x6f\x6d\x2e\x0a\x20"
-DATA ·d+153888(SB)/8,$"\x2a\x2f\x0a\x0a\x68\x74\x6d\x6c"
-DATA ·d+153896(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x66"
-DATA ·d+153904(SB)/8,$"\x6f\x6e\x74\x2d\x66\x61\x6d\x69"
-DATA ·d+153912(SB)/8,$"\x6c\x79\x3a\x20\x73\x61\x6e\x73"
-DATA ·d+153920(SB)/8,$"\x2d\x73\x65\x72\x69\x66\x3b\x20"
-DATA ·d+153928(SB)/8,$"\x2f\x2a\x20\x31\x20\x2a\x2f\x0a"
-DATA ·d+153936(SB)/8,$"\x20\x20\x20\x20\x2d\x6d\x73\x2d"
-DATA ·d+153944(SB)/8,$"\x74\x65\x78\x74\x2d\x73\x69\x7a"
-DATA ·d+153952(SB)/8,$"\x65\x2d\x61\x64\x6a\x75\x73\x74"
-DATA ·d+153960(SB)/8,$"\x3a\x20\x31\x30\x30\x25\x3b\x20"
-DATA ·d+153968(SB)/8,$"\x2f\x2a\x20\x32\x20\x2a\x2f\x0a"
-DATA ·d+153976(SB)/8,$"\x20\x20\x20\x20\x2d\x77\x65\x62"
-DATA ·d+153984(SB)/8,$"\x6b\x69\x74\x2d\x74\x65\x78\x74"
-DATA ·d+153992(SB)/8,$"\x2d\x73\x69\x7a\x65\x2d\x61\x64"
-DATA ·d+154000(SB)/8,$"\x6a\x75\x73\x74\x3a\x20\x31\x30"
-DATA ·d+154008(SB)/8,$"\x30\x25\x3b\x20\x2f\x2a\x20\x32"
-DATA ·d+154016(SB)/8,$"\x20\x2a\x2f\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+154024(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x52\x65"
-DATA ·d+154032(SB)/8,$"\x6d\x6f\x76\x65\x20\x64\x65\x66"
-DATA ·d+154040(SB)/8,$"\x61\x75\x6c\x74\x20\x6d\x61\x72"
-DATA ·d+154048(SB)/8,$"\x67\x69\x6e\x2e\x0a\x20\x2a\x2f"
-DATA ·d+154056(SB)/8,$"\x0a\x0a\x62\x6f\x64\x79\x20\x7b"
-DATA ·d+154064(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+154072(SB)/8,$"\x67\x69\x6e\x3a\x20\x30\x3b\x0a"
-DATA ·d+154080(SB)/8,$"\x7d\x0a\x0a\x2f\x2a\x20\x48\x54"
-DATA ·d+154088(SB)/8,$"\x4d\x4c\x35\x20\x64\x69\x73\x70"
-DATA ·d+154096(SB)/8,$"\x6c\x61\x79\x20\x64\x65\x66\x69"
-DATA ·d+154104(SB)/8,$"\x6e\x69\x74\x69\x6f\x6e\x73\x0a"
-DATA ·d+154112(SB)/8,$"\x20\x20\x20\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154120(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154128(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154136(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154144(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154152(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154160(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154168(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154176(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+154184(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x20\x2a\x2f"
-DATA ·d+154192(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
-DATA ·d+154200(SB)/8,$"\x20\x43\x6f\x72\x72\x65\x63\x74"
-DATA ·d+154208(SB)/8,$"\x20\x60\x62\x6c\x6f\x63\x6b\x60"
-DATA ·d+154216(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
-DATA ·d+154224(SB)/8,$"\x20\x6e\x6f\x74\x20\x64\x65\x66"
-DATA ·d+154232(SB)/8,$"\x69\x6e\x65\x64\x20\x66\x6f\x72"
-DATA ·d+154240(SB)/8,$"\x20\x61\x6e\x79\x20\x48\x54\x4d"
-DATA ·d+154248(SB)/8,$"\x4c\x35\x20\x65\x6c\x65\x6d\x65"
-DATA ·d+154256(SB)/8,$"\x6e\x74\x20\x69\x6e\x20\x49\x45"
-DATA ·d+154264(SB)/8,$"\x20\x38\x2f\x39\x2e\x0a\x20\x2a"
-DATA ·d+154272(SB)/8,$"\x20\x43\x6f\x72\x72\x65\x63\x74"
-DATA ·d+154280(SB)/8,$"\x20\x60\x62\x6c\x6f\x63\x6b\x60"
-DATA ·d+154288(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
-DATA ·d+154296(SB)/8,$"\x20\x6e\x6f\x74\x20\x64\x65\x66"
-DATA ·d+154304(SB)/8,$"\x69\x6e\x65\x64\x20\x66\x6f\x72"
-DATA ·d+154312(SB)/8,$"\x20\x60\x64\x65\x74\x61\x69\x6c"
-DATA ·d+154320(SB)/8,$"\x73\x60\x20\x6f\x72\x20\x60\x73"
-DATA ·d+154328(SB)/8,$"\x75\x6d\x6d\x61\x72\x79\x60\x20"
-DATA ·d+154336(SB)/8,$"\x69\x6e\x20\x49\x45\x20\x31\x30"
-DATA ·d+154344(SB)/8,$"\x2f\x31\x31\x0a\x20\x2a\x20\x61"
-DATA ·d+154352(SB)/8,$"\x6e\x64\x20\x46\x69\x72\x65\x66"
-DATA ·d+154360(SB)/8,$"\x6f\x78\x2e\x0a\x20\x2a\x20\x43"
-DATA ·d+154368(SB)/8,$"\x6f\x72\x72\x65\x63\x74\x20\x60"
-DATA ·d+154376(SB)/8,$"\x62\x6c\x6f\x63\x6b\x60\x20\x64"
-DATA ·d+154384(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x20\x6e"
-DATA ·d+154392(SB)/8,$"\x6f\x74\x20\x64\x65\x66\x69\x6e"
-DATA ·d+154400(SB)/8,$"\x65\x64\x20\x66\x6f\x72\x20\x60"
-DATA ·d+154408(SB)/8,$"\x6d\x61\x69\x6e\x60\x20\x69\x6e"
-DATA ·d+154416(SB)/8,$"\x20\x49\x45\x20\x31\x31\x2e\x0a"
-DATA ·d+154424(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x61\x72\x74"
-DATA ·d+154432(SB)/8,$"\x69\x63\x6c\x65\x2c\x0a\x61\x73"
-DATA ·d+154440(SB)/8,$"\x69\x64\x65\x2c\x0a\x64\x65\x74"
-DATA ·d+154448(SB)/8,$"\x61\x69\x6c\x73\x2c\x0a\x66\x69"
-DATA ·d+154456(SB)/8,$"\x67\x63\x61\x70\x74\x69\x6f\x6e"
-DATA ·d+154464(SB)/8,$"\x2c\x0a\x66\x69\x67\x75\x72\x65"
-DATA ·d+154472(SB)/8,$"\x2c\x0a\x66\x6f\x6f\x74\x65\x72"
-DATA ·d+154480(SB)/8,$"\x2c\x0a\x68\x65\x61\x64\x65\x72"
-DATA ·d+154488(SB)/8,$"\x2c\x0a\x68\x67\x72\x6f\x75\x70"
-DATA ·d+154496(SB)/8,$"\x2c\x0a\x6d\x61\x69\x6e\x2c\x0a"
-DATA ·d+154504(SB)/8,$"\x6d\x65\x6e\x75\x2c\x0a\x6e\x61"
-DATA ·d+154512(SB)/8,$"\x76\x2c\x0a\x73\x65\x63\x74\x69"
-DATA ·d+154520(SB)/8,$"\x6f\x6e\x2c\x0a\x73\x75\x6d\x6d"
-DATA ·d+154528(SB)/8,$"\x61\x72\x79\x20\x7b\x0a\x20\x20"
-DATA ·d+154536(SB)/8,$"\x20\x20\x64\x69\x73\x70\x6c\x61"
-DATA ·d+154544(SB)/8,$"\x79\x3a\x20\x62\x6c\x6f\x63\x6b"
-DATA ·d+154552(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+154560(SB)/8,$"\x0a\x20\x2a\x20\x31\x2e\x20\x43"
-DATA ·d+154568(SB)/8,$"\x6f\x72\x72\x65\x63\x74\x20\x60"
-DATA ·d+154576(SB)/8,$"\x69\x6e\x6c\x69\x6e\x65\x2d\x62"
-DATA ·d+154584(SB)/8,$"\x6c\x6f\x63\x6b\x60\x20\x64\x69"
-DATA ·d+154592(SB)/8,$"\x73\x70\x6c\x61\x79\x20\x6e\x6f"
-DATA ·d+154600(SB)/8,$"\x74\x20\x64\x65\x66\x69\x6e\x65"
-DATA ·d+154608(SB)/8,$"\x64\x20\x69\x6e\x20\x49\x45\x20"
-DATA ·d+154616(SB)/8,$"\x38\x2f\x39\x2e\x0a\x20\x2a\x20"
-DATA ·d+154624(SB)/8,$"\x32\x2e\x20\x4e\x6f\x72\x6d\x61"
-DATA ·d+154632(SB)/8,$"\x6c\x69\x7a\x65\x20\x76\x65\x72"
-DATA ·d+154640(SB)/8,$"\x74\x69\x63\x61\x6c\x20\x61\x6c"
-DATA ·d+154648(SB)/8,$"\x69\x67\x6e\x6d\x65\x6e\x74\x20"
-DATA ·d+154656(SB)/8,$"\x6f\x66\x20\x60\x70\x72\x6f\x67"
-DATA ·d+154664(SB)/8,$"\x72\x65\x73\x73\x60\x20\x69\x6e"
-DATA ·d+154672(SB)/8,$"\x20\x43\x68\x72\x6f\x6d\x65\x2c"
-DATA ·d+154680(SB)/8,$"\x20\x46\x69\x72\x65\x66\x6f\x78"
-DATA ·d+154688(SB)/8,$"\x2c\x20\x61\x6e\x64\x20\x4f\x70"
-DATA ·d+154696(SB)/8,$"\x65\x72\x61\x2e\x0a\x20\x2a\x2f"
-DATA ·d+154704(SB)/8,$"\x0a\x0a\x61\x75\x64\x69\x6f\x2c"
-DATA ·d+154712(SB)/8,$"\x0a\x63\x61\x6e\x76\x61\x73\x2c"
-DATA ·d+154720(SB)/8,$"\x0a\x70\x72\x6f\x67\x72\x65\x73"
-DATA ·d+154728(SB)/8,$"\x73\x2c\x0a\x76\x69\x64\x65\x6f"
-DATA ·d+154736(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x64"
-DATA ·d+154744(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x3a\x20"
-DATA ·d+154752(SB)/8,$"\x69\x6e\x6c\x69\x6e\x65\x2d\x62"
-DATA ·d+154760(SB)/8,$"\x6c\x6f\x63\x6b\x3b\x20\x2f\x2a"
-DATA ·d+154768(SB)/8,$"\x20\x31\x20\x2a\x2f\x0a\x20\x20"
-DATA ·d+154776(SB)/8,$"\x20\x20\x76\x65\x72\x74\x69\x63"
-DATA ·d+154784(SB)/8,$"\x61\x6c\x2d\x61\x6c\x69\x67\x6e"
-DATA ·d+154792(SB)/8,$"\x3a\x20\x62\x61\x73\x65\x6c\x69"
-DATA ·d+154800(SB)/8,$"\x6e\x65\x3b\x20\x2f\x2a\x20\x32"
-DATA ·d+154808(SB)/8,$"\x20\x2a\x2f\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+154816(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x50\x72"
-DATA ·d+154824(SB)/8,$"\x65\x76\x65\x6e\x74\x20\x6d\x6f"
-DATA ·d+154832(SB)/8,$"\x64\x65\x72\x6e\x20\x62\x72\x6f"
-DATA ·d+154840(SB)/8,$"\x77\x73\x65\x72\x73\x20\x66\x72"
-DATA ·d+154848(SB)/8,$"\x6f\x6d\x20\x64\x69\x73\x70\x6c"
-DATA ·d+154856(SB)/8,$"\x61\x79\x69\x6e\x67\x20\x60\x61"
-DATA ·d+154864(SB)/8,$"\x75\x64\x69\x6f\x60\x20\x77\x69"
-DATA ·d+154872(SB)/8,$"\x74\x68\x6f\x75\x74\x20\x63\x6f"
-DATA ·d+154880(SB)/8,$"\x6e\x74\x72\x6f\x6c\x73\x2e\x0a"
-DATA ·d+154888(SB)/8,$"\x20\x2a\x20\x52\x65\x6d\x6f\x76"
-DATA ·d+154896(SB)/8,$"\x65\x20\x65\x78\x63\x65\x73\x73"
-DATA ·d+154904(SB)/8,$"\x20\x68\x65\x69\x67\x68\x74\x20"
-DATA ·d+154912(SB)/8,$"\x69\x6e\x20\x69\x4f\x53\x20\x35"
-DATA ·d+154920(SB)/8,$"\x20\x64\x65\x76\x69\x63\x65\x73"
-DATA ·d+154928(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x61"
-DATA ·d+154936(SB)/8,$"\x75\x64\x69\x6f\x3a\x6e\x6f\x74"
-DATA ·d+154944(SB)/8,$"\x28\x5b\x63\x6f\x6e\x74\x72\x6f"
-DATA ·d+154952(SB)/8,$"\x6c\x73\x5d\x29\x20\x7b\x0a\x20"
-DATA ·d+154960(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
-DATA ·d+154968(SB)/8,$"\x61\x79\x3a\x20\x6e\x6f\x6e\x65"
-DATA ·d+154976(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x68\x65"
-DATA ·d+154984(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x30\x3b"
-DATA ·d+154992(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+155000(SB)/8,$"\x20\x2a\x20\x41\x64\x64\x72\x65"
-DATA ·d+155008(SB)/8,$"\x73\x73\x20\x60\x5b\x68\x69\x64"
-DATA ·d+155016(SB)/8,$"\x64\x65\x6e\x5d\x60\x20\x73\x74"
-DATA ·d+155024(SB)/8,$"\x79\x6c\x69\x6e\x67\x20\x6e\x6f"
-DATA ·d+155032(SB)/8,$"\x74\x20\x70\x72\x65\x73\x65\x6e"
-DATA ·d+155040(SB)/8,$"\x74\x20\x69\x6e\x20\x49\x45\x20"
-DATA ·d+155048(SB)/8,$"\x38\x2f\x39\x2f\x31\x30\x2e\x0a"
-DATA ·d+155056(SB)/8,$"\x20\x2a\x20\x48\x69\x64\x65\x20"
-DATA ·d+155064(SB)/8,$"\x74\x68\x65\x20\x60\x74\x65\x6d"
-DATA ·d+155072(SB)/8,$"\x70\x6c\x61\x74\x65\x60\x20\x65"
-DATA ·d+155080(SB)/8,$"\x6c\x65\x6d\x65\x6e\x74\x20\x69"
-DATA ·d+155088(SB)/8,$"\x6e\x20\x49\x45\x20\x38\x2f\x39"
-DATA ·d+155096(SB)/8,$"\x2f\x31\x31\x2c\x20\x53\x61\x66"
-DATA ·d+155104(SB)/8,$"\x61\x72\x69\x2c\x20\x61\x6e\x64"
-DATA ·d+155112(SB)/8,$"\x20\x46\x69\x72\x65\x66\x6f\x78"
-DATA ·d+155120(SB)/8,$"\x20\x3c\x20\x32\x32\x2e\x0a\x20"
-DATA ·d+155128(SB)/8,$"\x2a\x2f\x0a\x0a\x5b\x68\x69\x64"
-DATA ·d+155136(SB)/8,$"\x64\x65\x6e\x5d\x2c\x0a\x74\x65"
-DATA ·d+155144(SB)/8,$"\x6d\x70\x6c\x61\x74\x65\x20\x7b"
-DATA ·d+155152(SB)/8,$"\x0a\x20\x20\x20\x20\x64\x69\x73"
-DATA ·d+155160(SB)/8,$"\x70\x6c\x61\x79\x3a\x20\x6e\x6f"
-DATA ·d+155168(SB)/8,$"\x6e\x65\x3b\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+155176(SB)/8,$"\x2a\x20\x4c\x69\x6e\x6b\x73\x0a"
-DATA ·d+155184(SB)/8,$"\x20\x20\x20\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155192(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155200(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155208(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155216(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155224(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155232(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155240(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155248(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155256(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x20\x2a\x2f"
-DATA ·d+155264(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
-DATA ·d+155272(SB)/8,$"\x20\x52\x65\x6d\x6f\x76\x65\x20"
-DATA ·d+155280(SB)/8,$"\x74\x68\x65\x20\x67\x72\x61\x79"
-DATA ·d+155288(SB)/8,$"\x20\x62\x61\x63\x6b\x67\x72\x6f"
-DATA ·d+155296(SB)/8,$"\x75\x6e\x64\x20\x63\x6f\x6c\x6f"
-DATA ·d+155304(SB)/8,$"\x72\x20\x66\x72\x6f\x6d\x20\x61"
-DATA ·d+155312(SB)/8,$"\x63\x74\x69\x76\x65\x20\x6c\x69"
-DATA ·d+155320(SB)/8,$"\x6e\x6b\x73\x20\x69\x6e\x20\x49"
-DATA ·d+155328(SB)/8,$"\x45\x20\x31\x30\x2e\x0a\x20\x2a"
-DATA ·d+155336(SB)/8,$"\x2f\x0a\x0a\x61\x20\x7b\x0a\x20"
-DATA ·d+155344(SB)/8,$"\x20\x20\x20\x62\x61\x63\x6b\x67"
-DATA ·d+155352(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
-DATA ·d+155360(SB)/8,$"\x6c\x6f\x72\x3a\x20\x74\x72\x61"
-DATA ·d+155368(SB)/8,$"\x6e\x73\x70\x61\x72\x65\x6e\x74"
-DATA ·d+155376(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+155384(SB)/8,$"\x0a\x20\x2a\x20\x49\x6d\x70\x72"
-DATA ·d+155392(SB)/8,$"\x6f\x76\x65\x20\x72\x65\x61\x64"
-DATA ·d+155400(SB)/8,$"\x61\x62\x69\x6c\x69\x74\x79\x20"
-DATA ·d+155408(SB)/8,$"\x77\x68\x65\x6e\x20\x66\x6f\x63"
-DATA ·d+155416(SB)/8,$"\x75\x73\x65\x64\x20\x61\x6e\x64"
-DATA ·d+155424(SB)/8,$"\x20\x61\x6c\x73\x6f\x20\x6d\x6f"
-DATA ·d+155432(SB)/8,$"\x75\x73\x65\x20\x68\x6f\x76\x65"
-DATA ·d+155440(SB)/8,$"\x72\x65\x64\x20\x69\x6e\x20\x61"
-DATA ·d+155448(SB)/8,$"\x6c\x6c\x20\x62\x72\x6f\x77\x73"
-DATA ·d+155456(SB)/8,$"\x65\x72\x73\x2e\x0a\x20\x2a\x2f"
-DATA ·d+155464(SB)/8,$"\x0a\x0a\x61\x3a\x61\x63\x74\x69"
-DATA ·d+155472(SB)/8,$"\x76\x65\x2c\x0a\x61\x3a\x68\x6f"
-DATA ·d+155480(SB)/8,$"\x76\x65\x72\x20\x7b\x0a\x20\x20"
-DATA ·d+155488(SB)/8,$"\x20\x20\x6f\x75\x74\x6c\x69\x6e"
-DATA ·d+155496(SB)/8,$"\x65\x3a\x20\x30\x3b\x0a\x7d\x0a"
-DATA ·d+155504(SB)/8,$"\x0a\x2f\x2a\x20\x54\x65\x78\x74"
-DATA ·d+155512(SB)/8,$"\x2d\x6c\x65\x76\x65\x6c\x20\x73"
-DATA ·d+155520(SB)/8,$"\x65\x6d\x61\x6e\x74\x69\x63\x73"
-DATA ·d+155528(SB)/8,$"\x0a\x20\x20\x20\x3d\x3d\x3d\x3d"
-DATA ·d+155536(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+151816(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x3c\x69"
+DATA ·d+151824(SB)/8,$"\x6d\x67\x20\x73\x72\x63\x3d\x22"
+DATA ·d+151832(SB)/8,$"\x69\x6d\x61\x67\x65\x73\x2f\x6c"
+DATA ·d+151840(SB)/8,$"\x6f\x67\x6f\x2e\x70\x6e\x67\x22"
+DATA ·d+151848(SB)/8,$"\x20\x63\x6c\x61\x73\x73\x3d\x22"
+DATA ·d+151856(SB)/8,$"\x6c\x6f\x67\x6f\x22\x20\x61\x6c"
+DATA ·d+151864(SB)/8,$"\x74\x3d\x22\x4c\x6f\x67\x6f\x22"
+DATA ·d+151872(SB)/8,$"\x20\x2f\x3e\x0a\x20\x20\x20\x20"
+DATA ·d+151880(SB)/8,$"\x7b\x7b\x2d\x20\x65\x6c\x73\x65"
+DATA ·d+151888(SB)/8,$"\x20\x7d\x7d\x0a\x20\x20\x20\x20"
+DATA ·d+151896(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x69"
+DATA ·d+151904(SB)/8,$"\x66\x20\x6e\x65\x20\x2e\x50\x61"
+DATA ·d+151912(SB)/8,$"\x72\x61\x6d\x73\x2e\x4c\x6f\x67"
+DATA ·d+151920(SB)/8,$"\x6f\x20\x22\x6e\x6f\x6e\x65\x22"
+DATA ·d+151928(SB)/8,$"\x20\x7d\x7d\x0a\x20\x20\x20\x20"
+DATA ·d+151936(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x3c"
+DATA ·d+151944(SB)/8,$"\x69\x6d\x67\x20\x73\x72\x63\x3d"
+DATA ·d+151952(SB)/8,$"\x22\x69\x6d\x61\x67\x65\x73\x2f"
+DATA ·d+151960(SB)/8,$"\x7b\x7b\x20\x2e\x50\x61\x72\x61"
+DATA ·d+151968(SB)/8,$"\x6d\x73\x2e\x4c\x6f\x67\x6f\x20"
+DATA ·d+151976(SB)/8,$"\x7d\x7d\x22\x20\x63\x6c\x61\x73"
+DATA ·d+151984(SB)/8,$"\x73\x3d\x22\x6c\x6f\x67\x6f\x22"
+DATA ·d+151992(SB)/8,$"\x20\x61\x6c\x74\x3d\x22\x4c\x6f"
+DATA ·d+152000(SB)/8,$"\x67\x6f\x22\x20\x2f\x3e\x0a\x20"
+DATA ·d+152008(SB)/8,$"\x20\x20\x20\x20\x20\x20\x7b\x7b"
+DATA ·d+152016(SB)/8,$"\x2d\x20\x65\x6e\x64\x20\x7d\x7d"
+DATA ·d+152024(SB)/8,$"\x0a\x20\x20\x20\x20\x7b\x7b\x2d"
+DATA ·d+152032(SB)/8,$"\x20\x65\x6e\x64\x20\x7d\x7d\x0a"
+DATA ·d+152040(SB)/8,$"\x20\x20\x20\x20\x7b\x7b\x2d\x20"
+DATA ·d+152048(SB)/8,$"\x69\x66\x20\x2e\x56\x65\x72\x73"
+DATA ·d+152056(SB)/8,$"\x69\x6f\x6e\x73\x20\x7d\x7d\x0a"
+DATA ·d+152064(SB)/8,$"\x20\x20\x20\x20\x3c\x64\x69\x76"
+DATA ·d+152072(SB)/8,$"\x20\x63\x6c\x61\x73\x73\x3d\x22"
+DATA ·d+152080(SB)/8,$"\x76\x65\x72\x73\x69\x6f\x6e\x2d"
+DATA ·d+152088(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x6f\x72"
+DATA ·d+152096(SB)/8,$"\x22\x3e\x0a\x20\x20\x20\x20\x20"
+DATA ·d+152104(SB)/8,$"\x20\x20\x20\x3c\x73\x65\x6c\x65"
+DATA ·d+152112(SB)/8,$"\x63\x74\x20\x6f\x6e\x63\x68\x61"
+DATA ·d+152120(SB)/8,$"\x6e\x67\x65\x3d\x22\x77\x69\x6e"
+DATA ·d+152128(SB)/8,$"\x64\x6f\x77\x2e\x6c\x6f\x63\x61"
+DATA ·d+152136(SB)/8,$"\x74\x69\x6f\x6e\x2e\x68\x72\x65"
+DATA ·d+152144(SB)/8,$"\x66\x20\x3d\x20\x74\x68\x69\x73"
+DATA ·d+152152(SB)/8,$"\x2e\x76\x61\x6c\x75\x65\x22\x3e"
+DATA ·d+152160(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+152168(SB)/8,$"\x20\x7b\x7b\x2d\x20\x72\x61\x6e"
+DATA ·d+152176(SB)/8,$"\x67\x65\x20\x2e\x56\x65\x72\x73"
+DATA ·d+152184(SB)/8,$"\x69\x6f\x6e\x73\x20\x7d\x7d\x0a"
+DATA ·d+152192(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+152200(SB)/8,$"\x20\x20\x20\x20\x3c\x6f\x70\x74"
+DATA ·d+152208(SB)/8,$"\x69\x6f\x6e\x20\x76\x61\x6c\x75"
+DATA ·d+152216(SB)/8,$"\x65\x3d\x22\x7b\x7b\x20\x24\x2e"
+DATA ·d+152224(SB)/8,$"\x52\x6f\x6f\x74\x20\x7d\x7d\x7b"
+DATA ·d+152232(SB)/8,$"\x7b\x20\x2e\x4e\x61\x6d\x65\x20"
+DATA ·d+152240(SB)/8,$"\x7d\x7d\x2f\x69\x6e\x64\x65\x78"
+DATA ·d+152248(SB)/8,$"\x2e\x68\x74\x6d\x6c\x22\x7b\x7b"
+DATA ·d+152256(SB)/8,$"\x20\x69\x66\x20\x65\x71\x20\x2e"
+DATA ·d+152264(SB)/8,$"\x4e\x61\x6d\x65\x20\x24\x2e\x56"
+DATA ·d+152272(SB)/8,$"\x65\x72\x73\x69\x6f\x6e\x20\x7d"
+DATA ·d+152280(SB)/8,$"\x7d\x20\x73\x65\x6c\x65\x63\x74"
+DATA ·d+152288(SB)/8,$"\x65\x64\x7b\x7b\x20\x65\x6e\x64"
+DATA ·d+152296(SB)/8,$"\x20\x7d\x7d\x3e\x7b\x7b\x20\x2e"
+DATA ·d+152304(SB)/8,$"\x4e\x61\x6d\x65\x20\x7d\x7d\x7b"
+DATA ·d+152312(SB)/8,$"\x7b\x20\x69\x66\x20\x65\x71\x20"
+DATA ·d+152320(SB)/8,$"\x2e\x4e\x61\x6d\x65\x20\x24\x2e"
+DATA ·d+152328(SB)/8,$"\x4c\x61\x74\x65\x73\x74\x20\x7d"
+DATA ·d+152336(SB)/8,$"\x7d\x20\x28\x7b\x7b\x20\x24\x2e"
+DATA ·d+152344(SB)/8,$"\x53\x74\x72\x69\x6e\x67\x73\x2e"
+DATA ·d+152352(SB)/8,$"\x6c\x61\x74\x65\x73\x74\x20\x7d"
+DATA ·d+152360(SB)/8,$"\x7d\x29\x7b\x7b\x20\x65\x6e\x64"
+DATA ·d+152368(SB)/8,$"\x20\x7d\x7d\x3c\x2f\x6f\x70\x74"
+DATA ·d+152376(SB)/8,$"\x69\x6f\x6e\x3e\x0a\x20\x20\x20"
+DATA ·d+152384(SB)/8,$"\x20\x20\x20\x20\x20\x7b\x7b\x2d"
+DATA ·d+152392(SB)/8,$"\x20\x65\x6e\x64\x20\x7d\x7d\x0a"
+DATA ·d+152400(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+152408(SB)/8,$"\x3c\x2f\x73\x65\x6c\x65\x63\x74"
+DATA ·d+152416(SB)/8,$"\x3e\x0a\x20\x20\x20\x20\x3c\x2f"
+DATA ·d+152424(SB)/8,$"\x64\x69\x76\x3e\x0a\x20\x20\x20"
+DATA ·d+152432(SB)/8,$"\x20\x7b\x7b\x2d\x20\x65\x6e\x64"
+DATA ·d+152440(SB)/8,$"\x20\x7d\x7d\x0a\x20\x20\x20\x20"
+DATA ·d+152448(SB)/8,$"\x7b\x7b\x2d\x20\x69\x66\x20\x2e"
+DATA ·d+152456(SB)/8,$"\x4c\x6f\x63\x61\x6c\x65\x73\x20"
+DATA ·d+152464(SB)/8,$"\x7d\x7d\x0a\x20\x20\x20\x20\x3c"
+DATA ·d+152472(SB)/8,$"\x64\x69\x76\x20\x63\x6c\x61\x73"
+DATA ·d+152480(SB)/8,$"\x73\x3d\x22\x6c\x6f\x63\x61\x6c"
+DATA ·d+152488(SB)/8,$"\x65\x2d\x73\x65\x6c\x65\x63\x74"
+DATA ·d+152496(SB)/8,$"\x6f\x72\x22\x3e\x0a\x20\x20\x20"
+DATA ·d+152504(SB)/8,$"\x20\x20\x20\x20\x20\x3c\x73\x65"
+DATA ·d+152512(SB)/8,$"\x6c\x65\x63\x74\x20\x6f\x6e\x63"
+DATA ·d+152520(SB)/8,$"\x68\x61\x6e\x67\x65\x3d\x22\x77"
+DATA ·d+152528(SB)/8,$"\x69\x6e\x64\x6f\x77\x2e\x6c\x6f"
+DATA ·d+152536(SB)/8,$"\x63\x61\x74\x69\x6f\x6e\x2e\x68"
+DATA ·d+152544(SB)/8,$"\x72\x65\x66\x20\x3d\x20\x74\x68"
+DATA ·d+152552(SB)/8,$"\x69\x73\x2e\x76\x61\x6c\x75\x65"
+DATA ·d+152560(SB)/8,$"\x22\x3e\x0a\x20\x20\x20\x20\x20"
+DATA ·d+152568(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x72"
+DATA ·d+152576(SB)/8,$"\x61\x6e\x67\x65\x20\x2e\x4c\x6f"
+DATA ·d+152584(SB)/8,$"\x63\x61\x6c\x65\x73\x20\x7d\x7d"
+DATA ·d+152592(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+152600(SB)/8,$"\x20\x20\x20\x20\x20\x3c\x6f\x70"
+DATA ·d+152608(SB)/8,$"\x74\x69\x6f\x6e\x20\x76\x61\x6c"
+DATA ·d+152616(SB)/8,$"\x75\x65\x3d\x22\x7b\x7b\x20\x2e"
+DATA ·d+152624(SB)/8,$"\x55\x52\x4c\x20\x7d\x7d\x22\x7b"
+DATA ·d+152632(SB)/8,$"\x7b\x20\x69\x66\x20\x65\x71\x20"
+DATA ·d+152640(SB)/8,$"\x2e\x4c\x6f\x63\x61\x6c\x65\x20"
+DATA ·d+152648(SB)/8,$"\x24\x2e\x4c\x6f\x63\x61\x6c\x65"
+DATA ·d+152656(SB)/8,$"\x20\x7d\x7d\x20\x73\x65\x6c\x65"
+DATA ·d+152664(SB)/8,$"\x63\x74\x65\x64\x7b\x7b\x20\x65"
+DATA ·d+152672(SB)/8,$"\x6e\x64\x20\x7d\x7d\x3e\x7b\x7b"
+DATA ·d+152680(SB)/8,$"\x20\x2e\x4e\x61\x6d\x65\x20\x7d"
+DATA ·d+152688(SB)/8,$"\x7d\x3c\x2f\x6f\x70\x74\x69\x6f"
+DATA ·d+152696(SB)/8,$"\x6e\x3e\x0a\x20\x20\x20\x20\x20"
+DATA ·d+152704(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x65"
+DATA ·d+152712(SB)/8,$"\x6e\x64\x20\x7d\x7d\x0a\x20\x20"
+DATA ·d+152720(SB)/8,$"\x20\x20\x20\x20\x20\x20\x3c\x2f"
+DATA ·d+152728(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x3e\x0a"
+DATA ·d+152736(SB)/8,$"\x20\x20\x20\x20\x3c\x2f\x64\x69"
+DATA ·d+152744(SB)/8,$"\x76\x3e\x0a\x20\x20\x20\x20\x7b"
+DATA ·d+152752(SB)/8,$"\x7b\x2d\x20\x65\x6e\x64\x20\x7d"
+DATA ·d+152760(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x7b\x7b"
+DATA ·d+152768(SB)/8,$"\x2d\x20\x69\x66\x20\x67\x74\x20"
+DATA ·d+152776(SB)/8,$"\x28\x6c\x65\x6e\x20\x2e\x50\x61"
+DATA ·d+152784(SB)/8,$"\x72\x61\x6d\x73\x2e\x4c\x61\x6e"
+DATA ·d+152792(SB)/8,$"\x67\x73\x29\x20\x31\x20\x7d\x7d"
+DATA ·d+152800(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x64\x69"
+DATA ·d+152808(SB)/8,$"\x76\x20\x63\x6c\x61\x73\x73\x3d"
+DATA ·d+152816(SB)/8,$"\x22\x6c\x61\x6e\x67\x2d\x73\x65"
+DATA ·d+152824(SB)/8,$"\x6c\x65\x63\x74\x6f\x72\x22\x3e"
+DATA ·d+152832(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+152840(SB)/8,$"\x7b\x7b\x2d\x20\x72\x61\x6e\x67"
+DATA ·d+152848(SB)/8,$"\x65\x20\x2e\x50\x61\x72\x61\x6d"
+DATA ·d+152856(SB)/8,$"\x73\x2e\x4c\x61\x6e\x67\x73\x20"
+DATA ·d+152864(SB)/8,$"\x7d\x7d\x0a\x20\x20\x20\x20\x20"
+DATA ·d+152872(SB)/8,$"\x20\x20\x20\x20\x20\x20\x3c\x61"
+DATA ·d+152880(SB)/8,$"\x20\x68\x72\x65\x66\x3d\x22\x23"
+DATA ·d+152888(SB)/8,$"\x22\x20\x64\x61\x74\x61\x2d\x6c"
+DATA ·d+152896(SB)/8,$"\x61\x6e\x67\x75\x61\x67\x65\x2d"
+DATA ·d+152904(SB)/8,$"\x6e\x61\x6d\x65\x3d\x22\x7b\x7b"
+DATA ·d+152912(SB)/8,$"\x20\x2e\x20\x7d\x7d\x22\x3e\x7b"
+DATA ·d+152920(SB)/8,$"\x7b\x2e\x7d\x7d\x3c\x2f\x61\x3e"
+DATA ·d+152928(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+152936(SB)/8,$"\x7b\x7b\x2d\x20\x65\x6e\x64\x20"
+DATA ·d+152944(SB)/8,$"\x7d\x7d\x0a\x20\x20\x20\x20\x3c"
+DATA ·d+152952(SB)/8,$"\x2f\x64\x69\x76\x3e\x0a\x20\x20"
+DATA ·d+152960(SB)/8,$"\x20\x20\x7b\x7b\x2d\x20\x65\x6e"
+DATA ·d+152968(SB)/8,$"\x64\x20\x7d\x7d\x0a\x20\x20\x20"
+DATA ·d+152976(SB)/8,$"\x20\x7b\x7b\x2d\x20\x69\x66\x20"
+DATA ·d+152984(SB)/8,$"\x2e\x50\x61\x72\x61\x6d\x73\x2e"
+DATA ·d+152992(SB)/8,$"\x53\x65\x61\x72\x63\x68\x20\x7d"
+DATA ·d+153000(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x3c\x64"
+DATA ·d+153008(SB)/8,$"\x69\x76\x20\x63\x6c\x61\x73\x73"
+DATA ·d+153016(SB)/8,$"\x3d\x22\x73\x65\x61\x72\x63\x68"
+DATA ·d+153024(SB)/8,$"\x22\x3e\x0a\x20\x20\x20\x20\x20"
+DATA ·d+153032(SB)/8,$"\x20\x20\x20\x3c\x69\x6e\x70\x75"
+DATA ·d+153040(SB)/8,$"\x74\x20\x74\x79\x70\x65\x3d\x22"
+DATA ·d+153048(SB)/8,$"\x74\x65\x78\x74\x22\x20\x63\x6c"
+DATA ·d+153056(SB)/8,$"\x61\x73\x73\x3d\x22\x73\x65\x61"
+DATA ·d+153064(SB)/8,$"\x72\x63\x68\x22\x20\x69\x64\x3d"
+DATA ·d+153072(SB)/8,$"\x22\x69\x6e\x70\x75\x74\x2d\x73"
+DATA ·d+153080(SB)/8,$"\x65\x61\x72\x63\x68\x22\x20\x70"
+DATA ·d+153088(SB)/8,$"\x6c\x61\x63\x65\x68\x6f\x6c\x64"
+DATA ·d+153096(SB)/8,$"\x65\x72\x3d\x22\x7b\x7b\x20\x2e"
+DATA ·d+153104(SB)/8,$"\x53\x74\x72\x69\x6e\x67\x73\x2e"
+DATA ·d+153112(SB)/8,$"\x73\x65\x61\x72\x63\x68\x20\x7c"
+DATA ·d+153120(SB)/8,$"\x20\x68\x74\x6d\x6c\x20\x7d\x7d"
+DATA ·d+153128(SB)/8,$"\x22\x3e\x0a\x20\x20\x20\x20\x3c"
+DATA ·d+153136(SB)/8,$"\x2f\x64\x69\x76\x3e\x0a\x20\x20"
+DATA ·d+153144(SB)/8,$"\x20\x20\x3c\x75\x6c\x20\x63\x6c"
+DATA ·d+153152(SB)/8,$"\x61\x73\x73\x3d\x22\x73\x65\x61"
+DATA ·d+153160(SB)/8,$"\x72\x63\x68\x2d\x72\x65\x73\x75"
+DATA ·d+153168(SB)/8,$"\x6c\x74\x73\x22\x3e\x3c\x2f\x75"
+DATA ·d+153176(SB)/8,$"\x6c\x3e\x0a\x20\x20\x20\x20\x7b"
+DATA ·d+153184(SB)/8,$"\x7b\x2d\x20\x65\x6e\x64\x20\x7d"
+DATA ·d+153192(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x3c\x64"
+DATA ·d+153200(SB)/8,$"\x69\x76\x20\x69\x64\x3d\x22\x74"
+DATA ·d+153208(SB)/8,$"\x6f\x63\x22\x20\x63\x6c\x61\x73"
+DATA ·d+153216(SB)/8,$"\x73\x3d\x22\x74\x6f\x63\x2d\x6c"
+DATA ·d+153224(SB)/8,$"\x69\x73\x74\x2d\x68\x31\x22\x3e"
+DATA ·d+153232(SB)/8,$"\x0a\x20\x20\x20\x20\x7b\x7b\x2d"
+DATA ·d+153240(SB)/8,$"\x20\x2e\x54\x4f\x43\x20\x7d\x7d"
+DATA ·d+153248(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x2f\x64"
+DATA ·d+153256(SB)/8,$"\x69\x76\x3e\x0a\x20\x20\x20\x20"
+DATA ·d+153264(SB)/8,$"\x3c\x75\x6c\x20\x63\x6c\x61\x73"
+DATA ·d+153272(SB)/8,$"\x73\x3d\x22\x74\x6f\x63\x2d\x66"
+DATA ·d+153280(SB)/8,$"\x6f\x6f\x74\x65\x72\x22\x3e\x0a"
+DATA ·d+153288(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+153296(SB)/8,$"\x7b\x7b\x20\x72\x61\x6e\x67\x65"
+DATA ·d+153304(SB)/8,$"\x20\x2e\x50\x61\x72\x61\x6d\x73"
+DATA ·d+153312(SB)/8,$"\x2e\x54\x6f\x63\x46\x6f\x6f\x74"
+DATA ·d+153320(SB)/8,$"\x65\x72\x73\x20\x7d\x7d\x0a\x20"
+DATA ·d+153328(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x3c"
+DATA ·d+153336(SB)/8,$"\x6c\x69\x3e\x7b\x7b\x2e\x7d\x7d"
+DATA ·d+153344(SB)/8,$"\x3c\x2f\x6c\x69\x3e\x0a\x20\x20"
+DATA ·d+153352(SB)/8,$"\x20\x20\x20\x20\x20\x20\x7b\x7b"
+DATA ·d+153360(SB)/8,$"\x65\x6e\x64\x7d\x7d\x0a\x20\x20"
+DATA ·d+153368(SB)/8,$"\x20\x20\x3c\x2f\x75\x6c\x3e\x0a"
+DATA ·d+153376(SB)/8,$"\x3c\x2f\x64\x69\x76\x3e\x0a\x3c"
+DATA ·d+153384(SB)/8,$"\x64\x69\x76\x20\x63\x6c\x61\x73"
+DATA ·d+153392(SB)/8,$"\x73\x3d\x22\x70\x61\x67\x65\x2d"
+DATA ·d+153400(SB)/8,$"\x77\x72\x61\x70\x70\x65\x72\x22"
+DATA ·d+153408(SB)/8,$"\x3e\x0a\x20\x20\x20\x20\x3c\x64"
+DATA ·d+153416(SB)/8,$"\x69\x76\x20\x63\x6c\x61\x73\x73"
+DATA ·d+153424(SB)/8,$"\x3d\x22\x64\x61\x72\x6b\x2d\x62"
+DATA ·d+153432(SB)/8,$"\x6f\x78\x22\x3e\x3c\x2f\x64\x69"
+DATA ·d+153440(SB)/8,$"\x76\x3e\x0a\x20\x20\x20\x20\x3c"
+DATA ·d+153448(SB)/8,$"\x64\x69\x76\x20\x63\x6c\x61\x73"
+DATA ·d+153456(SB)/8,$"\x73\x3d\x22\x63\x6f\x6e\x74\x65"
+DATA ·d+153464(SB)/8,$"\x6e\x74\x22\x3e\x0a\x20\x20\x20"
+DATA ·d+153472(SB)/8,$"\x20\x7b\x7b\x2d\x20\x69\x66\x20"
+DATA ·d+153480(SB)/8,$"\x2e\x4f\x75\x74\x64\x61\x74\x65"
+DATA ·d+153488(SB)/8,$"\x64\x20\x7d\x7d\x0a\x20\x20\x20"
+DATA ·d+153496(SB)/8,$"\x20\x3c\x61\x73\x69\x64\x65\x20"
+DATA ·d+153504(SB)/8,$"\x63\x6c\x61\x73\x73\x3d\x22\x77"
+DATA ·d+153512(SB)/8,$"\x61\x72\x6e\x69\x6e\x67\x22\x3e"
+DATA ·d+153520(SB)/8,$"\x7b\x7b\x20\x70\x72\x69\x6e\x74"
+DATA ·d+153528(SB)/8,$"\x66\x20\x2e\x53\x74\x72\x69\x6e"
+DATA ·d+153536(SB)/8,$"\x67\x73\x2e\x6f\x75\x74\x64\x61"
+DATA ·d+153544(SB)/8,$"\x74\x65\x64\x20\x2e\x56\x65\x72"
+DATA ·d+153552(SB)/8,$"\x73\x69\x6f\x6e\x20\x28\x70\x72"
+DATA ·d+153560(SB)/8,$"\x69\x6e\x74\x66\x20\x22\x3c\x61"
+DATA ·d+153568(SB)/8,$"\x20\x68\x72\x65\x66\x3d\x5c\x22"
+DATA ·d+153576(SB)/8,$"\x25\x73\x6c\x61\x74\x65\x73\x74"
+DATA ·d+153584(SB)/8,$"\x2f\x69\x6e\x64\x65\x78\x2e\x68"
+DATA ·d+153592(SB)/8,$"\x74\x6d\x6c\x5c\x22\x3e\x25\x73"
+DATA ·d+153600(SB)/8,$"\x3c\x2f\x61\x3e\x22\x20\x2e\x52"
+DATA ·d+153608(SB)/8,$"\x6f\x6f\x74\x20\x2e\x4c\x61\x74"
+DATA ·d+153616(SB)/8,$"\x65\x73\x74\x29\x20\x7d\x7d\x3c"
+DATA ·d+153624(SB)/8,$"\x2f\x61\x73\x69\x64\x65\x3e\x0a"
+DATA ·d+153632(SB)/8,$"\x20\x20\x20\x20\x7b\x7b\x2d\x20"
+DATA ·d+153640(SB)/8,$"\x65\x6e\x64\x20\x7d\x7d\x0a\x20"
+DATA ·d+153648(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x2e"
+DATA ·d+153656(SB)/8,$"\x43\x6f\x6e\x74\x65\x6e\x74\x20"
+DATA ·d+153664(SB)/8,$"\x7d\x7d\x0a\x20\x20\x20\x20\x7b"
+DATA ·d+153672(SB)/8,$"\x7b\x2d\x20\x69\x66\x20\x6f\x72"
+DATA ·d+153680(SB)/8,$"\x20\x2e\x50\x72\x65\x76\x20\x2e"
+DATA ·d+153688(SB)/8,$"\x4e\x65\x78\x74\x20\x7d\x7d\x0a"
+DATA ·d+153696(SB)/8,$"\x20\x20\x20\x20\x3c\x64\x69\x76"
+DATA ·d+153704(SB)/8,$"\x20\x63\x6c\x61\x73\x73\x3d\x22"
+DATA ·d+153712(SB)/8,$"\x70\x61\x67\x65\x2d\x6e\x61\x76"
+DATA ·d+153720(SB)/8,$"\x22\x3e\x0a\x20\x20\x20\x20\x20"
+DATA ·d+153728(SB)/8,$"\x20\x20\x20\x7b\x7b\x2d\x20\x77"
+DATA ·d+153736(SB)/8,$"\x69\x74\x68\x20\x2e\x50\x72\x65"
+DATA ·d+153744(SB)/8,$"\x76\x20\x7d\x7d\x0a\x20\x20\x20"
+DATA ·d+153752(SB)/8,$"\x20\x20\x20\x20\x20\x3c\x61\x20"
+DATA ·d+153760(SB)/8,$"\x68\x72\x65\x66\x3d\x22\x7b\x7b"
+DATA ·d+153768(SB)/8,$"\x20\x2e\x55\x52\x4c\x20\x7d\x7d"
+DATA ·d+153776(SB)/8,$"\x22\x20\x63\x6c\x61\x73\x73\x3d"
+DATA ·d+153784(SB)/8,$"\x22\x70\x61\x67\x65\x2d\x6e\x61"
+DATA ·d+153792(SB)/8,$"\x76\x2d\x70\x72\x65\x76\x22\x3e"
+DATA ·d+153800(SB)/8,$"\x26\x6c\x61\x72\x72\x3b\x20\x7b"
+DATA ·d+153808(SB)/8,$"\x7b\x20\x2e\x54\x69\x74\x6c\x65"
+DATA ·d+153816(SB)/8,$"\x20\x7c\x20\x68\x74\x6d\x6c\x20"
+DATA ·d+153824(SB)/8,$"\x7d\x7d\x3c\x2f\x61\x3e\x0a\x20"
+DATA ·d+153832(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x7b"
+DATA ·d+153840(SB)/8,$"\x7b\x2d\x20\x65\x6e\x64\x20\x7d"
+DATA ·d+153848(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+153856(SB)/8,$"\x20\x20\x7b\x7b\x2d\x20\x77\x69"
+DATA ·d+153864(SB)/8,$"\x74\x68\x20\x2e\x4e\x65\x78\x74"
+DATA ·d+153872(SB)/8,$"\x20\x7d\x7d\x0a\x20\x20\x20\x20"
+DATA ·d+153880(SB)/8,$"\x20\x20\x20\x20\x3c\x61\x20\x68"
+DATA ·d+153888(SB)/8,$"\x72\x65\x66\x3d\x22\x7b\x7b\x20"
+DATA ·d+153896(SB)/8,$"\x2e\x55\x52\x4c\x20\x7d\x7d\x22"
+DATA ·d+153904(SB)/8,$"\x20\x63\x6c\x61\x73\x73\x3d\x22"
+DATA ·d+153912(SB)/8,$"\x70\x61\x67\x65\x2d\x6e\x61\x76"
+DATA ·d+153920(SB)/8,$"\x2d\x6e\x65\x78\x74\x22\x3e\x7b"
+DATA ·d+153928(SB)/8,$"\x7b\x20\x2e\x54\x69\x74\x6c\x65"
+DATA ·d+153936(SB)/8,$"\x20\x7c\x20\x68\x74\x6d\x6c\x20"
+DATA ·d+153944(SB)/8,$"\x7d\x7d\x20\x26\x72\x61\x72\x72"
+DATA ·d+153952(SB)/8,$"\x3b\x3c\x2f\x61\x3e\x0a\x20\x20"
+DATA ·d+153960(SB)/8,$"\x20\x20\x20\x20\x20\x20\x7b\x7b"
+DATA ·d+153968(SB)/8,$"\x2d\x20\x65\x6e\x64\x20\x7d\x7d"
+DATA ·d+153976(SB)/8,$"\x0a\x20\x20\x20\x20\x3c\x2f\x64"
+DATA ·d+153984(SB)/8,$"\x69\x76\x3e\x0a\x20\x20\x20\x20"
+DATA ·d+153992(SB)/8,$"\x7b\x7b\x2d\x20\x65\x6e\x64\x20"
+DATA ·d+154000(SB)/8,$"\x7d\x7d\x0a\x20\x20\x20\x20\x3c"
+DATA ·d+154008(SB)/8,$"\x2f\x64\x69\x76\x3e\x0a\x20\x20"
+DATA ·d+154016(SB)/8,$"\x20\x20\x7b\x7b\x2d\x20\x69\x66"
+DATA ·d+154024(SB)/8,$"\x20\x67\x74\x20\x28\x6c\x65\x6e"
+DATA ·d+154032(SB)/8,$"\x20\x2e\x50\x61\x72\x61\x6d\x73"
+DATA ·d+154040(SB)/8,$"\x2e\x4c\x61\x6e\x67\x73\x29\x20"
+DATA ·d+154048(SB)/8,$"\x31\x20\x7d\x7d\x0a\x20\x20\x20"
+DATA ·d+154056(SB)/8,$"\x20\x3c\x64\x69\x76\x20\x63\x6c"
+DATA ·d+154064(SB)/8,$"\x61\x73\x73\x3d\x22\x64\x61\x72"
+DATA ·d+154072(SB)/8,$"\x6b\x2d\x62\x6f\x78\x22\x3e\x0a"
+DATA ·d+154080(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+154088(SB)/8,$"\x3c\x64\x69\x76\x20\x63\x6c\x61"
+DATA ·d+154096(SB)/8,$"\x73\x73\x3d\x22\x6c\x61\x6e\x67"
+DATA ·d+154104(SB)/8,$"\x2d\x73\x65\x6c\x65\x63\x74\x6f"
+DATA ·d+154112(SB)/8,$"\x72\x22\x3e\x0a\x20\x20\x20\x20"
+DATA ·d+154120(SB)/8,$"\x20\x20\x20\x20\x7b\x7b\x2d\x20"
+DATA ·d+154128(SB)/8,$"\x72\x61\x6e\x67\x65\x20\x2e\x50"
+DATA ·d+154136(SB)/8,$"\x61\x72\x61\x6d\x73\x2e\x4c\x61"
+DATA ·d+154144(SB)/8,$"\x6e\x67\x73\x20\x7d\x7d\x0a\x20"
+DATA ·d+154152(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+154160(SB)/8,$"\x20\x20\x20\x3c\x61\x20\x68\x72"
+DATA ·d+154168(SB)/8,$"\x65\x66\x3d\x22\x23\x22\x20\x64"
+DATA ·d+154176(SB)/8,$"\x61\x74\x61\x2d\x6c\x61\x6e\x67"
+DATA ·d+154184(SB)/8,$"\x75\x61\x67\x65\x2d\x6e\x61\x6d"
+DATA ·d+154192(SB)/8,$"\x65\x3d\x22\x7b\x7b\x20\x2e\x20"
+DATA ·d+154200(SB)/8,$"\x7d\x7d\x22\x3e\x7b\x7b\x2e\x7d"
+DATA ·d+154208(SB)/8,$"\x7d\x3c\x2f\x61\x3e\x0a\x20\x20"
+DATA ·d+154216(SB)/8,$"\x20\x20\x20\x20\x20\x20\x7b\x7b"
+DATA ·d+154224(SB)/8,$"\x2d\x20\x65\x6e\x64\x20\x7d\x7d"
+DATA ·d+154232(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+154240(SB)/8,$"\x20\x3c\x2f\x64\x69\x76\x3e\x0a"
+DATA ·d+154248(SB)/8,$"\x20\x20\x20\x20\x3c\x2f\x64\x69"
+DATA ·d+154256(SB)/8,$"\x76\x3e\x0a\x20\x20\x20\x20\x7b"
+DATA ·d+154264(SB)/8,$"\x7b\x2d\x20\x65\x6e\x64\x20\x7d"
+DATA ·d+154272(SB)/8,$"\x7d\x0a\x3c\x2f\x64\x69\x76\x3e"
+DATA ·d+154280(SB)/8,$"\x0a\x3c\x2f\x62\x6f\x64\x79\x3e"
+DATA ·d+154288(SB)/8,$"\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e"
+DATA ·d+154296(SB)/8,$"\x0a\x00\x00\x00\x00\x00\x00\x00"
+DATA ·d+154304(SB)/8,$"\x40\x66\x6f\x6e\x74\x2d\x66\x61"
+DATA ·d+154312(SB)/8,$"\x63\x65\x20\x7b\x0a\x20\x20\x66"
+DATA ·d+154320(SB)/8,$"\x6f\x6e\x74\x2d\x66\x61\x6d\x69"
+DATA ·d+154328(SB)/8,$"\x6c\x79\x3a\x20\x27\x73\x6c\x61"
+DATA ·d+154336(SB)/8,$"\x74\x65\x27\x3b\x0a\x20\x20\x73"
+DATA ·d+154344(SB)/8,$"\x72\x63\x3a\x66\x6f\x6e\x74\x2d"
+DATA ·d+154352(SB)/8,$"\x75\x72\x6c\x28\x27\x73\x6c\x61"
+DATA ·d+154360(SB)/8,$"\x74\x65\x2e\x65\x6f\x74\x3f\x2d"
+DATA ·d+154368(SB)/8,$"\x73\x79\x76\x31\x34\x6d\x27\x29"
+DATA ·d+154376(SB)/8,$"\x3b\x0a\x20\x20\x73\x72\x63\x3a"
+DATA ·d+154384(SB)/8,$"\x66\x6f\x6e\x74\x2d\x75\x72\x6c"
+DATA ·d+154392(SB)/8,$"\x28\x27\x73\x6c\x61\x74\x65\x2e"
+DATA ·d+154400(SB)/8,$"\x65\x6f\x74\x3f\x23\x69\x65\x66"
+DATA ·d+154408(SB)/8,$"\x69\x78\x2d\x73\x79\x76\x31\x34"
+DATA ·d+154416(SB)/8,$"\x6d\x27\x29\x20\x66\x6f\x72\x6d"
+DATA ·d+154424(SB)/8,$"\x61\x74\x28\x27\x65\x6d\x62\x65"
+DATA ·d+154432(SB)/8,$"\x64\x64\x65\x64\x2d\x6f\x70\x65"
+DATA ·d+154440(SB)/8,$"\x6e\x74\x79\x70\x65\x27\x29\x2c"
+DATA ·d+154448(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
+DATA ·d+154456(SB)/8,$"\x74\x2d\x75\x72\x6c\x28\x27\x73"
+DATA ·d+154464(SB)/8,$"\x6c\x61\x74\x65\x2e\x77\x6f\x66"
+DATA ·d+154472(SB)/8,$"\x66\x32\x3f\x2d\x73\x79\x76\x31"
+DATA ·d+154480(SB)/8,$"\x34\x6d\x27\x29\x20\x66\x6f\x72"
+DATA ·d+154488(SB)/8,$"\x6d\x61\x74\x28\x27\x77\x6f\x66"
+DATA ·d+154496(SB)/8,$"\x66\x32\x27\x29\x2c\x0a\x20\x20"
+DATA ·d+154504(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x75"
+DATA ·d+154512(SB)/8,$"\x72\x6c\x28\x27\x73\x6c\x61\x74"
+DATA ·d+154520(SB)/8,$"\x65\x2e\x77\x6f\x66\x66\x3f\x2d"
+DATA ·d+154528(SB)/8,$"\x73\x79\x76\x31\x34\x6d\x27\x29"
+DATA ·d+154536(SB)/8,$"\x20\x66\x6f\x72\x6d\x61\x74\x28"
+DATA ·d+154544(SB)/8,$"\x27\x77\x6f\x66\x66\x27\x29\x2c"
+DATA ·d+154552(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
+DATA ·d+154560(SB)/8,$"\x74\x2d\x75\x72\x6c\x28\x27\x73"
+DATA ·d+154568(SB)/8,$"\x6c\x61\x74\x65\x2e\x74\x74\x66"
+DATA ·d+154576(SB)/8,$"\x3f\x2d\x73\x79\x76\x31\x34\x6d"
+DATA ·d+154584(SB)/8,$"\x27\x29\x20\x66\x6f\x72\x6d\x61"
+DATA ·d+154592(SB)/8,$"\x74\x28\x27\x74\x72\x75\x65\x74"
+DATA ·d+154600(SB)/8,$"\x79\x70\x65\x27\x29\x2c\x0a\x20"
+DATA ·d+154608(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
+DATA ·d+154616(SB)/8,$"\x75\x72\x6c\x28\x27\x73\x6c\x61"
+DATA ·d+154624(SB)/8,$"\x74\x65\x2e\x73\x76\x67\x3f\x2d"
+DATA ·d+154632(SB)/8,$"\x73\x79\x76\x31\x34\x6d\x23\x73"
+DATA ·d+154640(SB)/8,$"\x6c\x61\x74\x65\x27\x29\x20\x66"
+DATA ·d+154648(SB)/8,$"\x6f\x72\x6d\x61\x74\x28\x27\x73"
+DATA ·d+154656(SB)/8,$"\x76\x67\x27\x29\x3b\x0a\x20\x20"
+DATA ·d+154664(SB)/8,$"\x66\x6f\x6e\x74\x2d\x77\x65\x69"
+DATA ·d+154672(SB)/8,$"\x67\x68\x74\x3a\x20\x6e\x6f\x72"
+DATA ·d+154680(SB)/8,$"\x6d\x61\x6c\x3b\x0a\x20\x20\x66"
+DATA ·d+154688(SB)/8,$"\x6f\x6e\x74\x2d\x73\x74\x79\x6c"
+DATA ·d+154696(SB)/8,$"\x65\x3a\x20\x6e\x6f\x72\x6d\x61"
+DATA ·d+154704(SB)/8,$"\x6c\x3b\x0a\x7d\x0a\x0a\x25\x69"
+DATA ·d+154712(SB)/8,$"\x63\x6f\x6e\x20\x7b\x0a\x20\x20"
+DATA ·d+154720(SB)/8,$"\x66\x6f\x6e\x74\x2d\x66\x61\x6d"
+DATA ·d+154728(SB)/8,$"\x69\x6c\x79\x3a\x20\x27\x73\x6c"
+DATA ·d+154736(SB)/8,$"\x61\x74\x65\x27\x3b\x0a\x20\x20"
+DATA ·d+154744(SB)/8,$"\x73\x70\x65\x61\x6b\x3a\x20\x6e"
+DATA ·d+154752(SB)/8,$"\x6f\x6e\x65\x3b\x0a\x20\x20\x66"
+DATA ·d+154760(SB)/8,$"\x6f\x6e\x74\x2d\x73\x74\x79\x6c"
+DATA ·d+154768(SB)/8,$"\x65\x3a\x20\x6e\x6f\x72\x6d\x61"
+DATA ·d+154776(SB)/8,$"\x6c\x3b\x0a\x20\x20\x66\x6f\x6e"
+DATA ·d+154784(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
+DATA ·d+154792(SB)/8,$"\x3a\x20\x6e\x6f\x72\x6d\x61\x6c"
+DATA ·d+154800(SB)/8,$"\x3b\x0a\x20\x20\x66\x6f\x6e\x74"
+DATA ·d+154808(SB)/8,$"\x2d\x76\x61\x72\x69\x61\x6e\x74"
+DATA ·d+154816(SB)/8,$"\x3a\x20\x6e\x6f\x72\x6d\x61\x6c"
+DATA ·d+154824(SB)/8,$"\x3b\x0a\x20\x20\x74\x65\x78\x74"
+DATA ·d+154832(SB)/8,$"\x2d\x74\x72\x61\x6e\x73\x66\x6f"
+DATA ·d+154840(SB)/8,$"\x72\x6d\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+154848(SB)/8,$"\x3b\x0a\x20\x20\x6c\x69\x6e\x65"
+DATA ·d+154856(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
+DATA ·d+154864(SB)/8,$"\x20\x31\x3b\x0a\x7d\x0a\x0a\x25"
+DATA ·d+154872(SB)/8,$"\x69\x63\x6f\x6e\x2d\x65\x78\x63"
+DATA ·d+154880(SB)/8,$"\x6c\x61\x6d\x61\x74\x69\x6f\x6e"
+DATA ·d+154888(SB)/8,$"\x2d\x73\x69\x67\x6e\x20\x7b\x0a"
+DATA ·d+154896(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
+DATA ·d+154904(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x3b"
+DATA ·d+154912(SB)/8,$"\x0a\x20\x20\x63\x6f\x6e\x74\x65"
+DATA ·d+154920(SB)/8,$"\x6e\x74\x3a\x20\x22\x5c\x65\x36"
+DATA ·d+154928(SB)/8,$"\x30\x30\x22\x3b\x0a\x7d\x0a\x25"
+DATA ·d+154936(SB)/8,$"\x69\x63\x6f\x6e\x2d\x69\x6e\x66"
+DATA ·d+154944(SB)/8,$"\x6f\x2d\x73\x69\x67\x6e\x20\x7b"
+DATA ·d+154952(SB)/8,$"\x0a\x20\x20\x40\x65\x78\x74\x65"
+DATA ·d+154960(SB)/8,$"\x6e\x64\x20\x25\x69\x63\x6f\x6e"
+DATA ·d+154968(SB)/8,$"\x3b\x0a\x20\x20\x63\x6f\x6e\x74"
+DATA ·d+154976(SB)/8,$"\x65\x6e\x74\x3a\x20\x22\x5c\x65"
+DATA ·d+154984(SB)/8,$"\x36\x30\x32\x22\x3b\x0a\x7d\x0a"
+DATA ·d+154992(SB)/8,$"\x25\x69\x63\x6f\x6e\x2d\x6f\x6b"
+DATA ·d+155000(SB)/8,$"\x2d\x73\x69\x67\x6e\x20\x7b\x0a"
+DATA ·d+155008(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
+DATA ·d+155016(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x3b"
+DATA ·d+155024(SB)/8,$"\x0a\x20\x20\x63\x6f\x6e\x74\x65"
+DATA ·d+155032(SB)/8,$"\x6e\x74\x3a\x20\x22\x5c\x65\x36"
+DATA ·d+155040(SB)/8,$"\x30\x36\x22\x3b\x0a\x7d\x0a\x25"
+DATA ·d+155048(SB)/8,$"\x69\x63\x6f\x6e\x2d\x73\x65\x61"
+DATA ·d+155056(SB)/8,$"\x72\x63\x68\x20\x7b\x0a\x20\x20"
+DATA ·d+155064(SB)/8,$"\x40\x65\x78\x74\x65\x6e\x64\x20"
+DATA ·d+155072(SB)/8,$"\x25\x69\x63\x6f\x6e\x3b\x0a\x20"
+DATA ·d+155080(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
+DATA ·d+155088(SB)/8,$"\x3a\x20\x22\x5c\x65\x36\x30\x37"
+DATA ·d+155096(SB)/8,$"\x22\x3b\x0a\x7d\x0a\x00\x00\x00"
+DATA ·d+155104(SB)/8,$"\x2f\x2a\x21\x20\x6e\x6f\x72\x6d"
+DATA ·d+155112(SB)/8,$"\x61\x6c\x69\x7a\x65\x2e\x63\x73"
+DATA ·d+155120(SB)/8,$"\x73\x20\x76\x33\x2e\x30\x2e\x32"
+DATA ·d+155128(SB)/8,$"\x20\x7c\x20\x4d\x49\x54\x20\x4c"
+DATA ·d+155136(SB)/8,$"\x69\x63\x65\x6e\x73\x65\x20\x7c"
+DATA ·d+155144(SB)/8,$"\x20\x67\x69\x74\x2e\x69\x6f\x2f"
+DATA ·d+155152(SB)/8,$"\x6e\x6f\x72\x6d\x61\x6c\x69\x7a"
+DATA ·d+155160(SB)/8,$"\x65\x20\x2a\x2f\x0a\x0a\x2f\x2a"
+DATA ·d+155168(SB)/8,$"\x2a\x0a\x20\x2a\x20\x31\x2e\x20"
+DATA ·d+155176(SB)/8,$"\x53\x65\x74\x20\x64\x65\x66\x61"
+DATA ·d+155184(SB)/8,$"\x75\x6c\x74\x20\x66\x6f\x6e\x74"
+DATA ·d+155192(SB)/8,$"\x20\x66\x61\x6d\x69\x6c\x79\x20"
+DATA ·d+155200(SB)/8,$"\x74\x6f\x20\x73\x61\x6e\x73\x2d"
+DATA ·d+155208(SB)/8,$"\x73\x65\x72\x69\x66\x2e\x0a\x20"
+DATA ·d+155216(SB)/8,$"\x2a\x20\x32\x2e\x20\x50\x72\x65"
+DATA ·d+155224(SB)/8,$"\x76\x65\x6e\x74\x20\x69\x4f\x53"
+DATA ·d+155232(SB)/8,$"\x20\x74\x65\x78\x74\x20\x73\x69"
+DATA ·d+155240(SB)/8,$"\x7a\x65\x20\x61\x64\x6a\x75\x73"
+DATA ·d+155248(SB)/8,$"\x74\x20\x61\x66\x74\x65\x72\x20"
+DATA ·d+155256(SB)/8,$"\x6f\x72\x69\x65\x6e\x74\x61\x74"
+DATA ·d+155264(SB)/8,$"\x69\x6f\x6e\x20\x63\x68\x61\x6e"
+DATA ·d+155272(SB)/8,$"\x67\x65\x2c\x20\x77\x69\x74\x68"
+DATA ·d+155280(SB)/8,$"\x6f\x75\x74\x20\x64\x69\x73\x61"
+DATA ·d+155288(SB)/8,$"\x62\x6c\x69\x6e\x67\x0a\x20\x2a"
+DATA ·d+155296(SB)/8,$"\x20\x20\x20\x20\x75\x73\x65\x72"
+DATA ·d+155304(SB)/8,$"\x20\x7a\x6f\x6f\x6d\x2e\x0a\x20"
+DATA ·d+155312(SB)/8,$"\x2a\x2f\x0a\x0a\x68\x74\x6d\x6c"
+DATA ·d+155320(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x66"
+DATA ·d+155328(SB)/8,$"\x6f\x6e\x74\x2d\x66\x61\x6d\x69"
+DATA ·d+155336(SB)/8,$"\x6c\x79\x3a\x20\x73\x61\x6e\x73"
+DATA ·d+155344(SB)/8,$"\x2d\x73\x65\x72\x69\x66\x3b\x20"
+DATA ·d+155352(SB)/8,$"\x2f\x2a\x20\x31\x20\x2a\x2f\x0a"
+DATA ·d+155360(SB)/8,$"\x20\x20\x20\x20\x2d\x6d\x73\x2d"
+DATA ·d+155368(SB)/8,$"\x74\x65\x78\x74\x2d\x73\x69\x7a"
+DATA ·d+155376(SB)/8,$"\x65\x2d\x61\x64\x6a\x75\x73\x74"
+DATA ·d+155384(SB)/8,$"\x3a\x20\x31\x30\x30\x25\x3b\x20"
+DATA ·d+155392(SB)/8,$"\x2f\x2a\x20\x32\x20\x2a\x2f\x0a"
+DATA ·d+155400(SB)/8,$"\x20\x20\x20\x20\x2d\x77\x65\x62"
+DATA ·d+155408(SB)/8,$"\x6b\x69\x74\x2d\x74\x65\x78\x74"
+DATA ·d+155416(SB)/8,$"\x2d\x73\x69\x7a\x65\x2d\x61\x64"
+DATA ·d+155424(SB)/8,$"\x6a\x75\x73\x74\x3a\x20\x31\x30"
+DATA ·d+155432(SB)/8,$"\x30\x25\x3b\x20\x2f\x2a\x20\x32"
+DATA ·d+155440(SB)/8,$"\x20\x2a\x2f\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+155448(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x52\x65"
+DATA ·d+155456(SB)/8,$"\x6d\x6f\x76\x65\x20\x64\x65\x66"
+DATA ·d+155464(SB)/8,$"\x61\x75\x6c\x74\x20\x6d\x61\x72"
+DATA ·d+155472(SB)/8,$"\x67\x69\x6e\x2e\x0a\x20\x2a\x2f"
+DATA ·d+155480(SB)/8,$"\x0a\x0a\x62\x6f\x64\x79\x20\x7b"
+DATA ·d+155488(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+155496(SB)/8,$"\x67\x69\x6e\x3a\x20\x30\x3b\x0a"
+DATA ·d+155504(SB)/8,$"\x7d\x0a\x0a\x2f\x2a\x20\x48\x54"
+DATA ·d+155512(SB)/8,$"\x4d\x4c\x35\x20\x64\x69\x73\x70"
+DATA ·d+155520(SB)/8,$"\x6c\x61\x79\x20\x64\x65\x66\x69"
+DATA ·d+155528(SB)/8,$"\x6e\x69\x74\x69\x6f\x6e\x73\x0a"
+DATA ·d+155536(SB)/8,$"\x20\x20\x20\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+155544(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+155552(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+155560(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
@@ -19452,3477 +19452,3729 @@ DATA ·d+155568(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+155576(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+155584(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+155592(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+155600(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x20\x2a"
-DATA ·d+155608(SB)/8,$"\x2f\x0a\x0a\x2f\x2a\x2a\x0a\x20"
-DATA ·d+155616(SB)/8,$"\x2a\x20\x41\x64\x64\x72\x65\x73"
-DATA ·d+155624(SB)/8,$"\x73\x20\x73\x74\x79\x6c\x69\x6e"
-DATA ·d+155632(SB)/8,$"\x67\x20\x6e\x6f\x74\x20\x70\x72"
-DATA ·d+155640(SB)/8,$"\x65\x73\x65\x6e\x74\x20\x69\x6e"
-DATA ·d+155648(SB)/8,$"\x20\x49\x45\x20\x38\x2f\x39\x2f"
-DATA ·d+155656(SB)/8,$"\x31\x30\x2f\x31\x31\x2c\x20\x53"
-DATA ·d+155664(SB)/8,$"\x61\x66\x61\x72\x69\x2c\x20\x61"
-DATA ·d+155672(SB)/8,$"\x6e\x64\x20\x43\x68\x72\x6f\x6d"
-DATA ·d+155680(SB)/8,$"\x65\x2e\x0a\x20\x2a\x2f\x0a\x0a"
-DATA ·d+155688(SB)/8,$"\x61\x62\x62\x72\x5b\x74\x69\x74"
-DATA ·d+155696(SB)/8,$"\x6c\x65\x5d\x20\x7b\x0a\x20\x20"
-DATA ·d+155704(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+155712(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
-DATA ·d+155720(SB)/8,$"\x20\x31\x70\x78\x20\x64\x6f\x74"
-DATA ·d+155728(SB)/8,$"\x74\x65\x64\x3b\x0a\x7d\x0a\x0a"
-DATA ·d+155736(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x41"
-DATA ·d+155744(SB)/8,$"\x64\x64\x72\x65\x73\x73\x20\x73"
-DATA ·d+155752(SB)/8,$"\x74\x79\x6c\x65\x20\x73\x65\x74"
-DATA ·d+155760(SB)/8,$"\x20\x74\x6f\x20\x60\x62\x6f\x6c"
-DATA ·d+155768(SB)/8,$"\x64\x65\x72\x60\x20\x69\x6e\x20"
-DATA ·d+155776(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x20"
-DATA ·d+155784(SB)/8,$"\x34\x2b\x2c\x20\x53\x61\x66\x61"
-DATA ·d+155792(SB)/8,$"\x72\x69\x2c\x20\x61\x6e\x64\x20"
-DATA ·d+155800(SB)/8,$"\x43\x68\x72\x6f\x6d\x65\x2e\x0a"
-DATA ·d+155808(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x2c\x0a"
-DATA ·d+155816(SB)/8,$"\x73\x74\x72\x6f\x6e\x67\x20\x7b"
-DATA ·d+155824(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
-DATA ·d+155832(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
-DATA ·d+155840(SB)/8,$"\x3a\x20\x62\x6f\x6c\x64\x3b\x0a"
-DATA ·d+155848(SB)/8,$"\x7d\x0a\x0a\x2f\x2a\x2a\x0a\x20"
-DATA ·d+155856(SB)/8,$"\x2a\x20\x41\x64\x64\x72\x65\x73"
-DATA ·d+155864(SB)/8,$"\x73\x20\x73\x74\x79\x6c\x69\x6e"
-DATA ·d+155872(SB)/8,$"\x67\x20\x6e\x6f\x74\x20\x70\x72"
-DATA ·d+155880(SB)/8,$"\x65\x73\x65\x6e\x74\x20\x69\x6e"
-DATA ·d+155888(SB)/8,$"\x20\x53\x61\x66\x61\x72\x69\x20"
-DATA ·d+155896(SB)/8,$"\x61\x6e\x64\x20\x43\x68\x72\x6f"
-DATA ·d+155904(SB)/8,$"\x6d\x65\x2e\x0a\x20\x2a\x2f\x0a"
-DATA ·d+155912(SB)/8,$"\x0a\x64\x66\x6e\x20\x7b\x0a\x20"
-DATA ·d+155920(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
-DATA ·d+155928(SB)/8,$"\x73\x74\x79\x6c\x65\x3a\x20\x69"
-DATA ·d+155936(SB)/8,$"\x74\x61\x6c\x69\x63\x3b\x0a\x7d"
-DATA ·d+155944(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
-DATA ·d+155952(SB)/8,$"\x20\x41\x64\x64\x72\x65\x73\x73"
-DATA ·d+155960(SB)/8,$"\x20\x76\x61\x72\x69\x61\x62\x6c"
-DATA ·d+155968(SB)/8,$"\x65\x20\x60\x68\x31\x60\x20\x66"
-DATA ·d+155976(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
-DATA ·d+155984(SB)/8,$"\x20\x61\x6e\x64\x20\x6d\x61\x72"
-DATA ·d+155992(SB)/8,$"\x67\x69\x6e\x20\x77\x69\x74\x68"
-DATA ·d+156000(SB)/8,$"\x69\x6e\x20\x60\x73\x65\x63\x74"
-DATA ·d+156008(SB)/8,$"\x69\x6f\x6e\x60\x20\x61\x6e\x64"
-DATA ·d+156016(SB)/8,$"\x20\x60\x61\x72\x74\x69\x63\x6c"
-DATA ·d+156024(SB)/8,$"\x65\x60\x0a\x20\x2a\x20\x63\x6f"
-DATA ·d+156032(SB)/8,$"\x6e\x74\x65\x78\x74\x73\x20\x69"
-DATA ·d+156040(SB)/8,$"\x6e\x20\x46\x69\x72\x65\x66\x6f"
-DATA ·d+156048(SB)/8,$"\x78\x20\x34\x2b\x2c\x20\x53\x61"
-DATA ·d+156056(SB)/8,$"\x66\x61\x72\x69\x2c\x20\x61\x6e"
-DATA ·d+156064(SB)/8,$"\x64\x20\x43\x68\x72\x6f\x6d\x65"
-DATA ·d+156072(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x68"
-DATA ·d+156080(SB)/8,$"\x31\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+156088(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
-DATA ·d+156096(SB)/8,$"\x65\x3a\x20\x32\x65\x6d\x3b\x0a"
-DATA ·d+156104(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+156112(SB)/8,$"\x69\x6e\x3a\x20\x30\x2e\x36\x37"
-DATA ·d+156120(SB)/8,$"\x65\x6d\x20\x30\x3b\x0a\x7d\x0a"
-DATA ·d+156128(SB)/8,$"\x0a\x2f\x2a\x2a\x0a\x20\x2a\x20"
-DATA ·d+156136(SB)/8,$"\x41\x64\x64\x72\x65\x73\x73\x20"
-DATA ·d+156144(SB)/8,$"\x73\x74\x79\x6c\x69\x6e\x67\x20"
-DATA ·d+156152(SB)/8,$"\x6e\x6f\x74\x20\x70\x72\x65\x73"
-DATA ·d+156160(SB)/8,$"\x65\x6e\x74\x20\x69\x6e\x20\x49"
-DATA ·d+156168(SB)/8,$"\x45\x20\x38\x2f\x39\x2e\x0a\x20"
-DATA ·d+156176(SB)/8,$"\x2a\x2f\x0a\x0a\x6d\x61\x72\x6b"
-DATA ·d+156184(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x62"
-DATA ·d+156192(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
-DATA ·d+156200(SB)/8,$"\x64\x3a\x20\x23\x66\x66\x30\x3b"
-DATA ·d+156208(SB)/8,$"\x0a\x20\x20\x20\x20\x63\x6f\x6c"
-DATA ·d+156216(SB)/8,$"\x6f\x72\x3a\x20\x23\x30\x30\x30"
-DATA ·d+156224(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+156232(SB)/8,$"\x0a\x20\x2a\x20\x41\x64\x64\x72"
-DATA ·d+156240(SB)/8,$"\x65\x73\x73\x20\x69\x6e\x63\x6f"
-DATA ·d+156248(SB)/8,$"\x6e\x73\x69\x73\x74\x65\x6e\x74"
-DATA ·d+156256(SB)/8,$"\x20\x61\x6e\x64\x20\x76\x61\x72"
-DATA ·d+156264(SB)/8,$"\x69\x61\x62\x6c\x65\x20\x66\x6f"
-DATA ·d+156272(SB)/8,$"\x6e\x74\x20\x73\x69\x7a\x65\x20"
-DATA ·d+156280(SB)/8,$"\x69\x6e\x20\x61\x6c\x6c\x20\x62"
-DATA ·d+156288(SB)/8,$"\x72\x6f\x77\x73\x65\x72\x73\x2e"
-DATA ·d+156296(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x73\x6d"
-DATA ·d+156304(SB)/8,$"\x61\x6c\x6c\x20\x7b\x0a\x20\x20"
-DATA ·d+156312(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x73"
-DATA ·d+156320(SB)/8,$"\x69\x7a\x65\x3a\x20\x38\x30\x25"
-DATA ·d+156328(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+156336(SB)/8,$"\x0a\x20\x2a\x20\x50\x72\x65\x76"
-DATA ·d+156344(SB)/8,$"\x65\x6e\x74\x20\x60\x73\x75\x62"
-DATA ·d+156352(SB)/8,$"\x60\x20\x61\x6e\x64\x20\x60\x73"
-DATA ·d+156360(SB)/8,$"\x75\x70\x60\x20\x61\x66\x66\x65"
-DATA ·d+156368(SB)/8,$"\x63\x74\x69\x6e\x67\x20\x60\x6c"
-DATA ·d+156376(SB)/8,$"\x69\x6e\x65\x2d\x68\x65\x69\x67"
-DATA ·d+156384(SB)/8,$"\x68\x74\x60\x20\x69\x6e\x20\x61"
-DATA ·d+156392(SB)/8,$"\x6c\x6c\x20\x62\x72\x6f\x77\x73"
-DATA ·d+156400(SB)/8,$"\x65\x72\x73\x2e\x0a\x20\x2a\x2f"
-DATA ·d+156408(SB)/8,$"\x0a\x0a\x73\x75\x62\x2c\x0a\x73"
-DATA ·d+156416(SB)/8,$"\x75\x70\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+156424(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x73\x69"
-DATA ·d+156432(SB)/8,$"\x7a\x65\x3a\x20\x37\x35\x25\x3b"
-DATA ·d+156440(SB)/8,$"\x0a\x20\x20\x20\x20\x6c\x69\x6e"
-DATA ·d+156448(SB)/8,$"\x65\x2d\x68\x65\x69\x67\x68\x74"
-DATA ·d+156456(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x20"
-DATA ·d+156464(SB)/8,$"\x20\x70\x6f\x73\x69\x74\x69\x6f"
-DATA ·d+156472(SB)/8,$"\x6e\x3a\x20\x72\x65\x6c\x61\x74"
-DATA ·d+156480(SB)/8,$"\x69\x76\x65\x3b\x0a\x20\x20\x20"
-DATA ·d+156488(SB)/8,$"\x20\x76\x65\x72\x74\x69\x63\x61"
-DATA ·d+156496(SB)/8,$"\x6c\x2d\x61\x6c\x69\x67\x6e\x3a"
-DATA ·d+156504(SB)/8,$"\x20\x62\x61\x73\x65\x6c\x69\x6e"
-DATA ·d+156512(SB)/8,$"\x65\x3b\x0a\x7d\x0a\x0a\x73\x75"
-DATA ·d+156520(SB)/8,$"\x70\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+156528(SB)/8,$"\x74\x6f\x70\x3a\x20\x2d\x30\x2e"
-DATA ·d+156536(SB)/8,$"\x35\x65\x6d\x3b\x0a\x7d\x0a\x0a"
-DATA ·d+156544(SB)/8,$"\x73\x75\x62\x20\x7b\x0a\x20\x20"
-DATA ·d+156552(SB)/8,$"\x20\x20\x62\x6f\x74\x74\x6f\x6d"
-DATA ·d+156560(SB)/8,$"\x3a\x20\x2d\x30\x2e\x32\x35\x65"
-DATA ·d+156568(SB)/8,$"\x6d\x3b\x0a\x7d\x0a\x0a\x2f\x2a"
-DATA ·d+156576(SB)/8,$"\x20\x45\x6d\x62\x65\x64\x64\x65"
-DATA ·d+156584(SB)/8,$"\x64\x20\x63\x6f\x6e\x74\x65\x6e"
-DATA ·d+156592(SB)/8,$"\x74\x0a\x20\x20\x20\x3d\x3d\x3d"
-DATA ·d+156600(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156608(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+155600(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+155608(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x20\x2a\x2f"
+DATA ·d+155616(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
+DATA ·d+155624(SB)/8,$"\x20\x43\x6f\x72\x72\x65\x63\x74"
+DATA ·d+155632(SB)/8,$"\x20\x60\x62\x6c\x6f\x63\x6b\x60"
+DATA ·d+155640(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
+DATA ·d+155648(SB)/8,$"\x20\x6e\x6f\x74\x20\x64\x65\x66"
+DATA ·d+155656(SB)/8,$"\x69\x6e\x65\x64\x20\x66\x6f\x72"
+DATA ·d+155664(SB)/8,$"\x20\x61\x6e\x79\x20\x48\x54\x4d"
+DATA ·d+155672(SB)/8,$"\x4c\x35\x20\x65\x6c\x65\x6d\x65"
+DATA ·d+155680(SB)/8,$"\x6e\x74\x20\x69\x6e\x20\x49\x45"
+DATA ·d+155688(SB)/8,$"\x20\x38\x2f\x39\x2e\x0a\x20\x2a"
+DATA ·d+155696(SB)/8,$"\x20\x43\x6f\x72\x72\x65\x63\x74"
+DATA ·d+155704(SB)/8,$"\x20\x60\x62\x6c\x6f\x63\x6b\x60"
+DATA ·d+155712(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
+DATA ·d+155720(SB)/8,$"\x20\x6e\x6f\x74\x20\x64\x65\x66"
+DATA ·d+155728(SB)/8,$"\x69\x6e\x65\x64\x20\x66\x6f\x72"
+DATA ·d+155736(SB)/8,$"\x20\x60\x64\x65\x74\x61\x69\x6c"
+DATA ·d+155744(SB)/8,$"\x73\x60\x20\x6f\x72\x20\x60\x73"
+DATA ·d+155752(SB)/8,$"\x75\x6d\x6d\x61\x72\x79\x60\x20"
+DATA ·d+155760(SB)/8,$"\x69\x6e\x20\x49\x45\x20\x31\x30"
+DATA ·d+155768(SB)/8,$"\x2f\x31\x31\x0a\x20\x2a\x20\x61"
+DATA ·d+155776(SB)/8,$"\x6e\x64\x20\x46\x69\x72\x65\x66"
+DATA ·d+155784(SB)/8,$"\x6f\x78\x2e\x0a\x20\x2a\x20\x43"
+DATA ·d+155792(SB)/8,$"\x6f\x72\x72\x65\x63\x74\x20\x60"
+DATA ·d+155800(SB)/8,$"\x62\x6c\x6f\x63\x6b\x60\x20\x64"
+DATA ·d+155808(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x20\x6e"
+DATA ·d+155816(SB)/8,$"\x6f\x74\x20\x64\x65\x66\x69\x6e"
+DATA ·d+155824(SB)/8,$"\x65\x64\x20\x66\x6f\x72\x20\x60"
+DATA ·d+155832(SB)/8,$"\x6d\x61\x69\x6e\x60\x20\x69\x6e"
+DATA ·d+155840(SB)/8,$"\x20\x49\x45\x20\x31\x31\x2e\x0a"
+DATA ·d+155848(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x61\x72\x74"
+DATA ·d+155856(SB)/8,$"\x69\x63\x6c\x65\x2c\x0a\x61\x73"
+DATA ·d+155864(SB)/8,$"\x69\x64\x65\x2c\x0a\x64\x65\x74"
+DATA ·d+155872(SB)/8,$"\x61\x69\x6c\x73\x2c\x0a\x66\x69"
+DATA ·d+155880(SB)/8,$"\x67\x63\x61\x70\x74\x69\x6f\x6e"
+DATA ·d+155888(SB)/8,$"\x2c\x0a\x66\x69\x67\x75\x72\x65"
+DATA ·d+155896(SB)/8,$"\x2c\x0a\x66\x6f\x6f\x74\x65\x72"
+DATA ·d+155904(SB)/8,$"\x2c\x0a\x68\x65\x61\x64\x65\x72"
+DATA ·d+155912(SB)/8,$"\x2c\x0a\x68\x67\x72\x6f\x75\x70"
+DATA ·d+155920(SB)/8,$"\x2c\x0a\x6d\x61\x69\x6e\x2c\x0a"
+DATA ·d+155928(SB)/8,$"\x6d\x65\x6e\x75\x2c\x0a\x6e\x61"
+DATA ·d+155936(SB)/8,$"\x76\x2c\x0a\x73\x65\x63\x74\x69"
+DATA ·d+155944(SB)/8,$"\x6f\x6e\x2c\x0a\x73\x75\x6d\x6d"
+DATA ·d+155952(SB)/8,$"\x61\x72\x79\x20\x7b\x0a\x20\x20"
+DATA ·d+155960(SB)/8,$"\x20\x20\x64\x69\x73\x70\x6c\x61"
+DATA ·d+155968(SB)/8,$"\x79\x3a\x20\x62\x6c\x6f\x63\x6b"
+DATA ·d+155976(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+155984(SB)/8,$"\x0a\x20\x2a\x20\x31\x2e\x20\x43"
+DATA ·d+155992(SB)/8,$"\x6f\x72\x72\x65\x63\x74\x20\x60"
+DATA ·d+156000(SB)/8,$"\x69\x6e\x6c\x69\x6e\x65\x2d\x62"
+DATA ·d+156008(SB)/8,$"\x6c\x6f\x63\x6b\x60\x20\x64\x69"
+DATA ·d+156016(SB)/8,$"\x73\x70\x6c\x61\x79\x20\x6e\x6f"
+DATA ·d+156024(SB)/8,$"\x74\x20\x64\x65\x66\x69\x6e\x65"
+DATA ·d+156032(SB)/8,$"\x64\x20\x69\x6e\x20\x49\x45\x20"
+DATA ·d+156040(SB)/8,$"\x38\x2f\x39\x2e\x0a\x20\x2a\x20"
+DATA ·d+156048(SB)/8,$"\x32\x2e\x20\x4e\x6f\x72\x6d\x61"
+DATA ·d+156056(SB)/8,$"\x6c\x69\x7a\x65\x20\x76\x65\x72"
+DATA ·d+156064(SB)/8,$"\x74\x69\x63\x61\x6c\x20\x61\x6c"
+DATA ·d+156072(SB)/8,$"\x69\x67\x6e\x6d\x65\x6e\x74\x20"
+DATA ·d+156080(SB)/8,$"\x6f\x66\x20\x60\x70\x72\x6f\x67"
+DATA ·d+156088(SB)/8,$"\x72\x65\x73\x73\x60\x20\x69\x6e"
+DATA ·d+156096(SB)/8,$"\x20\x43\x68\x72\x6f\x6d\x65\x2c"
+DATA ·d+156104(SB)/8,$"\x20\x46\x69\x72\x65\x66\x6f\x78"
+DATA ·d+156112(SB)/8,$"\x2c\x20\x61\x6e\x64\x20\x4f\x70"
+DATA ·d+156120(SB)/8,$"\x65\x72\x61\x2e\x0a\x20\x2a\x2f"
+DATA ·d+156128(SB)/8,$"\x0a\x0a\x61\x75\x64\x69\x6f\x2c"
+DATA ·d+156136(SB)/8,$"\x0a\x63\x61\x6e\x76\x61\x73\x2c"
+DATA ·d+156144(SB)/8,$"\x0a\x70\x72\x6f\x67\x72\x65\x73"
+DATA ·d+156152(SB)/8,$"\x73\x2c\x0a\x76\x69\x64\x65\x6f"
+DATA ·d+156160(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x64"
+DATA ·d+156168(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x3a\x20"
+DATA ·d+156176(SB)/8,$"\x69\x6e\x6c\x69\x6e\x65\x2d\x62"
+DATA ·d+156184(SB)/8,$"\x6c\x6f\x63\x6b\x3b\x20\x2f\x2a"
+DATA ·d+156192(SB)/8,$"\x20\x31\x20\x2a\x2f\x0a\x20\x20"
+DATA ·d+156200(SB)/8,$"\x20\x20\x76\x65\x72\x74\x69\x63"
+DATA ·d+156208(SB)/8,$"\x61\x6c\x2d\x61\x6c\x69\x67\x6e"
+DATA ·d+156216(SB)/8,$"\x3a\x20\x62\x61\x73\x65\x6c\x69"
+DATA ·d+156224(SB)/8,$"\x6e\x65\x3b\x20\x2f\x2a\x20\x32"
+DATA ·d+156232(SB)/8,$"\x20\x2a\x2f\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+156240(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x50\x72"
+DATA ·d+156248(SB)/8,$"\x65\x76\x65\x6e\x74\x20\x6d\x6f"
+DATA ·d+156256(SB)/8,$"\x64\x65\x72\x6e\x20\x62\x72\x6f"
+DATA ·d+156264(SB)/8,$"\x77\x73\x65\x72\x73\x20\x66\x72"
+DATA ·d+156272(SB)/8,$"\x6f\x6d\x20\x64\x69\x73\x70\x6c"
+DATA ·d+156280(SB)/8,$"\x61\x79\x69\x6e\x67\x20\x60\x61"
+DATA ·d+156288(SB)/8,$"\x75\x64\x69\x6f\x60\x20\x77\x69"
+DATA ·d+156296(SB)/8,$"\x74\x68\x6f\x75\x74\x20\x63\x6f"
+DATA ·d+156304(SB)/8,$"\x6e\x74\x72\x6f\x6c\x73\x2e\x0a"
+DATA ·d+156312(SB)/8,$"\x20\x2a\x20\x52\x65\x6d\x6f\x76"
+DATA ·d+156320(SB)/8,$"\x65\x20\x65\x78\x63\x65\x73\x73"
+DATA ·d+156328(SB)/8,$"\x20\x68\x65\x69\x67\x68\x74\x20"
+DATA ·d+156336(SB)/8,$"\x69\x6e\x20\x69\x4f\x53\x20\x35"
+DATA ·d+156344(SB)/8,$"\x20\x64\x65\x76\x69\x63\x65\x73"
+DATA ·d+156352(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x61"
+DATA ·d+156360(SB)/8,$"\x75\x64\x69\x6f\x3a\x6e\x6f\x74"
+DATA ·d+156368(SB)/8,$"\x28\x5b\x63\x6f\x6e\x74\x72\x6f"
+DATA ·d+156376(SB)/8,$"\x6c\x73\x5d\x29\x20\x7b\x0a\x20"
+DATA ·d+156384(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
+DATA ·d+156392(SB)/8,$"\x61\x79\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+156400(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x68\x65"
+DATA ·d+156408(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x30\x3b"
+DATA ·d+156416(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+156424(SB)/8,$"\x20\x2a\x20\x41\x64\x64\x72\x65"
+DATA ·d+156432(SB)/8,$"\x73\x73\x20\x60\x5b\x68\x69\x64"
+DATA ·d+156440(SB)/8,$"\x64\x65\x6e\x5d\x60\x20\x73\x74"
+DATA ·d+156448(SB)/8,$"\x79\x6c\x69\x6e\x67\x20\x6e\x6f"
+DATA ·d+156456(SB)/8,$"\x74\x20\x70\x72\x65\x73\x65\x6e"
+DATA ·d+156464(SB)/8,$"\x74\x20\x69\x6e\x20\x49\x45\x20"
+DATA ·d+156472(SB)/8,$"\x38\x2f\x39\x2f\x31\x30\x2e\x0a"
+DATA ·d+156480(SB)/8,$"\x20\x2a\x20\x48\x69\x64\x65\x20"
+DATA ·d+156488(SB)/8,$"\x74\x68\x65\x20\x60\x74\x65\x6d"
+DATA ·d+156496(SB)/8,$"\x70\x6c\x61\x74\x65\x60\x20\x65"
+DATA ·d+156504(SB)/8,$"\x6c\x65\x6d\x65\x6e\x74\x20\x69"
+DATA ·d+156512(SB)/8,$"\x6e\x20\x49\x45\x20\x38\x2f\x39"
+DATA ·d+156520(SB)/8,$"\x2f\x31\x31\x2c\x20\x53\x61\x66"
+DATA ·d+156528(SB)/8,$"\x61\x72\x69\x2c\x20\x61\x6e\x64"
+DATA ·d+156536(SB)/8,$"\x20\x46\x69\x72\x65\x66\x6f\x78"
+DATA ·d+156544(SB)/8,$"\x20\x3c\x20\x32\x32\x2e\x0a\x20"
+DATA ·d+156552(SB)/8,$"\x2a\x2f\x0a\x0a\x5b\x68\x69\x64"
+DATA ·d+156560(SB)/8,$"\x64\x65\x6e\x5d\x2c\x0a\x74\x65"
+DATA ·d+156568(SB)/8,$"\x6d\x70\x6c\x61\x74\x65\x20\x7b"
+DATA ·d+156576(SB)/8,$"\x0a\x20\x20\x20\x20\x64\x69\x73"
+DATA ·d+156584(SB)/8,$"\x70\x6c\x61\x79\x3a\x20\x6e\x6f"
+DATA ·d+156592(SB)/8,$"\x6e\x65\x3b\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+156600(SB)/8,$"\x2a\x20\x4c\x69\x6e\x6b\x73\x0a"
+DATA ·d+156608(SB)/8,$"\x20\x20\x20\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+156616(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+156624(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+156632(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+156640(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+156648(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
 DATA ·d+156656(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156664(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x20"
-DATA ·d+156672(SB)/8,$"\x2a\x2f\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+156680(SB)/8,$"\x20\x2a\x20\x52\x65\x6d\x6f\x76"
-DATA ·d+156688(SB)/8,$"\x65\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+156696(SB)/8,$"\x20\x77\x68\x65\x6e\x20\x69\x6e"
-DATA ·d+156704(SB)/8,$"\x73\x69\x64\x65\x20\x60\x61\x60"
-DATA ·d+156712(SB)/8,$"\x20\x65\x6c\x65\x6d\x65\x6e\x74"
-DATA ·d+156720(SB)/8,$"\x20\x69\x6e\x20\x49\x45\x20\x38"
-DATA ·d+156728(SB)/8,$"\x2f\x39\x2f\x31\x30\x2e\x0a\x20"
-DATA ·d+156736(SB)/8,$"\x2a\x2f\x0a\x0a\x69\x6d\x67\x20"
-DATA ·d+156744(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x6f"
-DATA ·d+156752(SB)/8,$"\x72\x64\x65\x72\x3a\x20\x30\x3b"
-DATA ·d+156760(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+156768(SB)/8,$"\x20\x2a\x20\x43\x6f\x72\x72\x65"
-DATA ·d+156776(SB)/8,$"\x63\x74\x20\x6f\x76\x65\x72\x66"
-DATA ·d+156784(SB)/8,$"\x6c\x6f\x77\x20\x6e\x6f\x74\x20"
-DATA ·d+156792(SB)/8,$"\x68\x69\x64\x64\x65\x6e\x20\x69"
-DATA ·d+156800(SB)/8,$"\x6e\x20\x49\x45\x20\x39\x2f\x31"
-DATA ·d+156808(SB)/8,$"\x30\x2f\x31\x31\x2e\x0a\x20\x2a"
-DATA ·d+156816(SB)/8,$"\x2f\x0a\x0a\x73\x76\x67\x3a\x6e"
-DATA ·d+156824(SB)/8,$"\x6f\x74\x28\x3a\x72\x6f\x6f\x74"
-DATA ·d+156832(SB)/8,$"\x29\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+156840(SB)/8,$"\x6f\x76\x65\x72\x66\x6c\x6f\x77"
-DATA ·d+156848(SB)/8,$"\x3a\x20\x68\x69\x64\x64\x65\x6e"
-DATA ·d+156856(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x20"
-DATA ·d+156864(SB)/8,$"\x47\x72\x6f\x75\x70\x69\x6e\x67"
-DATA ·d+156872(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
-DATA ·d+156880(SB)/8,$"\x0a\x20\x20\x20\x3d\x3d\x3d\x3d"
-DATA ·d+156888(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156896(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156904(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156912(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156920(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156928(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156936(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156944(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+156952(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x20\x2a"
-DATA ·d+156960(SB)/8,$"\x2f\x0a\x0a\x2f\x2a\x2a\x0a\x20"
-DATA ·d+156968(SB)/8,$"\x2a\x20\x41\x64\x64\x72\x65\x73"
-DATA ·d+156976(SB)/8,$"\x73\x20\x6d\x61\x72\x67\x69\x6e"
-DATA ·d+156984(SB)/8,$"\x20\x6e\x6f\x74\x20\x70\x72\x65"
-DATA ·d+156992(SB)/8,$"\x73\x65\x6e\x74\x20\x69\x6e\x20"
-DATA ·d+157000(SB)/8,$"\x49\x45\x20\x38\x2f\x39\x20\x61"
-DATA ·d+157008(SB)/8,$"\x6e\x64\x20\x53\x61\x66\x61\x72"
-DATA ·d+157016(SB)/8,$"\x69\x2e\x0a\x20\x2a\x2f\x0a\x0a"
-DATA ·d+157024(SB)/8,$"\x66\x69\x67\x75\x72\x65\x20\x7b"
-DATA ·d+157032(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+157040(SB)/8,$"\x67\x69\x6e\x3a\x20\x31\x65\x6d"
-DATA ·d+157048(SB)/8,$"\x20\x34\x30\x70\x78\x3b\x0a\x7d"
-DATA ·d+157056(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
-DATA ·d+157064(SB)/8,$"\x20\x41\x64\x64\x72\x65\x73\x73"
-DATA ·d+157072(SB)/8,$"\x20\x64\x69\x66\x66\x65\x72\x65"
-DATA ·d+157080(SB)/8,$"\x6e\x63\x65\x73\x20\x62\x65\x74"
-DATA ·d+157088(SB)/8,$"\x77\x65\x65\x6e\x20\x46\x69\x72"
-DATA ·d+157096(SB)/8,$"\x65\x66\x6f\x78\x20\x61\x6e\x64"
-DATA ·d+157104(SB)/8,$"\x20\x6f\x74\x68\x65\x72\x20\x62"
-DATA ·d+157112(SB)/8,$"\x72\x6f\x77\x73\x65\x72\x73\x2e"
-DATA ·d+157120(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x68\x72"
-DATA ·d+157128(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x2d"
-DATA ·d+157136(SB)/8,$"\x6d\x6f\x7a\x2d\x62\x6f\x78\x2d"
-DATA ·d+157144(SB)/8,$"\x73\x69\x7a\x69\x6e\x67\x3a\x20"
-DATA ·d+157152(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x2d"
-DATA ·d+157160(SB)/8,$"\x62\x6f\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+157168(SB)/8,$"\x20\x62\x6f\x78\x2d\x73\x69\x7a"
-DATA ·d+157176(SB)/8,$"\x69\x6e\x67\x3a\x20\x63\x6f\x6e"
-DATA ·d+157184(SB)/8,$"\x74\x65\x6e\x74\x2d\x62\x6f\x78"
-DATA ·d+157192(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x68\x65"
-DATA ·d+157200(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x30\x3b"
-DATA ·d+157208(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+157216(SB)/8,$"\x20\x2a\x20\x43\x6f\x6e\x74\x61"
-DATA ·d+157224(SB)/8,$"\x69\x6e\x20\x6f\x76\x65\x72\x66"
-DATA ·d+157232(SB)/8,$"\x6c\x6f\x77\x20\x69\x6e\x20\x61"
-DATA ·d+157240(SB)/8,$"\x6c\x6c\x20\x62\x72\x6f\x77\x73"
-DATA ·d+157248(SB)/8,$"\x65\x72\x73\x2e\x0a\x20\x2a\x2f"
-DATA ·d+157256(SB)/8,$"\x0a\x0a\x70\x72\x65\x20\x7b\x0a"
-DATA ·d+157264(SB)/8,$"\x20\x20\x20\x20\x6f\x76\x65\x72"
-DATA ·d+157272(SB)/8,$"\x66\x6c\x6f\x77\x3a\x20\x61\x75"
-DATA ·d+157280(SB)/8,$"\x74\x6f\x3b\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+157288(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x41\x64"
-DATA ·d+157296(SB)/8,$"\x64\x72\x65\x73\x73\x20\x6f\x64"
-DATA ·d+157304(SB)/8,$"\x64\x20\x60\x65\x6d\x60\x2d\x75"
-DATA ·d+157312(SB)/8,$"\x6e\x69\x74\x20\x66\x6f\x6e\x74"
-DATA ·d+157320(SB)/8,$"\x20\x73\x69\x7a\x65\x20\x72\x65"
-DATA ·d+157328(SB)/8,$"\x6e\x64\x65\x72\x69\x6e\x67\x20"
-DATA ·d+157336(SB)/8,$"\x69\x6e\x20\x61\x6c\x6c\x20\x62"
-DATA ·d+157344(SB)/8,$"\x72\x6f\x77\x73\x65\x72\x73\x2e"
-DATA ·d+157352(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x63\x6f"
-DATA ·d+157360(SB)/8,$"\x64\x65\x2c\x0a\x6b\x62\x64\x2c"
-DATA ·d+157368(SB)/8,$"\x0a\x70\x72\x65\x2c\x0a\x73\x61"
-DATA ·d+157376(SB)/8,$"\x6d\x70\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+157384(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x66\x61"
-DATA ·d+157392(SB)/8,$"\x6d\x69\x6c\x79\x3a\x20\x6d\x6f"
-DATA ·d+157400(SB)/8,$"\x6e\x6f\x73\x70\x61\x63\x65\x2c"
-DATA ·d+157408(SB)/8,$"\x20\x6d\x6f\x6e\x6f\x73\x70\x61"
-DATA ·d+157416(SB)/8,$"\x63\x65\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+157424(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
-DATA ·d+157432(SB)/8,$"\x65\x3a\x20\x31\x65\x6d\x3b\x0a"
-DATA ·d+157440(SB)/8,$"\x7d\x0a\x0a\x2f\x2a\x20\x46\x6f"
-DATA ·d+157448(SB)/8,$"\x72\x6d\x73\x0a\x20\x20\x20\x3d"
-DATA ·d+157456(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157464(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157472(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157480(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157488(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157496(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157504(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157512(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157520(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+157528(SB)/8,$"\x3d\x20\x2a\x2f\x0a\x0a\x2f\x2a"
-DATA ·d+157536(SB)/8,$"\x2a\x0a\x20\x2a\x20\x4b\x6e\x6f"
-DATA ·d+157544(SB)/8,$"\x77\x6e\x20\x6c\x69\x6d\x69\x74"
-DATA ·d+157552(SB)/8,$"\x61\x74\x69\x6f\x6e\x3a\x20\x62"
-DATA ·d+157560(SB)/8,$"\x79\x20\x64\x65\x66\x61\x75\x6c"
-DATA ·d+157568(SB)/8,$"\x74\x2c\x20\x43\x68\x72\x6f\x6d"
-DATA ·d+157576(SB)/8,$"\x65\x20\x61\x6e\x64\x20\x53\x61"
-DATA ·d+157584(SB)/8,$"\x66\x61\x72\x69\x20\x6f\x6e\x20"
-DATA ·d+157592(SB)/8,$"\x4f\x53\x20\x58\x20\x61\x6c\x6c"
-DATA ·d+157600(SB)/8,$"\x6f\x77\x20\x76\x65\x72\x79\x20"
-DATA ·d+157608(SB)/8,$"\x6c\x69\x6d\x69\x74\x65\x64\x0a"
-DATA ·d+157616(SB)/8,$"\x20\x2a\x20\x73\x74\x79\x6c\x69"
-DATA ·d+157624(SB)/8,$"\x6e\x67\x20\x6f\x66\x20\x60\x73"
-DATA ·d+157632(SB)/8,$"\x65\x6c\x65\x63\x74\x60\x2c\x20"
-DATA ·d+157640(SB)/8,$"\x75\x6e\x6c\x65\x73\x73\x20\x61"
-DATA ·d+157648(SB)/8,$"\x20\x60\x62\x6f\x72\x64\x65\x72"
-DATA ·d+157656(SB)/8,$"\x60\x20\x70\x72\x6f\x70\x65\x72"
-DATA ·d+157664(SB)/8,$"\x74\x79\x20\x69\x73\x20\x73\x65"
-DATA ·d+157672(SB)/8,$"\x74\x2e\x0a\x20\x2a\x2f\x0a\x0a"
-DATA ·d+157680(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x31"
-DATA ·d+157688(SB)/8,$"\x2e\x20\x43\x6f\x72\x72\x65\x63"
-DATA ·d+157696(SB)/8,$"\x74\x20\x63\x6f\x6c\x6f\x72\x20"
-DATA ·d+157704(SB)/8,$"\x6e\x6f\x74\x20\x62\x65\x69\x6e"
-DATA ·d+157712(SB)/8,$"\x67\x20\x69\x6e\x68\x65\x72\x69"
-DATA ·d+157720(SB)/8,$"\x74\x65\x64\x2e\x0a\x20\x2a\x20"
-DATA ·d+157728(SB)/8,$"\x20\x20\x20\x4b\x6e\x6f\x77\x6e"
-DATA ·d+157736(SB)/8,$"\x20\x69\x73\x73\x75\x65\x3a\x20"
-DATA ·d+157744(SB)/8,$"\x61\x66\x66\x65\x63\x74\x73\x20"
-DATA ·d+157752(SB)/8,$"\x63\x6f\x6c\x6f\x72\x20\x6f\x66"
-DATA ·d+157760(SB)/8,$"\x20\x64\x69\x73\x61\x62\x6c\x65"
-DATA ·d+157768(SB)/8,$"\x64\x20\x65\x6c\x65\x6d\x65\x6e"
-DATA ·d+157776(SB)/8,$"\x74\x73\x2e\x0a\x20\x2a\x20\x32"
-DATA ·d+157784(SB)/8,$"\x2e\x20\x43\x6f\x72\x72\x65\x63"
-DATA ·d+157792(SB)/8,$"\x74\x20\x66\x6f\x6e\x74\x20\x70"
-DATA ·d+157800(SB)/8,$"\x72\x6f\x70\x65\x72\x74\x69\x65"
-DATA ·d+157808(SB)/8,$"\x73\x20\x6e\x6f\x74\x20\x62\x65"
-DATA ·d+157816(SB)/8,$"\x69\x6e\x67\x20\x69\x6e\x68\x65"
-DATA ·d+157824(SB)/8,$"\x72\x69\x74\x65\x64\x2e\x0a\x20"
-DATA ·d+157832(SB)/8,$"\x2a\x20\x33\x2e\x20\x41\x64\x64"
-DATA ·d+157840(SB)/8,$"\x72\x65\x73\x73\x20\x6d\x61\x72"
-DATA ·d+157848(SB)/8,$"\x67\x69\x6e\x73\x20\x73\x65\x74"
-DATA ·d+157856(SB)/8,$"\x20\x64\x69\x66\x66\x65\x72\x65"
-DATA ·d+157864(SB)/8,$"\x6e\x74\x6c\x79\x20\x69\x6e\x20"
-DATA ·d+157872(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x20"
-DATA ·d+157880(SB)/8,$"\x34\x2b\x2c\x20\x53\x61\x66\x61"
-DATA ·d+157888(SB)/8,$"\x72\x69\x2c\x20\x61\x6e\x64\x20"
-DATA ·d+157896(SB)/8,$"\x43\x68\x72\x6f\x6d\x65\x2e\x0a"
-DATA ·d+157904(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x75\x74"
-DATA ·d+157912(SB)/8,$"\x74\x6f\x6e\x2c\x0a\x69\x6e\x70"
-DATA ·d+157920(SB)/8,$"\x75\x74\x2c\x0a\x6f\x70\x74\x67"
-DATA ·d+157928(SB)/8,$"\x72\x6f\x75\x70\x2c\x0a\x73\x65"
-DATA ·d+157936(SB)/8,$"\x6c\x65\x63\x74\x2c\x0a\x74\x65"
-DATA ·d+157944(SB)/8,$"\x78\x74\x61\x72\x65\x61\x20\x7b"
-DATA ·d+157952(SB)/8,$"\x0a\x20\x20\x20\x20\x63\x6f\x6c"
-DATA ·d+157960(SB)/8,$"\x6f\x72\x3a\x20\x69\x6e\x68\x65"
-DATA ·d+157968(SB)/8,$"\x72\x69\x74\x3b\x20\x2f\x2a\x20"
-DATA ·d+157976(SB)/8,$"\x31\x20\x2a\x2f\x0a\x20\x20\x20"
-DATA ·d+157984(SB)/8,$"\x20\x66\x6f\x6e\x74\x3a\x20\x69"
-DATA ·d+157992(SB)/8,$"\x6e\x68\x65\x72\x69\x74\x3b\x20"
-DATA ·d+158000(SB)/8,$"\x2f\x2a\x20\x32\x20\x2a\x2f\x0a"
-DATA ·d+158008(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+158016(SB)/8,$"\x69\x6e\x3a\x20\x30\x3b\x20\x2f"
-DATA ·d+158024(SB)/8,$"\x2a\x20\x33\x20\x2a\x2f\x0a\x7d"
-DATA ·d+158032(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
-DATA ·d+158040(SB)/8,$"\x20\x41\x64\x64\x72\x65\x73\x73"
-DATA ·d+158048(SB)/8,$"\x20\x60\x6f\x76\x65\x72\x66\x6c"
-DATA ·d+158056(SB)/8,$"\x6f\x77\x60\x20\x73\x65\x74\x20"
-DATA ·d+158064(SB)/8,$"\x74\x6f\x20\x60\x68\x69\x64\x64"
-DATA ·d+158072(SB)/8,$"\x65\x6e\x60\x20\x69\x6e\x20\x49"
-DATA ·d+158080(SB)/8,$"\x45\x20\x38\x2f\x39\x2f\x31\x30"
-DATA ·d+158088(SB)/8,$"\x2f\x31\x31\x2e\x0a\x20\x2a\x2f"
-DATA ·d+158096(SB)/8,$"\x0a\x0a\x62\x75\x74\x74\x6f\x6e"
-DATA ·d+158104(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6f"
-DATA ·d+158112(SB)/8,$"\x76\x65\x72\x66\x6c\x6f\x77\x3a"
-DATA ·d+158120(SB)/8,$"\x20\x76\x69\x73\x69\x62\x6c\x65"
-DATA ·d+158128(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+158136(SB)/8,$"\x0a\x20\x2a\x20\x41\x64\x64\x72"
-DATA ·d+158144(SB)/8,$"\x65\x73\x73\x20\x69\x6e\x63\x6f"
-DATA ·d+158152(SB)/8,$"\x6e\x73\x69\x73\x74\x65\x6e\x74"
-DATA ·d+158160(SB)/8,$"\x20\x60\x74\x65\x78\x74\x2d\x74"
-DATA ·d+158168(SB)/8,$"\x72\x61\x6e\x73\x66\x6f\x72\x6d"
-DATA ·d+158176(SB)/8,$"\x60\x20\x69\x6e\x68\x65\x72\x69"
-DATA ·d+158184(SB)/8,$"\x74\x61\x6e\x63\x65\x20\x66\x6f"
-DATA ·d+158192(SB)/8,$"\x72\x20\x60\x62\x75\x74\x74\x6f"
-DATA ·d+158200(SB)/8,$"\x6e\x60\x20\x61\x6e\x64\x20\x60"
-DATA ·d+158208(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x60\x2e"
-DATA ·d+158216(SB)/8,$"\x0a\x20\x2a\x20\x41\x6c\x6c\x20"
-DATA ·d+158224(SB)/8,$"\x6f\x74\x68\x65\x72\x20\x66\x6f"
-DATA ·d+158232(SB)/8,$"\x72\x6d\x20\x63\x6f\x6e\x74\x72"
-DATA ·d+158240(SB)/8,$"\x6f\x6c\x20\x65\x6c\x65\x6d\x65"
-DATA ·d+158248(SB)/8,$"\x6e\x74\x73\x20\x64\x6f\x20\x6e"
-DATA ·d+158256(SB)/8,$"\x6f\x74\x20\x69\x6e\x68\x65\x72"
-DATA ·d+158264(SB)/8,$"\x69\x74\x20\x60\x74\x65\x78\x74"
-DATA ·d+158272(SB)/8,$"\x2d\x74\x72\x61\x6e\x73\x66\x6f"
-DATA ·d+158280(SB)/8,$"\x72\x6d\x60\x20\x76\x61\x6c\x75"
-DATA ·d+158288(SB)/8,$"\x65\x73\x2e\x0a\x20\x2a\x20\x43"
-DATA ·d+158296(SB)/8,$"\x6f\x72\x72\x65\x63\x74\x20\x60"
-DATA ·d+158304(SB)/8,$"\x62\x75\x74\x74\x6f\x6e\x60\x20"
-DATA ·d+158312(SB)/8,$"\x73\x74\x79\x6c\x65\x20\x69\x6e"
-DATA ·d+158320(SB)/8,$"\x68\x65\x72\x69\x74\x61\x6e\x63"
-DATA ·d+158328(SB)/8,$"\x65\x20\x69\x6e\x20\x46\x69\x72"
-DATA ·d+158336(SB)/8,$"\x65\x66\x6f\x78\x2c\x20\x49\x45"
-DATA ·d+158344(SB)/8,$"\x20\x38\x2f\x39\x2f\x31\x30\x2f"
-DATA ·d+158352(SB)/8,$"\x31\x31\x2c\x20\x61\x6e\x64\x20"
-DATA ·d+158360(SB)/8,$"\x4f\x70\x65\x72\x61\x2e\x0a\x20"
-DATA ·d+158368(SB)/8,$"\x2a\x20\x43\x6f\x72\x72\x65\x63"
-DATA ·d+158376(SB)/8,$"\x74\x20\x60\x73\x65\x6c\x65\x63"
-DATA ·d+158384(SB)/8,$"\x74\x60\x20\x73\x74\x79\x6c\x65"
-DATA ·d+158392(SB)/8,$"\x20\x69\x6e\x68\x65\x72\x69\x74"
-DATA ·d+158400(SB)/8,$"\x61\x6e\x63\x65\x20\x69\x6e\x20"
-DATA ·d+158408(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x2e"
-DATA ·d+158416(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x62\x75"
-DATA ·d+158424(SB)/8,$"\x74\x74\x6f\x6e\x2c\x0a\x73\x65"
-DATA ·d+158432(SB)/8,$"\x6c\x65\x63\x74\x20\x7b\x0a\x20"
-DATA ·d+158440(SB)/8,$"\x20\x20\x20\x74\x65\x78\x74\x2d"
-DATA ·d+158448(SB)/8,$"\x74\x72\x61\x6e\x73\x66\x6f\x72"
-DATA ·d+158456(SB)/8,$"\x6d\x3a\x20\x6e\x6f\x6e\x65\x3b"
-DATA ·d+158464(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+158472(SB)/8,$"\x20\x2a\x20\x31\x2e\x20\x41\x76"
-DATA ·d+158480(SB)/8,$"\x6f\x69\x64\x20\x74\x68\x65\x20"
-DATA ·d+158488(SB)/8,$"\x57\x65\x62\x4b\x69\x74\x20\x62"
-DATA ·d+158496(SB)/8,$"\x75\x67\x20\x69\x6e\x20\x41\x6e"
-DATA ·d+158504(SB)/8,$"\x64\x72\x6f\x69\x64\x20\x34\x2e"
-DATA ·d+158512(SB)/8,$"\x30\x2e\x2a\x20\x77\x68\x65\x72"
-DATA ·d+158520(SB)/8,$"\x65\x20\x28\x32\x29\x20\x64\x65"
-DATA ·d+158528(SB)/8,$"\x73\x74\x72\x6f\x79\x73\x20\x6e"
-DATA ·d+158536(SB)/8,$"\x61\x74\x69\x76\x65\x20\x60\x61"
-DATA ·d+158544(SB)/8,$"\x75\x64\x69\x6f\x60\x0a\x20\x2a"
-DATA ·d+158552(SB)/8,$"\x20\x20\x20\x20\x61\x6e\x64\x20"
-DATA ·d+158560(SB)/8,$"\x60\x76\x69\x64\x65\x6f\x60\x20"
-DATA ·d+158568(SB)/8,$"\x63\x6f\x6e\x74\x72\x6f\x6c\x73"
-DATA ·d+158576(SB)/8,$"\x2e\x0a\x20\x2a\x20\x32\x2e\x20"
-DATA ·d+158584(SB)/8,$"\x43\x6f\x72\x72\x65\x63\x74\x20"
-DATA ·d+158592(SB)/8,$"\x69\x6e\x61\x62\x69\x6c\x69\x74"
-DATA ·d+158600(SB)/8,$"\x79\x20\x74\x6f\x20\x73\x74\x79"
-DATA ·d+158608(SB)/8,$"\x6c\x65\x20\x63\x6c\x69\x63\x6b"
-DATA ·d+158616(SB)/8,$"\x61\x62\x6c\x65\x20\x60\x69\x6e"
-DATA ·d+158624(SB)/8,$"\x70\x75\x74\x60\x20\x74\x79\x70"
-DATA ·d+158632(SB)/8,$"\x65\x73\x20\x69\x6e\x20\x69\x4f"
-DATA ·d+158640(SB)/8,$"\x53\x2e\x0a\x20\x2a\x20\x33\x2e"
-DATA ·d+158648(SB)/8,$"\x20\x49\x6d\x70\x72\x6f\x76\x65"
-DATA ·d+158656(SB)/8,$"\x20\x75\x73\x61\x62\x69\x6c\x69"
-DATA ·d+158664(SB)/8,$"\x74\x79\x20\x61\x6e\x64\x20\x63"
-DATA ·d+158672(SB)/8,$"\x6f\x6e\x73\x69\x73\x74\x65\x6e"
-DATA ·d+158680(SB)/8,$"\x63\x79\x20\x6f\x66\x20\x63\x75"
-DATA ·d+158688(SB)/8,$"\x72\x73\x6f\x72\x20\x73\x74\x79"
-DATA ·d+158696(SB)/8,$"\x6c\x65\x20\x62\x65\x74\x77\x65"
-DATA ·d+158704(SB)/8,$"\x65\x6e\x20\x69\x6d\x61\x67\x65"
-DATA ·d+158712(SB)/8,$"\x2d\x74\x79\x70\x65\x0a\x20\x2a"
-DATA ·d+158720(SB)/8,$"\x20\x20\x20\x20\x60\x69\x6e\x70"
-DATA ·d+158728(SB)/8,$"\x75\x74\x60\x20\x61\x6e\x64\x20"
-DATA ·d+158736(SB)/8,$"\x6f\x74\x68\x65\x72\x73\x2e\x0a"
-DATA ·d+158744(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x75\x74"
-DATA ·d+158752(SB)/8,$"\x74\x6f\x6e\x2c\x0a\x68\x74\x6d"
-DATA ·d+158760(SB)/8,$"\x6c\x20\x69\x6e\x70\x75\x74\x5b"
-DATA ·d+158768(SB)/8,$"\x74\x79\x70\x65\x3d\x22\x62\x75"
-DATA ·d+158776(SB)/8,$"\x74\x74\x6f\x6e\x22\x5d\x2c\x20"
-DATA ·d+158784(SB)/8,$"\x2f\x2a\x20\x31\x20\x2a\x2f\x0a"
-DATA ·d+158792(SB)/8,$"\x69\x6e\x70\x75\x74\x5b\x74\x79"
-DATA ·d+158800(SB)/8,$"\x70\x65\x3d\x22\x72\x65\x73\x65"
-DATA ·d+158808(SB)/8,$"\x74\x22\x5d\x2c\x0a\x69\x6e\x70"
-DATA ·d+158816(SB)/8,$"\x75\x74\x5b\x74\x79\x70\x65\x3d"
-DATA ·d+158824(SB)/8,$"\x22\x73\x75\x62\x6d\x69\x74\x22"
-DATA ·d+158832(SB)/8,$"\x5d\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+158840(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
-DATA ·d+158848(SB)/8,$"\x61\x70\x70\x65\x61\x72\x61\x6e"
-DATA ·d+158856(SB)/8,$"\x63\x65\x3a\x20\x62\x75\x74\x74"
-DATA ·d+158864(SB)/8,$"\x6f\x6e\x3b\x20\x2f\x2a\x20\x32"
-DATA ·d+158872(SB)/8,$"\x20\x2a\x2f\x0a\x20\x20\x20\x20"
-DATA ·d+158880(SB)/8,$"\x63\x75\x72\x73\x6f\x72\x3a\x20"
-DATA ·d+158888(SB)/8,$"\x70\x6f\x69\x6e\x74\x65\x72\x3b"
-DATA ·d+158896(SB)/8,$"\x20\x2f\x2a\x20\x33\x20\x2a\x2f"
-DATA ·d+158904(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+158912(SB)/8,$"\x20\x2a\x20\x52\x65\x2d\x73\x65"
-DATA ·d+158920(SB)/8,$"\x74\x20\x64\x65\x66\x61\x75\x6c"
-DATA ·d+158928(SB)/8,$"\x74\x20\x63\x75\x72\x73\x6f\x72"
-DATA ·d+158936(SB)/8,$"\x20\x66\x6f\x72\x20\x64\x69\x73"
-DATA ·d+158944(SB)/8,$"\x61\x62\x6c\x65\x64\x20\x65\x6c"
-DATA ·d+158952(SB)/8,$"\x65\x6d\x65\x6e\x74\x73\x2e\x0a"
-DATA ·d+158960(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x75\x74"
-DATA ·d+158968(SB)/8,$"\x74\x6f\x6e\x5b\x64\x69\x73\x61"
-DATA ·d+158976(SB)/8,$"\x62\x6c\x65\x64\x5d\x2c\x0a\x68"
-DATA ·d+158984(SB)/8,$"\x74\x6d\x6c\x20\x69\x6e\x70\x75"
-DATA ·d+158992(SB)/8,$"\x74\x5b\x64\x69\x73\x61\x62\x6c"
-DATA ·d+159000(SB)/8,$"\x65\x64\x5d\x20\x7b\x0a\x20\x20"
-DATA ·d+159008(SB)/8,$"\x20\x20\x63\x75\x72\x73\x6f\x72"
-DATA ·d+159016(SB)/8,$"\x3a\x20\x64\x65\x66\x61\x75\x6c"
-DATA ·d+159024(SB)/8,$"\x74\x3b\x0a\x7d\x0a\x0a\x2f\x2a"
-DATA ·d+159032(SB)/8,$"\x2a\x0a\x20\x2a\x20\x52\x65\x6d"
-DATA ·d+159040(SB)/8,$"\x6f\x76\x65\x20\x69\x6e\x6e\x65"
-DATA ·d+159048(SB)/8,$"\x72\x20\x70\x61\x64\x64\x69\x6e"
-DATA ·d+159056(SB)/8,$"\x67\x20\x61\x6e\x64\x20\x62\x6f"
-DATA ·d+159064(SB)/8,$"\x72\x64\x65\x72\x20\x69\x6e\x20"
-DATA ·d+159072(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x20"
-DATA ·d+159080(SB)/8,$"\x34\x2b\x2e\x0a\x20\x2a\x2f\x0a"
-DATA ·d+159088(SB)/8,$"\x0a\x62\x75\x74\x74\x6f\x6e\x3a"
-DATA ·d+159096(SB)/8,$"\x3a\x2d\x6d\x6f\x7a\x2d\x66\x6f"
-DATA ·d+159104(SB)/8,$"\x63\x75\x73\x2d\x69\x6e\x6e\x65"
-DATA ·d+159112(SB)/8,$"\x72\x2c\x0a\x69\x6e\x70\x75\x74"
-DATA ·d+159120(SB)/8,$"\x3a\x3a\x2d\x6d\x6f\x7a\x2d\x66"
-DATA ·d+159128(SB)/8,$"\x6f\x63\x75\x73\x2d\x69\x6e\x6e"
-DATA ·d+159136(SB)/8,$"\x65\x72\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+159144(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x3a"
-DATA ·d+159152(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+159160(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
-DATA ·d+159168(SB)/8,$"\x20\x30\x3b\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+159176(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x41\x64"
-DATA ·d+159184(SB)/8,$"\x64\x72\x65\x73\x73\x20\x46\x69"
-DATA ·d+159192(SB)/8,$"\x72\x65\x66\x6f\x78\x20\x34\x2b"
-DATA ·d+159200(SB)/8,$"\x20\x73\x65\x74\x74\x69\x6e\x67"
-DATA ·d+159208(SB)/8,$"\x20\x60\x6c\x69\x6e\x65\x2d\x68"
-DATA ·d+159216(SB)/8,$"\x65\x69\x67\x68\x74\x60\x20\x6f"
-DATA ·d+159224(SB)/8,$"\x6e\x20\x60\x69\x6e\x70\x75\x74"
-DATA ·d+159232(SB)/8,$"\x60\x20\x75\x73\x69\x6e\x67\x20"
-DATA ·d+159240(SB)/8,$"\x60\x21\x69\x6d\x70\x6f\x72\x74"
-DATA ·d+159248(SB)/8,$"\x61\x6e\x74\x60\x20\x69\x6e\x0a"
-DATA ·d+159256(SB)/8,$"\x20\x2a\x20\x74\x68\x65\x20\x55"
-DATA ·d+159264(SB)/8,$"\x41\x20\x73\x74\x79\x6c\x65\x73"
-DATA ·d+159272(SB)/8,$"\x68\x65\x65\x74\x2e\x0a\x20\x2a"
-DATA ·d+159280(SB)/8,$"\x2f\x0a\x0a\x69\x6e\x70\x75\x74"
-DATA ·d+159288(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6c"
-DATA ·d+159296(SB)/8,$"\x69\x6e\x65\x2d\x68\x65\x69\x67"
-DATA ·d+159304(SB)/8,$"\x68\x74\x3a\x20\x6e\x6f\x72\x6d"
-DATA ·d+159312(SB)/8,$"\x61\x6c\x3b\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+159320(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x49\x74"
-DATA ·d+159328(SB)/8,$"\x27\x73\x20\x72\x65\x63\x6f\x6d"
-DATA ·d+159336(SB)/8,$"\x6d\x65\x6e\x64\x65\x64\x20\x74"
-DATA ·d+159344(SB)/8,$"\x68\x61\x74\x20\x79\x6f\x75\x20"
-DATA ·d+159352(SB)/8,$"\x64\x6f\x6e\x27\x74\x20\x61\x74"
-DATA ·d+159360(SB)/8,$"\x74\x65\x6d\x70\x74\x20\x74\x6f"
-DATA ·d+159368(SB)/8,$"\x20\x73\x74\x79\x6c\x65\x20\x74"
-DATA ·d+159376(SB)/8,$"\x68\x65\x73\x65\x20\x65\x6c\x65"
-DATA ·d+159384(SB)/8,$"\x6d\x65\x6e\x74\x73\x2e\x0a\x20"
-DATA ·d+159392(SB)/8,$"\x2a\x20\x46\x69\x72\x65\x66\x6f"
-DATA ·d+159400(SB)/8,$"\x78\x27\x73\x20\x69\x6d\x70\x6c"
-DATA ·d+159408(SB)/8,$"\x65\x6d\x65\x6e\x74\x61\x74\x69"
-DATA ·d+159416(SB)/8,$"\x6f\x6e\x20\x64\x6f\x65\x73\x6e"
-DATA ·d+159424(SB)/8,$"\x27\x74\x20\x72\x65\x73\x70\x65"
-DATA ·d+159432(SB)/8,$"\x63\x74\x20\x62\x6f\x78\x2d\x73"
-DATA ·d+159440(SB)/8,$"\x69\x7a\x69\x6e\x67\x2c\x20\x70"
-DATA ·d+159448(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x2c\x20"
-DATA ·d+159456(SB)/8,$"\x6f\x72\x20\x77\x69\x64\x74\x68"
-DATA ·d+159464(SB)/8,$"\x2e\x0a\x20\x2a\x0a\x20\x2a\x20"
-DATA ·d+159472(SB)/8,$"\x31\x2e\x20\x41\x64\x64\x72\x65"
-DATA ·d+159480(SB)/8,$"\x73\x73\x20\x62\x6f\x78\x20\x73"
-DATA ·d+159488(SB)/8,$"\x69\x7a\x69\x6e\x67\x20\x73\x65"
-DATA ·d+159496(SB)/8,$"\x74\x20\x74\x6f\x20\x60\x63\x6f"
-DATA ·d+159504(SB)/8,$"\x6e\x74\x65\x6e\x74\x2d\x62\x6f"
-DATA ·d+159512(SB)/8,$"\x78\x60\x20\x69\x6e\x20\x49\x45"
-DATA ·d+159520(SB)/8,$"\x20\x38\x2f\x39\x2f\x31\x30\x2e"
-DATA ·d+159528(SB)/8,$"\x0a\x20\x2a\x20\x32\x2e\x20\x52"
-DATA ·d+159536(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x65\x78"
-DATA ·d+159544(SB)/8,$"\x63\x65\x73\x73\x20\x70\x61\x64"
-DATA ·d+159552(SB)/8,$"\x64\x69\x6e\x67\x20\x69\x6e\x20"
-DATA ·d+159560(SB)/8,$"\x49\x45\x20\x38\x2f\x39\x2f\x31"
-DATA ·d+159568(SB)/8,$"\x30\x2e\x0a\x20\x2a\x2f\x0a\x0a"
-DATA ·d+159576(SB)/8,$"\x69\x6e\x70\x75\x74\x5b\x74\x79"
-DATA ·d+159584(SB)/8,$"\x70\x65\x3d\x22\x63\x68\x65\x63"
-DATA ·d+159592(SB)/8,$"\x6b\x62\x6f\x78\x22\x5d\x2c\x0a"
-DATA ·d+159600(SB)/8,$"\x69\x6e\x70\x75\x74\x5b\x74\x79"
-DATA ·d+159608(SB)/8,$"\x70\x65\x3d\x22\x72\x61\x64\x69"
-DATA ·d+159616(SB)/8,$"\x6f\x22\x5d\x20\x7b\x0a\x20\x20"
-DATA ·d+159624(SB)/8,$"\x20\x20\x62\x6f\x78\x2d\x73\x69"
-DATA ·d+159632(SB)/8,$"\x7a\x69\x6e\x67\x3a\x20\x62\x6f"
-DATA ·d+159640(SB)/8,$"\x72\x64\x65\x72\x2d\x62\x6f\x78"
-DATA ·d+159648(SB)/8,$"\x3b\x20\x2f\x2a\x20\x31\x20\x2a"
-DATA ·d+159656(SB)/8,$"\x2f\x0a\x20\x20\x20\x20\x70\x61"
-DATA ·d+159664(SB)/8,$"\x64\x64\x69\x6e\x67\x3a\x20\x30"
-DATA ·d+159672(SB)/8,$"\x3b\x20\x2f\x2a\x20\x32\x20\x2a"
-DATA ·d+159680(SB)/8,$"\x2f\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+159688(SB)/8,$"\x0a\x20\x2a\x20\x46\x69\x78\x20"
-DATA ·d+159696(SB)/8,$"\x74\x68\x65\x20\x63\x75\x72\x73"
-DATA ·d+159704(SB)/8,$"\x6f\x72\x20\x73\x74\x79\x6c\x65"
-DATA ·d+159712(SB)/8,$"\x20\x66\x6f\x72\x20\x43\x68\x72"
-DATA ·d+159720(SB)/8,$"\x6f\x6d\x65\x27\x73\x20\x69\x6e"
-DATA ·d+159728(SB)/8,$"\x63\x72\x65\x6d\x65\x6e\x74\x2f"
-DATA ·d+159736(SB)/8,$"\x64\x65\x63\x72\x65\x6d\x65\x6e"
-DATA ·d+159744(SB)/8,$"\x74\x20\x62\x75\x74\x74\x6f\x6e"
-DATA ·d+159752(SB)/8,$"\x73\x2e\x20\x46\x6f\x72\x20\x63"
-DATA ·d+159760(SB)/8,$"\x65\x72\x74\x61\x69\x6e\x0a\x20"
-DATA ·d+159768(SB)/8,$"\x2a\x20\x60\x66\x6f\x6e\x74\x2d"
-DATA ·d+159776(SB)/8,$"\x73\x69\x7a\x65\x60\x20\x76\x61"
-DATA ·d+159784(SB)/8,$"\x6c\x75\x65\x73\x20\x6f\x66\x20"
-DATA ·d+159792(SB)/8,$"\x74\x68\x65\x20\x60\x69\x6e\x70"
-DATA ·d+159800(SB)/8,$"\x75\x74\x60\x2c\x20\x69\x74\x20"
-DATA ·d+159808(SB)/8,$"\x63\x61\x75\x73\x65\x73\x20\x74"
-DATA ·d+159816(SB)/8,$"\x68\x65\x20\x63\x75\x72\x73\x6f"
-DATA ·d+159824(SB)/8,$"\x72\x20\x73\x74\x79\x6c\x65\x20"
-DATA ·d+159832(SB)/8,$"\x6f\x66\x20\x74\x68\x65\x0a\x20"
-DATA ·d+159840(SB)/8,$"\x2a\x20\x64\x65\x63\x72\x65\x6d"
-DATA ·d+159848(SB)/8,$"\x65\x6e\x74\x20\x62\x75\x74\x74"
-DATA ·d+159856(SB)/8,$"\x6f\x6e\x20\x74\x6f\x20\x63\x68"
-DATA ·d+159864(SB)/8,$"\x61\x6e\x67\x65\x20\x66\x72\x6f"
-DATA ·d+159872(SB)/8,$"\x6d\x20\x60\x64\x65\x66\x61\x75"
-DATA ·d+159880(SB)/8,$"\x6c\x74\x60\x20\x74\x6f\x20\x60"
-DATA ·d+159888(SB)/8,$"\x74\x65\x78\x74\x60\x2e\x0a\x20"
-DATA ·d+159896(SB)/8,$"\x2a\x2f\x0a\x0a\x69\x6e\x70\x75"
-DATA ·d+159904(SB)/8,$"\x74\x5b\x74\x79\x70\x65\x3d\x22"
-DATA ·d+159912(SB)/8,$"\x6e\x75\x6d\x62\x65\x72\x22\x5d"
-DATA ·d+159920(SB)/8,$"\x3a\x3a\x2d\x77\x65\x62\x6b\x69"
-DATA ·d+159928(SB)/8,$"\x74\x2d\x69\x6e\x6e\x65\x72\x2d"
-DATA ·d+159936(SB)/8,$"\x73\x70\x69\x6e\x2d\x62\x75\x74"
-DATA ·d+159944(SB)/8,$"\x74\x6f\x6e\x2c\x0a\x69\x6e\x70"
-DATA ·d+159952(SB)/8,$"\x75\x74\x5b\x74\x79\x70\x65\x3d"
-DATA ·d+159960(SB)/8,$"\x22\x6e\x75\x6d\x62\x65\x72\x22"
-DATA ·d+159968(SB)/8,$"\x5d\x3a\x3a\x2d\x77\x65\x62\x6b"
-DATA ·d+159976(SB)/8,$"\x69\x74\x2d\x6f\x75\x74\x65\x72"
-DATA ·d+159984(SB)/8,$"\x2d\x73\x70\x69\x6e\x2d\x62\x75"
-DATA ·d+159992(SB)/8,$"\x74\x74\x6f\x6e\x20\x7b\x0a\x20"
-DATA ·d+160000(SB)/8,$"\x20\x20\x20\x68\x65\x69\x67\x68"
-DATA ·d+160008(SB)/8,$"\x74\x3a\x20\x61\x75\x74\x6f\x3b"
-DATA ·d+160016(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+160024(SB)/8,$"\x20\x2a\x20\x31\x2e\x20\x41\x64"
-DATA ·d+160032(SB)/8,$"\x64\x72\x65\x73\x73\x20\x60\x61"
-DATA ·d+160040(SB)/8,$"\x70\x70\x65\x61\x72\x61\x6e\x63"
-DATA ·d+160048(SB)/8,$"\x65\x60\x20\x73\x65\x74\x20\x74"
-DATA ·d+160056(SB)/8,$"\x6f\x20\x60\x73\x65\x61\x72\x63"
-DATA ·d+160064(SB)/8,$"\x68\x66\x69\x65\x6c\x64\x60\x20"
-DATA ·d+160072(SB)/8,$"\x69\x6e\x20\x53\x61\x66\x61\x72"
-DATA ·d+160080(SB)/8,$"\x69\x20\x61\x6e\x64\x20\x43\x68"
-DATA ·d+160088(SB)/8,$"\x72\x6f\x6d\x65\x2e\x0a\x20\x2a"
-DATA ·d+160096(SB)/8,$"\x20\x32\x2e\x20\x41\x64\x64\x72"
-DATA ·d+160104(SB)/8,$"\x65\x73\x73\x20\x60\x62\x6f\x78"
-DATA ·d+160112(SB)/8,$"\x2d\x73\x69\x7a\x69\x6e\x67\x60"
-DATA ·d+160120(SB)/8,$"\x20\x73\x65\x74\x20\x74\x6f\x20"
-DATA ·d+160128(SB)/8,$"\x60\x62\x6f\x72\x64\x65\x72\x2d"
-DATA ·d+160136(SB)/8,$"\x62\x6f\x78\x60\x20\x69\x6e\x20"
-DATA ·d+160144(SB)/8,$"\x53\x61\x66\x61\x72\x69\x20\x61"
-DATA ·d+160152(SB)/8,$"\x6e\x64\x20\x43\x68\x72\x6f\x6d"
-DATA ·d+160160(SB)/8,$"\x65\x0a\x20\x2a\x20\x20\x20\x20"
-DATA ·d+160168(SB)/8,$"\x28\x69\x6e\x63\x6c\x75\x64\x65"
-DATA ·d+160176(SB)/8,$"\x20\x60\x2d\x6d\x6f\x7a\x60\x20"
-DATA ·d+160184(SB)/8,$"\x74\x6f\x20\x66\x75\x74\x75\x72"
-DATA ·d+160192(SB)/8,$"\x65\x2d\x70\x72\x6f\x6f\x66\x29"
-DATA ·d+160200(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x69"
-DATA ·d+160208(SB)/8,$"\x6e\x70\x75\x74\x5b\x74\x79\x70"
-DATA ·d+160216(SB)/8,$"\x65\x3d\x22\x73\x65\x61\x72\x63"
-DATA ·d+160224(SB)/8,$"\x68\x22\x5d\x20\x7b\x0a\x20\x20"
-DATA ·d+160232(SB)/8,$"\x20\x20\x2d\x77\x65\x62\x6b\x69"
-DATA ·d+160240(SB)/8,$"\x74\x2d\x61\x70\x70\x65\x61\x72"
-DATA ·d+160248(SB)/8,$"\x61\x6e\x63\x65\x3a\x20\x74\x65"
-DATA ·d+160256(SB)/8,$"\x78\x74\x66\x69\x65\x6c\x64\x3b"
-DATA ·d+160264(SB)/8,$"\x20\x2f\x2a\x20\x31\x20\x2a\x2f"
-DATA ·d+160272(SB)/8,$"\x0a\x20\x20\x20\x20\x2d\x6d\x6f"
-DATA ·d+160280(SB)/8,$"\x7a\x2d\x62\x6f\x78\x2d\x73\x69"
-DATA ·d+160288(SB)/8,$"\x7a\x69\x6e\x67\x3a\x20\x63\x6f"
-DATA ·d+160296(SB)/8,$"\x6e\x74\x65\x6e\x74\x2d\x62\x6f"
-DATA ·d+160304(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x2d"
-DATA ·d+160312(SB)/8,$"\x77\x65\x62\x6b\x69\x74\x2d\x62"
-DATA ·d+160320(SB)/8,$"\x6f\x78\x2d\x73\x69\x7a\x69\x6e"
-DATA ·d+160328(SB)/8,$"\x67\x3a\x20\x63\x6f\x6e\x74\x65"
-DATA ·d+160336(SB)/8,$"\x6e\x74\x2d\x62\x6f\x78\x3b\x20"
-DATA ·d+160344(SB)/8,$"\x2f\x2a\x20\x32\x20\x2a\x2f\x0a"
-DATA ·d+160352(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x78\x2d"
-DATA ·d+160360(SB)/8,$"\x73\x69\x7a\x69\x6e\x67\x3a\x20"
-DATA ·d+160368(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x2d"
-DATA ·d+160376(SB)/8,$"\x62\x6f\x78\x3b\x0a\x7d\x0a\x0a"
-DATA ·d+160384(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x52"
-DATA ·d+160392(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x69\x6e"
-DATA ·d+160400(SB)/8,$"\x6e\x65\x72\x20\x70\x61\x64\x64"
-DATA ·d+160408(SB)/8,$"\x69\x6e\x67\x20\x61\x6e\x64\x20"
-DATA ·d+160416(SB)/8,$"\x73\x65\x61\x72\x63\x68\x20\x63"
-DATA ·d+160424(SB)/8,$"\x61\x6e\x63\x65\x6c\x20\x62\x75"
-DATA ·d+160432(SB)/8,$"\x74\x74\x6f\x6e\x20\x69\x6e\x20"
-DATA ·d+160440(SB)/8,$"\x53\x61\x66\x61\x72\x69\x20\x61"
-DATA ·d+160448(SB)/8,$"\x6e\x64\x20\x43\x68\x72\x6f\x6d"
-DATA ·d+160456(SB)/8,$"\x65\x20\x6f\x6e\x20\x4f\x53\x20"
-DATA ·d+160464(SB)/8,$"\x58\x2e\x0a\x20\x2a\x20\x53\x61"
-DATA ·d+160472(SB)/8,$"\x66\x61\x72\x69\x20\x28\x62\x75"
-DATA ·d+160480(SB)/8,$"\x74\x20\x6e\x6f\x74\x20\x43\x68"
-DATA ·d+160488(SB)/8,$"\x72\x6f\x6d\x65\x29\x20\x63\x6c"
-DATA ·d+160496(SB)/8,$"\x69\x70\x73\x20\x74\x68\x65\x20"
-DATA ·d+160504(SB)/8,$"\x63\x61\x6e\x63\x65\x6c\x20\x62"
-DATA ·d+160512(SB)/8,$"\x75\x74\x74\x6f\x6e\x20\x77\x68"
-DATA ·d+160520(SB)/8,$"\x65\x6e\x20\x74\x68\x65\x20\x73"
-DATA ·d+160528(SB)/8,$"\x65\x61\x72\x63\x68\x20\x69\x6e"
-DATA ·d+160536(SB)/8,$"\x70\x75\x74\x20\x68\x61\x73\x0a"
-DATA ·d+160544(SB)/8,$"\x20\x2a\x20\x70\x61\x64\x64\x69"
-DATA ·d+160552(SB)/8,$"\x6e\x67\x20\x28\x61\x6e\x64\x20"
-DATA ·d+160560(SB)/8,$"\x60\x74\x65\x78\x74\x66\x69\x65"
-DATA ·d+160568(SB)/8,$"\x6c\x64\x60\x20\x61\x70\x70\x65"
-DATA ·d+160576(SB)/8,$"\x61\x72\x61\x6e\x63\x65\x29\x2e"
-DATA ·d+160584(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x69\x6e"
-DATA ·d+160592(SB)/8,$"\x70\x75\x74\x5b\x74\x79\x70\x65"
-DATA ·d+160600(SB)/8,$"\x3d\x22\x73\x65\x61\x72\x63\x68"
-DATA ·d+160608(SB)/8,$"\x22\x5d\x3a\x3a\x2d\x77\x65\x62"
-DATA ·d+160616(SB)/8,$"\x6b\x69\x74\x2d\x73\x65\x61\x72"
-DATA ·d+160624(SB)/8,$"\x63\x68\x2d\x63\x61\x6e\x63\x65"
-DATA ·d+160632(SB)/8,$"\x6c\x2d\x62\x75\x74\x74\x6f\x6e"
-DATA ·d+160640(SB)/8,$"\x2c\x0a\x69\x6e\x70\x75\x74\x5b"
-DATA ·d+160648(SB)/8,$"\x74\x79\x70\x65\x3d\x22\x73\x65"
-DATA ·d+160656(SB)/8,$"\x61\x72\x63\x68\x22\x5d\x3a\x3a"
-DATA ·d+160664(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
-DATA ·d+160672(SB)/8,$"\x73\x65\x61\x72\x63\x68\x2d\x64"
-DATA ·d+160680(SB)/8,$"\x65\x63\x6f\x72\x61\x74\x69\x6f"
-DATA ·d+160688(SB)/8,$"\x6e\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+160696(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
-DATA ·d+160704(SB)/8,$"\x61\x70\x70\x65\x61\x72\x61\x6e"
-DATA ·d+160712(SB)/8,$"\x63\x65\x3a\x20\x6e\x6f\x6e\x65"
-DATA ·d+160720(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+160728(SB)/8,$"\x0a\x20\x2a\x20\x44\x65\x66\x69"
-DATA ·d+160736(SB)/8,$"\x6e\x65\x20\x63\x6f\x6e\x73\x69"
-DATA ·d+160744(SB)/8,$"\x73\x74\x65\x6e\x74\x20\x62\x6f"
-DATA ·d+160752(SB)/8,$"\x72\x64\x65\x72\x2c\x20\x6d\x61"
-DATA ·d+160760(SB)/8,$"\x72\x67\x69\x6e\x2c\x20\x61\x6e"
-DATA ·d+160768(SB)/8,$"\x64\x20\x70\x61\x64\x64\x69\x6e"
-DATA ·d+160776(SB)/8,$"\x67\x2e\x0a\x20\x2a\x2f\x0a\x0a"
-DATA ·d+160784(SB)/8,$"\x66\x69\x65\x6c\x64\x73\x65\x74"
-DATA ·d+160792(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x62"
-DATA ·d+160800(SB)/8,$"\x6f\x72\x64\x65\x72\x3a\x20\x31"
-DATA ·d+160808(SB)/8,$"\x70\x78\x20\x73\x6f\x6c\x69\x64"
-DATA ·d+160816(SB)/8,$"\x20\x23\x63\x30\x63\x30\x63\x30"
-DATA ·d+160824(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6d\x61"
-DATA ·d+160832(SB)/8,$"\x72\x67\x69\x6e\x3a\x20\x30\x20"
-DATA ·d+160840(SB)/8,$"\x32\x70\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+160848(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+160856(SB)/8,$"\x3a\x20\x30\x2e\x33\x35\x65\x6d"
-DATA ·d+160864(SB)/8,$"\x20\x30\x2e\x36\x32\x35\x65\x6d"
-DATA ·d+160872(SB)/8,$"\x20\x30\x2e\x37\x35\x65\x6d\x3b"
-DATA ·d+160880(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
-DATA ·d+160888(SB)/8,$"\x20\x2a\x20\x31\x2e\x20\x43\x6f"
-DATA ·d+160896(SB)/8,$"\x72\x72\x65\x63\x74\x20\x60\x63"
-DATA ·d+160904(SB)/8,$"\x6f\x6c\x6f\x72\x60\x20\x6e\x6f"
-DATA ·d+160912(SB)/8,$"\x74\x20\x62\x65\x69\x6e\x67\x20"
-DATA ·d+160920(SB)/8,$"\x69\x6e\x68\x65\x72\x69\x74\x65"
-DATA ·d+160928(SB)/8,$"\x64\x20\x69\x6e\x20\x49\x45\x20"
-DATA ·d+160936(SB)/8,$"\x38\x2f\x39\x2f\x31\x30\x2f\x31"
-DATA ·d+160944(SB)/8,$"\x31\x2e\x0a\x20\x2a\x20\x32\x2e"
-DATA ·d+160952(SB)/8,$"\x20\x52\x65\x6d\x6f\x76\x65\x20"
-DATA ·d+160960(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
-DATA ·d+160968(SB)/8,$"\x73\x6f\x20\x70\x65\x6f\x70\x6c"
-DATA ·d+160976(SB)/8,$"\x65\x20\x61\x72\x65\x6e\x27\x74"
-DATA ·d+160984(SB)/8,$"\x20\x63\x61\x75\x67\x68\x74\x20"
-DATA ·d+160992(SB)/8,$"\x6f\x75\x74\x20\x69\x66\x20\x74"
-DATA ·d+161000(SB)/8,$"\x68\x65\x79\x20\x7a\x65\x72\x6f"
-DATA ·d+161008(SB)/8,$"\x20\x6f\x75\x74\x20\x66\x69\x65"
-DATA ·d+161016(SB)/8,$"\x6c\x64\x73\x65\x74\x73\x2e\x0a"
-DATA ·d+161024(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x6c\x65\x67"
-DATA ·d+161032(SB)/8,$"\x65\x6e\x64\x20\x7b\x0a\x20\x20"
-DATA ·d+161040(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+161048(SB)/8,$"\x3a\x20\x30\x3b\x20\x2f\x2a\x20"
-DATA ·d+161056(SB)/8,$"\x31\x20\x2a\x2f\x0a\x20\x20\x20"
-DATA ·d+161064(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+161072(SB)/8,$"\x3a\x20\x30\x3b\x20\x2f\x2a\x20"
-DATA ·d+161080(SB)/8,$"\x32\x20\x2a\x2f\x0a\x7d\x0a\x0a"
-DATA ·d+161088(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x52"
-DATA ·d+161096(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x64\x65"
-DATA ·d+161104(SB)/8,$"\x66\x61\x75\x6c\x74\x20\x76\x65"
-DATA ·d+161112(SB)/8,$"\x72\x74\x69\x63\x61\x6c\x20\x73"
-DATA ·d+161120(SB)/8,$"\x63\x72\x6f\x6c\x6c\x62\x61\x72"
-DATA ·d+161128(SB)/8,$"\x20\x69\x6e\x20\x49\x45\x20\x38"
-DATA ·d+161136(SB)/8,$"\x2f\x39\x2f\x31\x30\x2f\x31\x31"
-DATA ·d+161144(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x74"
-DATA ·d+161152(SB)/8,$"\x65\x78\x74\x61\x72\x65\x61\x20"
-DATA ·d+161160(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x6f\x76"
-DATA ·d+161168(SB)/8,$"\x65\x72\x66\x6c\x6f\x77\x3a\x20"
-DATA ·d+161176(SB)/8,$"\x61\x75\x74\x6f\x3b\x0a\x7d\x0a"
-DATA ·d+161184(SB)/8,$"\x0a\x2f\x2a\x2a\x0a\x20\x2a\x20"
-DATA ·d+161192(SB)/8,$"\x44\x6f\x6e\x27\x74\x20\x69\x6e"
-DATA ·d+161200(SB)/8,$"\x68\x65\x72\x69\x74\x20\x74\x68"
-DATA ·d+161208(SB)/8,$"\x65\x20\x60\x66\x6f\x6e\x74\x2d"
-DATA ·d+161216(SB)/8,$"\x77\x65\x69\x67\x68\x74\x60\x20"
-DATA ·d+161224(SB)/8,$"\x28\x61\x70\x70\x6c\x69\x65\x64"
-DATA ·d+161232(SB)/8,$"\x20\x62\x79\x20\x61\x20\x72\x75"
-DATA ·d+161240(SB)/8,$"\x6c\x65\x20\x61\x62\x6f\x76\x65"
-DATA ·d+161248(SB)/8,$"\x29\x2e\x0a\x20\x2a\x20\x4e\x4f"
-DATA ·d+161256(SB)/8,$"\x54\x45\x3a\x20\x74\x68\x65\x20"
-DATA ·d+161264(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x20"
-DATA ·d+161272(SB)/8,$"\x63\x61\x6e\x6e\x6f\x74\x20\x73"
-DATA ·d+161280(SB)/8,$"\x61\x66\x65\x6c\x79\x20\x62\x65"
-DATA ·d+161288(SB)/8,$"\x20\x63\x68\x61\x6e\x67\x65\x64"
-DATA ·d+161296(SB)/8,$"\x20\x69\x6e\x20\x43\x68\x72\x6f"
-DATA ·d+161304(SB)/8,$"\x6d\x65\x20\x61\x6e\x64\x20\x53"
-DATA ·d+161312(SB)/8,$"\x61\x66\x61\x72\x69\x20\x6f\x6e"
-DATA ·d+161320(SB)/8,$"\x20\x4f\x53\x20\x58\x2e\x0a\x20"
-DATA ·d+161328(SB)/8,$"\x2a\x2f\x0a\x0a\x6f\x70\x74\x67"
-DATA ·d+161336(SB)/8,$"\x72\x6f\x75\x70\x20\x7b\x0a\x20"
-DATA ·d+161344(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
-DATA ·d+161352(SB)/8,$"\x77\x65\x69\x67\x68\x74\x3a\x20"
-DATA ·d+161360(SB)/8,$"\x62\x6f\x6c\x64\x3b\x0a\x7d\x0a"
-DATA ·d+161368(SB)/8,$"\x0a\x2f\x2a\x20\x54\x61\x62\x6c"
-DATA ·d+161376(SB)/8,$"\x65\x73\x0a\x20\x20\x20\x3d\x3d"
-DATA ·d+161384(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161392(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161400(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161408(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161416(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161424(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161432(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161440(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161448(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
-DATA ·d+161456(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x2f\x2a\x2a"
-DATA ·d+161464(SB)/8,$"\x0a\x20\x2a\x20\x52\x65\x6d\x6f"
-DATA ·d+161472(SB)/8,$"\x76\x65\x20\x6d\x6f\x73\x74\x20"
-DATA ·d+161480(SB)/8,$"\x73\x70\x61\x63\x69\x6e\x67\x20"
-DATA ·d+161488(SB)/8,$"\x62\x65\x74\x77\x65\x65\x6e\x20"
-DATA ·d+161496(SB)/8,$"\x74\x61\x62\x6c\x65\x20\x63\x65"
-DATA ·d+161504(SB)/8,$"\x6c\x6c\x73\x2e\x0a\x20\x2a\x2f"
-DATA ·d+161512(SB)/8,$"\x0a\x0a\x74\x61\x62\x6c\x65\x20"
-DATA ·d+161520(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x6f"
-DATA ·d+161528(SB)/8,$"\x72\x64\x65\x72\x2d\x63\x6f\x6c"
-DATA ·d+161536(SB)/8,$"\x6c\x61\x70\x73\x65\x3a\x20\x63"
-DATA ·d+161544(SB)/8,$"\x6f\x6c\x6c\x61\x70\x73\x65\x3b"
-DATA ·d+161552(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
-DATA ·d+161560(SB)/8,$"\x64\x65\x72\x2d\x73\x70\x61\x63"
-DATA ·d+161568(SB)/8,$"\x69\x6e\x67\x3a\x20\x30\x3b\x0a"
-DATA ·d+161576(SB)/8,$"\x7d\x0a\x0a\x74\x64\x2c\x0a\x74"
-DATA ·d+161584(SB)/8,$"\x68\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+161592(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
-DATA ·d+161600(SB)/8,$"\x20\x30\x3b\x0a\x7d\x0a\x00\x00"
-DATA ·d+161608(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161616(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161624(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161632(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161664(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161672(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161680(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161688(SB)/8,$"\x0a\x2f\x2f\x20\x52\x54\x4c\x20"
-DATA ·d+161696(SB)/8,$"\x53\x74\x79\x6c\x65\x73\x20\x56"
-DATA ·d+161704(SB)/8,$"\x61\x72\x69\x61\x62\x6c\x65\x73"
-DATA ·d+161712(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161720(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161728(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161736(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161744(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161752(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161760(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161768(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161776(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161784(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161792(SB)/8,$"\x2f\x0a\x0a\x24\x64\x65\x66\x61"
-DATA ·d+161800(SB)/8,$"\x75\x6c\x74\x3a\x20\x61\x75\x74"
-DATA ·d+161808(SB)/8,$"\x6f\x3b\x0a\x0a\x2f\x2f\x2f\x2f"
-DATA ·d+161816(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161824(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161832(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161840(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161848(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161856(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161864(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161872(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161880(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161888(SB)/8,$"\x2f\x2f\x2f\x2f\x0a\x2f\x2f\x20"
-DATA ·d+161896(SB)/8,$"\x54\x41\x42\x4c\x45\x20\x4f\x46"
-DATA ·d+161904(SB)/8,$"\x20\x43\x4f\x4e\x54\x45\x4e\x54"
-DATA ·d+161912(SB)/8,$"\x53\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161920(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161928(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161936(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161944(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161952(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161960(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161968(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161976(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161984(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+161992(SB)/8,$"\x2f\x2f\x0a\x0a\x23\x74\x6f\x63"
-DATA ·d+162000(SB)/8,$"\x3e\x75\x6c\x3e\x6c\x69\x3e\x61"
-DATA ·d+162008(SB)/8,$"\x3e\x73\x70\x61\x6e\x20\x7b\x0a"
-DATA ·d+162016(SB)/8,$"\x20\x20\x20\x20\x66\x6c\x6f\x61"
-DATA ·d+162024(SB)/8,$"\x74\x3a\x20\x6c\x65\x66\x74\x3b"
-DATA ·d+162032(SB)/8,$"\x0a\x7d\x0a\x0a\x2e\x74\x6f\x63"
-DATA ·d+162040(SB)/8,$"\x2d\x77\x72\x61\x70\x70\x65\x72"
-DATA ·d+162048(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x74"
-DATA ·d+162056(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
-DATA ·d+162064(SB)/8,$"\x6e\x3a\x20\x72\x69\x67\x68\x74"
-DATA ·d+162072(SB)/8,$"\x20\x30\x2e\x33\x73\x20\x65\x61"
-DATA ·d+162080(SB)/8,$"\x73\x65\x2d\x69\x6e\x2d\x6f\x75"
-DATA ·d+162088(SB)/8,$"\x74\x20\x21\x69\x6d\x70\x6f\x72"
-DATA ·d+162096(SB)/8,$"\x74\x61\x6e\x74\x3b\x0a\x20\x20"
-DATA ·d+162104(SB)/8,$"\x20\x20\x6c\x65\x66\x74\x3a\x20"
-DATA ·d+162112(SB)/8,$"\x24\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+162120(SB)/8,$"\x20\x21\x69\x6d\x70\x6f\x72\x74"
-DATA ·d+162128(SB)/8,$"\x61\x6e\x74\x3b\x0a\x20\x20\x20"
-DATA ·d+162136(SB)/8,$"\x20\x23\x7b\x72\x69\x67\x68\x74"
-DATA ·d+162144(SB)/8,$"\x7d\x3a\x20\x30\x3b\x0a\x7d\x0a"
-DATA ·d+162152(SB)/8,$"\x0a\x2e\x74\x6f\x63\x2d\x68\x32"
-DATA ·d+162160(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x70"
-DATA ·d+162168(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x2d\x23"
-DATA ·d+162176(SB)/8,$"\x7b\x72\x69\x67\x68\x74\x7d\x3a"
-DATA ·d+162184(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x70\x61"
-DATA ·d+162192(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x2b\x20"
-DATA ·d+162200(SB)/8,$"\x24\x6e\x61\x76\x2d\x69\x6e\x64"
-DATA ·d+162208(SB)/8,$"\x65\x6e\x74\x3b\x0a\x7d\x0a\x0a"
-DATA ·d+162216(SB)/8,$"\x23\x6e\x61\x76\x2d\x62\x75\x74"
-DATA ·d+162224(SB)/8,$"\x74\x6f\x6e\x20\x7b\x0a\x20\x20"
-DATA ·d+162232(SB)/8,$"\x20\x20\x23\x7b\x72\x69\x67\x68"
-DATA ·d+162240(SB)/8,$"\x74\x7d\x3a\x20\x30\x3b\x0a\x20"
-DATA ·d+162248(SB)/8,$"\x20\x20\x20\x74\x72\x61\x6e\x73"
-DATA ·d+162256(SB)/8,$"\x69\x74\x69\x6f\x6e\x3a\x20\x72"
-DATA ·d+162264(SB)/8,$"\x69\x67\x68\x74\x20\x30\x2e\x33"
-DATA ·d+162272(SB)/8,$"\x73\x20\x65\x61\x73\x65\x2d\x69"
-DATA ·d+162280(SB)/8,$"\x6e\x2d\x6f\x75\x74\x3b\x0a\x20"
-DATA ·d+162288(SB)/8,$"\x20\x20\x20\x26\x2e\x6f\x70\x65"
-DATA ·d+162296(SB)/8,$"\x6e\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+162304(SB)/8,$"\x20\x20\x20\x20\x72\x69\x67\x68"
-DATA ·d+162312(SB)/8,$"\x74\x3a\x20\x24\x6e\x61\x76\x2d"
-DATA ·d+162320(SB)/8,$"\x77\x69\x64\x74\x68\x0a\x20\x20"
-DATA ·d+162328(SB)/8,$"\x20\x20\x7d\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+162336(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162344(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162352(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162360(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162368(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162376(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162384(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162392(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162400(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162408(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
-DATA ·d+162416(SB)/8,$"\x2f\x2f\x20\x50\x41\x47\x45\x20"
-DATA ·d+162424(SB)/8,$"\x4c\x41\x59\x4f\x55\x54\x20\x41"
-DATA ·d+162432(SB)/8,$"\x4e\x44\x20\x43\x4f\x44\x45\x20"
-DATA ·d+162440(SB)/8,$"\x53\x41\x4d\x50\x4c\x45\x20\x42"
-DATA ·d+162448(SB)/8,$"\x41\x43\x4b\x47\x52\x4f\x55\x4e"
-DATA ·d+162456(SB)/8,$"\x44\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162464(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162472(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162480(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162488(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162504(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162536(SB)/8,$"\x2f\x2f\x0a\x2e\x70\x61\x67\x65"
-DATA ·d+162544(SB)/8,$"\x2d\x77\x72\x61\x70\x70\x65\x72"
-DATA ·d+162552(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6d"
-DATA ·d+162560(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x23\x7b"
-DATA ·d+162568(SB)/8,$"\x6c\x65\x66\x74\x7d\x3a\x20\x24"
-DATA ·d+162576(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x20"
-DATA ·d+162584(SB)/8,$"\x21\x69\x6d\x70\x6f\x72\x74\x61"
-DATA ·d+162592(SB)/8,$"\x6e\x74\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+162600(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x23"
-DATA ·d+162608(SB)/8,$"\x7b\x72\x69\x67\x68\x74\x7d\x3a"
-DATA ·d+162616(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x77\x69"
-DATA ·d+162624(SB)/8,$"\x64\x74\x68\x3b\x0a\x20\x20\x20"
-DATA ·d+162632(SB)/8,$"\x20\x2e\x64\x61\x72\x6b\x2d\x62"
-DATA ·d+162640(SB)/8,$"\x6f\x78\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+162648(SB)/8,$"\x20\x20\x20\x20\x20\x23\x7b\x72"
-DATA ·d+162656(SB)/8,$"\x69\x67\x68\x74\x7d\x3a\x20\x24"
-DATA ·d+162664(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
-DATA ·d+162672(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+162680(SB)/8,$"\x20\x23\x7b\x6c\x65\x66\x74\x7d"
-DATA ·d+162688(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x20"
-DATA ·d+162696(SB)/8,$"\x20\x7d\x0a\x7d\x0a\x0a\x2e\x6c"
-DATA ·d+162704(SB)/8,$"\x61\x6e\x67\x2d\x73\x65\x6c\x65"
-DATA ·d+162712(SB)/8,$"\x63\x74\x6f\x72\x20\x7b\x0a\x20"
-DATA ·d+162720(SB)/8,$"\x20\x20\x20\x77\x69\x64\x74\x68"
-DATA ·d+162728(SB)/8,$"\x3a\x20\x24\x64\x65\x66\x61\x75"
-DATA ·d+162736(SB)/8,$"\x6c\x74\x20\x21\x69\x6d\x70\x6f"
-DATA ·d+162744(SB)/8,$"\x72\x74\x61\x6e\x74\x3b\x0a\x20"
-DATA ·d+162752(SB)/8,$"\x20\x20\x20\x61\x20\x7b\x0a\x20"
-DATA ·d+162760(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x66"
-DATA ·d+162768(SB)/8,$"\x6c\x6f\x61\x74\x3a\x20\x72\x69"
-DATA ·d+162776(SB)/8,$"\x67\x68\x74\x3b\x0a\x20\x20\x20"
-DATA ·d+162784(SB)/8,$"\x20\x7d\x0a\x7d\x0a\x0a\x2f\x2f"
-DATA ·d+162792(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162800(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162808(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162816(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162824(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162832(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162840(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162848(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162856(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162864(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x2f"
-DATA ·d+162872(SB)/8,$"\x2f\x20\x43\x4f\x44\x45\x20\x53"
-DATA ·d+162880(SB)/8,$"\x41\x4d\x50\x4c\x45\x20\x53\x54"
-DATA ·d+162888(SB)/8,$"\x59\x4c\x45\x53\x0a\x2f\x2f\x2f"
-DATA ·d+162896(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162904(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162912(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162920(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162928(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162936(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162944(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162952(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162960(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+162968(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x0a\x2e\x63"
-DATA ·d+162976(SB)/8,$"\x6f\x6e\x74\x65\x6e\x74\x20\x7b"
-DATA ·d+162984(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x3e\x68"
-DATA ·d+162992(SB)/8,$"\x31\x2c\x0a\x20\x20\x20\x20\x26"
-DATA ·d+163000(SB)/8,$"\x3e\x68\x32\x2c\x0a\x20\x20\x20"
-DATA ·d+163008(SB)/8,$"\x20\x26\x3e\x68\x33\x2c\x0a\x20"
-DATA ·d+163016(SB)/8,$"\x20\x20\x20\x26\x3e\x68\x34\x2c"
-DATA ·d+163024(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x3e\x68"
-DATA ·d+163032(SB)/8,$"\x35\x2c\x0a\x20\x20\x20\x20\x26"
-DATA ·d+163040(SB)/8,$"\x3e\x68\x36\x2c\x0a\x20\x20\x20"
-DATA ·d+163048(SB)/8,$"\x20\x26\x3e\x70\x2c\x0a\x20\x20"
-DATA ·d+163056(SB)/8,$"\x20\x20\x26\x3e\x74\x61\x62\x6c"
-DATA ·d+163064(SB)/8,$"\x65\x2c\x0a\x20\x20\x20\x20\x26"
-DATA ·d+163072(SB)/8,$"\x3e\x75\x6c\x2c\x0a\x20\x20\x20"
-DATA ·d+163080(SB)/8,$"\x20\x26\x3e\x6f\x6c\x2c\x0a\x20"
-DATA ·d+163088(SB)/8,$"\x20\x20\x20\x26\x3e\x61\x73\x69"
-DATA ·d+163096(SB)/8,$"\x64\x65\x2c\x0a\x20\x20\x20\x20"
-DATA ·d+163104(SB)/8,$"\x26\x3e\x64\x6c\x20\x7b\x0a\x20"
-DATA ·d+163112(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x6d"
-DATA ·d+163120(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x23\x7b"
-DATA ·d+163128(SB)/8,$"\x6c\x65\x66\x74\x7d\x3a\x20\x24"
-DATA ·d+163136(SB)/8,$"\x65\x78\x61\x6d\x70\x6c\x65\x73"
-DATA ·d+163144(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3b\x0a"
-DATA ·d+163152(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163160(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x23"
-DATA ·d+163168(SB)/8,$"\x7b\x72\x69\x67\x68\x74\x7d\x3a"
-DATA ·d+163176(SB)/8,$"\x20\x24\x64\x65\x66\x61\x75\x6c"
-DATA ·d+163184(SB)/8,$"\x74\x20\x21\x69\x6d\x70\x6f\x72"
-DATA ·d+163192(SB)/8,$"\x74\x61\x6e\x74\x3b\x0a\x20\x20"
-DATA ·d+163200(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x20\x20"
-DATA ·d+163208(SB)/8,$"\x26\x3e\x75\x6c\x2c\x0a\x20\x20"
-DATA ·d+163216(SB)/8,$"\x20\x20\x26\x3e\x6f\x6c\x20\x7b"
-DATA ·d+163224(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163232(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+163240(SB)/8,$"\x2d\x23\x7b\x72\x69\x67\x68\x74"
-DATA ·d+163248(SB)/8,$"\x7d\x3a\x20\x24\x6d\x61\x69\x6e"
-DATA ·d+163256(SB)/8,$"\x2d\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+163264(SB)/8,$"\x20\x2b\x20\x31\x35\x70\x78\x3b"
-DATA ·d+163272(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x20"
-DATA ·d+163280(SB)/8,$"\x20\x20\x20\x74\x61\x62\x6c\x65"
-DATA ·d+163288(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+163296(SB)/8,$"\x20\x20\x20\x74\x68\x2c\x0a\x20"
-DATA ·d+163304(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x74"
-DATA ·d+163312(SB)/8,$"\x64\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+163320(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163328(SB)/8,$"\x74\x65\x78\x74\x2d\x61\x6c\x69"
-DATA ·d+163336(SB)/8,$"\x67\x6e\x3a\x20\x72\x69\x67\x68"
-DATA ·d+163344(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+163352(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x20"
-DATA ·d+163360(SB)/8,$"\x20\x7d\x0a\x20\x20\x20\x20\x64"
-DATA ·d+163368(SB)/8,$"\x64\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+163376(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+163384(SB)/8,$"\x69\x6e\x2d\x23\x7b\x72\x69\x67"
-DATA ·d+163392(SB)/8,$"\x68\x74\x7d\x3a\x20\x31\x35\x70"
-DATA ·d+163400(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x7d"
-DATA ·d+163408(SB)/8,$"\x0a\x20\x20\x20\x20\x61\x73\x69"
-DATA ·d+163416(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+163424(SB)/8,$"\x20\x20\x20\x20\x20\x61\x73\x69"
-DATA ·d+163432(SB)/8,$"\x64\x65\x3a\x62\x65\x66\x6f\x72"
-DATA ·d+163440(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+163448(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163456(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x2d"
-DATA ·d+163464(SB)/8,$"\x23\x7b\x6c\x65\x66\x74\x7d\x3a"
-DATA ·d+163472(SB)/8,$"\x20\x30\x2e\x35\x65\x6d\x3b\x0a"
-DATA ·d+163480(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163488(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+163496(SB)/8,$"\x20\x20\x2e\x73\x65\x61\x72\x63"
-DATA ·d+163504(SB)/8,$"\x68\x2d\x68\x69\x67\x68\x6c\x69"
-DATA ·d+163512(SB)/8,$"\x67\x68\x74\x20\x7b\x0a\x20\x20"
-DATA ·d+163520(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163528(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
-DATA ·d+163536(SB)/8,$"\x6f\x75\x6e\x64\x3a\x20\x6c\x69"
-DATA ·d+163544(SB)/8,$"\x6e\x65\x61\x72\x2d\x67\x72\x61"
-DATA ·d+163552(SB)/8,$"\x64\x69\x65\x6e\x74\x28\x74\x6f"
-DATA ·d+163560(SB)/8,$"\x20\x74\x6f\x70\x20\x72\x69\x67"
-DATA ·d+163568(SB)/8,$"\x68\x74\x2c\x20\x23\x46\x37\x45"
-DATA ·d+163576(SB)/8,$"\x36\x33\x33\x20\x30\x25\x2c\x20"
-DATA ·d+163584(SB)/8,$"\x23\x46\x31\x44\x33\x32\x46\x20"
-DATA ·d+163592(SB)/8,$"\x31\x30\x30\x25\x29\x3b\x0a\x20"
-DATA ·d+163600(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x7d"
-DATA ·d+163608(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x20"
-DATA ·d+163616(SB)/8,$"\x20\x20\x20\x70\x72\x65\x2c\x0a"
-DATA ·d+163624(SB)/8,$"\x20\x20\x20\x20\x62\x6c\x6f\x63"
-DATA ·d+163632(SB)/8,$"\x6b\x71\x75\x6f\x74\x65\x20\x7b"
-DATA ·d+163640(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163648(SB)/8,$"\x20\x66\x6c\x6f\x61\x74\x3a\x20"
-DATA ·d+163656(SB)/8,$"\x6c\x65\x66\x74\x20\x21\x69\x6d"
-DATA ·d+163664(SB)/8,$"\x70\x6f\x72\x74\x61\x6e\x74\x3b"
-DATA ·d+163672(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+163680(SB)/8,$"\x20\x63\x6c\x65\x61\x72\x3a\x20"
-DATA ·d+163688(SB)/8,$"\x6c\x65\x66\x74\x20\x21\x69\x6d"
-DATA ·d+163696(SB)/8,$"\x70\x6f\x72\x74\x61\x6e\x74\x3b"
-DATA ·d+163704(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x7d"
-DATA ·d+163712(SB)/8,$"\x0a\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163720(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163728(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163736(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163744(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163752(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+156664(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+156672(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+156680(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x20\x2a\x2f"
+DATA ·d+156688(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
+DATA ·d+156696(SB)/8,$"\x20\x52\x65\x6d\x6f\x76\x65\x20"
+DATA ·d+156704(SB)/8,$"\x74\x68\x65\x20\x67\x72\x61\x79"
+DATA ·d+156712(SB)/8,$"\x20\x62\x61\x63\x6b\x67\x72\x6f"
+DATA ·d+156720(SB)/8,$"\x75\x6e\x64\x20\x63\x6f\x6c\x6f"
+DATA ·d+156728(SB)/8,$"\x72\x20\x66\x72\x6f\x6d\x20\x61"
+DATA ·d+156736(SB)/8,$"\x63\x74\x69\x76\x65\x20\x6c\x69"
+DATA ·d+156744(SB)/8,$"\x6e\x6b\x73\x20\x69\x6e\x20\x49"
+DATA ·d+156752(SB)/8,$"\x45\x20\x31\x30\x2e\x0a\x20\x2a"
+DATA ·d+156760(SB)/8,$"\x2f\x0a\x0a\x61\x20\x7b\x0a\x20"
+DATA ·d+156768(SB)/8,$"\x20\x20\x20\x62\x61\x63\x6b\x67"
+DATA ·d+156776(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
+DATA ·d+156784(SB)/8,$"\x6c\x6f\x72\x3a\x20\x74\x72\x61"
+DATA ·d+156792(SB)/8,$"\x6e\x73\x70\x61\x72\x65\x6e\x74"
+DATA ·d+156800(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+156808(SB)/8,$"\x0a\x20\x2a\x20\x49\x6d\x70\x72"
+DATA ·d+156816(SB)/8,$"\x6f\x76\x65\x20\x72\x65\x61\x64"
+DATA ·d+156824(SB)/8,$"\x61\x62\x69\x6c\x69\x74\x79\x20"
+DATA ·d+156832(SB)/8,$"\x77\x68\x65\x6e\x20\x66\x6f\x63"
+DATA ·d+156840(SB)/8,$"\x75\x73\x65\x64\x20\x61\x6e\x64"
+DATA ·d+156848(SB)/8,$"\x20\x61\x6c\x73\x6f\x20\x6d\x6f"
+DATA ·d+156856(SB)/8,$"\x75\x73\x65\x20\x68\x6f\x76\x65"
+DATA ·d+156864(SB)/8,$"\x72\x65\x64\x20\x69\x6e\x20\x61"
+DATA ·d+156872(SB)/8,$"\x6c\x6c\x20\x62\x72\x6f\x77\x73"
+DATA ·d+156880(SB)/8,$"\x65\x72\x73\x2e\x0a\x20\x2a\x2f"
+DATA ·d+156888(SB)/8,$"\x0a\x0a\x61\x3a\x61\x63\x74\x69"
+DATA ·d+156896(SB)/8,$"\x76\x65\x2c\x0a\x61\x3a\x68\x6f"
+DATA ·d+156904(SB)/8,$"\x76\x65\x72\x20\x7b\x0a\x20\x20"
+DATA ·d+156912(SB)/8,$"\x20\x20\x6f\x75\x74\x6c\x69\x6e"
+DATA ·d+156920(SB)/8,$"\x65\x3a\x20\x30\x3b\x0a\x7d\x0a"
+DATA ·d+156928(SB)/8,$"\x0a\x2f\x2a\x20\x54\x65\x78\x74"
+DATA ·d+156936(SB)/8,$"\x2d\x6c\x65\x76\x65\x6c\x20\x73"
+DATA ·d+156944(SB)/8,$"\x65\x6d\x61\x6e\x74\x69\x63\x73"
+DATA ·d+156952(SB)/8,$"\x0a\x20\x20\x20\x3d\x3d\x3d\x3d"
+DATA ·d+156960(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+156968(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+156976(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+156984(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+156992(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+157000(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+157008(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+157016(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+157024(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x20\x2a"
+DATA ·d+157032(SB)/8,$"\x2f\x0a\x0a\x2f\x2a\x2a\x0a\x20"
+DATA ·d+157040(SB)/8,$"\x2a\x20\x41\x64\x64\x72\x65\x73"
+DATA ·d+157048(SB)/8,$"\x73\x20\x73\x74\x79\x6c\x69\x6e"
+DATA ·d+157056(SB)/8,$"\x67\x20\x6e\x6f\x74\x20\x70\x72"
+DATA ·d+157064(SB)/8,$"\x65\x73\x65\x6e\x74\x20\x69\x6e"
+DATA ·d+157072(SB)/8,$"\x20\x49\x45\x20\x38\x2f\x39\x2f"
+DATA ·d+157080(SB)/8,$"\x31\x30\x2f\x31\x31\x2c\x20\x53"
+DATA ·d+157088(SB)/8,$"\x61\x66\x61\x72\x69\x2c\x20\x61"
+DATA ·d+157096(SB)/8,$"\x6e\x64\x20\x43\x68\x72\x6f\x6d"
+DATA ·d+157104(SB)/8,$"\x65\x2e\x0a\x20\x2a\x2f\x0a\x0a"
+DATA ·d+157112(SB)/8,$"\x61\x62\x62\x72\x5b\x74\x69\x74"
+DATA ·d+157120(SB)/8,$"\x6c\x65\x5d\x20\x7b\x0a\x20\x20"
+DATA ·d+157128(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+157136(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
+DATA ·d+157144(SB)/8,$"\x20\x31\x70\x78\x20\x64\x6f\x74"
+DATA ·d+157152(SB)/8,$"\x74\x65\x64\x3b\x0a\x7d\x0a\x0a"
+DATA ·d+157160(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x41"
+DATA ·d+157168(SB)/8,$"\x64\x64\x72\x65\x73\x73\x20\x73"
+DATA ·d+157176(SB)/8,$"\x74\x79\x6c\x65\x20\x73\x65\x74"
+DATA ·d+157184(SB)/8,$"\x20\x74\x6f\x20\x60\x62\x6f\x6c"
+DATA ·d+157192(SB)/8,$"\x64\x65\x72\x60\x20\x69\x6e\x20"
+DATA ·d+157200(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x20"
+DATA ·d+157208(SB)/8,$"\x34\x2b\x2c\x20\x53\x61\x66\x61"
+DATA ·d+157216(SB)/8,$"\x72\x69\x2c\x20\x61\x6e\x64\x20"
+DATA ·d+157224(SB)/8,$"\x43\x68\x72\x6f\x6d\x65\x2e\x0a"
+DATA ·d+157232(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x2c\x0a"
+DATA ·d+157240(SB)/8,$"\x73\x74\x72\x6f\x6e\x67\x20\x7b"
+DATA ·d+157248(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
+DATA ·d+157256(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
+DATA ·d+157264(SB)/8,$"\x3a\x20\x62\x6f\x6c\x64\x3b\x0a"
+DATA ·d+157272(SB)/8,$"\x7d\x0a\x0a\x2f\x2a\x2a\x0a\x20"
+DATA ·d+157280(SB)/8,$"\x2a\x20\x41\x64\x64\x72\x65\x73"
+DATA ·d+157288(SB)/8,$"\x73\x20\x73\x74\x79\x6c\x69\x6e"
+DATA ·d+157296(SB)/8,$"\x67\x20\x6e\x6f\x74\x20\x70\x72"
+DATA ·d+157304(SB)/8,$"\x65\x73\x65\x6e\x74\x20\x69\x6e"
+DATA ·d+157312(SB)/8,$"\x20\x53\x61\x66\x61\x72\x69\x20"
+DATA ·d+157320(SB)/8,$"\x61\x6e\x64\x20\x43\x68\x72\x6f"
+DATA ·d+157328(SB)/8,$"\x6d\x65\x2e\x0a\x20\x2a\x2f\x0a"
+DATA ·d+157336(SB)/8,$"\x0a\x64\x66\x6e\x20\x7b\x0a\x20"
+DATA ·d+157344(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
+DATA ·d+157352(SB)/8,$"\x73\x74\x79\x6c\x65\x3a\x20\x69"
+DATA ·d+157360(SB)/8,$"\x74\x61\x6c\x69\x63\x3b\x0a\x7d"
+DATA ·d+157368(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
+DATA ·d+157376(SB)/8,$"\x20\x41\x64\x64\x72\x65\x73\x73"
+DATA ·d+157384(SB)/8,$"\x20\x76\x61\x72\x69\x61\x62\x6c"
+DATA ·d+157392(SB)/8,$"\x65\x20\x60\x68\x31\x60\x20\x66"
+DATA ·d+157400(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
+DATA ·d+157408(SB)/8,$"\x20\x61\x6e\x64\x20\x6d\x61\x72"
+DATA ·d+157416(SB)/8,$"\x67\x69\x6e\x20\x77\x69\x74\x68"
+DATA ·d+157424(SB)/8,$"\x69\x6e\x20\x60\x73\x65\x63\x74"
+DATA ·d+157432(SB)/8,$"\x69\x6f\x6e\x60\x20\x61\x6e\x64"
+DATA ·d+157440(SB)/8,$"\x20\x60\x61\x72\x74\x69\x63\x6c"
+DATA ·d+157448(SB)/8,$"\x65\x60\x0a\x20\x2a\x20\x63\x6f"
+DATA ·d+157456(SB)/8,$"\x6e\x74\x65\x78\x74\x73\x20\x69"
+DATA ·d+157464(SB)/8,$"\x6e\x20\x46\x69\x72\x65\x66\x6f"
+DATA ·d+157472(SB)/8,$"\x78\x20\x34\x2b\x2c\x20\x53\x61"
+DATA ·d+157480(SB)/8,$"\x66\x61\x72\x69\x2c\x20\x61\x6e"
+DATA ·d+157488(SB)/8,$"\x64\x20\x43\x68\x72\x6f\x6d\x65"
+DATA ·d+157496(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x68"
+DATA ·d+157504(SB)/8,$"\x31\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+157512(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
+DATA ·d+157520(SB)/8,$"\x65\x3a\x20\x32\x65\x6d\x3b\x0a"
+DATA ·d+157528(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+157536(SB)/8,$"\x69\x6e\x3a\x20\x30\x2e\x36\x37"
+DATA ·d+157544(SB)/8,$"\x65\x6d\x20\x30\x3b\x0a\x7d\x0a"
+DATA ·d+157552(SB)/8,$"\x0a\x2f\x2a\x2a\x0a\x20\x2a\x20"
+DATA ·d+157560(SB)/8,$"\x41\x64\x64\x72\x65\x73\x73\x20"
+DATA ·d+157568(SB)/8,$"\x73\x74\x79\x6c\x69\x6e\x67\x20"
+DATA ·d+157576(SB)/8,$"\x6e\x6f\x74\x20\x70\x72\x65\x73"
+DATA ·d+157584(SB)/8,$"\x65\x6e\x74\x20\x69\x6e\x20\x49"
+DATA ·d+157592(SB)/8,$"\x45\x20\x38\x2f\x39\x2e\x0a\x20"
+DATA ·d+157600(SB)/8,$"\x2a\x2f\x0a\x0a\x6d\x61\x72\x6b"
+DATA ·d+157608(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x62"
+DATA ·d+157616(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
+DATA ·d+157624(SB)/8,$"\x64\x3a\x20\x23\x66\x66\x30\x3b"
+DATA ·d+157632(SB)/8,$"\x0a\x20\x20\x20\x20\x63\x6f\x6c"
+DATA ·d+157640(SB)/8,$"\x6f\x72\x3a\x20\x23\x30\x30\x30"
+DATA ·d+157648(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+157656(SB)/8,$"\x0a\x20\x2a\x20\x41\x64\x64\x72"
+DATA ·d+157664(SB)/8,$"\x65\x73\x73\x20\x69\x6e\x63\x6f"
+DATA ·d+157672(SB)/8,$"\x6e\x73\x69\x73\x74\x65\x6e\x74"
+DATA ·d+157680(SB)/8,$"\x20\x61\x6e\x64\x20\x76\x61\x72"
+DATA ·d+157688(SB)/8,$"\x69\x61\x62\x6c\x65\x20\x66\x6f"
+DATA ·d+157696(SB)/8,$"\x6e\x74\x20\x73\x69\x7a\x65\x20"
+DATA ·d+157704(SB)/8,$"\x69\x6e\x20\x61\x6c\x6c\x20\x62"
+DATA ·d+157712(SB)/8,$"\x72\x6f\x77\x73\x65\x72\x73\x2e"
+DATA ·d+157720(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x73\x6d"
+DATA ·d+157728(SB)/8,$"\x61\x6c\x6c\x20\x7b\x0a\x20\x20"
+DATA ·d+157736(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x73"
+DATA ·d+157744(SB)/8,$"\x69\x7a\x65\x3a\x20\x38\x30\x25"
+DATA ·d+157752(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+157760(SB)/8,$"\x0a\x20\x2a\x20\x50\x72\x65\x76"
+DATA ·d+157768(SB)/8,$"\x65\x6e\x74\x20\x60\x73\x75\x62"
+DATA ·d+157776(SB)/8,$"\x60\x20\x61\x6e\x64\x20\x60\x73"
+DATA ·d+157784(SB)/8,$"\x75\x70\x60\x20\x61\x66\x66\x65"
+DATA ·d+157792(SB)/8,$"\x63\x74\x69\x6e\x67\x20\x60\x6c"
+DATA ·d+157800(SB)/8,$"\x69\x6e\x65\x2d\x68\x65\x69\x67"
+DATA ·d+157808(SB)/8,$"\x68\x74\x60\x20\x69\x6e\x20\x61"
+DATA ·d+157816(SB)/8,$"\x6c\x6c\x20\x62\x72\x6f\x77\x73"
+DATA ·d+157824(SB)/8,$"\x65\x72\x73\x2e\x0a\x20\x2a\x2f"
+DATA ·d+157832(SB)/8,$"\x0a\x0a\x73\x75\x62\x2c\x0a\x73"
+DATA ·d+157840(SB)/8,$"\x75\x70\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+157848(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x73\x69"
+DATA ·d+157856(SB)/8,$"\x7a\x65\x3a\x20\x37\x35\x25\x3b"
+DATA ·d+157864(SB)/8,$"\x0a\x20\x20\x20\x20\x6c\x69\x6e"
+DATA ·d+157872(SB)/8,$"\x65\x2d\x68\x65\x69\x67\x68\x74"
+DATA ·d+157880(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x20"
+DATA ·d+157888(SB)/8,$"\x20\x70\x6f\x73\x69\x74\x69\x6f"
+DATA ·d+157896(SB)/8,$"\x6e\x3a\x20\x72\x65\x6c\x61\x74"
+DATA ·d+157904(SB)/8,$"\x69\x76\x65\x3b\x0a\x20\x20\x20"
+DATA ·d+157912(SB)/8,$"\x20\x76\x65\x72\x74\x69\x63\x61"
+DATA ·d+157920(SB)/8,$"\x6c\x2d\x61\x6c\x69\x67\x6e\x3a"
+DATA ·d+157928(SB)/8,$"\x20\x62\x61\x73\x65\x6c\x69\x6e"
+DATA ·d+157936(SB)/8,$"\x65\x3b\x0a\x7d\x0a\x0a\x73\x75"
+DATA ·d+157944(SB)/8,$"\x70\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+157952(SB)/8,$"\x74\x6f\x70\x3a\x20\x2d\x30\x2e"
+DATA ·d+157960(SB)/8,$"\x35\x65\x6d\x3b\x0a\x7d\x0a\x0a"
+DATA ·d+157968(SB)/8,$"\x73\x75\x62\x20\x7b\x0a\x20\x20"
+DATA ·d+157976(SB)/8,$"\x20\x20\x62\x6f\x74\x74\x6f\x6d"
+DATA ·d+157984(SB)/8,$"\x3a\x20\x2d\x30\x2e\x32\x35\x65"
+DATA ·d+157992(SB)/8,$"\x6d\x3b\x0a\x7d\x0a\x0a\x2f\x2a"
+DATA ·d+158000(SB)/8,$"\x20\x45\x6d\x62\x65\x64\x64\x65"
+DATA ·d+158008(SB)/8,$"\x64\x20\x63\x6f\x6e\x74\x65\x6e"
+DATA ·d+158016(SB)/8,$"\x74\x0a\x20\x20\x20\x3d\x3d\x3d"
+DATA ·d+158024(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158032(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158040(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158048(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158056(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158064(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158072(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158080(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158088(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x20"
+DATA ·d+158096(SB)/8,$"\x2a\x2f\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+158104(SB)/8,$"\x20\x2a\x20\x52\x65\x6d\x6f\x76"
+DATA ·d+158112(SB)/8,$"\x65\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+158120(SB)/8,$"\x20\x77\x68\x65\x6e\x20\x69\x6e"
+DATA ·d+158128(SB)/8,$"\x73\x69\x64\x65\x20\x60\x61\x60"
+DATA ·d+158136(SB)/8,$"\x20\x65\x6c\x65\x6d\x65\x6e\x74"
+DATA ·d+158144(SB)/8,$"\x20\x69\x6e\x20\x49\x45\x20\x38"
+DATA ·d+158152(SB)/8,$"\x2f\x39\x2f\x31\x30\x2e\x0a\x20"
+DATA ·d+158160(SB)/8,$"\x2a\x2f\x0a\x0a\x69\x6d\x67\x20"
+DATA ·d+158168(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x6f"
+DATA ·d+158176(SB)/8,$"\x72\x64\x65\x72\x3a\x20\x30\x3b"
+DATA ·d+158184(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+158192(SB)/8,$"\x20\x2a\x20\x43\x6f\x72\x72\x65"
+DATA ·d+158200(SB)/8,$"\x63\x74\x20\x6f\x76\x65\x72\x66"
+DATA ·d+158208(SB)/8,$"\x6c\x6f\x77\x20\x6e\x6f\x74\x20"
+DATA ·d+158216(SB)/8,$"\x68\x69\x64\x64\x65\x6e\x20\x69"
+DATA ·d+158224(SB)/8,$"\x6e\x20\x49\x45\x20\x39\x2f\x31"
+DATA ·d+158232(SB)/8,$"\x30\x2f\x31\x31\x2e\x0a\x20\x2a"
+DATA ·d+158240(SB)/8,$"\x2f\x0a\x0a\x73\x76\x67\x3a\x6e"
+DATA ·d+158248(SB)/8,$"\x6f\x74\x28\x3a\x72\x6f\x6f\x74"
+DATA ·d+158256(SB)/8,$"\x29\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+158264(SB)/8,$"\x6f\x76\x65\x72\x66\x6c\x6f\x77"
+DATA ·d+158272(SB)/8,$"\x3a\x20\x68\x69\x64\x64\x65\x6e"
+DATA ·d+158280(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x20"
+DATA ·d+158288(SB)/8,$"\x47\x72\x6f\x75\x70\x69\x6e\x67"
+DATA ·d+158296(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
+DATA ·d+158304(SB)/8,$"\x0a\x20\x20\x20\x3d\x3d\x3d\x3d"
+DATA ·d+158312(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158320(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158328(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158336(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158344(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158352(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158360(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158368(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158376(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x20\x2a"
+DATA ·d+158384(SB)/8,$"\x2f\x0a\x0a\x2f\x2a\x2a\x0a\x20"
+DATA ·d+158392(SB)/8,$"\x2a\x20\x41\x64\x64\x72\x65\x73"
+DATA ·d+158400(SB)/8,$"\x73\x20\x6d\x61\x72\x67\x69\x6e"
+DATA ·d+158408(SB)/8,$"\x20\x6e\x6f\x74\x20\x70\x72\x65"
+DATA ·d+158416(SB)/8,$"\x73\x65\x6e\x74\x20\x69\x6e\x20"
+DATA ·d+158424(SB)/8,$"\x49\x45\x20\x38\x2f\x39\x20\x61"
+DATA ·d+158432(SB)/8,$"\x6e\x64\x20\x53\x61\x66\x61\x72"
+DATA ·d+158440(SB)/8,$"\x69\x2e\x0a\x20\x2a\x2f\x0a\x0a"
+DATA ·d+158448(SB)/8,$"\x66\x69\x67\x75\x72\x65\x20\x7b"
+DATA ·d+158456(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+158464(SB)/8,$"\x67\x69\x6e\x3a\x20\x31\x65\x6d"
+DATA ·d+158472(SB)/8,$"\x20\x34\x30\x70\x78\x3b\x0a\x7d"
+DATA ·d+158480(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
+DATA ·d+158488(SB)/8,$"\x20\x41\x64\x64\x72\x65\x73\x73"
+DATA ·d+158496(SB)/8,$"\x20\x64\x69\x66\x66\x65\x72\x65"
+DATA ·d+158504(SB)/8,$"\x6e\x63\x65\x73\x20\x62\x65\x74"
+DATA ·d+158512(SB)/8,$"\x77\x65\x65\x6e\x20\x46\x69\x72"
+DATA ·d+158520(SB)/8,$"\x65\x66\x6f\x78\x20\x61\x6e\x64"
+DATA ·d+158528(SB)/8,$"\x20\x6f\x74\x68\x65\x72\x20\x62"
+DATA ·d+158536(SB)/8,$"\x72\x6f\x77\x73\x65\x72\x73\x2e"
+DATA ·d+158544(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x68\x72"
+DATA ·d+158552(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x2d"
+DATA ·d+158560(SB)/8,$"\x6d\x6f\x7a\x2d\x62\x6f\x78\x2d"
+DATA ·d+158568(SB)/8,$"\x73\x69\x7a\x69\x6e\x67\x3a\x20"
+DATA ·d+158576(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x2d"
+DATA ·d+158584(SB)/8,$"\x62\x6f\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+158592(SB)/8,$"\x20\x62\x6f\x78\x2d\x73\x69\x7a"
+DATA ·d+158600(SB)/8,$"\x69\x6e\x67\x3a\x20\x63\x6f\x6e"
+DATA ·d+158608(SB)/8,$"\x74\x65\x6e\x74\x2d\x62\x6f\x78"
+DATA ·d+158616(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x68\x65"
+DATA ·d+158624(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x30\x3b"
+DATA ·d+158632(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+158640(SB)/8,$"\x20\x2a\x20\x43\x6f\x6e\x74\x61"
+DATA ·d+158648(SB)/8,$"\x69\x6e\x20\x6f\x76\x65\x72\x66"
+DATA ·d+158656(SB)/8,$"\x6c\x6f\x77\x20\x69\x6e\x20\x61"
+DATA ·d+158664(SB)/8,$"\x6c\x6c\x20\x62\x72\x6f\x77\x73"
+DATA ·d+158672(SB)/8,$"\x65\x72\x73\x2e\x0a\x20\x2a\x2f"
+DATA ·d+158680(SB)/8,$"\x0a\x0a\x70\x72\x65\x20\x7b\x0a"
+DATA ·d+158688(SB)/8,$"\x20\x20\x20\x20\x6f\x76\x65\x72"
+DATA ·d+158696(SB)/8,$"\x66\x6c\x6f\x77\x3a\x20\x61\x75"
+DATA ·d+158704(SB)/8,$"\x74\x6f\x3b\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+158712(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x41\x64"
+DATA ·d+158720(SB)/8,$"\x64\x72\x65\x73\x73\x20\x6f\x64"
+DATA ·d+158728(SB)/8,$"\x64\x20\x60\x65\x6d\x60\x2d\x75"
+DATA ·d+158736(SB)/8,$"\x6e\x69\x74\x20\x66\x6f\x6e\x74"
+DATA ·d+158744(SB)/8,$"\x20\x73\x69\x7a\x65\x20\x72\x65"
+DATA ·d+158752(SB)/8,$"\x6e\x64\x65\x72\x69\x6e\x67\x20"
+DATA ·d+158760(SB)/8,$"\x69\x6e\x20\x61\x6c\x6c\x20\x62"
+DATA ·d+158768(SB)/8,$"\x72\x6f\x77\x73\x65\x72\x73\x2e"
+DATA ·d+158776(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x63\x6f"
+DATA ·d+158784(SB)/8,$"\x64\x65\x2c\x0a\x6b\x62\x64\x2c"
+DATA ·d+158792(SB)/8,$"\x0a\x70\x72\x65\x2c\x0a\x73\x61"
+DATA ·d+158800(SB)/8,$"\x6d\x70\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+158808(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x66\x61"
+DATA ·d+158816(SB)/8,$"\x6d\x69\x6c\x79\x3a\x20\x6d\x6f"
+DATA ·d+158824(SB)/8,$"\x6e\x6f\x73\x70\x61\x63\x65\x2c"
+DATA ·d+158832(SB)/8,$"\x20\x6d\x6f\x6e\x6f\x73\x70\x61"
+DATA ·d+158840(SB)/8,$"\x63\x65\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+158848(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
+DATA ·d+158856(SB)/8,$"\x65\x3a\x20\x31\x65\x6d\x3b\x0a"
+DATA ·d+158864(SB)/8,$"\x7d\x0a\x0a\x2f\x2a\x20\x46\x6f"
+DATA ·d+158872(SB)/8,$"\x72\x6d\x73\x0a\x20\x20\x20\x3d"
+DATA ·d+158880(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158888(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158896(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158904(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158912(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158920(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158928(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158936(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158944(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+158952(SB)/8,$"\x3d\x20\x2a\x2f\x0a\x0a\x2f\x2a"
+DATA ·d+158960(SB)/8,$"\x2a\x0a\x20\x2a\x20\x4b\x6e\x6f"
+DATA ·d+158968(SB)/8,$"\x77\x6e\x20\x6c\x69\x6d\x69\x74"
+DATA ·d+158976(SB)/8,$"\x61\x74\x69\x6f\x6e\x3a\x20\x62"
+DATA ·d+158984(SB)/8,$"\x79\x20\x64\x65\x66\x61\x75\x6c"
+DATA ·d+158992(SB)/8,$"\x74\x2c\x20\x43\x68\x72\x6f\x6d"
+DATA ·d+159000(SB)/8,$"\x65\x20\x61\x6e\x64\x20\x53\x61"
+DATA ·d+159008(SB)/8,$"\x66\x61\x72\x69\x20\x6f\x6e\x20"
+DATA ·d+159016(SB)/8,$"\x4f\x53\x20\x58\x20\x61\x6c\x6c"
+DATA ·d+159024(SB)/8,$"\x6f\x77\x20\x76\x65\x72\x79\x20"
+DATA ·d+159032(SB)/8,$"\x6c\x69\x6d\x69\x74\x65\x64\x0a"
+DATA ·d+159040(SB)/8,$"\x20\x2a\x20\x73\x74\x79\x6c\x69"
+DATA ·d+159048(SB)/8,$"\x6e\x67\x20\x6f\x66\x20\x60\x73"
+DATA ·d+159056(SB)/8,$"\x65\x6c\x65\x63\x74\x60\x2c\x20"
+DATA ·d+159064(SB)/8,$"\x75\x6e\x6c\x65\x73\x73\x20\x61"
+DATA ·d+159072(SB)/8,$"\x20\x60\x62\x6f\x72\x64\x65\x72"
+DATA ·d+159080(SB)/8,$"\x60\x20\x70\x72\x6f\x70\x65\x72"
+DATA ·d+159088(SB)/8,$"\x74\x79\x20\x69\x73\x20\x73\x65"
+DATA ·d+159096(SB)/8,$"\x74\x2e\x0a\x20\x2a\x2f\x0a\x0a"
+DATA ·d+159104(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x31"
+DATA ·d+159112(SB)/8,$"\x2e\x20\x43\x6f\x72\x72\x65\x63"
+DATA ·d+159120(SB)/8,$"\x74\x20\x63\x6f\x6c\x6f\x72\x20"
+DATA ·d+159128(SB)/8,$"\x6e\x6f\x74\x20\x62\x65\x69\x6e"
+DATA ·d+159136(SB)/8,$"\x67\x20\x69\x6e\x68\x65\x72\x69"
+DATA ·d+159144(SB)/8,$"\x74\x65\x64\x2e\x0a\x20\x2a\x20"
+DATA ·d+159152(SB)/8,$"\x20\x20\x20\x4b\x6e\x6f\x77\x6e"
+DATA ·d+159160(SB)/8,$"\x20\x69\x73\x73\x75\x65\x3a\x20"
+DATA ·d+159168(SB)/8,$"\x61\x66\x66\x65\x63\x74\x73\x20"
+DATA ·d+159176(SB)/8,$"\x63\x6f\x6c\x6f\x72\x20\x6f\x66"
+DATA ·d+159184(SB)/8,$"\x20\x64\x69\x73\x61\x62\x6c\x65"
+DATA ·d+159192(SB)/8,$"\x64\x20\x65\x6c\x65\x6d\x65\x6e"
+DATA ·d+159200(SB)/8,$"\x74\x73\x2e\x0a\x20\x2a\x20\x32"
+DATA ·d+159208(SB)/8,$"\x2e\x20\x43\x6f\x72\x72\x65\x63"
+DATA ·d+159216(SB)/8,$"\x74\x20\x66\x6f\x6e\x74\x20\x70"
+DATA ·d+159224(SB)/8,$"\x72\x6f\x70\x65\x72\x74\x69\x65"
+DATA ·d+159232(SB)/8,$"\x73\x20\x6e\x6f\x74\x20\x62\x65"
+DATA ·d+159240(SB)/8,$"\x69\x6e\x67\x20\x69\x6e\x68\x65"
+DATA ·d+159248(SB)/8,$"\x72\x69\x74\x65\x64\x2e\x0a\x20"
+DATA ·d+159256(SB)/8,$"\x2a\x20\x33\x2e\x20\x41\x64\x64"
+DATA ·d+159264(SB)/8,$"\x72\x65\x73\x73\x20\x6d\x61\x72"
+DATA ·d+159272(SB)/8,$"\x67\x69\x6e\x73\x20\x73\x65\x74"
+DATA ·d+159280(SB)/8,$"\x20\x64\x69\x66\x66\x65\x72\x65"
+DATA ·d+159288(SB)/8,$"\x6e\x74\x6c\x79\x20\x69\x6e\x20"
+DATA ·d+159296(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x20"
+DATA ·d+159304(SB)/8,$"\x34\x2b\x2c\x20\x53\x61\x66\x61"
+DATA ·d+159312(SB)/8,$"\x72\x69\x2c\x20\x61\x6e\x64\x20"
+DATA ·d+159320(SB)/8,$"\x43\x68\x72\x6f\x6d\x65\x2e\x0a"
+DATA ·d+159328(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x75\x74"
+DATA ·d+159336(SB)/8,$"\x74\x6f\x6e\x2c\x0a\x69\x6e\x70"
+DATA ·d+159344(SB)/8,$"\x75\x74\x2c\x0a\x6f\x70\x74\x67"
+DATA ·d+159352(SB)/8,$"\x72\x6f\x75\x70\x2c\x0a\x73\x65"
+DATA ·d+159360(SB)/8,$"\x6c\x65\x63\x74\x2c\x0a\x74\x65"
+DATA ·d+159368(SB)/8,$"\x78\x74\x61\x72\x65\x61\x20\x7b"
+DATA ·d+159376(SB)/8,$"\x0a\x20\x20\x20\x20\x63\x6f\x6c"
+DATA ·d+159384(SB)/8,$"\x6f\x72\x3a\x20\x69\x6e\x68\x65"
+DATA ·d+159392(SB)/8,$"\x72\x69\x74\x3b\x20\x2f\x2a\x20"
+DATA ·d+159400(SB)/8,$"\x31\x20\x2a\x2f\x0a\x20\x20\x20"
+DATA ·d+159408(SB)/8,$"\x20\x66\x6f\x6e\x74\x3a\x20\x69"
+DATA ·d+159416(SB)/8,$"\x6e\x68\x65\x72\x69\x74\x3b\x20"
+DATA ·d+159424(SB)/8,$"\x2f\x2a\x20\x32\x20\x2a\x2f\x0a"
+DATA ·d+159432(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+159440(SB)/8,$"\x69\x6e\x3a\x20\x30\x3b\x20\x2f"
+DATA ·d+159448(SB)/8,$"\x2a\x20\x33\x20\x2a\x2f\x0a\x7d"
+DATA ·d+159456(SB)/8,$"\x0a\x0a\x2f\x2a\x2a\x0a\x20\x2a"
+DATA ·d+159464(SB)/8,$"\x20\x41\x64\x64\x72\x65\x73\x73"
+DATA ·d+159472(SB)/8,$"\x20\x60\x6f\x76\x65\x72\x66\x6c"
+DATA ·d+159480(SB)/8,$"\x6f\x77\x60\x20\x73\x65\x74\x20"
+DATA ·d+159488(SB)/8,$"\x74\x6f\x20\x60\x68\x69\x64\x64"
+DATA ·d+159496(SB)/8,$"\x65\x6e\x60\x20\x69\x6e\x20\x49"
+DATA ·d+159504(SB)/8,$"\x45\x20\x38\x2f\x39\x2f\x31\x30"
+DATA ·d+159512(SB)/8,$"\x2f\x31\x31\x2e\x0a\x20\x2a\x2f"
+DATA ·d+159520(SB)/8,$"\x0a\x0a\x62\x75\x74\x74\x6f\x6e"
+DATA ·d+159528(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6f"
+DATA ·d+159536(SB)/8,$"\x76\x65\x72\x66\x6c\x6f\x77\x3a"
+DATA ·d+159544(SB)/8,$"\x20\x76\x69\x73\x69\x62\x6c\x65"
+DATA ·d+159552(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+159560(SB)/8,$"\x0a\x20\x2a\x20\x41\x64\x64\x72"
+DATA ·d+159568(SB)/8,$"\x65\x73\x73\x20\x69\x6e\x63\x6f"
+DATA ·d+159576(SB)/8,$"\x6e\x73\x69\x73\x74\x65\x6e\x74"
+DATA ·d+159584(SB)/8,$"\x20\x60\x74\x65\x78\x74\x2d\x74"
+DATA ·d+159592(SB)/8,$"\x72\x61\x6e\x73\x66\x6f\x72\x6d"
+DATA ·d+159600(SB)/8,$"\x60\x20\x69\x6e\x68\x65\x72\x69"
+DATA ·d+159608(SB)/8,$"\x74\x61\x6e\x63\x65\x20\x66\x6f"
+DATA ·d+159616(SB)/8,$"\x72\x20\x60\x62\x75\x74\x74\x6f"
+DATA ·d+159624(SB)/8,$"\x6e\x60\x20\x61\x6e\x64\x20\x60"
+DATA ·d+159632(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x60\x2e"
+DATA ·d+159640(SB)/8,$"\x0a\x20\x2a\x20\x41\x6c\x6c\x20"
+DATA ·d+159648(SB)/8,$"\x6f\x74\x68\x65\x72\x20\x66\x6f"
+DATA ·d+159656(SB)/8,$"\x72\x6d\x20\x63\x6f\x6e\x74\x72"
+DATA ·d+159664(SB)/8,$"\x6f\x6c\x20\x65\x6c\x65\x6d\x65"
+DATA ·d+159672(SB)/8,$"\x6e\x74\x73\x20\x64\x6f\x20\x6e"
+DATA ·d+159680(SB)/8,$"\x6f\x74\x20\x69\x6e\x68\x65\x72"
+DATA ·d+159688(SB)/8,$"\x69\x74\x20\x60\x74\x65\x78\x74"
+DATA ·d+159696(SB)/8,$"\x2d\x74\x72\x61\x6e\x73\x66\x6f"
+DATA ·d+159704(SB)/8,$"\x72\x6d\x60\x20\x76\x61\x6c\x75"
+DATA ·d+159712(SB)/8,$"\x65\x73\x2e\x0a\x20\x2a\x20\x43"
+DATA ·d+159720(SB)/8,$"\x6f\x72\x72\x65\x63\x74\x20\x60"
+DATA ·d+159728(SB)/8,$"\x62\x75\x74\x74\x6f\x6e\x60\x20"
+DATA ·d+159736(SB)/8,$"\x73\x74\x79\x6c\x65\x20\x69\x6e"
+DATA ·d+159744(SB)/8,$"\x68\x65\x72\x69\x74\x61\x6e\x63"
+DATA ·d+159752(SB)/8,$"\x65\x20\x69\x6e\x20\x46\x69\x72"
+DATA ·d+159760(SB)/8,$"\x65\x66\x6f\x78\x2c\x20\x49\x45"
+DATA ·d+159768(SB)/8,$"\x20\x38\x2f\x39\x2f\x31\x30\x2f"
+DATA ·d+159776(SB)/8,$"\x31\x31\x2c\x20\x61\x6e\x64\x20"
+DATA ·d+159784(SB)/8,$"\x4f\x70\x65\x72\x61\x2e\x0a\x20"
+DATA ·d+159792(SB)/8,$"\x2a\x20\x43\x6f\x72\x72\x65\x63"
+DATA ·d+159800(SB)/8,$"\x74\x20\x60\x73\x65\x6c\x65\x63"
+DATA ·d+159808(SB)/8,$"\x74\x60\x20\x73\x74\x79\x6c\x65"
+DATA ·d+159816(SB)/8,$"\x20\x69\x6e\x68\x65\x72\x69\x74"
+DATA ·d+159824(SB)/8,$"\x61\x6e\x63\x65\x20\x69\x6e\x20"
+DATA ·d+159832(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x2e"
+DATA ·d+159840(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x62\x75"
+DATA ·d+159848(SB)/8,$"\x74\x74\x6f\x6e\x2c\x0a\x73\x65"
+DATA ·d+159856(SB)/8,$"\x6c\x65\x63\x74\x20\x7b\x0a\x20"
+DATA ·d+159864(SB)/8,$"\x20\x20\x20\x74\x65\x78\x74\x2d"
+DATA ·d+159872(SB)/8,$"\x74\x72\x61\x6e\x73\x66\x6f\x72"
+DATA ·d+159880(SB)/8,$"\x6d\x3a\x20\x6e\x6f\x6e\x65\x3b"
+DATA ·d+159888(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+159896(SB)/8,$"\x20\x2a\x20\x31\x2e\x20\x41\x76"
+DATA ·d+159904(SB)/8,$"\x6f\x69\x64\x20\x74\x68\x65\x20"
+DATA ·d+159912(SB)/8,$"\x57\x65\x62\x4b\x69\x74\x20\x62"
+DATA ·d+159920(SB)/8,$"\x75\x67\x20\x69\x6e\x20\x41\x6e"
+DATA ·d+159928(SB)/8,$"\x64\x72\x6f\x69\x64\x20\x34\x2e"
+DATA ·d+159936(SB)/8,$"\x30\x2e\x2a\x20\x77\x68\x65\x72"
+DATA ·d+159944(SB)/8,$"\x65\x20\x28\x32\x29\x20\x64\x65"
+DATA ·d+159952(SB)/8,$"\x73\x74\x72\x6f\x79\x73\x20\x6e"
+DATA ·d+159960(SB)/8,$"\x61\x74\x69\x76\x65\x20\x60\x61"
+DATA ·d+159968(SB)/8,$"\x75\x64\x69\x6f\x60\x0a\x20\x2a"
+DATA ·d+159976(SB)/8,$"\x20\x20\x20\x20\x61\x6e\x64\x20"
+DATA ·d+159984(SB)/8,$"\x60\x76\x69\x64\x65\x6f\x60\x20"
+DATA ·d+159992(SB)/8,$"\x63\x6f\x6e\x74\x72\x6f\x6c\x73"
+DATA ·d+160000(SB)/8,$"\x2e\x0a\x20\x2a\x20\x32\x2e\x20"
+DATA ·d+160008(SB)/8,$"\x43\x6f\x72\x72\x65\x63\x74\x20"
+DATA ·d+160016(SB)/8,$"\x69\x6e\x61\x62\x69\x6c\x69\x74"
+DATA ·d+160024(SB)/8,$"\x79\x20\x74\x6f\x20\x73\x74\x79"
+DATA ·d+160032(SB)/8,$"\x6c\x65\x20\x63\x6c\x69\x63\x6b"
+DATA ·d+160040(SB)/8,$"\x61\x62\x6c\x65\x20\x60\x69\x6e"
+DATA ·d+160048(SB)/8,$"\x70\x75\x74\x60\x20\x74\x79\x70"
+DATA ·d+160056(SB)/8,$"\x65\x73\x20\x69\x6e\x20\x69\x4f"
+DATA ·d+160064(SB)/8,$"\x53\x2e\x0a\x20\x2a\x20\x33\x2e"
+DATA ·d+160072(SB)/8,$"\x20\x49\x6d\x70\x72\x6f\x76\x65"
+DATA ·d+160080(SB)/8,$"\x20\x75\x73\x61\x62\x69\x6c\x69"
+DATA ·d+160088(SB)/8,$"\x74\x79\x20\x61\x6e\x64\x20\x63"
+DATA ·d+160096(SB)/8,$"\x6f\x6e\x73\x69\x73\x74\x65\x6e"
+DATA ·d+160104(SB)/8,$"\x63\x79\x20\x6f\x66\x20\x63\x75"
+DATA ·d+160112(SB)/8,$"\x72\x73\x6f\x72\x20\x73\x74\x79"
+DATA ·d+160120(SB)/8,$"\x6c\x65\x20\x62\x65\x74\x77\x65"
+DATA ·d+160128(SB)/8,$"\x65\x6e\x20\x69\x6d\x61\x67\x65"
+DATA ·d+160136(SB)/8,$"\x2d\x74\x79\x70\x65\x0a\x20\x2a"
+DATA ·d+160144(SB)/8,$"\x20\x20\x20\x20\x60\x69\x6e\x70"
+DATA ·d+160152(SB)/8,$"\x75\x74\x60\x20\x61\x6e\x64\x20"
+DATA ·d+160160(SB)/8,$"\x6f\x74\x68\x65\x72\x73\x2e\x0a"
+DATA ·d+160168(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x75\x74"
+DATA ·d+160176(SB)/8,$"\x74\x6f\x6e\x2c\x0a\x68\x74\x6d"
+DATA ·d+160184(SB)/8,$"\x6c\x20\x69\x6e\x70\x75\x74\x5b"
+DATA ·d+160192(SB)/8,$"\x74\x79\x70\x65\x3d\x22\x62\x75"
+DATA ·d+160200(SB)/8,$"\x74\x74\x6f\x6e\x22\x5d\x2c\x20"
+DATA ·d+160208(SB)/8,$"\x2f\x2a\x20\x31\x20\x2a\x2f\x0a"
+DATA ·d+160216(SB)/8,$"\x69\x6e\x70\x75\x74\x5b\x74\x79"
+DATA ·d+160224(SB)/8,$"\x70\x65\x3d\x22\x72\x65\x73\x65"
+DATA ·d+160232(SB)/8,$"\x74\x22\x5d\x2c\x0a\x69\x6e\x70"
+DATA ·d+160240(SB)/8,$"\x75\x74\x5b\x74\x79\x70\x65\x3d"
+DATA ·d+160248(SB)/8,$"\x22\x73\x75\x62\x6d\x69\x74\x22"
+DATA ·d+160256(SB)/8,$"\x5d\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+160264(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
+DATA ·d+160272(SB)/8,$"\x61\x70\x70\x65\x61\x72\x61\x6e"
+DATA ·d+160280(SB)/8,$"\x63\x65\x3a\x20\x62\x75\x74\x74"
+DATA ·d+160288(SB)/8,$"\x6f\x6e\x3b\x20\x2f\x2a\x20\x32"
+DATA ·d+160296(SB)/8,$"\x20\x2a\x2f\x0a\x20\x20\x20\x20"
+DATA ·d+160304(SB)/8,$"\x63\x75\x72\x73\x6f\x72\x3a\x20"
+DATA ·d+160312(SB)/8,$"\x70\x6f\x69\x6e\x74\x65\x72\x3b"
+DATA ·d+160320(SB)/8,$"\x20\x2f\x2a\x20\x33\x20\x2a\x2f"
+DATA ·d+160328(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+160336(SB)/8,$"\x20\x2a\x20\x52\x65\x2d\x73\x65"
+DATA ·d+160344(SB)/8,$"\x74\x20\x64\x65\x66\x61\x75\x6c"
+DATA ·d+160352(SB)/8,$"\x74\x20\x63\x75\x72\x73\x6f\x72"
+DATA ·d+160360(SB)/8,$"\x20\x66\x6f\x72\x20\x64\x69\x73"
+DATA ·d+160368(SB)/8,$"\x61\x62\x6c\x65\x64\x20\x65\x6c"
+DATA ·d+160376(SB)/8,$"\x65\x6d\x65\x6e\x74\x73\x2e\x0a"
+DATA ·d+160384(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x62\x75\x74"
+DATA ·d+160392(SB)/8,$"\x74\x6f\x6e\x5b\x64\x69\x73\x61"
+DATA ·d+160400(SB)/8,$"\x62\x6c\x65\x64\x5d\x2c\x0a\x68"
+DATA ·d+160408(SB)/8,$"\x74\x6d\x6c\x20\x69\x6e\x70\x75"
+DATA ·d+160416(SB)/8,$"\x74\x5b\x64\x69\x73\x61\x62\x6c"
+DATA ·d+160424(SB)/8,$"\x65\x64\x5d\x20\x7b\x0a\x20\x20"
+DATA ·d+160432(SB)/8,$"\x20\x20\x63\x75\x72\x73\x6f\x72"
+DATA ·d+160440(SB)/8,$"\x3a\x20\x64\x65\x66\x61\x75\x6c"
+DATA ·d+160448(SB)/8,$"\x74\x3b\x0a\x7d\x0a\x0a\x2f\x2a"
+DATA ·d+160456(SB)/8,$"\x2a\x0a\x20\x2a\x20\x52\x65\x6d"
+DATA ·d+160464(SB)/8,$"\x6f\x76\x65\x20\x69\x6e\x6e\x65"
+DATA ·d+160472(SB)/8,$"\x72\x20\x70\x61\x64\x64\x69\x6e"
+DATA ·d+160480(SB)/8,$"\x67\x20\x61\x6e\x64\x20\x62\x6f"
+DATA ·d+160488(SB)/8,$"\x72\x64\x65\x72\x20\x69\x6e\x20"
+DATA ·d+160496(SB)/8,$"\x46\x69\x72\x65\x66\x6f\x78\x20"
+DATA ·d+160504(SB)/8,$"\x34\x2b\x2e\x0a\x20\x2a\x2f\x0a"
+DATA ·d+160512(SB)/8,$"\x0a\x62\x75\x74\x74\x6f\x6e\x3a"
+DATA ·d+160520(SB)/8,$"\x3a\x2d\x6d\x6f\x7a\x2d\x66\x6f"
+DATA ·d+160528(SB)/8,$"\x63\x75\x73\x2d\x69\x6e\x6e\x65"
+DATA ·d+160536(SB)/8,$"\x72\x2c\x0a\x69\x6e\x70\x75\x74"
+DATA ·d+160544(SB)/8,$"\x3a\x3a\x2d\x6d\x6f\x7a\x2d\x66"
+DATA ·d+160552(SB)/8,$"\x6f\x63\x75\x73\x2d\x69\x6e\x6e"
+DATA ·d+160560(SB)/8,$"\x65\x72\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+160568(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x3a"
+DATA ·d+160576(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+160584(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
+DATA ·d+160592(SB)/8,$"\x20\x30\x3b\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+160600(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x41\x64"
+DATA ·d+160608(SB)/8,$"\x64\x72\x65\x73\x73\x20\x46\x69"
+DATA ·d+160616(SB)/8,$"\x72\x65\x66\x6f\x78\x20\x34\x2b"
+DATA ·d+160624(SB)/8,$"\x20\x73\x65\x74\x74\x69\x6e\x67"
+DATA ·d+160632(SB)/8,$"\x20\x60\x6c\x69\x6e\x65\x2d\x68"
+DATA ·d+160640(SB)/8,$"\x65\x69\x67\x68\x74\x60\x20\x6f"
+DATA ·d+160648(SB)/8,$"\x6e\x20\x60\x69\x6e\x70\x75\x74"
+DATA ·d+160656(SB)/8,$"\x60\x20\x75\x73\x69\x6e\x67\x20"
+DATA ·d+160664(SB)/8,$"\x60\x21\x69\x6d\x70\x6f\x72\x74"
+DATA ·d+160672(SB)/8,$"\x61\x6e\x74\x60\x20\x69\x6e\x0a"
+DATA ·d+160680(SB)/8,$"\x20\x2a\x20\x74\x68\x65\x20\x55"
+DATA ·d+160688(SB)/8,$"\x41\x20\x73\x74\x79\x6c\x65\x73"
+DATA ·d+160696(SB)/8,$"\x68\x65\x65\x74\x2e\x0a\x20\x2a"
+DATA ·d+160704(SB)/8,$"\x2f\x0a\x0a\x69\x6e\x70\x75\x74"
+DATA ·d+160712(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6c"
+DATA ·d+160720(SB)/8,$"\x69\x6e\x65\x2d\x68\x65\x69\x67"
+DATA ·d+160728(SB)/8,$"\x68\x74\x3a\x20\x6e\x6f\x72\x6d"
+DATA ·d+160736(SB)/8,$"\x61\x6c\x3b\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+160744(SB)/8,$"\x2a\x2a\x0a\x20\x2a\x20\x49\x74"
+DATA ·d+160752(SB)/8,$"\x27\x73\x20\x72\x65\x63\x6f\x6d"
+DATA ·d+160760(SB)/8,$"\x6d\x65\x6e\x64\x65\x64\x20\x74"
+DATA ·d+160768(SB)/8,$"\x68\x61\x74\x20\x79\x6f\x75\x20"
+DATA ·d+160776(SB)/8,$"\x64\x6f\x6e\x27\x74\x20\x61\x74"
+DATA ·d+160784(SB)/8,$"\x74\x65\x6d\x70\x74\x20\x74\x6f"
+DATA ·d+160792(SB)/8,$"\x20\x73\x74\x79\x6c\x65\x20\x74"
+DATA ·d+160800(SB)/8,$"\x68\x65\x73\x65\x20\x65\x6c\x65"
+DATA ·d+160808(SB)/8,$"\x6d\x65\x6e\x74\x73\x2e\x0a\x20"
+DATA ·d+160816(SB)/8,$"\x2a\x20\x46\x69\x72\x65\x66\x6f"
+DATA ·d+160824(SB)/8,$"\x78\x27\x73\x20\x69\x6d\x70\x6c"
+DATA ·d+160832(SB)/8,$"\x65\x6d\x65\x6e\x74\x61\x74\x69"
+DATA ·d+160840(SB)/8,$"\x6f\x6e\x20\x64\x6f\x65\x73\x6e"
+DATA ·d+160848(SB)/8,$"\x27\x74\x20\x72\x65\x73\x70\x65"
+DATA ·d+160856(SB)/8,$"\x63\x74\x20\x62\x6f\x78\x2d\x73"
+DATA ·d+160864(SB)/8,$"\x69\x7a\x69\x6e\x67\x2c\x20\x70"
+DATA ·d+160872(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x2c\x20"
+DATA ·d+160880(SB)/8,$"\x6f\x72\x20\x77\x69\x64\x74\x68"
+DATA ·d+160888(SB)/8,$"\x2e\x0a\x20\x2a\x0a\x20\x2a\x20"
+DATA ·d+160896(SB)/8,$"\x31\x2e\x20\x41\x64\x64\x72\x65"
+DATA ·d+160904(SB)/8,$"\x73\x73\x20\x62\x6f\x78\x20\x73"
+DATA ·d+160912(SB)/8,$"\x69\x7a\x69\x6e\x67\x20\x73\x65"
+DATA ·d+160920(SB)/8,$"\x74\x20\x74\x6f\x20\x60\x63\x6f"
+DATA ·d+160928(SB)/8,$"\x6e\x74\x65\x6e\x74\x2d\x62\x6f"
+DATA ·d+160936(SB)/8,$"\x78\x60\x20\x69\x6e\x20\x49\x45"
+DATA ·d+160944(SB)/8,$"\x20\x38\x2f\x39\x2f\x31\x30\x2e"
+DATA ·d+160952(SB)/8,$"\x0a\x20\x2a\x20\x32\x2e\x20\x52"
+DATA ·d+160960(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x65\x78"
+DATA ·d+160968(SB)/8,$"\x63\x65\x73\x73\x20\x70\x61\x64"
+DATA ·d+160976(SB)/8,$"\x64\x69\x6e\x67\x20\x69\x6e\x20"
+DATA ·d+160984(SB)/8,$"\x49\x45\x20\x38\x2f\x39\x2f\x31"
+DATA ·d+160992(SB)/8,$"\x30\x2e\x0a\x20\x2a\x2f\x0a\x0a"
+DATA ·d+161000(SB)/8,$"\x69\x6e\x70\x75\x74\x5b\x74\x79"
+DATA ·d+161008(SB)/8,$"\x70\x65\x3d\x22\x63\x68\x65\x63"
+DATA ·d+161016(SB)/8,$"\x6b\x62\x6f\x78\x22\x5d\x2c\x0a"
+DATA ·d+161024(SB)/8,$"\x69\x6e\x70\x75\x74\x5b\x74\x79"
+DATA ·d+161032(SB)/8,$"\x70\x65\x3d\x22\x72\x61\x64\x69"
+DATA ·d+161040(SB)/8,$"\x6f\x22\x5d\x20\x7b\x0a\x20\x20"
+DATA ·d+161048(SB)/8,$"\x20\x20\x62\x6f\x78\x2d\x73\x69"
+DATA ·d+161056(SB)/8,$"\x7a\x69\x6e\x67\x3a\x20\x62\x6f"
+DATA ·d+161064(SB)/8,$"\x72\x64\x65\x72\x2d\x62\x6f\x78"
+DATA ·d+161072(SB)/8,$"\x3b\x20\x2f\x2a\x20\x31\x20\x2a"
+DATA ·d+161080(SB)/8,$"\x2f\x0a\x20\x20\x20\x20\x70\x61"
+DATA ·d+161088(SB)/8,$"\x64\x64\x69\x6e\x67\x3a\x20\x30"
+DATA ·d+161096(SB)/8,$"\x3b\x20\x2f\x2a\x20\x32\x20\x2a"
+DATA ·d+161104(SB)/8,$"\x2f\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+161112(SB)/8,$"\x0a\x20\x2a\x20\x46\x69\x78\x20"
+DATA ·d+161120(SB)/8,$"\x74\x68\x65\x20\x63\x75\x72\x73"
+DATA ·d+161128(SB)/8,$"\x6f\x72\x20\x73\x74\x79\x6c\x65"
+DATA ·d+161136(SB)/8,$"\x20\x66\x6f\x72\x20\x43\x68\x72"
+DATA ·d+161144(SB)/8,$"\x6f\x6d\x65\x27\x73\x20\x69\x6e"
+DATA ·d+161152(SB)/8,$"\x63\x72\x65\x6d\x65\x6e\x74\x2f"
+DATA ·d+161160(SB)/8,$"\x64\x65\x63\x72\x65\x6d\x65\x6e"
+DATA ·d+161168(SB)/8,$"\x74\x20\x62\x75\x74\x74\x6f\x6e"
+DATA ·d+161176(SB)/8,$"\x73\x2e\x20\x46\x6f\x72\x20\x63"
+DATA ·d+161184(SB)/8,$"\x65\x72\x74\x61\x69\x6e\x0a\x20"
+DATA ·d+161192(SB)/8,$"\x2a\x20\x60\x66\x6f\x6e\x74\x2d"
+DATA ·d+161200(SB)/8,$"\x73\x69\x7a\x65\x60\x20\x76\x61"
+DATA ·d+161208(SB)/8,$"\x6c\x75\x65\x73\x20\x6f\x66\x20"
+DATA ·d+161216(SB)/8,$"\x74\x68\x65\x20\x60\x69\x6e\x70"
+DATA ·d+161224(SB)/8,$"\x75\x74\x60\x2c\x20\x69\x74\x20"
+DATA ·d+161232(SB)/8,$"\x63\x61\x75\x73\x65\x73\x20\x74"
+DATA ·d+161240(SB)/8,$"\x68\x65\x20\x63\x75\x72\x73\x6f"
+DATA ·d+161248(SB)/8,$"\x72\x20\x73\x74\x79\x6c\x65\x20"
+DATA ·d+161256(SB)/8,$"\x6f\x66\x20\x74\x68\x65\x0a\x20"
+DATA ·d+161264(SB)/8,$"\x2a\x20\x64\x65\x63\x72\x65\x6d"
+DATA ·d+161272(SB)/8,$"\x65\x6e\x74\x20\x62\x75\x74\x74"
+DATA ·d+161280(SB)/8,$"\x6f\x6e\x20\x74\x6f\x20\x63\x68"
+DATA ·d+161288(SB)/8,$"\x61\x6e\x67\x65\x20\x66\x72\x6f"
+DATA ·d+161296(SB)/8,$"\x6d\x20\x60\x64\x65\x66\x61\x75"
+DATA ·d+161304(SB)/8,$"\x6c\x74\x60\x20\x74\x6f\x20\x60"
+DATA ·d+161312(SB)/8,$"\x74\x65\x78\x74\x60\x2e\x0a\x20"
+DATA ·d+161320(SB)/8,$"\x2a\x2f\x0a\x0a\x69\x6e\x70\x75"
+DATA ·d+161328(SB)/8,$"\x74\x5b\x74\x79\x70\x65\x3d\x22"
+DATA ·d+161336(SB)/8,$"\x6e\x75\x6d\x62\x65\x72\x22\x5d"
+DATA ·d+161344(SB)/8,$"\x3a\x3a\x2d\x77\x65\x62\x6b\x69"
+DATA ·d+161352(SB)/8,$"\x74\x2d\x69\x6e\x6e\x65\x72\x2d"
+DATA ·d+161360(SB)/8,$"\x73\x70\x69\x6e\x2d\x62\x75\x74"
+DATA ·d+161368(SB)/8,$"\x74\x6f\x6e\x2c\x0a\x69\x6e\x70"
+DATA ·d+161376(SB)/8,$"\x75\x74\x5b\x74\x79\x70\x65\x3d"
+DATA ·d+161384(SB)/8,$"\x22\x6e\x75\x6d\x62\x65\x72\x22"
+DATA ·d+161392(SB)/8,$"\x5d\x3a\x3a\x2d\x77\x65\x62\x6b"
+DATA ·d+161400(SB)/8,$"\x69\x74\x2d\x6f\x75\x74\x65\x72"
+DATA ·d+161408(SB)/8,$"\x2d\x73\x70\x69\x6e\x2d\x62\x75"
+DATA ·d+161416(SB)/8,$"\x74\x74\x6f\x6e\x20\x7b\x0a\x20"
+DATA ·d+161424(SB)/8,$"\x20\x20\x20\x68\x65\x69\x67\x68"
+DATA ·d+161432(SB)/8,$"\x74\x3a\x20\x61\x75\x74\x6f\x3b"
+DATA ·d+161440(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+161448(SB)/8,$"\x20\x2a\x20\x31\x2e\x20\x41\x64"
+DATA ·d+161456(SB)/8,$"\x64\x72\x65\x73\x73\x20\x60\x61"
+DATA ·d+161464(SB)/8,$"\x70\x70\x65\x61\x72\x61\x6e\x63"
+DATA ·d+161472(SB)/8,$"\x65\x60\x20\x73\x65\x74\x20\x74"
+DATA ·d+161480(SB)/8,$"\x6f\x20\x60\x73\x65\x61\x72\x63"
+DATA ·d+161488(SB)/8,$"\x68\x66\x69\x65\x6c\x64\x60\x20"
+DATA ·d+161496(SB)/8,$"\x69\x6e\x20\x53\x61\x66\x61\x72"
+DATA ·d+161504(SB)/8,$"\x69\x20\x61\x6e\x64\x20\x43\x68"
+DATA ·d+161512(SB)/8,$"\x72\x6f\x6d\x65\x2e\x0a\x20\x2a"
+DATA ·d+161520(SB)/8,$"\x20\x32\x2e\x20\x41\x64\x64\x72"
+DATA ·d+161528(SB)/8,$"\x65\x73\x73\x20\x60\x62\x6f\x78"
+DATA ·d+161536(SB)/8,$"\x2d\x73\x69\x7a\x69\x6e\x67\x60"
+DATA ·d+161544(SB)/8,$"\x20\x73\x65\x74\x20\x74\x6f\x20"
+DATA ·d+161552(SB)/8,$"\x60\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+161560(SB)/8,$"\x62\x6f\x78\x60\x20\x69\x6e\x20"
+DATA ·d+161568(SB)/8,$"\x53\x61\x66\x61\x72\x69\x20\x61"
+DATA ·d+161576(SB)/8,$"\x6e\x64\x20\x43\x68\x72\x6f\x6d"
+DATA ·d+161584(SB)/8,$"\x65\x0a\x20\x2a\x20\x20\x20\x20"
+DATA ·d+161592(SB)/8,$"\x28\x69\x6e\x63\x6c\x75\x64\x65"
+DATA ·d+161600(SB)/8,$"\x20\x60\x2d\x6d\x6f\x7a\x60\x20"
+DATA ·d+161608(SB)/8,$"\x74\x6f\x20\x66\x75\x74\x75\x72"
+DATA ·d+161616(SB)/8,$"\x65\x2d\x70\x72\x6f\x6f\x66\x29"
+DATA ·d+161624(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x69"
+DATA ·d+161632(SB)/8,$"\x6e\x70\x75\x74\x5b\x74\x79\x70"
+DATA ·d+161640(SB)/8,$"\x65\x3d\x22\x73\x65\x61\x72\x63"
+DATA ·d+161648(SB)/8,$"\x68\x22\x5d\x20\x7b\x0a\x20\x20"
+DATA ·d+161656(SB)/8,$"\x20\x20\x2d\x77\x65\x62\x6b\x69"
+DATA ·d+161664(SB)/8,$"\x74\x2d\x61\x70\x70\x65\x61\x72"
+DATA ·d+161672(SB)/8,$"\x61\x6e\x63\x65\x3a\x20\x74\x65"
+DATA ·d+161680(SB)/8,$"\x78\x74\x66\x69\x65\x6c\x64\x3b"
+DATA ·d+161688(SB)/8,$"\x20\x2f\x2a\x20\x31\x20\x2a\x2f"
+DATA ·d+161696(SB)/8,$"\x0a\x20\x20\x20\x20\x2d\x6d\x6f"
+DATA ·d+161704(SB)/8,$"\x7a\x2d\x62\x6f\x78\x2d\x73\x69"
+DATA ·d+161712(SB)/8,$"\x7a\x69\x6e\x67\x3a\x20\x63\x6f"
+DATA ·d+161720(SB)/8,$"\x6e\x74\x65\x6e\x74\x2d\x62\x6f"
+DATA ·d+161728(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x2d"
+DATA ·d+161736(SB)/8,$"\x77\x65\x62\x6b\x69\x74\x2d\x62"
+DATA ·d+161744(SB)/8,$"\x6f\x78\x2d\x73\x69\x7a\x69\x6e"
+DATA ·d+161752(SB)/8,$"\x67\x3a\x20\x63\x6f\x6e\x74\x65"
+DATA ·d+161760(SB)/8,$"\x6e\x74\x2d\x62\x6f\x78\x3b\x20"
+DATA ·d+161768(SB)/8,$"\x2f\x2a\x20\x32\x20\x2a\x2f\x0a"
+DATA ·d+161776(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x78\x2d"
+DATA ·d+161784(SB)/8,$"\x73\x69\x7a\x69\x6e\x67\x3a\x20"
+DATA ·d+161792(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x2d"
+DATA ·d+161800(SB)/8,$"\x62\x6f\x78\x3b\x0a\x7d\x0a\x0a"
+DATA ·d+161808(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x52"
+DATA ·d+161816(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x69\x6e"
+DATA ·d+161824(SB)/8,$"\x6e\x65\x72\x20\x70\x61\x64\x64"
+DATA ·d+161832(SB)/8,$"\x69\x6e\x67\x20\x61\x6e\x64\x20"
+DATA ·d+161840(SB)/8,$"\x73\x65\x61\x72\x63\x68\x20\x63"
+DATA ·d+161848(SB)/8,$"\x61\x6e\x63\x65\x6c\x20\x62\x75"
+DATA ·d+161856(SB)/8,$"\x74\x74\x6f\x6e\x20\x69\x6e\x20"
+DATA ·d+161864(SB)/8,$"\x53\x61\x66\x61\x72\x69\x20\x61"
+DATA ·d+161872(SB)/8,$"\x6e\x64\x20\x43\x68\x72\x6f\x6d"
+DATA ·d+161880(SB)/8,$"\x65\x20\x6f\x6e\x20\x4f\x53\x20"
+DATA ·d+161888(SB)/8,$"\x58\x2e\x0a\x20\x2a\x20\x53\x61"
+DATA ·d+161896(SB)/8,$"\x66\x61\x72\x69\x20\x28\x62\x75"
+DATA ·d+161904(SB)/8,$"\x74\x20\x6e\x6f\x74\x20\x43\x68"
+DATA ·d+161912(SB)/8,$"\x72\x6f\x6d\x65\x29\x20\x63\x6c"
+DATA ·d+161920(SB)/8,$"\x69\x70\x73\x20\x74\x68\x65\x20"
+DATA ·d+161928(SB)/8,$"\x63\x61\x6e\x63\x65\x6c\x20\x62"
+DATA ·d+161936(SB)/8,$"\x75\x74\x74\x6f\x6e\x20\x77\x68"
+DATA ·d+161944(SB)/8,$"\x65\x6e\x20\x74\x68\x65\x20\x73"
+DATA ·d+161952(SB)/8,$"\x65\x61\x72\x63\x68\x20\x69\x6e"
+DATA ·d+161960(SB)/8,$"\x70\x75\x74\x20\x68\x61\x73\x0a"
+DATA ·d+161968(SB)/8,$"\x20\x2a\x20\x70\x61\x64\x64\x69"
+DATA ·d+161976(SB)/8,$"\x6e\x67\x20\x28\x61\x6e\x64\x20"
+DATA ·d+161984(SB)/8,$"\x60\x74\x65\x78\x74\x66\x69\x65"
+DATA ·d+161992(SB)/8,$"\x6c\x64\x60\x20\x61\x70\x70\x65"
+DATA ·d+162000(SB)/8,$"\x61\x72\x61\x6e\x63\x65\x29\x2e"
+DATA ·d+162008(SB)/8,$"\x0a\x20\x2a\x2f\x0a\x0a\x69\x6e"
+DATA ·d+162016(SB)/8,$"\x70\x75\x74\x5b\x74\x79\x70\x65"
+DATA ·d+162024(SB)/8,$"\x3d\x22\x73\x65\x61\x72\x63\x68"
+DATA ·d+162032(SB)/8,$"\x22\x5d\x3a\x3a\x2d\x77\x65\x62"
+DATA ·d+162040(SB)/8,$"\x6b\x69\x74\x2d\x73\x65\x61\x72"
+DATA ·d+162048(SB)/8,$"\x63\x68\x2d\x63\x61\x6e\x63\x65"
+DATA ·d+162056(SB)/8,$"\x6c\x2d\x62\x75\x74\x74\x6f\x6e"
+DATA ·d+162064(SB)/8,$"\x2c\x0a\x69\x6e\x70\x75\x74\x5b"
+DATA ·d+162072(SB)/8,$"\x74\x79\x70\x65\x3d\x22\x73\x65"
+DATA ·d+162080(SB)/8,$"\x61\x72\x63\x68\x22\x5d\x3a\x3a"
+DATA ·d+162088(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
+DATA ·d+162096(SB)/8,$"\x73\x65\x61\x72\x63\x68\x2d\x64"
+DATA ·d+162104(SB)/8,$"\x65\x63\x6f\x72\x61\x74\x69\x6f"
+DATA ·d+162112(SB)/8,$"\x6e\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+162120(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
+DATA ·d+162128(SB)/8,$"\x61\x70\x70\x65\x61\x72\x61\x6e"
+DATA ·d+162136(SB)/8,$"\x63\x65\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+162144(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+162152(SB)/8,$"\x0a\x20\x2a\x20\x44\x65\x66\x69"
+DATA ·d+162160(SB)/8,$"\x6e\x65\x20\x63\x6f\x6e\x73\x69"
+DATA ·d+162168(SB)/8,$"\x73\x74\x65\x6e\x74\x20\x62\x6f"
+DATA ·d+162176(SB)/8,$"\x72\x64\x65\x72\x2c\x20\x6d\x61"
+DATA ·d+162184(SB)/8,$"\x72\x67\x69\x6e\x2c\x20\x61\x6e"
+DATA ·d+162192(SB)/8,$"\x64\x20\x70\x61\x64\x64\x69\x6e"
+DATA ·d+162200(SB)/8,$"\x67\x2e\x0a\x20\x2a\x2f\x0a\x0a"
+DATA ·d+162208(SB)/8,$"\x66\x69\x65\x6c\x64\x73\x65\x74"
+DATA ·d+162216(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x62"
+DATA ·d+162224(SB)/8,$"\x6f\x72\x64\x65\x72\x3a\x20\x31"
+DATA ·d+162232(SB)/8,$"\x70\x78\x20\x73\x6f\x6c\x69\x64"
+DATA ·d+162240(SB)/8,$"\x20\x23\x63\x30\x63\x30\x63\x30"
+DATA ·d+162248(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6d\x61"
+DATA ·d+162256(SB)/8,$"\x72\x67\x69\x6e\x3a\x20\x30\x20"
+DATA ·d+162264(SB)/8,$"\x32\x70\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+162272(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+162280(SB)/8,$"\x3a\x20\x30\x2e\x33\x35\x65\x6d"
+DATA ·d+162288(SB)/8,$"\x20\x30\x2e\x36\x32\x35\x65\x6d"
+DATA ·d+162296(SB)/8,$"\x20\x30\x2e\x37\x35\x65\x6d\x3b"
+DATA ·d+162304(SB)/8,$"\x0a\x7d\x0a\x0a\x2f\x2a\x2a\x0a"
+DATA ·d+162312(SB)/8,$"\x20\x2a\x20\x31\x2e\x20\x43\x6f"
+DATA ·d+162320(SB)/8,$"\x72\x72\x65\x63\x74\x20\x60\x63"
+DATA ·d+162328(SB)/8,$"\x6f\x6c\x6f\x72\x60\x20\x6e\x6f"
+DATA ·d+162336(SB)/8,$"\x74\x20\x62\x65\x69\x6e\x67\x20"
+DATA ·d+162344(SB)/8,$"\x69\x6e\x68\x65\x72\x69\x74\x65"
+DATA ·d+162352(SB)/8,$"\x64\x20\x69\x6e\x20\x49\x45\x20"
+DATA ·d+162360(SB)/8,$"\x38\x2f\x39\x2f\x31\x30\x2f\x31"
+DATA ·d+162368(SB)/8,$"\x31\x2e\x0a\x20\x2a\x20\x32\x2e"
+DATA ·d+162376(SB)/8,$"\x20\x52\x65\x6d\x6f\x76\x65\x20"
+DATA ·d+162384(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
+DATA ·d+162392(SB)/8,$"\x73\x6f\x20\x70\x65\x6f\x70\x6c"
+DATA ·d+162400(SB)/8,$"\x65\x20\x61\x72\x65\x6e\x27\x74"
+DATA ·d+162408(SB)/8,$"\x20\x63\x61\x75\x67\x68\x74\x20"
+DATA ·d+162416(SB)/8,$"\x6f\x75\x74\x20\x69\x66\x20\x74"
+DATA ·d+162424(SB)/8,$"\x68\x65\x79\x20\x7a\x65\x72\x6f"
+DATA ·d+162432(SB)/8,$"\x20\x6f\x75\x74\x20\x66\x69\x65"
+DATA ·d+162440(SB)/8,$"\x6c\x64\x73\x65\x74\x73\x2e\x0a"
+DATA ·d+162448(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x6c\x65\x67"
+DATA ·d+162456(SB)/8,$"\x65\x6e\x64\x20\x7b\x0a\x20\x20"
+DATA ·d+162464(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+162472(SB)/8,$"\x3a\x20\x30\x3b\x20\x2f\x2a\x20"
+DATA ·d+162480(SB)/8,$"\x31\x20\x2a\x2f\x0a\x20\x20\x20"
+DATA ·d+162488(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+162496(SB)/8,$"\x3a\x20\x30\x3b\x20\x2f\x2a\x20"
+DATA ·d+162504(SB)/8,$"\x32\x20\x2a\x2f\x0a\x7d\x0a\x0a"
+DATA ·d+162512(SB)/8,$"\x2f\x2a\x2a\x0a\x20\x2a\x20\x52"
+DATA ·d+162520(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x64\x65"
+DATA ·d+162528(SB)/8,$"\x66\x61\x75\x6c\x74\x20\x76\x65"
+DATA ·d+162536(SB)/8,$"\x72\x74\x69\x63\x61\x6c\x20\x73"
+DATA ·d+162544(SB)/8,$"\x63\x72\x6f\x6c\x6c\x62\x61\x72"
+DATA ·d+162552(SB)/8,$"\x20\x69\x6e\x20\x49\x45\x20\x38"
+DATA ·d+162560(SB)/8,$"\x2f\x39\x2f\x31\x30\x2f\x31\x31"
+DATA ·d+162568(SB)/8,$"\x2e\x0a\x20\x2a\x2f\x0a\x0a\x74"
+DATA ·d+162576(SB)/8,$"\x65\x78\x74\x61\x72\x65\x61\x20"
+DATA ·d+162584(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x6f\x76"
+DATA ·d+162592(SB)/8,$"\x65\x72\x66\x6c\x6f\x77\x3a\x20"
+DATA ·d+162600(SB)/8,$"\x61\x75\x74\x6f\x3b\x0a\x7d\x0a"
+DATA ·d+162608(SB)/8,$"\x0a\x2f\x2a\x2a\x0a\x20\x2a\x20"
+DATA ·d+162616(SB)/8,$"\x44\x6f\x6e\x27\x74\x20\x69\x6e"
+DATA ·d+162624(SB)/8,$"\x68\x65\x72\x69\x74\x20\x74\x68"
+DATA ·d+162632(SB)/8,$"\x65\x20\x60\x66\x6f\x6e\x74\x2d"
+DATA ·d+162640(SB)/8,$"\x77\x65\x69\x67\x68\x74\x60\x20"
+DATA ·d+162648(SB)/8,$"\x28\x61\x70\x70\x6c\x69\x65\x64"
+DATA ·d+162656(SB)/8,$"\x20\x62\x79\x20\x61\x20\x72\x75"
+DATA ·d+162664(SB)/8,$"\x6c\x65\x20\x61\x62\x6f\x76\x65"
+DATA ·d+162672(SB)/8,$"\x29\x2e\x0a\x20\x2a\x20\x4e\x4f"
+DATA ·d+162680(SB)/8,$"\x54\x45\x3a\x20\x74\x68\x65\x20"
+DATA ·d+162688(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x20"
+DATA ·d+162696(SB)/8,$"\x63\x61\x6e\x6e\x6f\x74\x20\x73"
+DATA ·d+162704(SB)/8,$"\x61\x66\x65\x6c\x79\x20\x62\x65"
+DATA ·d+162712(SB)/8,$"\x20\x63\x68\x61\x6e\x67\x65\x64"
+DATA ·d+162720(SB)/8,$"\x20\x69\x6e\x20\x43\x68\x72\x6f"
+DATA ·d+162728(SB)/8,$"\x6d\x65\x20\x61\x6e\x64\x20\x53"
+DATA ·d+162736(SB)/8,$"\x61\x66\x61\x72\x69\x20\x6f\x6e"
+DATA ·d+162744(SB)/8,$"\x20\x4f\x53\x20\x58\x2e\x0a\x20"
+DATA ·d+162752(SB)/8,$"\x2a\x2f\x0a\x0a\x6f\x70\x74\x67"
+DATA ·d+162760(SB)/8,$"\x72\x6f\x75\x70\x20\x7b\x0a\x20"
+DATA ·d+162768(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
+DATA ·d+162776(SB)/8,$"\x77\x65\x69\x67\x68\x74\x3a\x20"
+DATA ·d+162784(SB)/8,$"\x62\x6f\x6c\x64\x3b\x0a\x7d\x0a"
+DATA ·d+162792(SB)/8,$"\x0a\x2f\x2a\x20\x54\x61\x62\x6c"
+DATA ·d+162800(SB)/8,$"\x65\x73\x0a\x20\x20\x20\x3d\x3d"
+DATA ·d+162808(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162816(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162824(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162832(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162840(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162848(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162856(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162864(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162872(SB)/8,$"\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d"
+DATA ·d+162880(SB)/8,$"\x20\x2a\x2f\x0a\x0a\x2f\x2a\x2a"
+DATA ·d+162888(SB)/8,$"\x0a\x20\x2a\x20\x52\x65\x6d\x6f"
+DATA ·d+162896(SB)/8,$"\x76\x65\x20\x6d\x6f\x73\x74\x20"
+DATA ·d+162904(SB)/8,$"\x73\x70\x61\x63\x69\x6e\x67\x20"
+DATA ·d+162912(SB)/8,$"\x62\x65\x74\x77\x65\x65\x6e\x20"
+DATA ·d+162920(SB)/8,$"\x74\x61\x62\x6c\x65\x20\x63\x65"
+DATA ·d+162928(SB)/8,$"\x6c\x6c\x73\x2e\x0a\x20\x2a\x2f"
+DATA ·d+162936(SB)/8,$"\x0a\x0a\x74\x61\x62\x6c\x65\x20"
+DATA ·d+162944(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x6f"
+DATA ·d+162952(SB)/8,$"\x72\x64\x65\x72\x2d\x63\x6f\x6c"
+DATA ·d+162960(SB)/8,$"\x6c\x61\x70\x73\x65\x3a\x20\x63"
+DATA ·d+162968(SB)/8,$"\x6f\x6c\x6c\x61\x70\x73\x65\x3b"
+DATA ·d+162976(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
+DATA ·d+162984(SB)/8,$"\x64\x65\x72\x2d\x73\x70\x61\x63"
+DATA ·d+162992(SB)/8,$"\x69\x6e\x67\x3a\x20\x30\x3b\x0a"
+DATA ·d+163000(SB)/8,$"\x7d\x0a\x0a\x74\x64\x2c\x0a\x74"
+DATA ·d+163008(SB)/8,$"\x68\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+163016(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
+DATA ·d+163024(SB)/8,$"\x20\x30\x3b\x0a\x7d\x0a\x00\x00"
+DATA ·d+163032(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163040(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163048(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163056(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163064(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163072(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163080(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163088(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163096(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163104(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163112(SB)/8,$"\x0a\x2f\x2f\x20\x52\x54\x4c\x20"
+DATA ·d+163120(SB)/8,$"\x53\x74\x79\x6c\x65\x73\x20\x56"
+DATA ·d+163128(SB)/8,$"\x61\x72\x69\x61\x62\x6c\x65\x73"
+DATA ·d+163136(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163144(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163152(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163160(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163168(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163176(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163184(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163192(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163200(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163208(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163216(SB)/8,$"\x2f\x0a\x0a\x24\x64\x65\x66\x61"
+DATA ·d+163224(SB)/8,$"\x75\x6c\x74\x3a\x20\x61\x75\x74"
+DATA ·d+163232(SB)/8,$"\x6f\x3b\x0a\x0a\x2f\x2f\x2f\x2f"
+DATA ·d+163240(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163248(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163256(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163264(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163272(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163280(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163288(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163296(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163304(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163312(SB)/8,$"\x2f\x2f\x2f\x2f\x0a\x2f\x2f\x20"
+DATA ·d+163320(SB)/8,$"\x54\x41\x42\x4c\x45\x20\x4f\x46"
+DATA ·d+163328(SB)/8,$"\x20\x43\x4f\x4e\x54\x45\x4e\x54"
+DATA ·d+163336(SB)/8,$"\x53\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163344(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163352(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163360(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163368(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163376(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163384(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163392(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163400(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163408(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163416(SB)/8,$"\x2f\x2f\x0a\x0a\x23\x74\x6f\x63"
+DATA ·d+163424(SB)/8,$"\x3e\x75\x6c\x3e\x6c\x69\x3e\x61"
+DATA ·d+163432(SB)/8,$"\x3e\x73\x70\x61\x6e\x20\x7b\x0a"
+DATA ·d+163440(SB)/8,$"\x20\x20\x20\x20\x66\x6c\x6f\x61"
+DATA ·d+163448(SB)/8,$"\x74\x3a\x20\x6c\x65\x66\x74\x3b"
+DATA ·d+163456(SB)/8,$"\x0a\x7d\x0a\x0a\x2e\x74\x6f\x63"
+DATA ·d+163464(SB)/8,$"\x2d\x77\x72\x61\x70\x70\x65\x72"
+DATA ·d+163472(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x74"
+DATA ·d+163480(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
+DATA ·d+163488(SB)/8,$"\x6e\x3a\x20\x72\x69\x67\x68\x74"
+DATA ·d+163496(SB)/8,$"\x20\x30\x2e\x33\x73\x20\x65\x61"
+DATA ·d+163504(SB)/8,$"\x73\x65\x2d\x69\x6e\x2d\x6f\x75"
+DATA ·d+163512(SB)/8,$"\x74\x20\x21\x69\x6d\x70\x6f\x72"
+DATA ·d+163520(SB)/8,$"\x74\x61\x6e\x74\x3b\x0a\x20\x20"
+DATA ·d+163528(SB)/8,$"\x20\x20\x6c\x65\x66\x74\x3a\x20"
+DATA ·d+163536(SB)/8,$"\x24\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+163544(SB)/8,$"\x20\x21\x69\x6d\x70\x6f\x72\x74"
+DATA ·d+163552(SB)/8,$"\x61\x6e\x74\x3b\x0a\x20\x20\x20"
+DATA ·d+163560(SB)/8,$"\x20\x23\x7b\x72\x69\x67\x68\x74"
+DATA ·d+163568(SB)/8,$"\x7d\x3a\x20\x30\x3b\x0a\x7d\x0a"
+DATA ·d+163576(SB)/8,$"\x0a\x2e\x74\x6f\x63\x2d\x68\x32"
+DATA ·d+163584(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x70"
+DATA ·d+163592(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x2d\x23"
+DATA ·d+163600(SB)/8,$"\x7b\x72\x69\x67\x68\x74\x7d\x3a"
+DATA ·d+163608(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x70\x61"
+DATA ·d+163616(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x2b\x20"
+DATA ·d+163624(SB)/8,$"\x24\x6e\x61\x76\x2d\x69\x6e\x64"
+DATA ·d+163632(SB)/8,$"\x65\x6e\x74\x3b\x0a\x7d\x0a\x0a"
+DATA ·d+163640(SB)/8,$"\x23\x6e\x61\x76\x2d\x62\x75\x74"
+DATA ·d+163648(SB)/8,$"\x74\x6f\x6e\x20\x7b\x0a\x20\x20"
+DATA ·d+163656(SB)/8,$"\x20\x20\x23\x7b\x72\x69\x67\x68"
+DATA ·d+163664(SB)/8,$"\x74\x7d\x3a\x20\x30\x3b\x0a\x20"
+DATA ·d+163672(SB)/8,$"\x20\x20\x20\x74\x72\x61\x6e\x73"
+DATA ·d+163680(SB)/8,$"\x69\x74\x69\x6f\x6e\x3a\x20\x72"
+DATA ·d+163688(SB)/8,$"\x69\x67\x68\x74\x20\x30\x2e\x33"
+DATA ·d+163696(SB)/8,$"\x73\x20\x65\x61\x73\x65\x2d\x69"
+DATA ·d+163704(SB)/8,$"\x6e\x2d\x6f\x75\x74\x3b\x0a\x20"
+DATA ·d+163712(SB)/8,$"\x20\x20\x20\x26\x2e\x6f\x70\x65"
+DATA ·d+163720(SB)/8,$"\x6e\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+163728(SB)/8,$"\x20\x20\x20\x20\x72\x69\x67\x68"
+DATA ·d+163736(SB)/8,$"\x74\x3a\x20\x24\x6e\x61\x76\x2d"
+DATA ·d+163744(SB)/8,$"\x77\x69\x64\x74\x68\x0a\x20\x20"
+DATA ·d+163752(SB)/8,$"\x20\x20\x7d\x0a\x7d\x0a\x0a\x2f"
 DATA ·d+163760(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+163768(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+163776(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+163784(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163792(SB)/8,$"\x2f\x2f\x0a\x2f\x2f\x20\x54\x59"
-DATA ·d+163800(SB)/8,$"\x50\x4f\x47\x52\x41\x50\x48\x59"
-DATA ·d+163808(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163792(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163800(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163808(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+163816(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+163824(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163832(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163840(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163848(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163856(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163864(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163872(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163880(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+163888(SB)/8,$"\x2f\x0a\x68\x31\x2c\x0a\x68\x32"
-DATA ·d+163896(SB)/8,$"\x2c\x0a\x68\x33\x2c\x0a\x68\x34"
-DATA ·d+163904(SB)/8,$"\x2c\x0a\x68\x35\x2c\x0a\x68\x36"
-DATA ·d+163912(SB)/8,$"\x2c\x0a\x70\x2c\x0a\x61\x73\x69"
-DATA ·d+163920(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+163928(SB)/8,$"\x20\x74\x65\x78\x74\x2d\x61\x6c"
-DATA ·d+163936(SB)/8,$"\x69\x67\x6e\x3a\x20\x72\x69\x67"
-DATA ·d+163944(SB)/8,$"\x68\x74\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+163952(SB)/8,$"\x64\x69\x72\x65\x63\x74\x69\x6f"
-DATA ·d+163960(SB)/8,$"\x6e\x3a\x20\x72\x74\x6c\x3b\x0a"
-DATA ·d+163968(SB)/8,$"\x7d\x0a\x0a\x2e\x74\x6f\x63\x2d"
-DATA ·d+163976(SB)/8,$"\x77\x72\x61\x70\x70\x65\x72\x20"
-DATA ·d+163984(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x74\x65"
-DATA ·d+163992(SB)/8,$"\x78\x74\x2d\x61\x6c\x69\x67\x6e"
-DATA ·d+164000(SB)/8,$"\x3a\x20\x72\x69\x67\x68\x74\x3b"
-DATA ·d+164008(SB)/8,$"\x0a\x20\x20\x20\x20\x64\x69\x72"
-DATA ·d+164016(SB)/8,$"\x65\x63\x74\x69\x6f\x6e\x3a\x20"
-DATA ·d+164024(SB)/8,$"\x72\x74\x6c\x3b\x0a\x20\x20\x20"
-DATA ·d+164032(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x77\x65"
-DATA ·d+164040(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x31\x30"
-DATA ·d+164048(SB)/8,$"\x30\x20\x21\x69\x6d\x70\x6f\x72"
-DATA ·d+164056(SB)/8,$"\x74\x61\x6e\x74\x3b\x0a\x7d\x0a"
-DATA ·d+164064(SB)/8,$"\x0a\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164072(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164080(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164088(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164096(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164104(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164112(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164120(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164128(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164136(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164144(SB)/8,$"\x2f\x2f\x0a\x2f\x2f\x20\x52\x45"
-DATA ·d+164152(SB)/8,$"\x53\x50\x4f\x4e\x53\x49\x56\x45"
-DATA ·d+164160(SB)/8,$"\x20\x44\x45\x53\x49\x47\x4e\x0a"
-DATA ·d+164168(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164176(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164184(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164192(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164200(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164208(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163832(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
+DATA ·d+163840(SB)/8,$"\x2f\x2f\x20\x50\x41\x47\x45\x20"
+DATA ·d+163848(SB)/8,$"\x4c\x41\x59\x4f\x55\x54\x20\x41"
+DATA ·d+163856(SB)/8,$"\x4e\x44\x20\x43\x4f\x44\x45\x20"
+DATA ·d+163864(SB)/8,$"\x53\x41\x4d\x50\x4c\x45\x20\x42"
+DATA ·d+163872(SB)/8,$"\x41\x43\x4b\x47\x52\x4f\x55\x4e"
+DATA ·d+163880(SB)/8,$"\x44\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163888(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163896(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163904(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163912(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163920(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163928(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163936(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163944(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163952(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+163960(SB)/8,$"\x2f\x2f\x0a\x2e\x70\x61\x67\x65"
+DATA ·d+163968(SB)/8,$"\x2d\x77\x72\x61\x70\x70\x65\x72"
+DATA ·d+163976(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6d"
+DATA ·d+163984(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x23\x7b"
+DATA ·d+163992(SB)/8,$"\x6c\x65\x66\x74\x7d\x3a\x20\x24"
+DATA ·d+164000(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x20"
+DATA ·d+164008(SB)/8,$"\x21\x69\x6d\x70\x6f\x72\x74\x61"
+DATA ·d+164016(SB)/8,$"\x6e\x74\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+164024(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x23"
+DATA ·d+164032(SB)/8,$"\x7b\x72\x69\x67\x68\x74\x7d\x3a"
+DATA ·d+164040(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x77\x69"
+DATA ·d+164048(SB)/8,$"\x64\x74\x68\x3b\x0a\x20\x20\x20"
+DATA ·d+164056(SB)/8,$"\x20\x2e\x64\x61\x72\x6b\x2d\x62"
+DATA ·d+164064(SB)/8,$"\x6f\x78\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+164072(SB)/8,$"\x20\x20\x20\x20\x20\x23\x7b\x72"
+DATA ·d+164080(SB)/8,$"\x69\x67\x68\x74\x7d\x3a\x20\x24"
+DATA ·d+164088(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
+DATA ·d+164096(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+164104(SB)/8,$"\x20\x23\x7b\x6c\x65\x66\x74\x7d"
+DATA ·d+164112(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x20"
+DATA ·d+164120(SB)/8,$"\x20\x7d\x0a\x7d\x0a\x0a\x2e\x6c"
+DATA ·d+164128(SB)/8,$"\x61\x6e\x67\x2d\x73\x65\x6c\x65"
+DATA ·d+164136(SB)/8,$"\x63\x74\x6f\x72\x20\x7b\x0a\x20"
+DATA ·d+164144(SB)/8,$"\x20\x20\x20\x77\x69\x64\x74\x68"
+DATA ·d+164152(SB)/8,$"\x3a\x20\x24\x64\x65\x66\x61\x75"
+DATA ·d+164160(SB)/8,$"\x6c\x74\x20\x21\x69\x6d\x70\x6f"
+DATA ·d+164168(SB)/8,$"\x72\x74\x61\x6e\x74\x3b\x0a\x20"
+DATA ·d+164176(SB)/8,$"\x20\x20\x20\x61\x20\x7b\x0a\x20"
+DATA ·d+164184(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x66"
+DATA ·d+164192(SB)/8,$"\x6c\x6f\x61\x74\x3a\x20\x72\x69"
+DATA ·d+164200(SB)/8,$"\x67\x68\x74\x3b\x0a\x20\x20\x20"
+DATA ·d+164208(SB)/8,$"\x20\x7d\x0a\x7d\x0a\x0a\x2f\x2f"
 DATA ·d+164216(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+164224(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+164232(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+164240(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+164248(SB)/8,$"\x0a\x40\x6d\x65\x64\x69\x61\x20"
-DATA ·d+164256(SB)/8,$"\x28\x6d\x61\x78\x2d\x77\x69\x64"
-DATA ·d+164264(SB)/8,$"\x74\x68\x3a\x20\x24\x74\x61\x62"
-DATA ·d+164272(SB)/8,$"\x6c\x65\x74\x2d\x77\x69\x64\x74"
-DATA ·d+164280(SB)/8,$"\x68\x29\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+164288(SB)/8,$"\x20\x2e\x74\x6f\x63\x2d\x77\x72"
-DATA ·d+164296(SB)/8,$"\x61\x70\x70\x65\x72\x20\x7b\x0a"
-DATA ·d+164304(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+164312(SB)/8,$"\x23\x7b\x72\x69\x67\x68\x74\x7d"
-DATA ·d+164320(SB)/8,$"\x3a\x20\x2d\x24\x6e\x61\x76\x2d"
-DATA ·d+164328(SB)/8,$"\x77\x69\x64\x74\x68\x3b\x0a\x20"
-DATA ·d+164336(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x26"
-DATA ·d+164344(SB)/8,$"\x2e\x6f\x70\x65\x6e\x20\x7b\x0a"
-DATA ·d+164352(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+164360(SB)/8,$"\x20\x20\x20\x20\x23\x7b\x72\x69"
-DATA ·d+164368(SB)/8,$"\x67\x68\x74\x7d\x3a\x20\x30\x3b"
-DATA ·d+164376(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+164384(SB)/8,$"\x20\x7d\x0a\x20\x20\x20\x20\x7d"
-DATA ·d+164392(SB)/8,$"\x0a\x20\x20\x20\x20\x2e\x70\x61"
-DATA ·d+164400(SB)/8,$"\x67\x65\x2d\x77\x72\x61\x70\x70"
-DATA ·d+164408(SB)/8,$"\x65\x72\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+164416(SB)/8,$"\x20\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+164424(SB)/8,$"\x67\x69\x6e\x2d\x23\x7b\x72\x69"
-DATA ·d+164432(SB)/8,$"\x67\x68\x74\x7d\x3a\x20\x30\x3b"
-DATA ·d+164440(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x7d"
-DATA ·d+164448(SB)/8,$"\x0a\x0a\x40\x6d\x65\x64\x69\x61"
-DATA ·d+164456(SB)/8,$"\x20\x28\x6d\x61\x78\x2d\x77\x69"
-DATA ·d+164464(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x70\x68"
-DATA ·d+164472(SB)/8,$"\x6f\x6e\x65\x2d\x77\x69\x64\x74"
-DATA ·d+164480(SB)/8,$"\x68\x29\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+164488(SB)/8,$"\x20\x25\x6c\x65\x66\x74\x2d\x63"
-DATA ·d+164496(SB)/8,$"\x6f\x6c\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+164504(SB)/8,$"\x20\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+164512(SB)/8,$"\x67\x69\x6e\x2d\x23\x7b\x6c\x65"
-DATA ·d+164520(SB)/8,$"\x66\x74\x7d\x3a\x20\x30\x3b\x0a"
-DATA ·d+164528(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x7d\x0a"
-DATA ·d+164536(SB)/8,$"\x2f\x2a\x0a\x43\x6f\x70\x79\x72"
-DATA ·d+164544(SB)/8,$"\x69\x67\x68\x74\x20\x32\x30\x30"
-DATA ·d+164552(SB)/8,$"\x38\x2d\x32\x30\x31\x33\x20\x43"
-DATA ·d+164560(SB)/8,$"\x6f\x6e\x63\x75\x72\x20\x54\x65"
-DATA ·d+164568(SB)/8,$"\x63\x68\x6e\x6f\x6c\x6f\x67\x69"
-DATA ·d+164576(SB)/8,$"\x65\x73\x2c\x20\x49\x6e\x63\x2e"
-DATA ·d+164584(SB)/8,$"\x0a\x0a\x4c\x69\x63\x65\x6e\x73"
-DATA ·d+164592(SB)/8,$"\x65\x64\x20\x75\x6e\x64\x65\x72"
-DATA ·d+164600(SB)/8,$"\x20\x74\x68\x65\x20\x41\x70\x61"
-DATA ·d+164608(SB)/8,$"\x63\x68\x65\x20\x4c\x69\x63\x65"
-DATA ·d+164616(SB)/8,$"\x6e\x73\x65\x2c\x20\x56\x65\x72"
-DATA ·d+164624(SB)/8,$"\x73\x69\x6f\x6e\x20\x32\x2e\x30"
-DATA ·d+164632(SB)/8,$"\x20\x28\x74\x68\x65\x20\x22\x4c"
-DATA ·d+164640(SB)/8,$"\x69\x63\x65\x6e\x73\x65\x22\x29"
-DATA ·d+164648(SB)/8,$"\x3b\x20\x79\x6f\x75\x20\x6d\x61"
-DATA ·d+164656(SB)/8,$"\x79\x0a\x6e\x6f\x74\x20\x75\x73"
-DATA ·d+164664(SB)/8,$"\x65\x20\x74\x68\x69\x73\x20\x66"
-DATA ·d+164672(SB)/8,$"\x69\x6c\x65\x20\x65\x78\x63\x65"
-DATA ·d+164680(SB)/8,$"\x70\x74\x20\x69\x6e\x20\x63\x6f"
-DATA ·d+164688(SB)/8,$"\x6d\x70\x6c\x69\x61\x6e\x63\x65"
-DATA ·d+164696(SB)/8,$"\x20\x77\x69\x74\x68\x20\x74\x68"
-DATA ·d+164704(SB)/8,$"\x65\x20\x4c\x69\x63\x65\x6e\x73"
-DATA ·d+164712(SB)/8,$"\x65\x2e\x20\x59\x6f\x75\x20\x6d"
-DATA ·d+164720(SB)/8,$"\x61\x79\x20\x6f\x62\x74\x61\x69"
-DATA ·d+164728(SB)/8,$"\x6e\x0a\x61\x20\x63\x6f\x70\x79"
-DATA ·d+164736(SB)/8,$"\x20\x6f\x66\x20\x74\x68\x65\x20"
-DATA ·d+164744(SB)/8,$"\x4c\x69\x63\x65\x6e\x73\x65\x20"
-DATA ·d+164752(SB)/8,$"\x61\x74\x0a\x0a\x20\x20\x68\x74"
-DATA ·d+164760(SB)/8,$"\x74\x70\x3a\x2f\x2f\x77\x77\x77"
-DATA ·d+164768(SB)/8,$"\x2e\x61\x70\x61\x63\x68\x65\x2e"
-DATA ·d+164776(SB)/8,$"\x6f\x72\x67\x2f\x6c\x69\x63\x65"
-DATA ·d+164784(SB)/8,$"\x6e\x73\x65\x73\x2f\x4c\x49\x43"
-DATA ·d+164792(SB)/8,$"\x45\x4e\x53\x45\x2d\x32\x2e\x30"
-DATA ·d+164800(SB)/8,$"\x0a\x0a\x55\x6e\x6c\x65\x73\x73"
-DATA ·d+164808(SB)/8,$"\x20\x72\x65\x71\x75\x69\x72\x65"
-DATA ·d+164816(SB)/8,$"\x64\x20\x62\x79\x20\x61\x70\x70"
-DATA ·d+164824(SB)/8,$"\x6c\x69\x63\x61\x62\x6c\x65\x20"
-DATA ·d+164832(SB)/8,$"\x6c\x61\x77\x20\x6f\x72\x20\x61"
-DATA ·d+164840(SB)/8,$"\x67\x72\x65\x65\x64\x20\x74\x6f"
-DATA ·d+164848(SB)/8,$"\x20\x69\x6e\x20\x77\x72\x69\x74"
-DATA ·d+164856(SB)/8,$"\x69\x6e\x67\x2c\x20\x73\x6f\x66"
-DATA ·d+164864(SB)/8,$"\x74\x77\x61\x72\x65\x0a\x64\x69"
-DATA ·d+164872(SB)/8,$"\x73\x74\x72\x69\x62\x75\x74\x65"
-DATA ·d+164880(SB)/8,$"\x64\x20\x75\x6e\x64\x65\x72\x20"
-DATA ·d+164888(SB)/8,$"\x74\x68\x65\x20\x4c\x69\x63\x65"
-DATA ·d+164896(SB)/8,$"\x6e\x73\x65\x20\x69\x73\x20\x64"
-DATA ·d+164904(SB)/8,$"\x69\x73\x74\x72\x69\x62\x75\x74"
-DATA ·d+164912(SB)/8,$"\x65\x64\x20\x6f\x6e\x20\x61\x6e"
-DATA ·d+164920(SB)/8,$"\x20\x22\x41\x53\x20\x49\x53\x22"
-DATA ·d+164928(SB)/8,$"\x20\x42\x41\x53\x49\x53\x2c\x20"
-DATA ·d+164936(SB)/8,$"\x57\x49\x54\x48\x4f\x55\x54\x0a"
-DATA ·d+164944(SB)/8,$"\x57\x41\x52\x52\x41\x4e\x54\x49"
-DATA ·d+164952(SB)/8,$"\x45\x53\x20\x4f\x52\x20\x43\x4f"
-DATA ·d+164960(SB)/8,$"\x4e\x44\x49\x54\x49\x4f\x4e\x53"
-DATA ·d+164968(SB)/8,$"\x20\x4f\x46\x20\x41\x4e\x59\x20"
-DATA ·d+164976(SB)/8,$"\x4b\x49\x4e\x44\x2c\x20\x65\x69"
-DATA ·d+164984(SB)/8,$"\x74\x68\x65\x72\x20\x65\x78\x70"
-DATA ·d+164992(SB)/8,$"\x72\x65\x73\x73\x20\x6f\x72\x20"
-DATA ·d+165000(SB)/8,$"\x69\x6d\x70\x6c\x69\x65\x64\x2e"
-DATA ·d+165008(SB)/8,$"\x20\x53\x65\x65\x20\x74\x68\x65"
-DATA ·d+165016(SB)/8,$"\x0a\x4c\x69\x63\x65\x6e\x73\x65"
-DATA ·d+165024(SB)/8,$"\x20\x66\x6f\x72\x20\x74\x68\x65"
-DATA ·d+165032(SB)/8,$"\x20\x73\x70\x65\x63\x69\x66\x69"
-DATA ·d+165040(SB)/8,$"\x63\x20\x6c\x61\x6e\x67\x75\x61"
-DATA ·d+165048(SB)/8,$"\x67\x65\x20\x67\x6f\x76\x65\x72"
-DATA ·d+165056(SB)/8,$"\x6e\x69\x6e\x67\x20\x70\x65\x72"
-DATA ·d+165064(SB)/8,$"\x6d\x69\x73\x73\x69\x6f\x6e\x73"
-DATA ·d+165072(SB)/8,$"\x20\x61\x6e\x64\x20\x6c\x69\x6d"
-DATA ·d+165080(SB)/8,$"\x69\x74\x61\x74\x69\x6f\x6e\x73"
-DATA ·d+165088(SB)/8,$"\x0a\x75\x6e\x64\x65\x72\x20\x74"
-DATA ·d+165096(SB)/8,$"\x68\x65\x20\x4c\x69\x63\x65\x6e"
-DATA ·d+165104(SB)/8,$"\x73\x65\x2e\x0a\x2a\x2f\x0a\x0a"
-DATA ·d+165112(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165120(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165128(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165136(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164248(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164256(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164264(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164272(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164280(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164288(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x2f"
+DATA ·d+164296(SB)/8,$"\x2f\x20\x43\x4f\x44\x45\x20\x53"
+DATA ·d+164304(SB)/8,$"\x41\x4d\x50\x4c\x45\x20\x53\x54"
+DATA ·d+164312(SB)/8,$"\x59\x4c\x45\x53\x0a\x2f\x2f\x2f"
+DATA ·d+164320(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164328(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164336(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164344(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164352(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164360(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164368(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164376(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164384(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+164392(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x0a\x2e\x63"
+DATA ·d+164400(SB)/8,$"\x6f\x6e\x74\x65\x6e\x74\x20\x7b"
+DATA ·d+164408(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x3e\x68"
+DATA ·d+164416(SB)/8,$"\x31\x2c\x0a\x20\x20\x20\x20\x26"
+DATA ·d+164424(SB)/8,$"\x3e\x68\x32\x2c\x0a\x20\x20\x20"
+DATA ·d+164432(SB)/8,$"\x20\x26\x3e\x68\x33\x2c\x0a\x20"
+DATA ·d+164440(SB)/8,$"\x20\x20\x20\x26\x3e\x68\x34\x2c"
+DATA ·d+164448(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x3e\x68"
+DATA ·d+164456(SB)/8,$"\x35\x2c\x0a\x20\x20\x20\x20\x26"
+DATA ·d+164464(SB)/8,$"\x3e\x68\x36\x2c\x0a\x20\x20\x20"
+DATA ·d+164472(SB)/8,$"\x20\x26\x3e\x70\x2c\x0a\x20\x20"
+DATA ·d+164480(SB)/8,$"\x20\x20\x26\x3e\x74\x61\x62\x6c"
+DATA ·d+164488(SB)/8,$"\x65\x2c\x0a\x20\x20\x20\x20\x26"
+DATA ·d+164496(SB)/8,$"\x3e\x75\x6c\x2c\x0a\x20\x20\x20"
+DATA ·d+164504(SB)/8,$"\x20\x26\x3e\x6f\x6c\x2c\x0a\x20"
+DATA ·d+164512(SB)/8,$"\x20\x20\x20\x26\x3e\x61\x73\x69"
+DATA ·d+164520(SB)/8,$"\x64\x65\x2c\x0a\x20\x20\x20\x20"
+DATA ·d+164528(SB)/8,$"\x26\x3e\x64\x6c\x20\x7b\x0a\x20"
+DATA ·d+164536(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x6d"
+DATA ·d+164544(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x23\x7b"
+DATA ·d+164552(SB)/8,$"\x6c\x65\x66\x74\x7d\x3a\x20\x24"
+DATA ·d+164560(SB)/8,$"\x65\x78\x61\x6d\x70\x6c\x65\x73"
+DATA ·d+164568(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3b\x0a"
+DATA ·d+164576(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+164584(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x23"
+DATA ·d+164592(SB)/8,$"\x7b\x72\x69\x67\x68\x74\x7d\x3a"
+DATA ·d+164600(SB)/8,$"\x20\x24\x64\x65\x66\x61\x75\x6c"
+DATA ·d+164608(SB)/8,$"\x74\x20\x21\x69\x6d\x70\x6f\x72"
+DATA ·d+164616(SB)/8,$"\x74\x61\x6e\x74\x3b\x0a\x20\x20"
+DATA ·d+164624(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x20\x20"
+DATA ·d+164632(SB)/8,$"\x26\x3e\x75\x6c\x2c\x0a\x20\x20"
+DATA ·d+164640(SB)/8,$"\x20\x20\x26\x3e\x6f\x6c\x20\x7b"
+DATA ·d+164648(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+164656(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+164664(SB)/8,$"\x2d\x23\x7b\x72\x69\x67\x68\x74"
+DATA ·d+164672(SB)/8,$"\x7d\x3a\x20\x24\x6d\x61\x69\x6e"
+DATA ·d+164680(SB)/8,$"\x2d\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+164688(SB)/8,$"\x20\x2b\x20\x31\x35\x70\x78\x3b"
+DATA ·d+164696(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x20"
+DATA ·d+164704(SB)/8,$"\x20\x20\x20\x74\x61\x62\x6c\x65"
+DATA ·d+164712(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+164720(SB)/8,$"\x20\x20\x20\x74\x68\x2c\x0a\x20"
+DATA ·d+164728(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x74"
+DATA ·d+164736(SB)/8,$"\x64\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+164744(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+164752(SB)/8,$"\x74\x65\x78\x74\x2d\x61\x6c\x69"
+DATA ·d+164760(SB)/8,$"\x67\x6e\x3a\x20\x72\x69\x67\x68"
+DATA ·d+164768(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+164776(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x20"
+DATA ·d+164784(SB)/8,$"\x20\x7d\x0a\x20\x20\x20\x20\x64"
+DATA ·d+164792(SB)/8,$"\x64\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+164800(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+164808(SB)/8,$"\x69\x6e\x2d\x23\x7b\x72\x69\x67"
+DATA ·d+164816(SB)/8,$"\x68\x74\x7d\x3a\x20\x31\x35\x70"
+DATA ·d+164824(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x7d"
+DATA ·d+164832(SB)/8,$"\x0a\x20\x20\x20\x20\x61\x73\x69"
+DATA ·d+164840(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+164848(SB)/8,$"\x20\x20\x20\x20\x20\x61\x73\x69"
+DATA ·d+164856(SB)/8,$"\x64\x65\x3a\x62\x65\x66\x6f\x72"
+DATA ·d+164864(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+164872(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+164880(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x2d"
+DATA ·d+164888(SB)/8,$"\x23\x7b\x6c\x65\x66\x74\x7d\x3a"
+DATA ·d+164896(SB)/8,$"\x20\x30\x2e\x35\x65\x6d\x3b\x0a"
+DATA ·d+164904(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+164912(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+164920(SB)/8,$"\x20\x20\x2e\x73\x65\x61\x72\x63"
+DATA ·d+164928(SB)/8,$"\x68\x2d\x68\x69\x67\x68\x6c\x69"
+DATA ·d+164936(SB)/8,$"\x67\x68\x74\x20\x7b\x0a\x20\x20"
+DATA ·d+164944(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+164952(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
+DATA ·d+164960(SB)/8,$"\x6f\x75\x6e\x64\x3a\x20\x6c\x69"
+DATA ·d+164968(SB)/8,$"\x6e\x65\x61\x72\x2d\x67\x72\x61"
+DATA ·d+164976(SB)/8,$"\x64\x69\x65\x6e\x74\x28\x74\x6f"
+DATA ·d+164984(SB)/8,$"\x20\x74\x6f\x70\x20\x72\x69\x67"
+DATA ·d+164992(SB)/8,$"\x68\x74\x2c\x20\x23\x46\x37\x45"
+DATA ·d+165000(SB)/8,$"\x36\x33\x33\x20\x30\x25\x2c\x20"
+DATA ·d+165008(SB)/8,$"\x23\x46\x31\x44\x33\x32\x46\x20"
+DATA ·d+165016(SB)/8,$"\x31\x30\x30\x25\x29\x3b\x0a\x20"
+DATA ·d+165024(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x7d"
+DATA ·d+165032(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x20"
+DATA ·d+165040(SB)/8,$"\x20\x20\x20\x70\x72\x65\x2c\x0a"
+DATA ·d+165048(SB)/8,$"\x20\x20\x20\x20\x62\x6c\x6f\x63"
+DATA ·d+165056(SB)/8,$"\x6b\x71\x75\x6f\x74\x65\x20\x7b"
+DATA ·d+165064(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+165072(SB)/8,$"\x20\x66\x6c\x6f\x61\x74\x3a\x20"
+DATA ·d+165080(SB)/8,$"\x6c\x65\x66\x74\x20\x21\x69\x6d"
+DATA ·d+165088(SB)/8,$"\x70\x6f\x72\x74\x61\x6e\x74\x3b"
+DATA ·d+165096(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+165104(SB)/8,$"\x20\x63\x6c\x65\x61\x72\x3a\x20"
+DATA ·d+165112(SB)/8,$"\x6c\x65\x66\x74\x20\x21\x69\x6d"
+DATA ·d+165120(SB)/8,$"\x70\x6f\x72\x74\x61\x6e\x74\x3b"
+DATA ·d+165128(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x7d"
+DATA ·d+165136(SB)/8,$"\x0a\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165144(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165152(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165160(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165168(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165176(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165184(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165192(SB)/8,$"\x2f\x0a\x2f\x2f\x20\x43\x55\x53"
-DATA ·d+165200(SB)/8,$"\x54\x4f\x4d\x49\x5a\x45\x20\x53"
-DATA ·d+165208(SB)/8,$"\x4c\x41\x54\x45\x0a\x2f\x2f\x2f"
-DATA ·d+165216(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165224(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165232(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165192(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165200(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165208(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165216(SB)/8,$"\x2f\x2f\x0a\x2f\x2f\x20\x54\x59"
+DATA ·d+165224(SB)/8,$"\x50\x4f\x47\x52\x41\x50\x48\x59"
+DATA ·d+165232(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165240(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165248(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165256(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165264(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165272(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+165280(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165288(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x0a\x2f\x2f"
-DATA ·d+165296(SB)/8,$"\x20\x55\x73\x65\x20\x74\x68\x65"
-DATA ·d+165304(SB)/8,$"\x73\x65\x20\x73\x65\x74\x74\x69"
-DATA ·d+165312(SB)/8,$"\x6e\x67\x73\x20\x74\x6f\x20\x68"
-DATA ·d+165320(SB)/8,$"\x65\x6c\x70\x20\x61\x64\x6a\x75"
-DATA ·d+165328(SB)/8,$"\x73\x74\x20\x74\x68\x65\x20\x61"
-DATA ·d+165336(SB)/8,$"\x70\x70\x65\x61\x72\x61\x6e\x63"
-DATA ·d+165344(SB)/8,$"\x65\x20\x6f\x66\x20\x53\x6c\x61"
-DATA ·d+165352(SB)/8,$"\x74\x65\x0a\x0a\x0a\x2f\x2f\x20"
-DATA ·d+165360(SB)/8,$"\x42\x41\x43\x4b\x47\x52\x4f\x55"
-DATA ·d+165368(SB)/8,$"\x4e\x44\x20\x43\x4f\x4c\x4f\x52"
-DATA ·d+165376(SB)/8,$"\x53\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165384(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+165392(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x24"
-DATA ·d+165400(SB)/8,$"\x6e\x61\x76\x2d\x62\x67\x3a\x20"
-DATA ·d+165408(SB)/8,$"\x23\x32\x45\x33\x33\x33\x36\x20"
-DATA ·d+165416(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+165424(SB)/8,$"\x3b\x0a\x24\x65\x78\x61\x6d\x70"
-DATA ·d+165432(SB)/8,$"\x6c\x65\x73\x2d\x62\x67\x3a\x20"
-DATA ·d+165440(SB)/8,$"\x23\x32\x45\x33\x33\x33\x36\x20"
-DATA ·d+165448(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+165456(SB)/8,$"\x3b\x0a\x24\x63\x6f\x64\x65\x2d"
-DATA ·d+165464(SB)/8,$"\x62\x67\x3a\x20\x23\x31\x45\x32"
-DATA ·d+165472(SB)/8,$"\x32\x32\x34\x20\x21\x64\x65\x66"
-DATA ·d+165480(SB)/8,$"\x61\x75\x6c\x74\x3b\x0a\x24\x63"
-DATA ·d+165488(SB)/8,$"\x6f\x64\x65\x2d\x61\x6e\x6e\x6f"
-DATA ·d+165496(SB)/8,$"\x74\x61\x74\x69\x6f\x6e\x2d\x62"
-DATA ·d+165504(SB)/8,$"\x67\x3a\x20\x23\x31\x39\x31\x44"
-DATA ·d+165512(SB)/8,$"\x31\x46\x20\x21\x64\x65\x66\x61"
-DATA ·d+165520(SB)/8,$"\x75\x6c\x74\x3b\x0a\x24\x6e\x61"
-DATA ·d+165528(SB)/8,$"\x76\x2d\x73\x75\x62\x69\x74\x65"
-DATA ·d+165536(SB)/8,$"\x6d\x2d\x62\x67\x3a\x20\x23\x31"
-DATA ·d+165544(SB)/8,$"\x45\x32\x32\x32\x34\x20\x21\x64"
-DATA ·d+165552(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
-DATA ·d+165560(SB)/8,$"\x24\x6e\x61\x76\x2d\x61\x63\x74"
-DATA ·d+165568(SB)/8,$"\x69\x76\x65\x2d\x62\x67\x3a\x20"
-DATA ·d+165576(SB)/8,$"\x23\x30\x46\x37\x35\x44\x34\x20"
-DATA ·d+165584(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+165592(SB)/8,$"\x3b\x0a\x24\x6e\x61\x76\x2d\x61"
-DATA ·d+165600(SB)/8,$"\x63\x74\x69\x76\x65\x2d\x70\x61"
-DATA ·d+165608(SB)/8,$"\x72\x65\x6e\x74\x2d\x62\x67\x3a"
-DATA ·d+165616(SB)/8,$"\x20\x23\x31\x45\x32\x32\x32\x34"
-DATA ·d+165624(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
-DATA ·d+165632(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x70\x61"
-DATA ·d+165640(SB)/8,$"\x72\x65\x6e\x74\x20\x6c\x69\x6e"
-DATA ·d+165648(SB)/8,$"\x6b\x73\x20\x6f\x66\x20\x74\x68"
-DATA ·d+165656(SB)/8,$"\x65\x20\x63\x75\x72\x72\x65\x6e"
-DATA ·d+165664(SB)/8,$"\x74\x20\x73\x65\x63\x74\x69\x6f"
-DATA ·d+165672(SB)/8,$"\x6e\x0a\x24\x6c\x61\x6e\x67\x2d"
-DATA ·d+165680(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x2d\x62"
-DATA ·d+165688(SB)/8,$"\x6f\x72\x64\x65\x72\x3a\x20\x23"
-DATA ·d+165696(SB)/8,$"\x30\x30\x30\x20\x21\x64\x65\x66"
-DATA ·d+165704(SB)/8,$"\x61\x75\x6c\x74\x3b\x0a\x24\x6c"
-DATA ·d+165712(SB)/8,$"\x61\x6e\x67\x2d\x73\x65\x6c\x65"
-DATA ·d+165720(SB)/8,$"\x63\x74\x2d\x62\x67\x3a\x20\x23"
-DATA ·d+165728(SB)/8,$"\x31\x45\x32\x32\x32\x34\x20\x21"
-DATA ·d+165736(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
-DATA ·d+165744(SB)/8,$"\x0a\x24\x6c\x61\x6e\x67\x2d\x73"
-DATA ·d+165752(SB)/8,$"\x65\x6c\x65\x63\x74\x2d\x61\x63"
-DATA ·d+165760(SB)/8,$"\x74\x69\x76\x65\x2d\x62\x67\x3a"
-DATA ·d+165768(SB)/8,$"\x20\x24\x65\x78\x61\x6d\x70\x6c"
-DATA ·d+165776(SB)/8,$"\x65\x73\x2d\x62\x67\x20\x21\x64"
-DATA ·d+165784(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x20"
-DATA ·d+165792(SB)/8,$"\x2f\x2f\x20\x66\x65\x65\x6c\x20"
-DATA ·d+165800(SB)/8,$"\x66\x72\x65\x65\x20\x74\x6f\x20"
-DATA ·d+165808(SB)/8,$"\x63\x68\x61\x6e\x67\x65\x20\x74"
-DATA ·d+165816(SB)/8,$"\x68\x69\x73\x20\x74\x6f\x20\x62"
-DATA ·d+165824(SB)/8,$"\x6c\x75\x65\x20\x6f\x72\x20\x73"
-DATA ·d+165832(SB)/8,$"\x6f\x6d\x65\x74\x68\x69\x6e\x67"
-DATA ·d+165840(SB)/8,$"\x0a\x24\x6c\x61\x6e\x67\x2d\x73"
-DATA ·d+165848(SB)/8,$"\x65\x6c\x65\x63\x74\x2d\x70\x72"
-DATA ·d+165856(SB)/8,$"\x65\x73\x73\x65\x64\x2d\x62\x67"
-DATA ·d+165864(SB)/8,$"\x3a\x20\x23\x31\x31\x31\x20\x21"
-DATA ·d+165872(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
-DATA ·d+165880(SB)/8,$"\x20\x2f\x2f\x20\x63\x6f\x6c\x6f"
-DATA ·d+165888(SB)/8,$"\x72\x20\x6f\x66\x20\x6c\x61\x6e"
-DATA ·d+165896(SB)/8,$"\x67\x75\x61\x67\x65\x20\x74\x61"
-DATA ·d+165904(SB)/8,$"\x62\x20\x62\x67\x20\x77\x68\x65"
-DATA ·d+165912(SB)/8,$"\x6e\x20\x6d\x6f\x75\x73\x65\x20"
-DATA ·d+165920(SB)/8,$"\x69\x73\x20\x70\x72\x65\x73\x73"
-DATA ·d+165928(SB)/8,$"\x65\x64\x0a\x24\x6d\x61\x69\x6e"
-DATA ·d+165936(SB)/8,$"\x2d\x62\x67\x3a\x20\x23\x46\x33"
-DATA ·d+165944(SB)/8,$"\x46\x37\x46\x39\x20\x21\x64\x65"
-DATA ·d+165952(SB)/8,$"\x66\x61\x75\x6c\x74\x3b\x0a\x24"
-DATA ·d+165960(SB)/8,$"\x61\x73\x69\x64\x65\x2d\x6e\x6f"
-DATA ·d+165968(SB)/8,$"\x74\x69\x63\x65\x2d\x62\x67\x3a"
-DATA ·d+165976(SB)/8,$"\x20\x23\x38\x66\x62\x63\x64\x34"
-DATA ·d+165984(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
-DATA ·d+165992(SB)/8,$"\x74\x3b\x0a\x24\x61\x73\x69\x64"
-DATA ·d+166000(SB)/8,$"\x65\x2d\x77\x61\x72\x6e\x69\x6e"
-DATA ·d+166008(SB)/8,$"\x67\x2d\x62\x67\x3a\x20\x23\x63"
-DATA ·d+166016(SB)/8,$"\x39\x37\x61\x37\x65\x20\x21\x64"
-DATA ·d+166024(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
-DATA ·d+166032(SB)/8,$"\x24\x61\x73\x69\x64\x65\x2d\x73"
-DATA ·d+166040(SB)/8,$"\x75\x63\x63\x65\x73\x73\x2d\x62"
-DATA ·d+166048(SB)/8,$"\x67\x3a\x20\x23\x36\x61\x63\x31"
-DATA ·d+166056(SB)/8,$"\x37\x34\x20\x21\x64\x65\x66\x61"
-DATA ·d+166064(SB)/8,$"\x75\x6c\x74\x3b\x0a\x24\x73\x65"
-DATA ·d+166072(SB)/8,$"\x61\x72\x63\x68\x2d\x6e\x6f\x74"
-DATA ·d+166080(SB)/8,$"\x69\x63\x65\x2d\x62\x67\x3a\x20"
-DATA ·d+166088(SB)/8,$"\x23\x63\x39\x37\x61\x37\x65\x20"
-DATA ·d+166096(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+166104(SB)/8,$"\x3b\x0a\x0a\x0a\x2f\x2f\x20\x54"
-DATA ·d+166112(SB)/8,$"\x45\x58\x54\x20\x43\x4f\x4c\x4f"
-DATA ·d+166120(SB)/8,$"\x52\x53\x0a\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+166128(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+166136(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
-DATA ·d+166144(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x74\x65"
-DATA ·d+166152(SB)/8,$"\x78\x74\x3a\x20\x23\x33\x33\x33"
-DATA ·d+166160(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
-DATA ·d+166168(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x6d\x61"
-DATA ·d+166176(SB)/8,$"\x69\x6e\x20\x63\x6f\x6e\x74\x65"
-DATA ·d+166184(SB)/8,$"\x6e\x74\x20\x74\x65\x78\x74\x20"
-DATA ·d+166192(SB)/8,$"\x63\x6f\x6c\x6f\x72\x0a\x24\x6e"
-DATA ·d+166200(SB)/8,$"\x61\x76\x2d\x74\x65\x78\x74\x3a"
-DATA ·d+166208(SB)/8,$"\x20\x23\x66\x66\x66\x20\x21\x64"
-DATA ·d+166216(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
-DATA ·d+166224(SB)/8,$"\x24\x6e\x61\x76\x2d\x61\x63\x74"
-DATA ·d+166232(SB)/8,$"\x69\x76\x65\x2d\x74\x65\x78\x74"
-DATA ·d+166240(SB)/8,$"\x3a\x20\x23\x66\x66\x66\x20\x21"
-DATA ·d+166248(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
-DATA ·d+166256(SB)/8,$"\x0a\x24\x6e\x61\x76\x2d\x61\x63"
-DATA ·d+166264(SB)/8,$"\x74\x69\x76\x65\x2d\x70\x61\x72"
-DATA ·d+166272(SB)/8,$"\x65\x6e\x74\x2d\x74\x65\x78\x74"
-DATA ·d+166280(SB)/8,$"\x3a\x20\x23\x66\x66\x66\x20\x21"
-DATA ·d+166288(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
-DATA ·d+166296(SB)/8,$"\x20\x2f\x2f\x20\x70\x61\x72\x65"
-DATA ·d+166304(SB)/8,$"\x6e\x74\x20\x6c\x69\x6e\x6b\x73"
-DATA ·d+166312(SB)/8,$"\x20\x6f\x66\x20\x74\x68\x65\x20"
-DATA ·d+166320(SB)/8,$"\x63\x75\x72\x72\x65\x6e\x74\x20"
-DATA ·d+166328(SB)/8,$"\x73\x65\x63\x74\x69\x6f\x6e\x0a"
-DATA ·d+166336(SB)/8,$"\x24\x6c\x61\x6e\x67\x2d\x73\x65"
-DATA ·d+166344(SB)/8,$"\x6c\x65\x63\x74\x2d\x74\x65\x78"
-DATA ·d+166352(SB)/8,$"\x74\x3a\x20\x23\x66\x66\x66\x20"
-DATA ·d+166360(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+166368(SB)/8,$"\x3b\x20\x2f\x2f\x20\x63\x6f\x6c"
-DATA ·d+166376(SB)/8,$"\x6f\x72\x20\x6f\x66\x20\x75\x6e"
-DATA ·d+166384(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x65\x64"
-DATA ·d+166392(SB)/8,$"\x20\x6c\x61\x6e\x67\x75\x61\x67"
-DATA ·d+166400(SB)/8,$"\x65\x20\x74\x61\x62\x20\x74\x65"
-DATA ·d+166408(SB)/8,$"\x78\x74\x0a\x24\x6c\x61\x6e\x67"
-DATA ·d+166416(SB)/8,$"\x2d\x73\x65\x6c\x65\x63\x74\x2d"
-DATA ·d+166424(SB)/8,$"\x61\x63\x74\x69\x76\x65\x2d\x74"
-DATA ·d+166432(SB)/8,$"\x65\x78\x74\x3a\x20\x23\x66\x66"
-DATA ·d+166440(SB)/8,$"\x66\x20\x21\x64\x65\x66\x61\x75"
-DATA ·d+166448(SB)/8,$"\x6c\x74\x3b\x20\x2f\x2f\x20\x63"
-DATA ·d+166456(SB)/8,$"\x6f\x6c\x6f\x72\x20\x6f\x66\x20"
-DATA ·d+166464(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x65\x64"
-DATA ·d+166472(SB)/8,$"\x20\x6c\x61\x6e\x67\x75\x61\x67"
-DATA ·d+166480(SB)/8,$"\x65\x20\x74\x61\x62\x20\x74\x65"
-DATA ·d+166488(SB)/8,$"\x78\x74\x0a\x24\x6c\x61\x6e\x67"
-DATA ·d+166496(SB)/8,$"\x2d\x73\x65\x6c\x65\x63\x74\x2d"
-DATA ·d+166504(SB)/8,$"\x70\x72\x65\x73\x73\x65\x64\x2d"
-DATA ·d+166512(SB)/8,$"\x74\x65\x78\x74\x3a\x20\x23\x66"
-DATA ·d+166520(SB)/8,$"\x66\x66\x20\x21\x64\x65\x66\x61"
-DATA ·d+166528(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
-DATA ·d+166536(SB)/8,$"\x63\x6f\x6c\x6f\x72\x20\x6f\x66"
-DATA ·d+166544(SB)/8,$"\x20\x6c\x61\x6e\x67\x75\x61\x67"
-DATA ·d+166552(SB)/8,$"\x65\x20\x74\x61\x62\x20\x74\x65"
-DATA ·d+166560(SB)/8,$"\x78\x74\x20\x77\x68\x65\x6e\x20"
-DATA ·d+166568(SB)/8,$"\x6d\x6f\x75\x73\x65\x20\x69\x73"
-DATA ·d+166576(SB)/8,$"\x20\x70\x72\x65\x73\x73\x65\x64"
-DATA ·d+166584(SB)/8,$"\x0a\x0a\x0a\x2f\x2f\x20\x53\x49"
-DATA ·d+166592(SB)/8,$"\x5a\x45\x53\x0a\x2f\x2f\x2f\x2f"
+DATA ·d+165288(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165296(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165304(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165312(SB)/8,$"\x2f\x0a\x68\x31\x2c\x0a\x68\x32"
+DATA ·d+165320(SB)/8,$"\x2c\x0a\x68\x33\x2c\x0a\x68\x34"
+DATA ·d+165328(SB)/8,$"\x2c\x0a\x68\x35\x2c\x0a\x68\x36"
+DATA ·d+165336(SB)/8,$"\x2c\x0a\x70\x2c\x0a\x61\x73\x69"
+DATA ·d+165344(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+165352(SB)/8,$"\x20\x74\x65\x78\x74\x2d\x61\x6c"
+DATA ·d+165360(SB)/8,$"\x69\x67\x6e\x3a\x20\x72\x69\x67"
+DATA ·d+165368(SB)/8,$"\x68\x74\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+165376(SB)/8,$"\x64\x69\x72\x65\x63\x74\x69\x6f"
+DATA ·d+165384(SB)/8,$"\x6e\x3a\x20\x72\x74\x6c\x3b\x0a"
+DATA ·d+165392(SB)/8,$"\x7d\x0a\x0a\x2e\x74\x6f\x63\x2d"
+DATA ·d+165400(SB)/8,$"\x77\x72\x61\x70\x70\x65\x72\x20"
+DATA ·d+165408(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x74\x65"
+DATA ·d+165416(SB)/8,$"\x78\x74\x2d\x61\x6c\x69\x67\x6e"
+DATA ·d+165424(SB)/8,$"\x3a\x20\x72\x69\x67\x68\x74\x3b"
+DATA ·d+165432(SB)/8,$"\x0a\x20\x20\x20\x20\x64\x69\x72"
+DATA ·d+165440(SB)/8,$"\x65\x63\x74\x69\x6f\x6e\x3a\x20"
+DATA ·d+165448(SB)/8,$"\x72\x74\x6c\x3b\x0a\x20\x20\x20"
+DATA ·d+165456(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x77\x65"
+DATA ·d+165464(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x31\x30"
+DATA ·d+165472(SB)/8,$"\x30\x20\x21\x69\x6d\x70\x6f\x72"
+DATA ·d+165480(SB)/8,$"\x74\x61\x6e\x74\x3b\x0a\x7d\x0a"
+DATA ·d+165488(SB)/8,$"\x0a\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165504(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165536(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165544(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165552(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165560(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165568(SB)/8,$"\x2f\x2f\x0a\x2f\x2f\x20\x52\x45"
+DATA ·d+165576(SB)/8,$"\x53\x50\x4f\x4e\x53\x49\x56\x45"
+DATA ·d+165584(SB)/8,$"\x20\x44\x45\x53\x49\x47\x4e\x0a"
+DATA ·d+165592(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165600(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165608(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165616(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165624(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165632(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165664(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+165672(SB)/8,$"\x0a\x40\x6d\x65\x64\x69\x61\x20"
+DATA ·d+165680(SB)/8,$"\x28\x6d\x61\x78\x2d\x77\x69\x64"
+DATA ·d+165688(SB)/8,$"\x74\x68\x3a\x20\x24\x74\x61\x62"
+DATA ·d+165696(SB)/8,$"\x6c\x65\x74\x2d\x77\x69\x64\x74"
+DATA ·d+165704(SB)/8,$"\x68\x29\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+165712(SB)/8,$"\x20\x2e\x74\x6f\x63\x2d\x77\x72"
+DATA ·d+165720(SB)/8,$"\x61\x70\x70\x65\x72\x20\x7b\x0a"
+DATA ·d+165728(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+165736(SB)/8,$"\x23\x7b\x72\x69\x67\x68\x74\x7d"
+DATA ·d+165744(SB)/8,$"\x3a\x20\x2d\x24\x6e\x61\x76\x2d"
+DATA ·d+165752(SB)/8,$"\x77\x69\x64\x74\x68\x3b\x0a\x20"
+DATA ·d+165760(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x26"
+DATA ·d+165768(SB)/8,$"\x2e\x6f\x70\x65\x6e\x20\x7b\x0a"
+DATA ·d+165776(SB)/8,$"\x20\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+165784(SB)/8,$"\x20\x20\x20\x20\x23\x7b\x72\x69"
+DATA ·d+165792(SB)/8,$"\x67\x68\x74\x7d\x3a\x20\x30\x3b"
+DATA ·d+165800(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+165808(SB)/8,$"\x20\x7d\x0a\x20\x20\x20\x20\x7d"
+DATA ·d+165816(SB)/8,$"\x0a\x20\x20\x20\x20\x2e\x70\x61"
+DATA ·d+165824(SB)/8,$"\x67\x65\x2d\x77\x72\x61\x70\x70"
+DATA ·d+165832(SB)/8,$"\x65\x72\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+165840(SB)/8,$"\x20\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+165848(SB)/8,$"\x67\x69\x6e\x2d\x23\x7b\x72\x69"
+DATA ·d+165856(SB)/8,$"\x67\x68\x74\x7d\x3a\x20\x30\x3b"
+DATA ·d+165864(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x7d"
+DATA ·d+165872(SB)/8,$"\x0a\x0a\x40\x6d\x65\x64\x69\x61"
+DATA ·d+165880(SB)/8,$"\x20\x28\x6d\x61\x78\x2d\x77\x69"
+DATA ·d+165888(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x70\x68"
+DATA ·d+165896(SB)/8,$"\x6f\x6e\x65\x2d\x77\x69\x64\x74"
+DATA ·d+165904(SB)/8,$"\x68\x29\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+165912(SB)/8,$"\x20\x25\x6c\x65\x66\x74\x2d\x63"
+DATA ·d+165920(SB)/8,$"\x6f\x6c\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+165928(SB)/8,$"\x20\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+165936(SB)/8,$"\x67\x69\x6e\x2d\x23\x7b\x6c\x65"
+DATA ·d+165944(SB)/8,$"\x66\x74\x7d\x3a\x20\x30\x3b\x0a"
+DATA ·d+165952(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x7d\x0a"
+DATA ·d+165960(SB)/8,$"\x2f\x2a\x0a\x43\x6f\x70\x79\x72"
+DATA ·d+165968(SB)/8,$"\x69\x67\x68\x74\x20\x32\x30\x30"
+DATA ·d+165976(SB)/8,$"\x38\x2d\x32\x30\x31\x33\x20\x43"
+DATA ·d+165984(SB)/8,$"\x6f\x6e\x63\x75\x72\x20\x54\x65"
+DATA ·d+165992(SB)/8,$"\x63\x68\x6e\x6f\x6c\x6f\x67\x69"
+DATA ·d+166000(SB)/8,$"\x65\x73\x2c\x20\x49\x6e\x63\x2e"
+DATA ·d+166008(SB)/8,$"\x0a\x0a\x4c\x69\x63\x65\x6e\x73"
+DATA ·d+166016(SB)/8,$"\x65\x64\x20\x75\x6e\x64\x65\x72"
+DATA ·d+166024(SB)/8,$"\x20\x74\x68\x65\x20\x41\x70\x61"
+DATA ·d+166032(SB)/8,$"\x63\x68\x65\x20\x4c\x69\x63\x65"
+DATA ·d+166040(SB)/8,$"\x6e\x73\x65\x2c\x20\x56\x65\x72"
+DATA ·d+166048(SB)/8,$"\x73\x69\x6f\x6e\x20\x32\x2e\x30"
+DATA ·d+166056(SB)/8,$"\x20\x28\x74\x68\x65\x20\x22\x4c"
+DATA ·d+166064(SB)/8,$"\x69\x63\x65\x6e\x73\x65\x22\x29"
+DATA ·d+166072(SB)/8,$"\x3b\x20\x79\x6f\x75\x20\x6d\x61"
+DATA ·d+166080(SB)/8,$"\x79\x0a\x6e\x6f\x74\x20\x75\x73"
+DATA ·d+166088(SB)/8,$"\x65\x20\x74\x68\x69\x73\x20\x66"
+DATA ·d+166096(SB)/8,$"\x69\x6c\x65\x20\x65\x78\x63\x65"
+DATA ·d+166104(SB)/8,$"\x70\x74\x20\x69\x6e\x20\x63\x6f"
+DATA ·d+166112(SB)/8,$"\x6d\x70\x6c\x69\x61\x6e\x63\x65"
+DATA ·d+166120(SB)/8,$"\x20\x77\x69\x74\x68\x20\x74\x68"
+DATA ·d+166128(SB)/8,$"\x65\x20\x4c\x69\x63\x65\x6e\x73"
+DATA ·d+166136(SB)/8,$"\x65\x2e\x20\x59\x6f\x75\x20\x6d"
+DATA ·d+166144(SB)/8,$"\x61\x79\x20\x6f\x62\x74\x61\x69"
+DATA ·d+166152(SB)/8,$"\x6e\x0a\x61\x20\x63\x6f\x70\x79"
+DATA ·d+166160(SB)/8,$"\x20\x6f\x66\x20\x74\x68\x65\x20"
+DATA ·d+166168(SB)/8,$"\x4c\x69\x63\x65\x6e\x73\x65\x20"
+DATA ·d+166176(SB)/8,$"\x61\x74\x0a\x0a\x20\x20\x68\x74"
+DATA ·d+166184(SB)/8,$"\x74\x70\x3a\x2f\x2f\x77\x77\x77"
+DATA ·d+166192(SB)/8,$"\x2e\x61\x70\x61\x63\x68\x65\x2e"
+DATA ·d+166200(SB)/8,$"\x6f\x72\x67\x2f\x6c\x69\x63\x65"
+DATA ·d+166208(SB)/8,$"\x6e\x73\x65\x73\x2f\x4c\x49\x43"
+DATA ·d+166216(SB)/8,$"\x45\x4e\x53\x45\x2d\x32\x2e\x30"
+DATA ·d+166224(SB)/8,$"\x0a\x0a\x55\x6e\x6c\x65\x73\x73"
+DATA ·d+166232(SB)/8,$"\x20\x72\x65\x71\x75\x69\x72\x65"
+DATA ·d+166240(SB)/8,$"\x64\x20\x62\x79\x20\x61\x70\x70"
+DATA ·d+166248(SB)/8,$"\x6c\x69\x63\x61\x62\x6c\x65\x20"
+DATA ·d+166256(SB)/8,$"\x6c\x61\x77\x20\x6f\x72\x20\x61"
+DATA ·d+166264(SB)/8,$"\x67\x72\x65\x65\x64\x20\x74\x6f"
+DATA ·d+166272(SB)/8,$"\x20\x69\x6e\x20\x77\x72\x69\x74"
+DATA ·d+166280(SB)/8,$"\x69\x6e\x67\x2c\x20\x73\x6f\x66"
+DATA ·d+166288(SB)/8,$"\x74\x77\x61\x72\x65\x0a\x64\x69"
+DATA ·d+166296(SB)/8,$"\x73\x74\x72\x69\x62\x75\x74\x65"
+DATA ·d+166304(SB)/8,$"\x64\x20\x75\x6e\x64\x65\x72\x20"
+DATA ·d+166312(SB)/8,$"\x74\x68\x65\x20\x4c\x69\x63\x65"
+DATA ·d+166320(SB)/8,$"\x6e\x73\x65\x20\x69\x73\x20\x64"
+DATA ·d+166328(SB)/8,$"\x69\x73\x74\x72\x69\x62\x75\x74"
+DATA ·d+166336(SB)/8,$"\x65\x64\x20\x6f\x6e\x20\x61\x6e"
+DATA ·d+166344(SB)/8,$"\x20\x22\x41\x53\x20\x49\x53\x22"
+DATA ·d+166352(SB)/8,$"\x20\x42\x41\x53\x49\x53\x2c\x20"
+DATA ·d+166360(SB)/8,$"\x57\x49\x54\x48\x4f\x55\x54\x0a"
+DATA ·d+166368(SB)/8,$"\x57\x41\x52\x52\x41\x4e\x54\x49"
+DATA ·d+166376(SB)/8,$"\x45\x53\x20\x4f\x52\x20\x43\x4f"
+DATA ·d+166384(SB)/8,$"\x4e\x44\x49\x54\x49\x4f\x4e\x53"
+DATA ·d+166392(SB)/8,$"\x20\x4f\x46\x20\x41\x4e\x59\x20"
+DATA ·d+166400(SB)/8,$"\x4b\x49\x4e\x44\x2c\x20\x65\x69"
+DATA ·d+166408(SB)/8,$"\x74\x68\x65\x72\x20\x65\x78\x70"
+DATA ·d+166416(SB)/8,$"\x72\x65\x73\x73\x20\x6f\x72\x20"
+DATA ·d+166424(SB)/8,$"\x69\x6d\x70\x6c\x69\x65\x64\x2e"
+DATA ·d+166432(SB)/8,$"\x20\x53\x65\x65\x20\x74\x68\x65"
+DATA ·d+166440(SB)/8,$"\x0a\x4c\x69\x63\x65\x6e\x73\x65"
+DATA ·d+166448(SB)/8,$"\x20\x66\x6f\x72\x20\x74\x68\x65"
+DATA ·d+166456(SB)/8,$"\x20\x73\x70\x65\x63\x69\x66\x69"
+DATA ·d+166464(SB)/8,$"\x63\x20\x6c\x61\x6e\x67\x75\x61"
+DATA ·d+166472(SB)/8,$"\x67\x65\x20\x67\x6f\x76\x65\x72"
+DATA ·d+166480(SB)/8,$"\x6e\x69\x6e\x67\x20\x70\x65\x72"
+DATA ·d+166488(SB)/8,$"\x6d\x69\x73\x73\x69\x6f\x6e\x73"
+DATA ·d+166496(SB)/8,$"\x20\x61\x6e\x64\x20\x6c\x69\x6d"
+DATA ·d+166504(SB)/8,$"\x69\x74\x61\x74\x69\x6f\x6e\x73"
+DATA ·d+166512(SB)/8,$"\x0a\x75\x6e\x64\x65\x72\x20\x74"
+DATA ·d+166520(SB)/8,$"\x68\x65\x20\x4c\x69\x63\x65\x6e"
+DATA ·d+166528(SB)/8,$"\x73\x65\x2e\x0a\x2a\x2f\x0a\x0a"
+DATA ·d+166536(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166544(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166552(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166560(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166568(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166576(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166584(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166592(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+166600(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
 DATA ·d+166608(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+166616(SB)/8,$"\x0a\x24\x6e\x61\x76\x2d\x77\x69"
-DATA ·d+166624(SB)/8,$"\x64\x74\x68\x3a\x20\x32\x33\x30"
-DATA ·d+166632(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
-DATA ·d+166640(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
-DATA ·d+166648(SB)/8,$"\x77\x69\x64\x74\x68\x20\x6f\x66"
-DATA ·d+166656(SB)/8,$"\x20\x74\x68\x65\x20\x6e\x61\x76"
-DATA ·d+166664(SB)/8,$"\x62\x61\x72\x0a\x24\x65\x78\x61"
-DATA ·d+166672(SB)/8,$"\x6d\x70\x6c\x65\x73\x2d\x77\x69"
-DATA ·d+166680(SB)/8,$"\x64\x74\x68\x3a\x20\x35\x30\x25"
-DATA ·d+166688(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
-DATA ·d+166696(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x70\x6f"
-DATA ·d+166704(SB)/8,$"\x72\x74\x69\x6f\x6e\x20\x6f\x66"
-DATA ·d+166712(SB)/8,$"\x20\x74\x68\x65\x20\x73\x63\x72"
-DATA ·d+166720(SB)/8,$"\x65\x65\x6e\x20\x74\x61\x6b\x65"
-DATA ·d+166728(SB)/8,$"\x6e\x20\x75\x70\x20\x62\x79\x20"
-DATA ·d+166736(SB)/8,$"\x63\x6f\x64\x65\x20\x65\x78\x61"
-DATA ·d+166744(SB)/8,$"\x6d\x70\x6c\x65\x73\x0a\x24\x6c"
-DATA ·d+166752(SB)/8,$"\x6f\x67\x6f\x2d\x6d\x61\x72\x67"
-DATA ·d+166760(SB)/8,$"\x69\x6e\x3a\x20\x30\x70\x78\x20"
-DATA ·d+166768(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+166776(SB)/8,$"\x3b\x20\x2f\x2f\x20\x6d\x61\x72"
-DATA ·d+166784(SB)/8,$"\x67\x69\x6e\x20\x62\x65\x6c\x6f"
-DATA ·d+166792(SB)/8,$"\x77\x20\x6c\x6f\x67\x6f\x0a\x24"
-DATA ·d+166800(SB)/8,$"\x6d\x61\x69\x6e\x2d\x70\x61\x64"
-DATA ·d+166808(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x32\x38"
-DATA ·d+166816(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
-DATA ·d+166824(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
-DATA ·d+166832(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
-DATA ·d+166840(SB)/8,$"\x74\x6f\x20\x6c\x65\x66\x74\x20"
-DATA ·d+166848(SB)/8,$"\x61\x6e\x64\x20\x72\x69\x67\x68"
-DATA ·d+166856(SB)/8,$"\x74\x20\x6f\x66\x20\x63\x6f\x6e"
-DATA ·d+166864(SB)/8,$"\x74\x65\x6e\x74\x20\x26\x20\x65"
-DATA ·d+166872(SB)/8,$"\x78\x61\x6d\x70\x6c\x65\x73\x0a"
-DATA ·d+166880(SB)/8,$"\x24\x6e\x61\x76\x2d\x70\x61\x64"
-DATA ·d+166888(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x31\x35"
-DATA ·d+166896(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
-DATA ·d+166904(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
-DATA ·d+166912(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
-DATA ·d+166920(SB)/8,$"\x74\x6f\x20\x6c\x65\x66\x74\x20"
-DATA ·d+166928(SB)/8,$"\x61\x6e\x64\x20\x72\x69\x67\x68"
-DATA ·d+166936(SB)/8,$"\x74\x20\x6f\x66\x20\x6e\x61\x76"
-DATA ·d+166944(SB)/8,$"\x62\x61\x72\x0a\x24\x6e\x61\x76"
-DATA ·d+166952(SB)/8,$"\x2d\x76\x2d\x70\x61\x64\x64\x69"
-DATA ·d+166960(SB)/8,$"\x6e\x67\x3a\x20\x31\x30\x70\x78"
-DATA ·d+166968(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
-DATA ·d+166976(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x70\x61"
-DATA ·d+166984(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x75\x73"
-DATA ·d+166992(SB)/8,$"\x65\x64\x20\x76\x65\x72\x74\x69"
-DATA ·d+167000(SB)/8,$"\x63\x61\x6c\x6c\x79\x20\x61\x72"
-DATA ·d+167008(SB)/8,$"\x6f\x75\x6e\x64\x20\x73\x65\x61"
-DATA ·d+167016(SB)/8,$"\x72\x63\x68\x20\x62\x6f\x78\x65"
-DATA ·d+167024(SB)/8,$"\x73\x20\x61\x6e\x64\x20\x72\x65"
-DATA ·d+167032(SB)/8,$"\x73\x75\x6c\x74\x73\x0a\x24\x6e"
-DATA ·d+167040(SB)/8,$"\x61\x76\x2d\x69\x6e\x64\x65\x6e"
-DATA ·d+167048(SB)/8,$"\x74\x3a\x20\x31\x30\x70\x78\x20"
-DATA ·d+167056(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+167064(SB)/8,$"\x3b\x20\x2f\x2f\x20\x65\x78\x74"
-DATA ·d+167072(SB)/8,$"\x72\x61\x20\x70\x61\x64\x64\x69"
-DATA ·d+167080(SB)/8,$"\x6e\x67\x20\x66\x6f\x72\x20\x54"
-DATA ·d+167088(SB)/8,$"\x6f\x43\x20\x73\x75\x62\x69\x74"
-DATA ·d+167096(SB)/8,$"\x65\x6d\x73\x0a\x24\x63\x6f\x64"
-DATA ·d+167104(SB)/8,$"\x65\x2d\x61\x6e\x6e\x6f\x74\x61"
-DATA ·d+167112(SB)/8,$"\x74\x69\x6f\x6e\x2d\x70\x61\x64"
-DATA ·d+167120(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x31\x33"
-DATA ·d+167128(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
-DATA ·d+167136(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
-DATA ·d+167144(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
-DATA ·d+167152(SB)/8,$"\x69\x6e\x73\x69\x64\x65\x20\x63"
-DATA ·d+167160(SB)/8,$"\x6f\x64\x65\x20\x61\x6e\x6e\x6f"
-DATA ·d+167168(SB)/8,$"\x74\x61\x74\x69\x6f\x6e\x73\x0a"
-DATA ·d+167176(SB)/8,$"\x24\x68\x31\x2d\x6d\x61\x72\x67"
-DATA ·d+167184(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
-DATA ·d+167192(SB)/8,$"\x6d\x3a\x20\x32\x31\x70\x78\x20"
-DATA ·d+167200(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+167208(SB)/8,$"\x3b\x20\x2f\x2f\x20\x70\x61\x64"
-DATA ·d+167216(SB)/8,$"\x64\x69\x6e\x67\x20\x75\x6e\x64"
-DATA ·d+167224(SB)/8,$"\x65\x72\x20\x74\x68\x65\x20\x6c"
-DATA ·d+167232(SB)/8,$"\x61\x72\x67\x65\x73\x74\x20\x68"
-DATA ·d+167240(SB)/8,$"\x65\x61\x64\x65\x72\x20\x74\x61"
-DATA ·d+167248(SB)/8,$"\x67\x73\x0a\x24\x74\x61\x62\x6c"
-DATA ·d+167256(SB)/8,$"\x65\x74\x2d\x77\x69\x64\x74\x68"
-DATA ·d+167264(SB)/8,$"\x3a\x20\x39\x33\x30\x70\x78\x20"
-DATA ·d+167272(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+167280(SB)/8,$"\x3b\x20\x2f\x2f\x20\x6d\x69\x6e"
-DATA ·d+167288(SB)/8,$"\x20\x77\x69\x64\x74\x68\x20\x62"
-DATA ·d+167296(SB)/8,$"\x65\x66\x6f\x72\x65\x20\x72\x65"
-DATA ·d+167304(SB)/8,$"\x76\x65\x72\x74\x69\x6e\x67\x20"
-DATA ·d+167312(SB)/8,$"\x74\x6f\x20\x74\x61\x62\x6c\x65"
-DATA ·d+167320(SB)/8,$"\x74\x20\x73\x69\x7a\x65\x0a\x24"
-DATA ·d+167328(SB)/8,$"\x70\x68\x6f\x6e\x65\x2d\x77\x69"
-DATA ·d+167336(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x74\x61"
-DATA ·d+167344(SB)/8,$"\x62\x6c\x65\x74\x2d\x77\x69\x64"
-DATA ·d+167352(SB)/8,$"\x74\x68\x20\x2d\x20\x24\x6e\x61"
-DATA ·d+167360(SB)/8,$"\x76\x2d\x77\x69\x64\x74\x68\x20"
-DATA ·d+167368(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
-DATA ·d+167376(SB)/8,$"\x3b\x20\x2f\x2f\x20\x6d\x69\x6e"
-DATA ·d+167384(SB)/8,$"\x20\x77\x69\x64\x74\x68\x20\x62"
-DATA ·d+167392(SB)/8,$"\x65\x66\x6f\x72\x65\x20\x72\x65"
-DATA ·d+167400(SB)/8,$"\x76\x65\x72\x74\x69\x6e\x67\x20"
-DATA ·d+167408(SB)/8,$"\x74\x6f\x20\x6d\x6f\x62\x69\x6c"
-DATA ·d+167416(SB)/8,$"\x65\x20\x73\x69\x7a\x65\x0a\x0a"
-DATA ·d+167424(SB)/8,$"\x0a\x2f\x2f\x20\x46\x4f\x4e\x54"
-DATA ·d+167432(SB)/8,$"\x53\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+167440(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+167448(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x25"
-DATA ·d+167456(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x2d"
-DATA ·d+167464(SB)/8,$"\x66\x6f\x6e\x74\x20\x7b\x0a\x20"
-DATA ·d+167472(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x66\x61"
-DATA ·d+167480(SB)/8,$"\x6d\x69\x6c\x79\x3a\x20\x2d\x61"
-DATA ·d+167488(SB)/8,$"\x70\x70\x6c\x65\x2d\x73\x79\x73"
-DATA ·d+167496(SB)/8,$"\x74\x65\x6d\x2c\x20\x42\x6c\x69"
-DATA ·d+167504(SB)/8,$"\x6e\x6b\x4d\x61\x63\x53\x79\x73"
-DATA ·d+167512(SB)/8,$"\x74\x65\x6d\x46\x6f\x6e\x74\x2c"
-DATA ·d+167520(SB)/8,$"\x20\x22\x53\x65\x67\x6f\x65\x20"
-DATA ·d+167528(SB)/8,$"\x55\x49\x22\x2c\x20\x52\x6f\x62"
-DATA ·d+167536(SB)/8,$"\x6f\x74\x6f\x2c\x20\x48\x65\x6c"
-DATA ·d+167544(SB)/8,$"\x76\x65\x74\x69\x63\x61\x2c\x20"
-DATA ·d+167552(SB)/8,$"\x41\x72\x69\x61\x6c\x2c\x20\x73"
-DATA ·d+167560(SB)/8,$"\x61\x6e\x73\x2d\x73\x65\x72\x69"
-DATA ·d+167568(SB)/8,$"\x66\x2c\x20\x22\x41\x70\x70\x6c"
-DATA ·d+167576(SB)/8,$"\x65\x20\x43\x6f\x6c\x6f\x72\x20"
-DATA ·d+167584(SB)/8,$"\x45\x6d\x6f\x6a\x69\x22\x2c\x20"
-DATA ·d+167592(SB)/8,$"\x22\x53\x65\x67\x6f\x65\x20\x55"
-DATA ·d+167600(SB)/8,$"\x49\x20\x45\x6d\x6f\x6a\x69\x22"
-DATA ·d+167608(SB)/8,$"\x2c\x20\x22\x53\x65\x67\x6f\x65"
-DATA ·d+167616(SB)/8,$"\x20\x55\x49\x20\x53\x79\x6d\x62"
-DATA ·d+167624(SB)/8,$"\x6f\x6c\x22\x3b\x0a\x20\x20\x66"
-DATA ·d+167632(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
-DATA ·d+167640(SB)/8,$"\x3a\x20\x31\x34\x70\x78\x3b\x0a"
-DATA ·d+167648(SB)/8,$"\x7d\x0a\x0a\x25\x68\x65\x61\x64"
-DATA ·d+167656(SB)/8,$"\x65\x72\x2d\x66\x6f\x6e\x74\x20"
-DATA ·d+167664(SB)/8,$"\x7b\x0a\x20\x20\x40\x65\x78\x74"
-DATA ·d+167672(SB)/8,$"\x65\x6e\x64\x20\x25\x64\x65\x66"
-DATA ·d+167680(SB)/8,$"\x61\x75\x6c\x74\x2d\x66\x6f\x6e"
-DATA ·d+167688(SB)/8,$"\x74\x3b\x0a\x20\x20\x66\x6f\x6e"
-DATA ·d+167696(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
-DATA ·d+167704(SB)/8,$"\x3a\x20\x62\x6f\x6c\x64\x3b\x0a"
-DATA ·d+167712(SB)/8,$"\x7d\x0a\x0a\x25\x63\x6f\x64\x65"
-DATA ·d+167720(SB)/8,$"\x2d\x66\x6f\x6e\x74\x20\x7b\x0a"
-DATA ·d+167728(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x66"
-DATA ·d+167736(SB)/8,$"\x61\x6d\x69\x6c\x79\x3a\x20\x43"
-DATA ·d+167744(SB)/8,$"\x6f\x6e\x73\x6f\x6c\x61\x73\x2c"
-DATA ·d+167752(SB)/8,$"\x20\x4d\x65\x6e\x6c\x6f\x2c\x20"
-DATA ·d+167760(SB)/8,$"\x4d\x6f\x6e\x61\x63\x6f\x2c\x20"
-DATA ·d+167768(SB)/8,$"\x22\x4c\x75\x63\x69\x64\x61\x20"
-DATA ·d+167776(SB)/8,$"\x43\x6f\x6e\x73\x6f\x6c\x65\x22"
-DATA ·d+167784(SB)/8,$"\x2c\x20\x22\x4c\x69\x62\x65\x72"
-DATA ·d+167792(SB)/8,$"\x61\x74\x69\x6f\x6e\x20\x4d\x6f"
-DATA ·d+167800(SB)/8,$"\x6e\x6f\x22\x2c\x20\x22\x44\x65"
-DATA ·d+167808(SB)/8,$"\x6a\x61\x56\x75\x20\x53\x61\x6e"
-DATA ·d+167816(SB)/8,$"\x73\x20\x4d\x6f\x6e\x6f\x22\x2c"
-DATA ·d+167824(SB)/8,$"\x20\x22\x42\x69\x74\x73\x74\x72"
-DATA ·d+167832(SB)/8,$"\x65\x61\x6d\x20\x56\x65\x72\x61"
-DATA ·d+167840(SB)/8,$"\x20\x53\x61\x6e\x73\x20\x4d\x6f"
-DATA ·d+167848(SB)/8,$"\x6e\x6f\x22\x2c\x20\x22\x43\x6f"
-DATA ·d+167856(SB)/8,$"\x75\x72\x69\x65\x72\x20\x4e\x65"
-DATA ·d+167864(SB)/8,$"\x77\x22\x2c\x20\x6d\x6f\x6e\x6f"
-DATA ·d+167872(SB)/8,$"\x73\x70\x61\x63\x65\x2c\x20\x73"
-DATA ·d+167880(SB)/8,$"\x65\x72\x69\x66\x3b\x0a\x20\x20"
-DATA ·d+167888(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
-DATA ·d+167896(SB)/8,$"\x65\x3a\x20\x31\x32\x70\x78\x3b"
-DATA ·d+167904(SB)/8,$"\x0a\x20\x20\x6c\x69\x6e\x65\x2d"
-DATA ·d+167912(SB)/8,$"\x68\x65\x69\x67\x68\x74\x3a\x20"
-DATA ·d+167920(SB)/8,$"\x31\x2e\x35\x3b\x0a\x7d\x0a\x0a"
-DATA ·d+167928(SB)/8,$"\x0a\x2f\x2f\x20\x4f\x54\x48\x45"
-DATA ·d+167936(SB)/8,$"\x52\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+167944(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+167952(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x24"
-DATA ·d+167960(SB)/8,$"\x6e\x61\x76\x2d\x66\x6f\x6f\x74"
-DATA ·d+167968(SB)/8,$"\x65\x72\x2d\x62\x6f\x72\x64\x65"
-DATA ·d+167976(SB)/8,$"\x72\x2d\x63\x6f\x6c\x6f\x72\x3a"
-DATA ·d+167984(SB)/8,$"\x20\x23\x36\x36\x36\x20\x21\x64"
-DATA ·d+167992(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
-DATA ·d+168000(SB)/8,$"\x24\x73\x65\x61\x72\x63\x68\x2d"
-DATA ·d+168008(SB)/8,$"\x62\x6f\x78\x2d\x62\x6f\x72\x64"
-DATA ·d+168016(SB)/8,$"\x65\x72\x2d\x63\x6f\x6c\x6f\x72"
-DATA ·d+168024(SB)/8,$"\x3a\x20\x23\x36\x36\x36\x20\x21"
-DATA ·d+168032(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
-DATA ·d+168040(SB)/8,$"\x0a\x0a\x0a\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168048(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168056(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168064(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168072(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168080(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168088(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168096(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168104(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168112(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168120(SB)/8,$"\x2f\x2f\x2f\x0a\x2f\x2f\x20\x49"
-DATA ·d+168128(SB)/8,$"\x4e\x54\x45\x52\x4e\x41\x4c\x0a"
-DATA ·d+168136(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168144(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168152(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168160(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168168(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168176(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168184(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168192(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168200(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168208(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+168216(SB)/8,$"\x0a\x2f\x2f\x20\x54\x68\x65\x73"
-DATA ·d+168224(SB)/8,$"\x65\x20\x73\x65\x74\x74\x69\x6e"
-DATA ·d+168232(SB)/8,$"\x67\x73\x20\x61\x72\x65\x20\x70"
-DATA ·d+168240(SB)/8,$"\x72\x6f\x62\x61\x62\x6c\x79\x20"
-DATA ·d+168248(SB)/8,$"\x62\x65\x73\x74\x20\x6c\x65\x66"
-DATA ·d+168256(SB)/8,$"\x74\x20\x61\x6c\x6f\x6e\x65\x2e"
-DATA ·d+168264(SB)/8,$"\x0a\x0a\x25\x62\x72\x65\x61\x6b"
-DATA ·d+168272(SB)/8,$"\x2d\x77\x6f\x72\x64\x73\x20\x7b"
-DATA ·d+168280(SB)/8,$"\x0a\x20\x20\x20\x20\x77\x6f\x72"
-DATA ·d+168288(SB)/8,$"\x64\x2d\x62\x72\x65\x61\x6b\x3a"
-DATA ·d+168296(SB)/8,$"\x20\x62\x72\x65\x61\x6b\x2d\x61"
-DATA ·d+168304(SB)/8,$"\x6c\x6c\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+168312(SB)/8,$"\x68\x79\x70\x68\x65\x6e\x73\x3a"
-DATA ·d+168320(SB)/8,$"\x20\x61\x75\x74\x6f\x3b\x0a\x7d"
-DATA ·d+168328(SB)/8,$"\x0a\x00\x00\x00\x00\x00\x00\x00"
-DATA ·d+168336(SB)/8,$"\x40\x63\x68\x61\x72\x73\x65\x74"
-DATA ·d+168344(SB)/8,$"\x20\x22\x75\x74\x66\x2d\x38\x22"
-DATA ·d+168352(SB)/8,$"\x3b\x0a\x40\x69\x6d\x70\x6f\x72"
-DATA ·d+168360(SB)/8,$"\x74\x20\x27\x6e\x6f\x72\x6d\x61"
-DATA ·d+168368(SB)/8,$"\x6c\x69\x7a\x65\x27\x3b\x0a\x40"
-DATA ·d+168376(SB)/8,$"\x69\x6d\x70\x6f\x72\x74\x20\x27"
-DATA ·d+168384(SB)/8,$"\x76\x61\x72\x69\x61\x62\x6c\x65"
-DATA ·d+168392(SB)/8,$"\x73\x27\x3b\x0a\x40\x69\x6d\x70"
-DATA ·d+168400(SB)/8,$"\x6f\x72\x74\x20\x27\x69\x63\x6f"
-DATA ·d+168408(SB)/8,$"\x6e\x2d\x66\x6f\x6e\x74\x27\x3b"
-DATA ·d+168416(SB)/8,$"\x0a\x0a\x2f\x2a\x0a\x43\x6f\x70"
-DATA ·d+168424(SB)/8,$"\x79\x72\x69\x67\x68\x74\x20\x32"
-DATA ·d+168432(SB)/8,$"\x30\x30\x38\x2d\x32\x30\x31\x33"
-DATA ·d+168440(SB)/8,$"\x20\x43\x6f\x6e\x63\x75\x72\x20"
-DATA ·d+168448(SB)/8,$"\x54\x65\x63\x68\x6e\x6f\x6c\x6f"
-DATA ·d+168456(SB)/8,$"\x67\x69\x65\x73\x2c\x20\x49\x6e"
-DATA ·d+168464(SB)/8,$"\x63\x2e\x0a\x0a\x4c\x69\x63\x65"
-DATA ·d+168472(SB)/8,$"\x6e\x73\x65\x64\x20\x75\x6e\x64"
-DATA ·d+168480(SB)/8,$"\x65\x72\x20\x74\x68\x65\x20\x41"
-DATA ·d+168488(SB)/8,$"\x70\x61\x63\x68\x65\x20\x4c\x69"
-DATA ·d+168496(SB)/8,$"\x63\x65\x6e\x73\x65\x2c\x20\x56"
-DATA ·d+168504(SB)/8,$"\x65\x72\x73\x69\x6f\x6e\x20\x32"
-DATA ·d+168512(SB)/8,$"\x2e\x30\x20\x28\x74\x68\x65\x20"
-DATA ·d+168520(SB)/8,$"\x22\x4c\x69\x63\x65\x6e\x73\x65"
-DATA ·d+168528(SB)/8,$"\x22\x29\x3b\x20\x79\x6f\x75\x20"
-DATA ·d+168536(SB)/8,$"\x6d\x61\x79\x0a\x6e\x6f\x74\x20"
-DATA ·d+168544(SB)/8,$"\x75\x73\x65\x20\x74\x68\x69\x73"
-DATA ·d+168552(SB)/8,$"\x20\x66\x69\x6c\x65\x20\x65\x78"
-DATA ·d+168560(SB)/8,$"\x63\x65\x70\x74\x20\x69\x6e\x20"
-DATA ·d+168568(SB)/8,$"\x63\x6f\x6d\x70\x6c\x69\x61\x6e"
-DATA ·d+168576(SB)/8,$"\x63\x65\x20\x77\x69\x74\x68\x20"
-DATA ·d+168584(SB)/8,$"\x74\x68\x65\x20\x4c\x69\x63\x65"
-DATA ·d+168592(SB)/8,$"\x6e\x73\x65\x2e\x20\x59\x6f\x75"
-DATA ·d+168600(SB)/8,$"\x20\x6d\x61\x79\x20\x6f\x62\x74"
-DATA ·d+168608(SB)/8,$"\x61\x69\x6e\x0a\x61\x20\x63\x6f"
-DATA ·d+168616(SB)/8,$"\x70\x79\x20\x6f\x66\x20\x74\x68"
-DATA ·d+168624(SB)/8,$"\x65\x20\x4c\x69\x63\x65\x6e\x73"
-DATA ·d+168632(SB)/8,$"\x65\x20\x61\x74\x0a\x0a\x20\x20"
-DATA ·d+168640(SB)/8,$"\x68\x74\x74\x70\x3a\x2f\x2f\x77"
-DATA ·d+168648(SB)/8,$"\x77\x77\x2e\x61\x70\x61\x63\x68"
-DATA ·d+168656(SB)/8,$"\x65\x2e\x6f\x72\x67\x2f\x6c\x69"
-DATA ·d+168664(SB)/8,$"\x63\x65\x6e\x73\x65\x73\x2f\x4c"
-DATA ·d+168672(SB)/8,$"\x49\x43\x45\x4e\x53\x45\x2d\x32"
-DATA ·d+168680(SB)/8,$"\x2e\x30\x0a\x0a\x55\x6e\x6c\x65"
-DATA ·d+168688(SB)/8,$"\x73\x73\x20\x72\x65\x71\x75\x69"
-DATA ·d+168696(SB)/8,$"\x72\x65\x64\x20\x62\x79\x20\x61"
-DATA ·d+168704(SB)/8,$"\x70\x70\x6c\x69\x63\x61\x62\x6c"
-DATA ·d+168712(SB)/8,$"\x65\x20\x6c\x61\x77\x20\x6f\x72"
-DATA ·d+168720(SB)/8,$"\x20\x61\x67\x72\x65\x65\x64\x20"
-DATA ·d+168728(SB)/8,$"\x74\x6f\x20\x69\x6e\x20\x77\x72"
-DATA ·d+168736(SB)/8,$"\x69\x74\x69\x6e\x67\x2c\x20\x73"
-DATA ·d+168744(SB)/8,$"\x6f\x66\x74\x77\x61\x72\x65\x0a"
-DATA ·d+168752(SB)/8,$"\x64\x69\x73\x74\x72\x69\x62\x75"
-DATA ·d+168760(SB)/8,$"\x74\x65\x64\x20\x75\x6e\x64\x65"
-DATA ·d+168768(SB)/8,$"\x72\x20\x74\x68\x65\x20\x4c\x69"
-DATA ·d+168776(SB)/8,$"\x63\x65\x6e\x73\x65\x20\x69\x73"
-DATA ·d+168784(SB)/8,$"\x20\x64\x69\x73\x74\x72\x69\x62"
-DATA ·d+168792(SB)/8,$"\x75\x74\x65\x64\x20\x6f\x6e\x20"
-DATA ·d+168800(SB)/8,$"\x61\x6e\x20\x22\x41\x53\x20\x49"
-DATA ·d+168808(SB)/8,$"\x53\x22\x20\x42\x41\x53\x49\x53"
-DATA ·d+168816(SB)/8,$"\x2c\x20\x57\x49\x54\x48\x4f\x55"
-DATA ·d+168824(SB)/8,$"\x54\x0a\x57\x41\x52\x52\x41\x4e"
-DATA ·d+168832(SB)/8,$"\x54\x49\x45\x53\x20\x4f\x52\x20"
-DATA ·d+168840(SB)/8,$"\x43\x4f\x4e\x44\x49\x54\x49\x4f"
-DATA ·d+168848(SB)/8,$"\x4e\x53\x20\x4f\x46\x20\x41\x4e"
-DATA ·d+168856(SB)/8,$"\x59\x20\x4b\x49\x4e\x44\x2c\x20"
-DATA ·d+168864(SB)/8,$"\x65\x69\x74\x68\x65\x72\x20\x65"
-DATA ·d+168872(SB)/8,$"\x78\x70\x72\x65\x73\x73\x20\x6f"
-DATA ·d+168880(SB)/8,$"\x72\x20\x69\x6d\x70\x6c\x69\x65"
-DATA ·d+168888(SB)/8,$"\x64\x2e\x20\x53\x65\x65\x20\x74"
-DATA ·d+168896(SB)/8,$"\x68\x65\x0a\x4c\x69\x63\x65\x6e"
-DATA ·d+168904(SB)/8,$"\x73\x65\x20\x66\x6f\x72\x20\x74"
-DATA ·d+168912(SB)/8,$"\x68\x65\x20\x73\x70\x65\x63\x69"
-DATA ·d+168920(SB)/8,$"\x66\x69\x63\x20\x6c\x61\x6e\x67"
-DATA ·d+168928(SB)/8,$"\x75\x61\x67\x65\x20\x67\x6f\x76"
-DATA ·d+168936(SB)/8,$"\x65\x72\x6e\x69\x6e\x67\x20\x70"
-DATA ·d+168944(SB)/8,$"\x65\x72\x6d\x69\x73\x73\x69\x6f"
-DATA ·d+168952(SB)/8,$"\x6e\x73\x20\x61\x6e\x64\x20\x6c"
-DATA ·d+168960(SB)/8,$"\x69\x6d\x69\x74\x61\x74\x69\x6f"
-DATA ·d+168968(SB)/8,$"\x6e\x73\x0a\x75\x6e\x64\x65\x72"
-DATA ·d+168976(SB)/8,$"\x20\x74\x68\x65\x20\x4c\x69\x63"
-DATA ·d+168984(SB)/8,$"\x65\x6e\x73\x65\x2e\x0a\x2a\x2f"
-DATA ·d+168992(SB)/8,$"\x0a\x0a\x24\x70\x72\x69\x6e\x74"
-DATA ·d+169000(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+169008(SB)/8,$"\x23\x39\x39\x39\x3b\x0a\x24\x70"
-DATA ·d+169016(SB)/8,$"\x72\x69\x6e\x74\x2d\x63\x6f\x6c"
-DATA ·d+169024(SB)/8,$"\x6f\x72\x2d\x6c\x69\x67\x68\x74"
-DATA ·d+169032(SB)/8,$"\x3a\x20\x23\x63\x63\x63\x3b\x0a"
-DATA ·d+169040(SB)/8,$"\x24\x70\x72\x69\x6e\x74\x2d\x66"
-DATA ·d+169048(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
-DATA ·d+169056(SB)/8,$"\x3a\x20\x31\x32\x70\x78\x3b\x0a"
-DATA ·d+169064(SB)/8,$"\x0a\x62\x6f\x64\x79\x20\x7b\x0a"
-DATA ·d+169072(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
-DATA ·d+169080(SB)/8,$"\x64\x20\x25\x64\x65\x66\x61\x75"
-DATA ·d+169088(SB)/8,$"\x6c\x74\x2d\x66\x6f\x6e\x74\x3b"
-DATA ·d+169096(SB)/8,$"\x0a\x7d\x0a\x0a\x2e\x74\x6f\x63"
-DATA ·d+169104(SB)/8,$"\x69\x66\x79\x2c\x20\x2e\x74\x6f"
-DATA ·d+169112(SB)/8,$"\x63\x2d\x66\x6f\x6f\x74\x65\x72"
-DATA ·d+169120(SB)/8,$"\x2c\x20\x2e\x6c\x61\x6e\x67\x2d"
-DATA ·d+169128(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x6f\x72"
-DATA ·d+169136(SB)/8,$"\x2c\x20\x2e\x73\x65\x61\x72\x63"
-DATA ·d+169144(SB)/8,$"\x68\x2c\x20\x23\x6e\x61\x76\x2d"
-DATA ·d+169152(SB)/8,$"\x62\x75\x74\x74\x6f\x6e\x20\x7b"
-DATA ·d+169160(SB)/8,$"\x0a\x20\x20\x64\x69\x73\x70\x6c"
-DATA ·d+169168(SB)/8,$"\x61\x79\x3a\x20\x6e\x6f\x6e\x65"
-DATA ·d+169176(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2e\x74\x6f"
-DATA ·d+169184(SB)/8,$"\x63\x69\x66\x79\x2d\x77\x72\x61"
-DATA ·d+169192(SB)/8,$"\x70\x70\x65\x72\x3e\x69\x6d\x67"
-DATA ·d+169200(SB)/8,$"\x20\x7b\x0a\x20\x20\x6d\x61\x72"
-DATA ·d+169208(SB)/8,$"\x67\x69\x6e\x3a\x20\x30\x20\x61"
-DATA ·d+169216(SB)/8,$"\x75\x74\x6f\x3b\x0a\x20\x20\x64"
-DATA ·d+169224(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x3a\x20"
-DATA ·d+169232(SB)/8,$"\x62\x6c\x6f\x63\x6b\x3b\x0a\x7d"
-DATA ·d+169240(SB)/8,$"\x0a\x0a\x2e\x63\x6f\x6e\x74\x65"
-DATA ·d+169248(SB)/8,$"\x6e\x74\x20\x7b\x0a\x20\x20\x66"
-DATA ·d+169256(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
-DATA ·d+169264(SB)/8,$"\x3a\x20\x31\x32\x70\x78\x3b\x0a"
-DATA ·d+169272(SB)/8,$"\x0a\x20\x20\x70\x72\x65\x2c\x20"
-DATA ·d+169280(SB)/8,$"\x63\x6f\x64\x65\x20\x7b\x0a\x20"
-DATA ·d+169288(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
-DATA ·d+169296(SB)/8,$"\x6e\x64\x20\x25\x63\x6f\x64\x65"
-DATA ·d+169304(SB)/8,$"\x2d\x66\x6f\x6e\x74\x3b\x0a\x20"
-DATA ·d+169312(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
-DATA ·d+169320(SB)/8,$"\x6e\x64\x20\x25\x62\x72\x65\x61"
-DATA ·d+169328(SB)/8,$"\x6b\x2d\x77\x6f\x72\x64\x73\x3b"
-DATA ·d+169336(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
-DATA ·d+169344(SB)/8,$"\x64\x65\x72\x3a\x20\x31\x70\x78"
-DATA ·d+169352(SB)/8,$"\x20\x73\x6f\x6c\x69\x64\x20\x24"
-DATA ·d+169360(SB)/8,$"\x70\x72\x69\x6e\x74\x2d\x63\x6f"
-DATA ·d+169368(SB)/8,$"\x6c\x6f\x72\x3b\x0a\x20\x20\x20"
-DATA ·d+169376(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
-DATA ·d+169384(SB)/8,$"\x72\x61\x64\x69\x75\x73\x3a\x20"
-DATA ·d+169392(SB)/8,$"\x35\x70\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+169400(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x73\x69"
-DATA ·d+169408(SB)/8,$"\x7a\x65\x3a\x20\x30\x2e\x38\x65"
-DATA ·d+169416(SB)/8,$"\x6d\x3b\x0a\x20\x20\x7d\x0a\x0a"
-DATA ·d+169424(SB)/8,$"\x20\x20\x70\x72\x65\x20\x7b\x0a"
-DATA ·d+169432(SB)/8,$"\x20\x20\x20\x20\x63\x6f\x64\x65"
-DATA ·d+169440(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+169448(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x3a"
-DATA ·d+169456(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+169464(SB)/8,$"\x7d\x0a\x20\x20\x7d\x0a\x0a\x20"
-DATA ·d+169472(SB)/8,$"\x20\x70\x72\x65\x20\x7b\x0a\x20"
-DATA ·d+169480(SB)/8,$"\x20\x20\x20\x70\x61\x64\x64\x69"
-DATA ·d+169488(SB)/8,$"\x6e\x67\x3a\x20\x31\x2e\x33\x65"
-DATA ·d+169496(SB)/8,$"\x6d\x3b\x0a\x20\x20\x7d\x0a\x0a"
-DATA ·d+169504(SB)/8,$"\x20\x20\x63\x6f\x64\x65\x20\x7b"
-DATA ·d+169512(SB)/8,$"\x0a\x20\x20\x20\x20\x70\x61\x64"
-DATA ·d+169520(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x30\x2e"
-DATA ·d+169528(SB)/8,$"\x32\x65\x6d\x3b\x0a\x20\x20\x7d"
-DATA ·d+169536(SB)/8,$"\x0a\x0a\x20\x20\x74\x61\x62\x6c"
-DATA ·d+169544(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+169552(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x3a\x20"
-DATA ·d+169560(SB)/8,$"\x31\x70\x78\x20\x73\x6f\x6c\x69"
-DATA ·d+169568(SB)/8,$"\x64\x20\x24\x70\x72\x69\x6e\x74"
-DATA ·d+169576(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3b\x0a"
-DATA ·d+169584(SB)/8,$"\x20\x20\x20\x20\x74\x72\x20\x7b"
-DATA ·d+169592(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x62"
-DATA ·d+169600(SB)/8,$"\x6f\x72\x64\x65\x72\x2d\x62\x6f"
-DATA ·d+169608(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x31\x70"
-DATA ·d+169616(SB)/8,$"\x78\x20\x73\x6f\x6c\x69\x64\x20"
-DATA ·d+169624(SB)/8,$"\x24\x70\x72\x69\x6e\x74\x2d\x63"
-DATA ·d+169632(SB)/8,$"\x6f\x6c\x6f\x72\x3b\x0a\x20\x20"
-DATA ·d+169640(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x20\x20"
-DATA ·d+169648(SB)/8,$"\x74\x64\x2c\x74\x68\x20\x7b\x0a"
-DATA ·d+169656(SB)/8,$"\x20\x20\x20\x20\x20\x20\x70\x61"
-DATA ·d+169664(SB)/8,$"\x64\x64\x69\x6e\x67\x3a\x20\x30"
-DATA ·d+169672(SB)/8,$"\x2e\x37\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+169680(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x7d\x0a"
-DATA ·d+169688(SB)/8,$"\x0a\x20\x20\x70\x20\x7b\x0a\x20"
-DATA ·d+169696(SB)/8,$"\x20\x20\x20\x6c\x69\x6e\x65\x2d"
-DATA ·d+169704(SB)/8,$"\x68\x65\x69\x67\x68\x74\x3a\x20"
-DATA ·d+169712(SB)/8,$"\x31\x2e\x35\x3b\x0a\x20\x20\x7d"
-DATA ·d+169720(SB)/8,$"\x0a\x0a\x20\x20\x61\x20\x7b\x0a"
-DATA ·d+169728(SB)/8,$"\x20\x20\x20\x20\x74\x65\x78\x74"
-DATA ·d+169736(SB)/8,$"\x2d\x64\x65\x63\x6f\x72\x61\x74"
-DATA ·d+169744(SB)/8,$"\x69\x6f\x6e\x3a\x20\x6e\x6f\x6e"
-DATA ·d+169752(SB)/8,$"\x65\x3b\x0a\x20\x20\x20\x20\x63"
-DATA ·d+169760(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x23\x30"
-DATA ·d+169768(SB)/8,$"\x30\x30\x3b\x0a\x20\x20\x7d\x0a"
-DATA ·d+169776(SB)/8,$"\x0a\x20\x20\x68\x31\x20\x7b\x0a"
-DATA ·d+169784(SB)/8,$"\x20\x20\x20\x20\x40\x65\x78\x74"
-DATA ·d+169792(SB)/8,$"\x65\x6e\x64\x20\x25\x68\x65\x61"
-DATA ·d+169800(SB)/8,$"\x64\x65\x72\x2d\x66\x6f\x6e\x74"
-DATA ·d+169808(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x66\x6f"
-DATA ·d+169816(SB)/8,$"\x6e\x74\x2d\x73\x69\x7a\x65\x3a"
-DATA ·d+169824(SB)/8,$"\x20\x32\x2e\x35\x65\x6d\x3b\x0a"
-DATA ·d+169832(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+169840(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
-DATA ·d+169848(SB)/8,$"\x20\x30\x2e\x35\x65\x6d\x3b\x0a"
-DATA ·d+169856(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+169864(SB)/8,$"\x69\x6e\x67\x2d\x62\x6f\x74\x74"
-DATA ·d+169872(SB)/8,$"\x6f\x6d\x3a\x20\x30\x2e\x35\x65"
-DATA ·d+169880(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x6d"
-DATA ·d+169888(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x74\x6f"
-DATA ·d+169896(SB)/8,$"\x70\x3a\x20\x31\x65\x6d\x3b\x0a"
-DATA ·d+169904(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+169912(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
-DATA ·d+169920(SB)/8,$"\x6d\x3a\x20\x24\x68\x31\x2d\x6d"
-DATA ·d+169928(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x62\x6f"
-DATA ·d+169936(SB)/8,$"\x74\x74\x6f\x6d\x3b\x0a\x20\x20"
-DATA ·d+169944(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+169952(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x73\x6f"
-DATA ·d+169960(SB)/8,$"\x6c\x69\x64\x20\x24\x70\x72\x69"
-DATA ·d+169968(SB)/8,$"\x6e\x74\x2d\x63\x6f\x6c\x6f\x72"
-DATA ·d+169976(SB)/8,$"\x2d\x6c\x69\x67\x68\x74\x3b\x0a"
-DATA ·d+169984(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x72\x64"
-DATA ·d+169992(SB)/8,$"\x65\x72\x2d\x77\x69\x64\x74\x68"
-DATA ·d+170000(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x30\x3b"
-DATA ·d+170008(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x65\x78"
-DATA ·d+170016(SB)/8,$"\x74\x2d\x61\x6c\x69\x67\x6e\x3a"
-DATA ·d+170024(SB)/8,$"\x20\x63\x65\x6e\x74\x65\x72\x3b"
-DATA ·d+170032(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+170040(SB)/8,$"\x68\x32\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+170048(SB)/8,$"\x20\x40\x65\x78\x74\x65\x6e\x64"
-DATA ·d+170056(SB)/8,$"\x20\x25\x68\x65\x61\x64\x65\x72"
-DATA ·d+170064(SB)/8,$"\x2d\x66\x6f\x6e\x74\x3b\x0a\x20"
-DATA ·d+170072(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
-DATA ·d+170080(SB)/8,$"\x73\x69\x7a\x65\x3a\x20\x31\x2e"
-DATA ·d+170088(SB)/8,$"\x38\x65\x6d\x3b\x0a\x20\x20\x20"
-DATA ·d+170096(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
-DATA ·d+170104(SB)/8,$"\x74\x6f\x70\x3a\x20\x32\x65\x6d"
-DATA ·d+170112(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x62\x6f"
-DATA ·d+170120(SB)/8,$"\x72\x64\x65\x72\x2d\x74\x6f\x70"
-DATA ·d+170128(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x73\x6f"
-DATA ·d+170136(SB)/8,$"\x6c\x69\x64\x20\x24\x70\x72\x69"
-DATA ·d+170144(SB)/8,$"\x6e\x74\x2d\x63\x6f\x6c\x6f\x72"
-DATA ·d+170152(SB)/8,$"\x2d\x6c\x69\x67\x68\x74\x3b\x0a"
-DATA ·d+170160(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+170168(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
-DATA ·d+170176(SB)/8,$"\x20\x30\x2e\x38\x65\x6d\x3b\x0a"
-DATA ·d+170184(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x68"
-DATA ·d+170192(SB)/8,$"\x31\x2b\x68\x32\x2c\x20\x68\x31"
-DATA ·d+170200(SB)/8,$"\x2b\x64\x69\x76\x2b\x68\x32\x20"
-DATA ·d+170208(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x6f"
-DATA ·d+170216(SB)/8,$"\x72\x64\x65\x72\x2d\x74\x6f\x70"
-DATA ·d+170224(SB)/8,$"\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a"
-DATA ·d+170232(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+170240(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
-DATA ·d+170248(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+170256(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x74"
-DATA ·d+170264(SB)/8,$"\x6f\x70\x3a\x20\x30\x3b\x0a\x20"
-DATA ·d+170272(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x33"
-DATA ·d+170280(SB)/8,$"\x2c\x20\x68\x34\x20\x7b\x0a\x20"
-DATA ·d+170288(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
-DATA ·d+170296(SB)/8,$"\x6e\x64\x20\x25\x68\x65\x61\x64"
-DATA ·d+170304(SB)/8,$"\x65\x72\x2d\x66\x6f\x6e\x74\x3b"
-DATA ·d+170312(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
-DATA ·d+170320(SB)/8,$"\x74\x2d\x73\x69\x7a\x65\x3a\x20"
-DATA ·d+170328(SB)/8,$"\x30\x2e\x38\x65\x6d\x3b\x0a\x20"
-DATA ·d+170336(SB)/8,$"\x20\x20\x20\x6d\x61\x72\x67\x69"
-DATA ·d+170344(SB)/8,$"\x6e\x2d\x74\x6f\x70\x3a\x20\x31"
-DATA ·d+170352(SB)/8,$"\x2e\x35\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+170360(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
-DATA ·d+170368(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
-DATA ·d+170376(SB)/8,$"\x20\x30\x2e\x38\x65\x6d\x3b\x0a"
-DATA ·d+170384(SB)/8,$"\x20\x20\x20\x20\x74\x65\x78\x74"
-DATA ·d+170392(SB)/8,$"\x2d\x74\x72\x61\x6e\x73\x66\x6f"
-DATA ·d+170400(SB)/8,$"\x72\x6d\x3a\x20\x75\x70\x70\x65"
-DATA ·d+170408(SB)/8,$"\x72\x63\x61\x73\x65\x3b\x0a\x20"
-DATA ·d+170416(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x35"
-DATA ·d+170424(SB)/8,$"\x2c\x20\x68\x36\x20\x7b\x0a\x20"
-DATA ·d+170432(SB)/8,$"\x20\x20\x20\x74\x65\x78\x74\x2d"
-DATA ·d+170440(SB)/8,$"\x74\x72\x61\x6e\x73\x66\x6f\x72"
-DATA ·d+170448(SB)/8,$"\x6d\x3a\x20\x75\x70\x70\x65\x72"
-DATA ·d+170456(SB)/8,$"\x63\x61\x73\x65\x3b\x0a\x20\x20"
-DATA ·d+170464(SB)/8,$"\x7d\x0a\x0a\x20\x20\x61\x73\x69"
-DATA ·d+170472(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+170480(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+170488(SB)/8,$"\x3a\x20\x31\x65\x6d\x3b\x0a\x20"
-DATA ·d+170496(SB)/8,$"\x20\x20\x20\x62\x6f\x72\x64\x65"
-DATA ·d+170504(SB)/8,$"\x72\x3a\x20\x31\x70\x78\x20\x73"
-DATA ·d+170512(SB)/8,$"\x6f\x6c\x69\x64\x20\x24\x70\x72"
-DATA ·d+170520(SB)/8,$"\x69\x6e\x74\x2d\x63\x6f\x6c\x6f"
-DATA ·d+170528(SB)/8,$"\x72\x2d\x6c\x69\x67\x68\x74\x3b"
-DATA ·d+170536(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
-DATA ·d+170544(SB)/8,$"\x64\x65\x72\x2d\x72\x61\x64\x69"
-DATA ·d+170552(SB)/8,$"\x75\x73\x3a\x20\x35\x70\x78\x3b"
-DATA ·d+170560(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+170568(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
-DATA ·d+170576(SB)/8,$"\x20\x31\x2e\x35\x65\x6d\x3b\x0a"
-DATA ·d+170584(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+170592(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
-DATA ·d+170600(SB)/8,$"\x6d\x3a\x20\x31\x2e\x35\x65\x6d"
-DATA ·d+170608(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6c\x69"
-DATA ·d+170616(SB)/8,$"\x6e\x65\x2d\x68\x65\x69\x67\x68"
-DATA ·d+170624(SB)/8,$"\x74\x3a\x20\x31\x2e\x36\x3b\x0a"
-DATA ·d+170632(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x61"
-DATA ·d+170640(SB)/8,$"\x73\x69\x64\x65\x3a\x62\x65\x66"
-DATA ·d+170648(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
-DATA ·d+170656(SB)/8,$"\x20\x20\x76\x65\x72\x74\x69\x63"
-DATA ·d+170664(SB)/8,$"\x61\x6c\x2d\x61\x6c\x69\x67\x6e"
-DATA ·d+170672(SB)/8,$"\x3a\x20\x6d\x69\x64\x64\x6c\x65"
-DATA ·d+170680(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x70\x61"
-DATA ·d+170688(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x72\x69"
-DATA ·d+170696(SB)/8,$"\x67\x68\x74\x3a\x20\x30\x2e\x35"
-DATA ·d+170704(SB)/8,$"\x65\x6d\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+170712(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
-DATA ·d+170720(SB)/8,$"\x65\x3a\x20\x31\x34\x70\x78\x3b"
-DATA ·d+170728(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+170736(SB)/8,$"\x61\x73\x69\x64\x65\x2e\x6e\x6f"
-DATA ·d+170744(SB)/8,$"\x74\x69\x63\x65\x3a\x62\x65\x66"
-DATA ·d+170752(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
-DATA ·d+170760(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
-DATA ·d+170768(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x2d"
-DATA ·d+170776(SB)/8,$"\x69\x6e\x66\x6f\x2d\x73\x69\x67"
-DATA ·d+170784(SB)/8,$"\x6e\x3b\x0a\x20\x20\x7d\x0a\x0a"
-DATA ·d+170792(SB)/8,$"\x20\x20\x61\x73\x69\x64\x65\x2e"
-DATA ·d+170800(SB)/8,$"\x77\x61\x72\x6e\x69\x6e\x67\x3a"
-DATA ·d+170808(SB)/8,$"\x62\x65\x66\x6f\x72\x65\x20\x7b"
-DATA ·d+170816(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
-DATA ·d+170824(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x69\x63"
-DATA ·d+170832(SB)/8,$"\x6f\x6e\x2d\x65\x78\x63\x6c\x61"
-DATA ·d+170840(SB)/8,$"\x6d\x61\x74\x69\x6f\x6e\x2d\x73"
-DATA ·d+170848(SB)/8,$"\x69\x67\x6e\x3b\x0a\x20\x20\x7d"
-DATA ·d+170856(SB)/8,$"\x0a\x0a\x20\x20\x61\x73\x69\x64"
-DATA ·d+170864(SB)/8,$"\x65\x2e\x73\x75\x63\x63\x65\x73"
-DATA ·d+170872(SB)/8,$"\x73\x3a\x62\x65\x66\x6f\x72\x65"
-DATA ·d+170880(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x40"
-DATA ·d+170888(SB)/8,$"\x65\x78\x74\x65\x6e\x64\x20\x25"
-DATA ·d+170896(SB)/8,$"\x69\x63\x6f\x6e\x2d\x6f\x6b\x2d"
-DATA ·d+170904(SB)/8,$"\x73\x69\x67\x6e\x3b\x0a\x20\x20"
-DATA ·d+170912(SB)/8,$"\x7d\x0a\x7d\x00\x00\x00\x00\x00"
-DATA ·d+170920(SB)/8,$"\x40\x63\x68\x61\x72\x73\x65\x74"
-DATA ·d+170928(SB)/8,$"\x20\x22\x75\x74\x66\x2d\x38\x22"
-DATA ·d+170936(SB)/8,$"\x3b\x0a\x40\x69\x6d\x70\x6f\x72"
-DATA ·d+170944(SB)/8,$"\x74\x20\x27\x6e\x6f\x72\x6d\x61"
-DATA ·d+170952(SB)/8,$"\x6c\x69\x7a\x65\x27\x3b\x0a\x40"
-DATA ·d+170960(SB)/8,$"\x69\x6d\x70\x6f\x72\x74\x20\x27"
-DATA ·d+170968(SB)/8,$"\x76\x61\x72\x69\x61\x62\x6c\x65"
-DATA ·d+170976(SB)/8,$"\x73\x27\x3b\x0a\x40\x69\x6d\x70"
-DATA ·d+170984(SB)/8,$"\x6f\x72\x74\x20\x27\x69\x63\x6f"
-DATA ·d+170992(SB)/8,$"\x6e\x2d\x66\x6f\x6e\x74\x27\x3b"
-DATA ·d+171000(SB)/8,$"\x0a\x2f\x2f\x20\x40\x69\x6d\x70"
-DATA ·d+171008(SB)/8,$"\x6f\x72\x74\x20\x27\x72\x74\x6c"
-DATA ·d+171016(SB)/8,$"\x27\x3b\x20\x2f\x2f\x20\x75\x6e"
-DATA ·d+171024(SB)/8,$"\x63\x6f\x6d\x6d\x65\x6e\x74\x20"
-DATA ·d+171032(SB)/8,$"\x74\x6f\x20\x73\x77\x69\x74\x63"
-DATA ·d+171040(SB)/8,$"\x68\x20\x74\x6f\x20\x52\x54\x4c"
-DATA ·d+171048(SB)/8,$"\x20\x66\x6f\x72\x6d\x61\x74\x0a"
-DATA ·d+171056(SB)/8,$"\x0a\x2f\x2a\x0a\x43\x6f\x70\x79"
-DATA ·d+171064(SB)/8,$"\x72\x69\x67\x68\x74\x20\x32\x30"
-DATA ·d+171072(SB)/8,$"\x30\x38\x2d\x32\x30\x31\x33\x20"
-DATA ·d+171080(SB)/8,$"\x43\x6f\x6e\x63\x75\x72\x20\x54"
-DATA ·d+171088(SB)/8,$"\x65\x63\x68\x6e\x6f\x6c\x6f\x67"
-DATA ·d+171096(SB)/8,$"\x69\x65\x73\x2c\x20\x49\x6e\x63"
-DATA ·d+171104(SB)/8,$"\x2e\x0a\x0a\x4c\x69\x63\x65\x6e"
-DATA ·d+171112(SB)/8,$"\x73\x65\x64\x20\x75\x6e\x64\x65"
-DATA ·d+171120(SB)/8,$"\x72\x20\x74\x68\x65\x20\x41\x70"
-DATA ·d+171128(SB)/8,$"\x61\x63\x68\x65\x20\x4c\x69\x63"
-DATA ·d+171136(SB)/8,$"\x65\x6e\x73\x65\x2c\x20\x56\x65"
-DATA ·d+171144(SB)/8,$"\x72\x73\x69\x6f\x6e\x20\x32\x2e"
-DATA ·d+171152(SB)/8,$"\x30\x20\x28\x74\x68\x65\x20\x22"
-DATA ·d+171160(SB)/8,$"\x4c\x69\x63\x65\x6e\x73\x65\x22"
-DATA ·d+171168(SB)/8,$"\x29\x3b\x20\x79\x6f\x75\x20\x6d"
-DATA ·d+171176(SB)/8,$"\x61\x79\x0a\x6e\x6f\x74\x20\x75"
-DATA ·d+171184(SB)/8,$"\x73\x65\x20\x74\x68\x69\x73\x20"
-DATA ·d+171192(SB)/8,$"\x66\x69\x6c\x65\x20\x65\x78\x63"
-DATA ·d+171200(SB)/8,$"\x65\x70\x74\x20\x69\x6e\x20\x63"
-DATA ·d+171208(SB)/8,$"\x6f\x6d\x70\x6c\x69\x61\x6e\x63"
-DATA ·d+171216(SB)/8,$"\x65\x20\x77\x69\x74\x68\x20\x74"
-DATA ·d+171224(SB)/8,$"\x68\x65\x20\x4c\x69\x63\x65\x6e"
-DATA ·d+171232(SB)/8,$"\x73\x65\x2e\x20\x59\x6f\x75\x20"
-DATA ·d+171240(SB)/8,$"\x6d\x61\x79\x20\x6f\x62\x74\x61"
-DATA ·d+171248(SB)/8,$"\x69\x6e\x0a\x61\x20\x63\x6f\x70"
-DATA ·d+171256(SB)/8,$"\x79\x20\x6f\x66\x20\x74\x68\x65"
-DATA ·d+171264(SB)/8,$"\x20\x4c\x69\x63\x65\x6e\x73\x65"
-DATA ·d+171272(SB)/8,$"\x20\x61\x74\x0a\x0a\x20\x20\x68"
-DATA ·d+171280(SB)/8,$"\x74\x74\x70\x3a\x2f\x2f\x77\x77"
-DATA ·d+171288(SB)/8,$"\x77\x2e\x61\x70\x61\x63\x68\x65"
-DATA ·d+171296(SB)/8,$"\x2e\x6f\x72\x67\x2f\x6c\x69\x63"
-DATA ·d+171304(SB)/8,$"\x65\x6e\x73\x65\x73\x2f\x4c\x49"
-DATA ·d+171312(SB)/8,$"\x43\x45\x4e\x53\x45\x2d\x32\x2e"
-DATA ·d+171320(SB)/8,$"\x30\x0a\x0a\x55\x6e\x6c\x65\x73"
-DATA ·d+171328(SB)/8,$"\x73\x20\x72\x65\x71\x75\x69\x72"
-DATA ·d+171336(SB)/8,$"\x65\x64\x20\x62\x79\x20\x61\x70"
-DATA ·d+171344(SB)/8,$"\x70\x6c\x69\x63\x61\x62\x6c\x65"
-DATA ·d+171352(SB)/8,$"\x20\x6c\x61\x77\x20\x6f\x72\x20"
-DATA ·d+171360(SB)/8,$"\x61\x67\x72\x65\x65\x64\x20\x74"
-DATA ·d+171368(SB)/8,$"\x6f\x20\x69\x6e\x20\x77\x72\x69"
-DATA ·d+171376(SB)/8,$"\x74\x69\x6e\x67\x2c\x20\x73\x6f"
-DATA ·d+171384(SB)/8,$"\x66\x74\x77\x61\x72\x65\x0a\x64"
-DATA ·d+171392(SB)/8,$"\x69\x73\x74\x72\x69\x62\x75\x74"
-DATA ·d+171400(SB)/8,$"\x65\x64\x20\x75\x6e\x64\x65\x72"
-DATA ·d+171408(SB)/8,$"\x20\x74\x68\x65\x20\x4c\x69\x63"
-DATA ·d+171416(SB)/8,$"\x65\x6e\x73\x65\x20\x69\x73\x20"
-DATA ·d+171424(SB)/8,$"\x64\x69\x73\x74\x72\x69\x62\x75"
-DATA ·d+171432(SB)/8,$"\x74\x65\x64\x20\x6f\x6e\x20\x61"
-DATA ·d+171440(SB)/8,$"\x6e\x20\x22\x41\x53\x20\x49\x53"
-DATA ·d+171448(SB)/8,$"\x22\x20\x42\x41\x53\x49\x53\x2c"
-DATA ·d+171456(SB)/8,$"\x20\x57\x49\x54\x48\x4f\x55\x54"
-DATA ·d+171464(SB)/8,$"\x0a\x57\x41\x52\x52\x41\x4e\x54"
-DATA ·d+171472(SB)/8,$"\x49\x45\x53\x20\x4f\x52\x20\x43"
-DATA ·d+171480(SB)/8,$"\x4f\x4e\x44\x49\x54\x49\x4f\x4e"
-DATA ·d+171488(SB)/8,$"\x53\x20\x4f\x46\x20\x41\x4e\x59"
-DATA ·d+171496(SB)/8,$"\x20\x4b\x49\x4e\x44\x2c\x20\x65"
-DATA ·d+171504(SB)/8,$"\x69\x74\x68\x65\x72\x20\x65\x78"
-DATA ·d+171512(SB)/8,$"\x70\x72\x65\x73\x73\x20\x6f\x72"
-DATA ·d+171520(SB)/8,$"\x20\x69\x6d\x70\x6c\x69\x65\x64"
-DATA ·d+171528(SB)/8,$"\x2e\x20\x53\x65\x65\x20\x74\x68"
-DATA ·d+171536(SB)/8,$"\x65\x0a\x4c\x69\x63\x65\x6e\x73"
-DATA ·d+171544(SB)/8,$"\x65\x20\x66\x6f\x72\x20\x74\x68"
-DATA ·d+171552(SB)/8,$"\x65\x20\x73\x70\x65\x63\x69\x66"
-DATA ·d+171560(SB)/8,$"\x69\x63\x20\x6c\x61\x6e\x67\x75"
-DATA ·d+171568(SB)/8,$"\x61\x67\x65\x20\x67\x6f\x76\x65"
-DATA ·d+171576(SB)/8,$"\x72\x6e\x69\x6e\x67\x20\x70\x65"
-DATA ·d+171584(SB)/8,$"\x72\x6d\x69\x73\x73\x69\x6f\x6e"
-DATA ·d+171592(SB)/8,$"\x73\x20\x61\x6e\x64\x20\x6c\x69"
-DATA ·d+171600(SB)/8,$"\x6d\x69\x74\x61\x74\x69\x6f\x6e"
-DATA ·d+171608(SB)/8,$"\x73\x0a\x75\x6e\x64\x65\x72\x20"
-DATA ·d+171616(SB)/8,$"\x74\x68\x65\x20\x4c\x69\x63\x65"
-DATA ·d+171624(SB)/8,$"\x6e\x73\x65\x2e\x0a\x2a\x2f\x0a"
-DATA ·d+171632(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171664(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171672(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171680(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171688(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171696(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171704(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171712(SB)/8,$"\x2f\x0a\x2f\x2f\x20\x47\x45\x4e"
-DATA ·d+171720(SB)/8,$"\x45\x52\x41\x4c\x20\x53\x54\x55"
-DATA ·d+171728(SB)/8,$"\x46\x46\x0a\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171736(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171744(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171752(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171760(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171768(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171776(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171784(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171792(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171800(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+171808(SB)/8,$"\x2f\x2f\x2f\x0a\x0a\x68\x74\x6d"
-DATA ·d+171816(SB)/8,$"\x6c\x2c\x20\x62\x6f\x64\x79\x20"
-DATA ·d+171824(SB)/8,$"\x7b\x0a\x20\x20\x63\x6f\x6c\x6f"
-DATA ·d+171832(SB)/8,$"\x72\x3a\x20\x24\x6d\x61\x69\x6e"
-DATA ·d+171840(SB)/8,$"\x2d\x74\x65\x78\x74\x3b\x0a\x20"
-DATA ·d+171848(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+171856(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x6d"
-DATA ·d+171864(SB)/8,$"\x61\x72\x67\x69\x6e\x3a\x20\x30"
-DATA ·d+171872(SB)/8,$"\x3b\x0a\x20\x20\x2d\x77\x65\x62"
-DATA ·d+171880(SB)/8,$"\x6b\x69\x74\x2d\x66\x6f\x6e\x74"
-DATA ·d+171888(SB)/8,$"\x2d\x73\x6d\x6f\x6f\x74\x68\x69"
-DATA ·d+171896(SB)/8,$"\x6e\x67\x3a\x20\x61\x6e\x74\x69"
-DATA ·d+171904(SB)/8,$"\x61\x6c\x69\x61\x73\x65\x64\x3b"
-DATA ·d+171912(SB)/8,$"\x0a\x20\x20\x2d\x6d\x6f\x7a\x2d"
-DATA ·d+171920(SB)/8,$"\x6f\x73\x78\x2d\x66\x6f\x6e\x74"
-DATA ·d+171928(SB)/8,$"\x2d\x73\x6d\x6f\x6f\x74\x68\x69"
-DATA ·d+171936(SB)/8,$"\x6e\x67\x3a\x20\x67\x72\x61\x79"
-DATA ·d+171944(SB)/8,$"\x73\x63\x61\x6c\x65\x3b\x0a\x20"
-DATA ·d+171952(SB)/8,$"\x20\x40\x65\x78\x74\x65\x6e\x64"
-DATA ·d+171960(SB)/8,$"\x20\x25\x64\x65\x66\x61\x75\x6c"
-DATA ·d+171968(SB)/8,$"\x74\x2d\x66\x6f\x6e\x74\x3b\x0a"
-DATA ·d+171976(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
-DATA ·d+171984(SB)/8,$"\x6f\x75\x6e\x64\x2d\x63\x6f\x6c"
-DATA ·d+171992(SB)/8,$"\x6f\x72\x3a\x20\x24\x6d\x61\x69"
-DATA ·d+172000(SB)/8,$"\x6e\x2d\x62\x67\x3b\x0a\x20\x20"
-DATA ·d+172008(SB)/8,$"\x68\x65\x69\x67\x68\x74\x3a\x20"
-DATA ·d+172016(SB)/8,$"\x31\x30\x30\x25\x3b\x0a\x20\x20"
-DATA ·d+172024(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
-DATA ·d+172032(SB)/8,$"\x74\x65\x78\x74\x2d\x73\x69\x7a"
-DATA ·d+172040(SB)/8,$"\x65\x2d\x61\x64\x6a\x75\x73\x74"
-DATA ·d+172048(SB)/8,$"\x3a\x20\x6e\x6f\x6e\x65\x3b\x20"
-DATA ·d+172056(SB)/8,$"\x2f\x2a\x20\x4e\x65\x76\x65\x72"
-DATA ·d+172064(SB)/8,$"\x20\x61\x75\x74\x6f\x72\x65\x73"
-DATA ·d+172072(SB)/8,$"\x69\x7a\x65\x20\x74\x65\x78\x74"
-DATA ·d+172080(SB)/8,$"\x20\x2a\x2f\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+172088(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172096(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172104(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172112(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172120(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172128(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172136(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172144(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172152(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172160(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
-DATA ·d+172168(SB)/8,$"\x2f\x2f\x20\x54\x41\x42\x4c\x45"
-DATA ·d+172176(SB)/8,$"\x20\x4f\x46\x20\x43\x4f\x4e\x54"
-DATA ·d+172184(SB)/8,$"\x45\x4e\x54\x53\x0a\x2f\x2f\x2f"
-DATA ·d+172192(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172200(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172208(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172216(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172224(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172232(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172240(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172248(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172256(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+172264(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x0a\x0a\x23"
-DATA ·d+172272(SB)/8,$"\x74\x6f\x63\x20\x3e\x20\x75\x6c"
-DATA ·d+172280(SB)/8,$"\x20\x3e\x20\x6c\x69\x20\x3e\x20"
-DATA ·d+172288(SB)/8,$"\x61\x20\x3e\x20\x73\x70\x61\x6e"
-DATA ·d+172296(SB)/8,$"\x20\x7b\x0a\x20\x20\x66\x6c\x6f"
-DATA ·d+172304(SB)/8,$"\x61\x74\x3a\x20\x72\x69\x67\x68"
-DATA ·d+172312(SB)/8,$"\x74\x3b\x0a\x20\x20\x62\x61\x63"
-DATA ·d+172320(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2d"
-DATA ·d+172328(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x23"
-DATA ·d+172336(SB)/8,$"\x32\x34\x38\x34\x46\x46\x3b\x0a"
-DATA ·d+172344(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+172352(SB)/8,$"\x2d\x72\x61\x64\x69\x75\x73\x3a"
-DATA ·d+172360(SB)/8,$"\x20\x34\x30\x70\x78\x3b\x0a\x20"
-DATA ·d+172368(SB)/8,$"\x20\x77\x69\x64\x74\x68\x3a\x20"
-DATA ·d+172376(SB)/8,$"\x32\x30\x70\x78\x3b\x0a\x7d\x0a"
-DATA ·d+172384(SB)/8,$"\x0a\x2e\x74\x6f\x63\x2d\x77\x72"
-DATA ·d+172392(SB)/8,$"\x61\x70\x70\x65\x72\x20\x7b\x0a"
-DATA ·d+172400(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
-DATA ·d+172408(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6c\x65"
-DATA ·d+172416(SB)/8,$"\x66\x74\x20\x30\x2e\x33\x73\x20"
-DATA ·d+172424(SB)/8,$"\x65\x61\x73\x65\x2d\x69\x6e\x2d"
-DATA ·d+172432(SB)/8,$"\x6f\x75\x74\x3b\x0a\x0a\x20\x20"
-DATA ·d+172440(SB)/8,$"\x6f\x76\x65\x72\x66\x6c\x6f\x77"
-DATA ·d+172448(SB)/8,$"\x2d\x79\x3a\x20\x61\x75\x74\x6f"
-DATA ·d+172456(SB)/8,$"\x3b\x0a\x20\x20\x6f\x76\x65\x72"
-DATA ·d+172464(SB)/8,$"\x66\x6c\x6f\x77\x2d\x78\x3a\x20"
-DATA ·d+172472(SB)/8,$"\x68\x69\x64\x64\x65\x6e\x3b\x0a"
-DATA ·d+172480(SB)/8,$"\x20\x20\x70\x6f\x73\x69\x74\x69"
-DATA ·d+172488(SB)/8,$"\x6f\x6e\x3a\x20\x66\x69\x78\x65"
-DATA ·d+172496(SB)/8,$"\x64\x3b\x0a\x20\x20\x7a\x2d\x69"
-DATA ·d+172504(SB)/8,$"\x6e\x64\x65\x78\x3a\x20\x33\x30"
-DATA ·d+172512(SB)/8,$"\x3b\x0a\x20\x20\x74\x6f\x70\x3a"
-DATA ·d+172520(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x6c\x65"
-DATA ·d+172528(SB)/8,$"\x66\x74\x3a\x20\x30\x3b\x0a\x20"
-DATA ·d+172536(SB)/8,$"\x20\x62\x6f\x74\x74\x6f\x6d\x3a"
-DATA ·d+172544(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x77\x69"
-DATA ·d+172552(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x6e\x61"
-DATA ·d+172560(SB)/8,$"\x76\x2d\x77\x69\x64\x74\x68\x3b"
-DATA ·d+172568(SB)/8,$"\x0a\x20\x20\x62\x61\x63\x6b\x67"
-DATA ·d+172576(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
-DATA ·d+172584(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x6e\x61"
-DATA ·d+172592(SB)/8,$"\x76\x2d\x62\x67\x3b\x0a\x20\x20"
-DATA ·d+172600(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
-DATA ·d+172608(SB)/8,$"\x65\x3a\x20\x31\x33\x70\x78\x3b"
-DATA ·d+172616(SB)/8,$"\x0a\x20\x20\x66\x6f\x6e\x74\x2d"
-DATA ·d+172624(SB)/8,$"\x77\x65\x69\x67\x68\x74\x3a\x20"
-DATA ·d+172632(SB)/8,$"\x62\x6f\x6c\x64\x3b\x0a\x0a\x20"
-DATA ·d+172640(SB)/8,$"\x20\x2f\x2f\x20\x6c\x61\x6e\x67"
-DATA ·d+172648(SB)/8,$"\x75\x61\x67\x65\x20\x73\x65\x6c"
-DATA ·d+172656(SB)/8,$"\x65\x63\x74\x6f\x72\x20\x66\x6f"
-DATA ·d+172664(SB)/8,$"\x72\x20\x6d\x6f\x62\x69\x6c\x65"
-DATA ·d+172672(SB)/8,$"\x20\x64\x65\x76\x69\x63\x65\x73"
-DATA ·d+172680(SB)/8,$"\x0a\x20\x20\x2e\x6c\x61\x6e\x67"
-DATA ·d+172688(SB)/8,$"\x2d\x73\x65\x6c\x65\x63\x74\x6f"
-DATA ·d+172696(SB)/8,$"\x72\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+172704(SB)/8,$"\x64\x69\x73\x70\x6c\x61\x79\x3a"
-DATA ·d+172712(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
-DATA ·d+172720(SB)/8,$"\x20\x20\x20\x61\x20\x7b\x0a\x20"
-DATA ·d+172728(SB)/8,$"\x20\x20\x20\x20\x20\x70\x61\x64"
-DATA ·d+172736(SB)/8,$"\x64\x69\x6e\x67\x2d\x74\x6f\x70"
-DATA ·d+172744(SB)/8,$"\x3a\x20\x30\x2e\x35\x65\x6d\x3b"
-DATA ·d+172752(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x70"
-DATA ·d+172760(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x2d\x62"
-DATA ·d+172768(SB)/8,$"\x6f\x74\x74\x6f\x6d\x3a\x20\x30"
-DATA ·d+172776(SB)/8,$"\x2e\x35\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+172784(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x7d\x0a"
-DATA ·d+172792(SB)/8,$"\x0a\x20\x20\x2f\x2f\x20\x54\x68"
-DATA ·d+172800(SB)/8,$"\x69\x73\x20\x69\x73\x20\x74\x68"
-DATA ·d+172808(SB)/8,$"\x65\x20\x6c\x6f\x67\x6f\x20\x61"
-DATA ·d+172816(SB)/8,$"\x74\x20\x74\x68\x65\x20\x74\x6f"
-DATA ·d+172824(SB)/8,$"\x70\x20\x6f\x66\x20\x74\x68\x65"
-DATA ·d+172832(SB)/8,$"\x20\x54\x6f\x43\x0a\x20\x20\x2e"
-DATA ·d+172840(SB)/8,$"\x6c\x6f\x67\x6f\x20\x7b\x0a\x20"
-DATA ·d+172848(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
-DATA ·d+172856(SB)/8,$"\x61\x79\x3a\x20\x62\x6c\x6f\x63"
-DATA ·d+172864(SB)/8,$"\x6b\x3b\x0a\x20\x20\x20\x20\x6d"
-DATA ·d+172872(SB)/8,$"\x61\x78\x2d\x77\x69\x64\x74\x68"
-DATA ·d+172880(SB)/8,$"\x3a\x20\x31\x30\x30\x25\x3b\x0a"
-DATA ·d+172888(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+172896(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
-DATA ·d+172904(SB)/8,$"\x6d\x3a\x20\x24\x6c\x6f\x67\x6f"
-DATA ·d+172912(SB)/8,$"\x2d\x6d\x61\x72\x67\x69\x6e\x3b"
-DATA ·d+172920(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+172928(SB)/8,$"\x26\x3e\x2e\x73\x65\x61\x72\x63"
-DATA ·d+172936(SB)/8,$"\x68\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+172944(SB)/8,$"\x70\x6f\x73\x69\x74\x69\x6f\x6e"
-DATA ·d+172952(SB)/8,$"\x3a\x20\x72\x65\x6c\x61\x74\x69"
-DATA ·d+172960(SB)/8,$"\x76\x65\x3b\x0a\x0a\x20\x20\x20"
-DATA ·d+172968(SB)/8,$"\x20\x69\x6e\x70\x75\x74\x20\x7b"
-DATA ·d+172976(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x62"
-DATA ·d+172984(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
-DATA ·d+172992(SB)/8,$"\x64\x3a\x20\x24\x6e\x61\x76\x2d"
-DATA ·d+173000(SB)/8,$"\x62\x67\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+173008(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+173016(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3a\x20"
-DATA ·d+173024(SB)/8,$"\x30\x20\x30\x20\x31\x70\x78\x20"
-DATA ·d+173032(SB)/8,$"\x30\x3b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+173040(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
-DATA ·d+173048(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
-DATA ·d+173056(SB)/8,$"\x73\x65\x61\x72\x63\x68\x2d\x62"
-DATA ·d+173064(SB)/8,$"\x6f\x78\x2d\x62\x6f\x72\x64\x65"
-DATA ·d+173072(SB)/8,$"\x72\x2d\x63\x6f\x6c\x6f\x72\x3b"
-DATA ·d+173080(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x70"
-DATA ·d+173088(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x3a\x20"
-DATA ·d+173096(SB)/8,$"\x36\x70\x78\x20\x30\x20\x36\x70"
-DATA ·d+173104(SB)/8,$"\x78\x20\x32\x30\x70\x78\x3b\x0a"
-DATA ·d+173112(SB)/8,$"\x20\x20\x20\x20\x20\x20\x62\x6f"
-DATA ·d+173120(SB)/8,$"\x78\x2d\x73\x69\x7a\x69\x6e\x67"
-DATA ·d+173128(SB)/8,$"\x3a\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+173136(SB)/8,$"\x2d\x62\x6f\x78\x3b\x0a\x20\x20"
-DATA ·d+173144(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+173152(SB)/8,$"\x69\x6e\x3a\x20\x24\x6e\x61\x76"
-DATA ·d+173160(SB)/8,$"\x2d\x76\x2d\x70\x61\x64\x64\x69"
-DATA ·d+173168(SB)/8,$"\x6e\x67\x20\x24\x6e\x61\x76\x2d"
-DATA ·d+173176(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3b"
-DATA ·d+173184(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x77"
-DATA ·d+173192(SB)/8,$"\x69\x64\x74\x68\x3a\x20\x24\x6e"
-DATA ·d+173200(SB)/8,$"\x61\x76\x2d\x77\x69\x64\x74\x68"
-DATA ·d+173208(SB)/8,$"\x20\x2d\x20\x28\x24\x6e\x61\x76"
-DATA ·d+173216(SB)/8,$"\x2d\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+173224(SB)/8,$"\x2a\x32\x29\x3b\x0a\x20\x20\x20"
-DATA ·d+173232(SB)/8,$"\x20\x20\x20\x6f\x75\x74\x6c\x69"
-DATA ·d+173240(SB)/8,$"\x6e\x65\x3a\x20\x6e\x6f\x6e\x65"
-DATA ·d+173248(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+173256(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
-DATA ·d+173264(SB)/8,$"\x6e\x61\x76\x2d\x74\x65\x78\x74"
-DATA ·d+173272(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+173280(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x2d\x72"
-DATA ·d+173288(SB)/8,$"\x61\x64\x69\x75\x73\x3a\x20\x30"
-DATA ·d+173296(SB)/8,$"\x3b\x20\x2f\x2a\x20\x69\x6f\x73"
-DATA ·d+173304(SB)/8,$"\x20\x68\x61\x73\x20\x61\x20\x64"
-DATA ·d+173312(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x20\x62"
-DATA ·d+173320(SB)/8,$"\x6f\x72\x64\x65\x72\x20\x72\x61"
-DATA ·d+173328(SB)/8,$"\x64\x69\x75\x73\x20\x2a\x2f\x0a"
-DATA ·d+173336(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x0a\x20"
-DATA ·d+173344(SB)/8,$"\x20\x20\x20\x26\x3a\x62\x65\x66"
-DATA ·d+173352(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
-DATA ·d+173360(SB)/8,$"\x20\x20\x20\x20\x70\x6f\x73\x69"
-DATA ·d+173368(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x61\x62"
-DATA ·d+173376(SB)/8,$"\x73\x6f\x6c\x75\x74\x65\x3b\x0a"
-DATA ·d+173384(SB)/8,$"\x20\x20\x20\x20\x20\x20\x74\x6f"
-DATA ·d+173392(SB)/8,$"\x70\x3a\x20\x31\x37\x70\x78\x3b"
-DATA ·d+173400(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x6c"
-DATA ·d+173408(SB)/8,$"\x65\x66\x74\x3a\x20\x24\x6e\x61"
-DATA ·d+173416(SB)/8,$"\x76\x2d\x70\x61\x64\x64\x69\x6e"
-DATA ·d+173424(SB)/8,$"\x67\x3b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+173432(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+173440(SB)/8,$"\x24\x6e\x61\x76\x2d\x74\x65\x78"
-DATA ·d+173448(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+173456(SB)/8,$"\x20\x40\x65\x78\x74\x65\x6e\x64"
-DATA ·d+173464(SB)/8,$"\x20\x25\x69\x63\x6f\x6e\x2d\x73"
-DATA ·d+173472(SB)/8,$"\x65\x61\x72\x63\x68\x3b\x0a\x20"
-DATA ·d+173480(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x7d"
-DATA ·d+173488(SB)/8,$"\x0a\x0a\x20\x20\x2e\x73\x65\x61"
-DATA ·d+173496(SB)/8,$"\x72\x63\x68\x2d\x72\x65\x73\x75"
-DATA ·d+173504(SB)/8,$"\x6c\x74\x73\x20\x7b\x0a\x20\x20"
-DATA ·d+173512(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
-DATA ·d+173520(SB)/8,$"\x2d\x74\x6f\x70\x3a\x20\x30\x3b"
-DATA ·d+173528(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x78"
-DATA ·d+173536(SB)/8,$"\x2d\x73\x69\x7a\x69\x6e\x67\x3a"
-DATA ·d+173544(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
-DATA ·d+173552(SB)/8,$"\x62\x6f\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+173560(SB)/8,$"\x20\x68\x65\x69\x67\x68\x74\x3a"
-DATA ·d+173568(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+173576(SB)/8,$"\x6f\x76\x65\x72\x66\x6c\x6f\x77"
-DATA ·d+173584(SB)/8,$"\x2d\x79\x3a\x20\x61\x75\x74\x6f"
-DATA ·d+173592(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6f\x76"
-DATA ·d+173600(SB)/8,$"\x65\x72\x66\x6c\x6f\x77\x2d\x78"
-DATA ·d+173608(SB)/8,$"\x3a\x20\x68\x69\x64\x64\x65\x6e"
-DATA ·d+173616(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x74\x72"
-DATA ·d+173624(SB)/8,$"\x61\x6e\x73\x69\x74\x69\x6f\x6e"
-DATA ·d+173632(SB)/8,$"\x2d\x70\x72\x6f\x70\x65\x72\x74"
-DATA ·d+173640(SB)/8,$"\x79\x3a\x20\x68\x65\x69\x67\x68"
-DATA ·d+173648(SB)/8,$"\x74\x2c\x20\x6d\x61\x72\x67\x69"
-DATA ·d+173656(SB)/8,$"\x6e\x3b\x0a\x20\x20\x20\x20\x74"
-DATA ·d+173664(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
-DATA ·d+173672(SB)/8,$"\x6e\x2d\x64\x75\x72\x61\x74\x69"
-DATA ·d+173680(SB)/8,$"\x6f\x6e\x3a\x20\x31\x38\x30\x6d"
-DATA ·d+173688(SB)/8,$"\x73\x3b\x0a\x20\x20\x20\x20\x74"
-DATA ·d+173696(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
-DATA ·d+173704(SB)/8,$"\x6e\x2d\x74\x69\x6d\x69\x6e\x67"
-DATA ·d+173712(SB)/8,$"\x2d\x66\x75\x6e\x63\x74\x69\x6f"
-DATA ·d+173720(SB)/8,$"\x6e\x3a\x20\x65\x61\x73\x65\x2d"
-DATA ·d+173728(SB)/8,$"\x69\x6e\x2d\x6f\x75\x74\x3b\x0a"
-DATA ·d+173736(SB)/8,$"\x20\x20\x20\x20\x62\x61\x63\x6b"
-DATA ·d+173744(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x3a\x20"
-DATA ·d+173752(SB)/8,$"\x24\x6e\x61\x76\x2d\x73\x75\x62"
-DATA ·d+173760(SB)/8,$"\x69\x74\x65\x6d\x2d\x62\x67\x3b"
-DATA ·d+173768(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x2e\x76"
-DATA ·d+173776(SB)/8,$"\x69\x73\x69\x62\x6c\x65\x20\x7b"
-DATA ·d+173784(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x68"
-DATA ·d+173792(SB)/8,$"\x65\x69\x67\x68\x74\x3a\x20\x33"
-DATA ·d+173800(SB)/8,$"\x30\x25\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+173808(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
-DATA ·d+173816(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
-DATA ·d+173824(SB)/8,$"\x20\x31\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+173832(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x20"
-DATA ·d+173840(SB)/8,$"\x20\x6c\x69\x20\x7b\x0a\x20\x20"
-DATA ·d+173848(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+173856(SB)/8,$"\x69\x6e\x3a\x20\x31\x65\x6d\x20"
-DATA ·d+173864(SB)/8,$"\x24\x6e\x61\x76\x2d\x70\x61\x64"
-DATA ·d+173872(SB)/8,$"\x64\x69\x6e\x67\x3b\x0a\x20\x20"
-DATA ·d+173880(SB)/8,$"\x20\x20\x20\x20\x6c\x69\x6e\x65"
-DATA ·d+173888(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
-DATA ·d+173896(SB)/8,$"\x20\x31\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+173904(SB)/8,$"\x7d\x0a\x0a\x20\x20\x20\x20\x61"
-DATA ·d+173912(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+173920(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+173928(SB)/8,$"\x24\x6e\x61\x76\x2d\x74\x65\x78"
-DATA ·d+173936(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+173944(SB)/8,$"\x20\x74\x65\x78\x74\x2d\x64\x65"
-DATA ·d+173952(SB)/8,$"\x63\x6f\x72\x61\x74\x69\x6f\x6e"
-DATA ·d+173960(SB)/8,$"\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a"
-DATA ·d+173968(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x26"
-DATA ·d+173976(SB)/8,$"\x3a\x68\x6f\x76\x65\x72\x20\x7b"
-DATA ·d+173984(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
-DATA ·d+173992(SB)/8,$"\x20\x74\x65\x78\x74\x2d\x64\x65"
-DATA ·d+174000(SB)/8,$"\x63\x6f\x72\x61\x74\x69\x6f\x6e"
-DATA ·d+174008(SB)/8,$"\x3a\x20\x75\x6e\x64\x65\x72\x6c"
-DATA ·d+174016(SB)/8,$"\x69\x6e\x65\x3b\x0a\x20\x20\x20"
-DATA ·d+174024(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x20"
-DATA ·d+174032(SB)/8,$"\x20\x7d\x0a\x20\x20\x7d\x0a\x0a"
-DATA ·d+174040(SB)/8,$"\x0a\x20\x20\x2f\x2f\x20\x54\x68"
-DATA ·d+174048(SB)/8,$"\x65\x20\x54\x61\x62\x6c\x65\x20"
-DATA ·d+174056(SB)/8,$"\x6f\x66\x20\x43\x6f\x6e\x74\x65"
-DATA ·d+174064(SB)/8,$"\x6e\x74\x73\x20\x69\x73\x20\x63"
-DATA ·d+174072(SB)/8,$"\x6f\x6d\x70\x6f\x73\x65\x64\x20"
-DATA ·d+174080(SB)/8,$"\x6f\x66\x20\x6d\x75\x6c\x74\x69"
-DATA ·d+174088(SB)/8,$"\x70\x6c\x65\x20\x6e\x65\x73\x74"
-DATA ·d+174096(SB)/8,$"\x65\x64\x0a\x20\x20\x2f\x2f\x20"
-DATA ·d+174104(SB)/8,$"\x75\x6e\x6f\x72\x64\x65\x72\x65"
-DATA ·d+174112(SB)/8,$"\x64\x20\x6c\x69\x73\x74\x73\x2e"
-DATA ·d+174120(SB)/8,$"\x20\x20\x54\x68\x65\x73\x65\x20"
-DATA ·d+174128(SB)/8,$"\x73\x74\x79\x6c\x65\x73\x20\x72"
-DATA ·d+174136(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x74\x68"
-DATA ·d+174144(SB)/8,$"\x65\x20\x64\x65\x66\x61\x75\x6c"
-DATA ·d+174152(SB)/8,$"\x74\x0a\x20\x20\x2f\x2f\x20\x73"
-DATA ·d+174160(SB)/8,$"\x74\x79\x6c\x69\x6e\x67\x20\x6f"
-DATA ·d+174168(SB)/8,$"\x66\x20\x61\x6e\x20\x75\x6e\x6f"
-DATA ·d+174176(SB)/8,$"\x72\x64\x65\x72\x65\x64\x20\x6c"
-DATA ·d+174184(SB)/8,$"\x69\x73\x74\x20\x62\x65\x63\x61"
-DATA ·d+174192(SB)/8,$"\x75\x73\x65\x20\x69\x74\x20\x69"
-DATA ·d+174200(SB)/8,$"\x73\x20\x75\x67\x6c\x79\x2e\x0a"
-DATA ·d+174208(SB)/8,$"\x20\x20\x75\x6c\x2c\x20\x6c\x69"
-DATA ·d+174216(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6c"
-DATA ·d+174224(SB)/8,$"\x69\x73\x74\x2d\x73\x74\x79\x6c"
-DATA ·d+174232(SB)/8,$"\x65\x3a\x20\x6e\x6f\x6e\x65\x3b"
-DATA ·d+174240(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+174248(SB)/8,$"\x67\x69\x6e\x3a\x20\x30\x3b\x0a"
-DATA ·d+174256(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+174264(SB)/8,$"\x69\x6e\x67\x3a\x20\x30\x3b\x0a"
-DATA ·d+174272(SB)/8,$"\x20\x20\x20\x20\x6c\x69\x6e\x65"
-DATA ·d+174280(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
-DATA ·d+174288(SB)/8,$"\x20\x32\x38\x70\x78\x3b\x0a\x20"
-DATA ·d+174296(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x6c\x69"
-DATA ·d+174304(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x63"
-DATA ·d+174312(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x6e"
-DATA ·d+174320(SB)/8,$"\x61\x76\x2d\x74\x65\x78\x74\x3b"
-DATA ·d+174328(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x72\x61"
-DATA ·d+174336(SB)/8,$"\x6e\x73\x69\x74\x69\x6f\x6e\x2d"
-DATA ·d+174344(SB)/8,$"\x70\x72\x6f\x70\x65\x72\x74\x79"
-DATA ·d+174352(SB)/8,$"\x3a\x20\x62\x61\x63\x6b\x67\x72"
-DATA ·d+174360(SB)/8,$"\x6f\x75\x6e\x64\x3b\x0a\x20\x20"
-DATA ·d+174368(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
-DATA ·d+174376(SB)/8,$"\x74\x69\x6f\x6e\x2d\x74\x69\x6d"
-DATA ·d+174384(SB)/8,$"\x69\x6e\x67\x2d\x66\x75\x6e\x63"
-DATA ·d+174392(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6c\x69"
-DATA ·d+174400(SB)/8,$"\x6e\x65\x61\x72\x3b\x0a\x20\x20"
-DATA ·d+174408(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
-DATA ·d+174416(SB)/8,$"\x74\x69\x6f\x6e\x2d\x64\x75\x72"
-DATA ·d+174424(SB)/8,$"\x61\x74\x69\x6f\x6e\x3a\x20\x32"
-DATA ·d+174432(SB)/8,$"\x30\x30\x6d\x73\x3b\x0a\x20\x20"
-DATA ·d+174440(SB)/8,$"\x7d\x0a\x0a\x20\x20\x2f\x2f\x20"
-DATA ·d+174448(SB)/8,$"\x54\x68\x69\x73\x20\x69\x73\x20"
-DATA ·d+174456(SB)/8,$"\x74\x68\x65\x20\x63\x75\x72\x72"
-DATA ·d+174464(SB)/8,$"\x65\x6e\x74\x6c\x79\x20\x73\x65"
-DATA ·d+174472(SB)/8,$"\x6c\x65\x63\x74\x65\x64\x20\x54"
-DATA ·d+174480(SB)/8,$"\x6f\x43\x20\x65\x6e\x74\x72\x79"
-DATA ·d+174488(SB)/8,$"\x0a\x20\x20\x2e\x74\x6f\x63\x2d"
-DATA ·d+174496(SB)/8,$"\x6c\x69\x6e\x6b\x2e\x61\x63\x74"
-DATA ·d+174504(SB)/8,$"\x69\x76\x65\x20\x7b\x0a\x20\x20"
-DATA ·d+174512(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
-DATA ·d+174520(SB)/8,$"\x6f\x75\x6e\x64\x2d\x63\x6f\x6c"
-DATA ·d+174528(SB)/8,$"\x6f\x72\x3a\x20\x24\x6e\x61\x76"
-DATA ·d+174536(SB)/8,$"\x2d\x61\x63\x74\x69\x76\x65\x2d"
-DATA ·d+174544(SB)/8,$"\x62\x67\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+174552(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
-DATA ·d+174560(SB)/8,$"\x6e\x61\x76\x2d\x61\x63\x74\x69"
-DATA ·d+174568(SB)/8,$"\x76\x65\x2d\x74\x65\x78\x74\x3b"
-DATA ·d+174576(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+174584(SB)/8,$"\x2f\x2f\x20\x74\x68\x69\x73\x20"
-DATA ·d+174592(SB)/8,$"\x69\x73\x20\x70\x61\x72\x65\x6e"
-DATA ·d+174600(SB)/8,$"\x74\x20\x6c\x69\x6e\x6b\x73\x20"
-DATA ·d+174608(SB)/8,$"\x6f\x66\x20\x74\x68\x65\x20\x63"
-DATA ·d+174616(SB)/8,$"\x75\x72\x72\x65\x6e\x74\x6c\x79"
-DATA ·d+174624(SB)/8,$"\x20\x73\x65\x6c\x65\x63\x74\x65"
-DATA ·d+174632(SB)/8,$"\x64\x20\x54\x6f\x43\x20\x65\x6e"
-DATA ·d+174640(SB)/8,$"\x74\x72\x79\x0a\x20\x20\x2e\x74"
-DATA ·d+174648(SB)/8,$"\x6f\x63\x2d\x6c\x69\x6e\x6b\x2e"
-DATA ·d+174656(SB)/8,$"\x61\x63\x74\x69\x76\x65\x2d\x70"
-DATA ·d+174664(SB)/8,$"\x61\x72\x65\x6e\x74\x20\x7b\x0a"
-DATA ·d+174672(SB)/8,$"\x20\x20\x20\x20\x62\x61\x63\x6b"
-DATA ·d+174680(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x2d\x63"
-DATA ·d+174688(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x6e"
-DATA ·d+174696(SB)/8,$"\x61\x76\x2d\x61\x63\x74\x69\x76"
-DATA ·d+174704(SB)/8,$"\x65\x2d\x70\x61\x72\x65\x6e\x74"
-DATA ·d+174712(SB)/8,$"\x2d\x62\x67\x3b\x0a\x20\x20\x20"
-DATA ·d+174720(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+174728(SB)/8,$"\x24\x6e\x61\x76\x2d\x61\x63\x74"
-DATA ·d+174736(SB)/8,$"\x69\x76\x65\x2d\x70\x61\x72\x65"
-DATA ·d+174744(SB)/8,$"\x6e\x74\x2d\x74\x65\x78\x74\x3b"
-DATA ·d+174752(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+174760(SB)/8,$"\x2e\x74\x6f\x63\x2d\x6c\x69\x73"
-DATA ·d+174768(SB)/8,$"\x74\x2d\x68\x32\x20\x7b\x0a\x20"
-DATA ·d+174776(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
-DATA ·d+174784(SB)/8,$"\x61\x79\x3a\x20\x6e\x6f\x6e\x65"
-DATA ·d+174792(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x62\x61"
-DATA ·d+174800(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
-DATA ·d+174808(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+174816(SB)/8,$"\x24\x6e\x61\x76\x2d\x73\x75\x62"
-DATA ·d+174824(SB)/8,$"\x69\x74\x65\x6d\x2d\x62\x67\x3b"
-DATA ·d+174832(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
-DATA ·d+174840(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
-DATA ·d+174848(SB)/8,$"\x3a\x20\x35\x30\x30\x3b\x0a\x20"
-DATA ·d+174856(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x2e\x74"
-DATA ·d+174864(SB)/8,$"\x6f\x63\x2d\x68\x32\x20\x7b\x0a"
-DATA ·d+174872(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+174880(SB)/8,$"\x69\x6e\x67\x2d\x6c\x65\x66\x74"
-DATA ·d+174888(SB)/8,$"\x3a\x20\x24\x6e\x61\x76\x2d\x70"
-DATA ·d+174896(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x20\x2b"
-DATA ·d+174904(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x69\x6e"
-DATA ·d+174912(SB)/8,$"\x64\x65\x6e\x74\x3b\x0a\x20\x20"
-DATA ·d+174920(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x73"
-DATA ·d+174928(SB)/8,$"\x69\x7a\x65\x3a\x20\x31\x32\x70"
-DATA ·d+174936(SB)/8,$"\x78\x3b\x0a\x20\x20\x7d\x0a\x0a"
-DATA ·d+174944(SB)/8,$"\x20\x20\x2e\x74\x6f\x63\x2d\x66"
-DATA ·d+174952(SB)/8,$"\x6f\x6f\x74\x65\x72\x20\x7b\x0a"
-DATA ·d+174960(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+174968(SB)/8,$"\x69\x6e\x67\x3a\x20\x31\x65\x6d"
-DATA ·d+174976(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+174984(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x74"
-DATA ·d+174992(SB)/8,$"\x6f\x70\x3a\x20\x31\x65\x6d\x3b"
-DATA ·d+175000(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
-DATA ·d+175008(SB)/8,$"\x64\x65\x72\x2d\x74\x6f\x70\x3a"
-DATA ·d+175016(SB)/8,$"\x20\x31\x70\x78\x20\x64\x61\x73"
-DATA ·d+175024(SB)/8,$"\x68\x65\x64\x20\x24\x6e\x61\x76"
-DATA ·d+175032(SB)/8,$"\x2d\x66\x6f\x6f\x74\x65\x72\x2d"
-DATA ·d+175040(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x2d\x63"
-DATA ·d+175048(SB)/8,$"\x6f\x6c\x6f\x72\x3b\x0a\x0a\x20"
-DATA ·d+175056(SB)/8,$"\x20\x20\x20\x6c\x69\x2c\x61\x20"
-DATA ·d+175064(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+175072(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
-DATA ·d+175080(SB)/8,$"\x6e\x61\x76\x2d\x74\x65\x78\x74"
-DATA ·d+175088(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+175096(SB)/8,$"\x74\x65\x78\x74\x2d\x64\x65\x63"
-DATA ·d+175104(SB)/8,$"\x6f\x72\x61\x74\x69\x6f\x6e\x3a"
-DATA ·d+175112(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
-DATA ·d+175120(SB)/8,$"\x20\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+175128(SB)/8,$"\x20\x20\x61\x3a\x68\x6f\x76\x65"
-DATA ·d+175136(SB)/8,$"\x72\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+175144(SB)/8,$"\x20\x20\x74\x65\x78\x74\x2d\x64"
-DATA ·d+175152(SB)/8,$"\x65\x63\x6f\x72\x61\x74\x69\x6f"
-DATA ·d+175160(SB)/8,$"\x6e\x3a\x20\x75\x6e\x64\x65\x72"
-DATA ·d+175168(SB)/8,$"\x6c\x69\x6e\x65\x3b\x0a\x20\x20"
-DATA ·d+175176(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x20"
-DATA ·d+175184(SB)/8,$"\x20\x6c\x69\x20\x7b\x0a\x20\x20"
-DATA ·d+175192(SB)/8,$"\x20\x20\x20\x20\x66\x6f\x6e\x74"
-DATA ·d+175200(SB)/8,$"\x2d\x73\x69\x7a\x65\x3a\x20\x30"
-DATA ·d+175208(SB)/8,$"\x2e\x38\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+175216(SB)/8,$"\x20\x20\x20\x20\x6c\x69\x6e\x65"
-DATA ·d+175224(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
-DATA ·d+175232(SB)/8,$"\x20\x31\x2e\x37\x3b\x0a\x20\x20"
-DATA ·d+175240(SB)/8,$"\x20\x20\x20\x20\x74\x65\x78\x74"
-DATA ·d+175248(SB)/8,$"\x2d\x64\x65\x63\x6f\x72\x61\x74"
-DATA ·d+175256(SB)/8,$"\x69\x6f\x6e\x3a\x20\x6e\x6f\x6e"
-DATA ·d+175264(SB)/8,$"\x65\x3b\x0a\x20\x20\x20\x20\x7d"
-DATA ·d+175272(SB)/8,$"\x0a\x20\x20\x7d\x0a\x7d\x0a\x0a"
-DATA ·d+175280(SB)/8,$"\x2e\x74\x6f\x63\x2d\x6c\x69\x6e"
-DATA ·d+175288(SB)/8,$"\x6b\x2c\x20\x2e\x74\x6f\x63\x2d"
-DATA ·d+175296(SB)/8,$"\x66\x6f\x6f\x74\x65\x72\x20\x6c"
-DATA ·d+175304(SB)/8,$"\x69\x20\x7b\x0a\x20\x20\x70\x61"
-DATA ·d+175312(SB)/8,$"\x64\x64\x69\x6e\x67\x3a\x20\x30"
-DATA ·d+175320(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x70\x61"
-DATA ·d+175328(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x30\x20"
-DATA ·d+175336(SB)/8,$"\x24\x6e\x61\x76\x2d\x70\x61\x64"
-DATA ·d+175344(SB)/8,$"\x64\x69\x6e\x67\x3b\x0a\x20\x20"
-DATA ·d+175352(SB)/8,$"\x64\x69\x73\x70\x6c\x61\x79\x3a"
-DATA ·d+175360(SB)/8,$"\x20\x62\x6c\x6f\x63\x6b\x3b\x0a"
-DATA ·d+175368(SB)/8,$"\x20\x20\x6f\x76\x65\x72\x66\x6c"
-DATA ·d+175376(SB)/8,$"\x6f\x77\x2d\x78\x3a\x20\x68\x69"
-DATA ·d+175384(SB)/8,$"\x64\x64\x65\x6e\x3b\x0a\x20\x20"
-DATA ·d+175392(SB)/8,$"\x77\x68\x69\x74\x65\x2d\x73\x70"
-DATA ·d+175400(SB)/8,$"\x61\x63\x65\x3a\x20\x6e\x6f\x77"
-DATA ·d+175408(SB)/8,$"\x72\x61\x70\x3b\x0a\x20\x20\x74"
-DATA ·d+175416(SB)/8,$"\x65\x78\x74\x2d\x6f\x76\x65\x72"
-DATA ·d+175424(SB)/8,$"\x66\x6c\x6f\x77\x3a\x20\x65\x6c"
-DATA ·d+175432(SB)/8,$"\x6c\x69\x70\x73\x69\x73\x3b\x0a"
-DATA ·d+175440(SB)/8,$"\x20\x20\x74\x65\x78\x74\x2d\x64"
-DATA ·d+175448(SB)/8,$"\x65\x63\x6f\x72\x61\x74\x69\x6f"
-DATA ·d+175456(SB)/8,$"\x6e\x3a\x20\x6e\x6f\x6e\x65\x3b"
-DATA ·d+175464(SB)/8,$"\x0a\x20\x20\x63\x6f\x6c\x6f\x72"
-DATA ·d+175472(SB)/8,$"\x3a\x20\x24\x6e\x61\x76\x2d\x74"
-DATA ·d+175480(SB)/8,$"\x65\x78\x74\x3b\x0a\x20\x20\x74"
-DATA ·d+175488(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
-DATA ·d+175496(SB)/8,$"\x6e\x2d\x70\x72\x6f\x70\x65\x72"
-DATA ·d+175504(SB)/8,$"\x74\x79\x3a\x20\x62\x61\x63\x6b"
-DATA ·d+175512(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x3b\x0a"
-DATA ·d+175520(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
-DATA ·d+175528(SB)/8,$"\x74\x69\x6f\x6e\x2d\x74\x69\x6d"
-DATA ·d+175536(SB)/8,$"\x69\x6e\x67\x2d\x66\x75\x6e\x63"
-DATA ·d+175544(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6c\x69"
-DATA ·d+175552(SB)/8,$"\x6e\x65\x61\x72\x3b\x0a\x20\x20"
-DATA ·d+175560(SB)/8,$"\x74\x72\x61\x6e\x73\x69\x74\x69"
-DATA ·d+175568(SB)/8,$"\x6f\x6e\x2d\x64\x75\x72\x61\x74"
-DATA ·d+175576(SB)/8,$"\x69\x6f\x6e\x3a\x20\x31\x33\x30"
-DATA ·d+175584(SB)/8,$"\x6d\x73\x3b\x0a\x7d\x0a\x0a\x2f"
-DATA ·d+175592(SB)/8,$"\x2f\x20\x62\x75\x74\x74\x6f\x6e"
-DATA ·d+175600(SB)/8,$"\x20\x74\x6f\x20\x73\x68\x6f\x77"
-DATA ·d+175608(SB)/8,$"\x20\x6e\x61\x76\x69\x67\x61\x74"
-DATA ·d+175616(SB)/8,$"\x69\x6f\x6e\x20\x6f\x6e\x20\x6d"
-DATA ·d+175624(SB)/8,$"\x6f\x62\x69\x6c\x65\x20\x64\x65"
-DATA ·d+175632(SB)/8,$"\x76\x69\x63\x65\x73\x0a\x23\x6e"
-DATA ·d+175640(SB)/8,$"\x61\x76\x2d\x62\x75\x74\x74\x6f"
-DATA ·d+175648(SB)/8,$"\x6e\x20\x7b\x0a\x20\x20\x73\x70"
-DATA ·d+175656(SB)/8,$"\x61\x6e\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+175664(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
-DATA ·d+175672(SB)/8,$"\x3a\x20\x62\x6c\x6f\x63\x6b\x3b"
-DATA ·d+175680(SB)/8,$"\x0a\x20\x20\x20\x20\x24\x73\x69"
-DATA ·d+175688(SB)/8,$"\x64\x65\x2d\x70\x61\x64\x3a\x20"
-DATA ·d+175696(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x70\x61"
-DATA ·d+175704(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x2f\x20"
-DATA ·d+175712(SB)/8,$"\x32\x20\x2d\x20\x38\x70\x78\x3b"
-DATA ·d+175720(SB)/8,$"\x0a\x20\x20\x20\x20\x70\x61\x64"
-DATA ·d+175728(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x24\x73"
-DATA ·d+175736(SB)/8,$"\x69\x64\x65\x2d\x70\x61\x64\x20"
-DATA ·d+175744(SB)/8,$"\x24\x73\x69\x64\x65\x2d\x70\x61"
-DATA ·d+175752(SB)/8,$"\x64\x20\x24\x73\x69\x64\x65\x2d"
-DATA ·d+175760(SB)/8,$"\x70\x61\x64\x3b\x0a\x20\x20\x20"
-DATA ·d+175768(SB)/8,$"\x20\x62\x61\x63\x6b\x67\x72\x6f"
-DATA ·d+175776(SB)/8,$"\x75\x6e\x64\x2d\x63\x6f\x6c\x6f"
-DATA ·d+175784(SB)/8,$"\x72\x3a\x20\x72\x67\x62\x61\x28"
-DATA ·d+175792(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x62\x67"
-DATA ·d+175800(SB)/8,$"\x2c\x20\x30\x2e\x37\x29\x3b\x0a"
-DATA ·d+175808(SB)/8,$"\x20\x20\x20\x20\x74\x72\x61\x6e"
-DATA ·d+175816(SB)/8,$"\x73\x66\x6f\x72\x6d\x2d\x6f\x72"
-DATA ·d+175824(SB)/8,$"\x69\x67\x69\x6e\x3a\x20\x30\x20"
-DATA ·d+175832(SB)/8,$"\x30\x3b\x0a\x20\x20\x20\x20\x74"
-DATA ·d+175840(SB)/8,$"\x72\x61\x6e\x73\x66\x6f\x72\x6d"
-DATA ·d+175848(SB)/8,$"\x3a\x20\x72\x6f\x74\x61\x74\x65"
-DATA ·d+175856(SB)/8,$"\x28\x2d\x39\x30\x64\x65\x67\x29"
-DATA ·d+175864(SB)/8,$"\x20\x74\x72\x61\x6e\x73\x6c\x61"
-DATA ·d+175872(SB)/8,$"\x74\x65\x28\x2d\x31\x30\x30\x25"
-DATA ·d+175880(SB)/8,$"\x2c\x20\x30\x29\x3b\x0a\x20\x20"
-DATA ·d+175888(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+175896(SB)/8,$"\x2d\x72\x61\x64\x69\x75\x73\x3a"
-DATA ·d+175904(SB)/8,$"\x20\x30\x20\x30\x20\x30\x20\x35"
-DATA ·d+175912(SB)/8,$"\x70\x78\x3b\x0a\x20\x20\x7d\x0a"
-DATA ·d+175920(SB)/8,$"\x20\x20\x70\x61\x64\x64\x69\x6e"
-DATA ·d+175928(SB)/8,$"\x67\x3a\x20\x30\x20\x31\x2e\x35"
-DATA ·d+175936(SB)/8,$"\x65\x6d\x20\x35\x65\x6d\x20\x30"
-DATA ·d+175944(SB)/8,$"\x3b\x20\x2f\x2f\x20\x69\x6e\x63"
-DATA ·d+175952(SB)/8,$"\x72\x65\x61\x73\x65\x20\x74\x6f"
-DATA ·d+175960(SB)/8,$"\x75\x63\x68\x20\x73\x69\x7a\x65"
-DATA ·d+175968(SB)/8,$"\x20\x61\x72\x65\x61\x0a\x20\x20"
-DATA ·d+175976(SB)/8,$"\x64\x69\x73\x70\x6c\x61\x79\x3a"
-DATA ·d+175984(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
-DATA ·d+175992(SB)/8,$"\x20\x70\x6f\x73\x69\x74\x69\x6f"
-DATA ·d+176000(SB)/8,$"\x6e\x3a\x20\x66\x69\x78\x65\x64"
-DATA ·d+176008(SB)/8,$"\x3b\x0a\x20\x20\x74\x6f\x70\x3a"
-DATA ·d+176016(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x6c\x65"
-DATA ·d+176024(SB)/8,$"\x66\x74\x3a\x20\x30\x3b\x0a\x20"
-DATA ·d+176032(SB)/8,$"\x20\x7a\x2d\x69\x6e\x64\x65\x78"
-DATA ·d+176040(SB)/8,$"\x3a\x20\x31\x30\x30\x3b\x0a\x20"
-DATA ·d+176048(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+176056(SB)/8,$"\x23\x30\x30\x30\x3b\x0a\x20\x20"
-DATA ·d+176064(SB)/8,$"\x74\x65\x78\x74\x2d\x64\x65\x63"
-DATA ·d+176072(SB)/8,$"\x6f\x72\x61\x74\x69\x6f\x6e\x3a"
-DATA ·d+176080(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
-DATA ·d+176088(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x77\x65"
-DATA ·d+176096(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x62\x6f"
-DATA ·d+176104(SB)/8,$"\x6c\x64\x3b\x0a\x20\x20\x6f\x70"
-DATA ·d+176112(SB)/8,$"\x61\x63\x69\x74\x79\x3a\x20\x30"
-DATA ·d+176120(SB)/8,$"\x2e\x37\x3b\x0a\x20\x20\x6c\x69"
-DATA ·d+176128(SB)/8,$"\x6e\x65\x2d\x68\x65\x69\x67\x68"
-DATA ·d+176136(SB)/8,$"\x74\x3a\x20\x31\x36\x70\x78\x3b"
-DATA ·d+176144(SB)/8,$"\x0a\x20\x20\x69\x6d\x67\x20\x7b"
-DATA ·d+176152(SB)/8,$"\x0a\x20\x20\x20\x20\x68\x65\x69"
-DATA ·d+176160(SB)/8,$"\x67\x68\x74\x3a\x20\x31\x36\x70"
-DATA ·d+176168(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x76"
-DATA ·d+176176(SB)/8,$"\x65\x72\x74\x69\x63\x61\x6c\x2d"
-DATA ·d+176184(SB)/8,$"\x61\x6c\x69\x67\x6e\x3a\x20\x62"
-DATA ·d+176192(SB)/8,$"\x6f\x74\x74\x6f\x6d\x3b\x0a\x20"
-DATA ·d+176200(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x74\x72"
-DATA ·d+176208(SB)/8,$"\x61\x6e\x73\x69\x74\x69\x6f\x6e"
-DATA ·d+176216(SB)/8,$"\x3a\x20\x6c\x65\x66\x74\x20\x30"
-DATA ·d+176224(SB)/8,$"\x2e\x33\x73\x20\x65\x61\x73\x65"
-DATA ·d+176232(SB)/8,$"\x2d\x69\x6e\x2d\x6f\x75\x74\x3b"
-DATA ·d+176240(SB)/8,$"\x0a\x0a\x20\x20\x26\x3a\x68\x6f"
-DATA ·d+176248(SB)/8,$"\x76\x65\x72\x20\x7b\x20\x6f\x70"
-DATA ·d+176256(SB)/8,$"\x61\x63\x69\x74\x79\x3a\x20\x31"
-DATA ·d+176264(SB)/8,$"\x3b\x20\x7d\x0a\x20\x20\x26\x2e"
-DATA ·d+176272(SB)/8,$"\x6f\x70\x65\x6e\x20\x7b\x6c\x65"
-DATA ·d+176280(SB)/8,$"\x66\x74\x3a\x20\x24\x6e\x61\x76"
-DATA ·d+176288(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x7d\x0a"
-DATA ·d+176296(SB)/8,$"\x7d\x0a\x0a\x0a\x2f\x2f\x2f\x2f"
-DATA ·d+176304(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176312(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176320(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176328(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176336(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176344(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176352(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176360(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176368(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176376(SB)/8,$"\x2f\x2f\x2f\x2f\x0a\x2f\x2f\x20"
-DATA ·d+176384(SB)/8,$"\x50\x41\x47\x45\x20\x4c\x41\x59"
-DATA ·d+176392(SB)/8,$"\x4f\x55\x54\x20\x41\x4e\x44\x20"
-DATA ·d+176400(SB)/8,$"\x43\x4f\x44\x45\x20\x53\x41\x4d"
-DATA ·d+176408(SB)/8,$"\x50\x4c\x45\x20\x42\x41\x43\x4b"
-DATA ·d+176416(SB)/8,$"\x47\x52\x4f\x55\x4e\x44\x0a\x2f"
-DATA ·d+176424(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176432(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176440(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176448(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176456(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176464(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176472(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176480(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176488(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+176496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
-DATA ·d+176504(SB)/8,$"\x0a\x2e\x70\x61\x67\x65\x2d\x77"
-DATA ·d+176512(SB)/8,$"\x72\x61\x70\x70\x65\x72\x20\x7b"
-DATA ·d+176520(SB)/8,$"\x0a\x20\x20\x6d\x61\x72\x67\x69"
-DATA ·d+176528(SB)/8,$"\x6e\x2d\x6c\x65\x66\x74\x3a\x20"
-DATA ·d+176536(SB)/8,$"\x24\x6e\x61\x76\x2d\x77\x69\x64"
-DATA ·d+176544(SB)/8,$"\x74\x68\x3b\x0a\x20\x20\x70\x6f"
-DATA ·d+176552(SB)/8,$"\x73\x69\x74\x69\x6f\x6e\x3a\x20"
-DATA ·d+176560(SB)/8,$"\x72\x65\x6c\x61\x74\x69\x76\x65"
-DATA ·d+176568(SB)/8,$"\x3b\x0a\x20\x20\x7a\x2d\x69\x6e"
-DATA ·d+176576(SB)/8,$"\x64\x65\x78\x3a\x20\x31\x30\x3b"
-DATA ·d+176584(SB)/8,$"\x0a\x20\x20\x62\x61\x63\x6b\x67"
-DATA ·d+176592(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
-DATA ·d+176600(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x6d\x61"
-DATA ·d+176608(SB)/8,$"\x69\x6e\x2d\x62\x67\x3b\x0a\x20"
-DATA ·d+176616(SB)/8,$"\x20\x6d\x69\x6e\x2d\x68\x65\x69"
-DATA ·d+176624(SB)/8,$"\x67\x68\x74\x3a\x20\x31\x30\x30"
-DATA ·d+176632(SB)/8,$"\x25\x3b\x0a\x0a\x20\x20\x70\x61"
-DATA ·d+176640(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x62\x6f"
-DATA ·d+176648(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x31\x70"
-DATA ·d+176656(SB)/8,$"\x78\x3b\x20\x2f\x2f\x20\x70\x72"
-DATA ·d+176664(SB)/8,$"\x65\x76\x65\x6e\x74\x20\x6d\x61"
-DATA ·d+176672(SB)/8,$"\x72\x67\x69\x6e\x20\x6f\x76\x65"
-DATA ·d+176680(SB)/8,$"\x72\x66\x6c\x6f\x77\x0a\x0a\x20"
-DATA ·d+176688(SB)/8,$"\x20\x2f\x2f\x20\x54\x68\x65\x20"
-DATA ·d+176696(SB)/8,$"\x64\x61\x72\x6b\x20\x62\x6f\x78"
-DATA ·d+176704(SB)/8,$"\x20\x69\x73\x20\x77\x68\x61\x74"
-DATA ·d+176712(SB)/8,$"\x20\x67\x69\x76\x65\x73\x20\x74"
-DATA ·d+176720(SB)/8,$"\x68\x65\x20\x63\x6f\x64\x65\x20"
-DATA ·d+176728(SB)/8,$"\x73\x61\x6d\x70\x6c\x65\x73\x20"
-DATA ·d+176736(SB)/8,$"\x74\x68\x65\x69\x72\x20\x64\x61"
-DATA ·d+176744(SB)/8,$"\x72\x6b\x20\x62\x61\x63\x6b\x67"
-DATA ·d+176752(SB)/8,$"\x72\x6f\x75\x6e\x64\x2e\x0a\x20"
-DATA ·d+176760(SB)/8,$"\x20\x2f\x2f\x20\x49\x74\x20\x73"
-DATA ·d+176768(SB)/8,$"\x69\x74\x73\x20\x65\x73\x73\x65"
-DATA ·d+176776(SB)/8,$"\x6e\x74\x69\x61\x6c\x6c\x79\x20"
-DATA ·d+176784(SB)/8,$"\x75\x6e\x64\x65\x72\x20\x74\x68"
-DATA ·d+176792(SB)/8,$"\x65\x20\x61\x63\x74\x75\x61\x6c"
-DATA ·d+176800(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
-DATA ·d+176808(SB)/8,$"\x20\x62\x6c\x6f\x63\x6b\x2c\x20"
-DATA ·d+176816(SB)/8,$"\x77\x68\x69\x63\x68\x20\x68\x61"
-DATA ·d+176824(SB)/8,$"\x73\x20\x61\x0a\x20\x20\x2f\x2f"
-DATA ·d+176832(SB)/8,$"\x20\x74\x72\x61\x6e\x73\x70\x61"
-DATA ·d+176840(SB)/8,$"\x72\x65\x6e\x74\x20\x62\x61\x63"
-DATA ·d+176848(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2e"
-DATA ·d+176856(SB)/8,$"\x0a\x20\x20\x2f\x2f\x20\x49\x20"
-DATA ·d+176864(SB)/8,$"\x6b\x6e\x6f\x77\x2c\x20\x69\x74"
-DATA ·d+176872(SB)/8,$"\x27\x73\x20\x68\x61\x63\x6b\x69"
-DATA ·d+176880(SB)/8,$"\x73\x68\x2c\x20\x62\x75\x74\x20"
-DATA ·d+176888(SB)/8,$"\x69\x74\x27\x73\x20\x74\x68\x65"
-DATA ·d+176896(SB)/8,$"\x20\x73\x69\x6d\x70\x6c\x69\x73"
-DATA ·d+176904(SB)/8,$"\x74\x20\x77\x61\x79\x20\x74\x6f"
-DATA ·d+176912(SB)/8,$"\x20\x6d\x61\x6b\x65\x20\x74\x68"
-DATA ·d+176920(SB)/8,$"\x65\x20\x6c\x65\x66\x74\x0a\x20"
-DATA ·d+176928(SB)/8,$"\x20\x2f\x2f\x20\x68\x61\x6c\x66"
-DATA ·d+176936(SB)/8,$"\x20\x6f\x66\x20\x74\x68\x65\x20"
-DATA ·d+176944(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x20"
-DATA ·d+176952(SB)/8,$"\x61\x6c\x77\x61\x79\x73\x20\x74"
-DATA ·d+176960(SB)/8,$"\x68\x69\x73\x20\x62\x61\x63\x6b"
-DATA ·d+176968(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x20\x63"
-DATA ·d+176976(SB)/8,$"\x6f\x6c\x6f\x72\x2e\x0a\x20\x20"
-DATA ·d+176984(SB)/8,$"\x2e\x64\x61\x72\x6b\x2d\x62\x6f"
-DATA ·d+176992(SB)/8,$"\x78\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+177000(SB)/8,$"\x77\x69\x64\x74\x68\x3a\x20\x24"
-DATA ·d+177008(SB)/8,$"\x65\x78\x61\x6d\x70\x6c\x65\x73"
-DATA ·d+177016(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3b\x0a"
-DATA ·d+177024(SB)/8,$"\x20\x20\x20\x20\x62\x61\x63\x6b"
-DATA ·d+177032(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x2d\x63"
-DATA ·d+177040(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x65"
-DATA ·d+177048(SB)/8,$"\x78\x61\x6d\x70\x6c\x65\x73\x2d"
-DATA ·d+177056(SB)/8,$"\x62\x67\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+177064(SB)/8,$"\x70\x6f\x73\x69\x74\x69\x6f\x6e"
-DATA ·d+177072(SB)/8,$"\x3a\x20\x61\x62\x73\x6f\x6c\x75"
-DATA ·d+177080(SB)/8,$"\x74\x65\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+177088(SB)/8,$"\x72\x69\x67\x68\x74\x3a\x20\x30"
-DATA ·d+177096(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x74\x6f"
-DATA ·d+177104(SB)/8,$"\x70\x3a\x20\x30\x3b\x0a\x20\x20"
-DATA ·d+177112(SB)/8,$"\x20\x20\x62\x6f\x74\x74\x6f\x6d"
-DATA ·d+177120(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x7d"
-DATA ·d+177128(SB)/8,$"\x0a\x0a\x20\x20\x2e\x6c\x61\x6e"
-DATA ·d+177136(SB)/8,$"\x67\x2d\x73\x65\x6c\x65\x63\x74"
-DATA ·d+177144(SB)/8,$"\x6f\x72\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+177152(SB)/8,$"\x20\x70\x6f\x73\x69\x74\x69\x6f"
-DATA ·d+177160(SB)/8,$"\x6e\x3a\x20\x66\x69\x78\x65\x64"
-DATA ·d+177168(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x7a\x2d"
-DATA ·d+177176(SB)/8,$"\x69\x6e\x64\x65\x78\x3a\x20\x35"
-DATA ·d+177184(SB)/8,$"\x30\x3b\x0a\x20\x20\x20\x20\x62"
-DATA ·d+177192(SB)/8,$"\x6f\x72\x64\x65\x72\x2d\x62\x6f"
-DATA ·d+177200(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x35\x70"
-DATA ·d+177208(SB)/8,$"\x78\x20\x73\x6f\x6c\x69\x64\x20"
-DATA ·d+177216(SB)/8,$"\x24\x6c\x61\x6e\x67\x2d\x73\x65"
-DATA ·d+177224(SB)/8,$"\x6c\x65\x63\x74\x2d\x61\x63\x74"
-DATA ·d+177232(SB)/8,$"\x69\x76\x65\x2d\x62\x67\x3b\x0a"
-DATA ·d+177240(SB)/8,$"\x20\x20\x7d\x0a\x7d\x0a\x0a\x2e"
-DATA ·d+177248(SB)/8,$"\x6c\x61\x6e\x67\x2d\x73\x65\x6c"
-DATA ·d+177256(SB)/8,$"\x65\x63\x74\x6f\x72\x20\x7b\x0a"
-DATA ·d+177264(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
-DATA ·d+177272(SB)/8,$"\x6f\x75\x6e\x64\x2d\x63\x6f\x6c"
-DATA ·d+177280(SB)/8,$"\x6f\x72\x3a\x20\x24\x6c\x61\x6e"
-DATA ·d+177288(SB)/8,$"\x67\x2d\x73\x65\x6c\x65\x63\x74"
-DATA ·d+177296(SB)/8,$"\x2d\x62\x67\x3b\x0a\x20\x20\x77"
-DATA ·d+177304(SB)/8,$"\x69\x64\x74\x68\x3a\x20\x31\x30"
-DATA ·d+177312(SB)/8,$"\x30\x25\x3b\x0a\x20\x20\x66\x6f"
-DATA ·d+177320(SB)/8,$"\x6e\x74\x2d\x77\x65\x69\x67\x68"
-DATA ·d+177328(SB)/8,$"\x74\x3a\x20\x62\x6f\x6c\x64\x3b"
-DATA ·d+177336(SB)/8,$"\x0a\x20\x20\x61\x20\x7b\x0a\x20"
-DATA ·d+177344(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
-DATA ·d+177352(SB)/8,$"\x61\x79\x3a\x20\x62\x6c\x6f\x63"
-DATA ·d+177360(SB)/8,$"\x6b\x3b\x0a\x20\x20\x20\x20\x66"
-DATA ·d+177368(SB)/8,$"\x6c\x6f\x61\x74\x3a\x6c\x65\x66"
-DATA ·d+177376(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x63"
-DATA ·d+177384(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x6c"
-DATA ·d+177392(SB)/8,$"\x61\x6e\x67\x2d\x73\x65\x6c\x65"
-DATA ·d+177400(SB)/8,$"\x63\x74\x2d\x74\x65\x78\x74\x3b"
-DATA ·d+177408(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x65\x78"
-DATA ·d+177416(SB)/8,$"\x74\x2d\x64\x65\x63\x6f\x72\x61"
-DATA ·d+177424(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6e\x6f"
-DATA ·d+177432(SB)/8,$"\x6e\x65\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+177440(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
-DATA ·d+177448(SB)/8,$"\x20\x30\x20\x31\x30\x70\x78\x3b"
-DATA ·d+177456(SB)/8,$"\x0a\x20\x20\x20\x20\x6c\x69\x6e"
-DATA ·d+177464(SB)/8,$"\x65\x2d\x68\x65\x69\x67\x68\x74"
-DATA ·d+177472(SB)/8,$"\x3a\x20\x33\x30\x70\x78\x3b\x0a"
-DATA ·d+177480(SB)/8,$"\x20\x20\x20\x20\x6f\x75\x74\x6c"
-DATA ·d+177488(SB)/8,$"\x69\x6e\x65\x3a\x20\x30\x3b\x0a"
-DATA ·d+177496(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x3a\x61"
-DATA ·d+177504(SB)/8,$"\x63\x74\x69\x76\x65\x2c\x20\x26"
-DATA ·d+177512(SB)/8,$"\x3a\x66\x6f\x63\x75\x73\x20\x7b"
-DATA ·d+177520(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x62"
-DATA ·d+177528(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
-DATA ·d+177536(SB)/8,$"\x64\x2d\x63\x6f\x6c\x6f\x72\x3a"
-DATA ·d+177544(SB)/8,$"\x20\x24\x6c\x61\x6e\x67\x2d\x73"
-DATA ·d+177552(SB)/8,$"\x65\x6c\x65\x63\x74\x2d\x70\x72"
-DATA ·d+177560(SB)/8,$"\x65\x73\x73\x65\x64\x2d\x62\x67"
-DATA ·d+177568(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+177576(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
-DATA ·d+177584(SB)/8,$"\x6c\x61\x6e\x67\x2d\x73\x65\x6c"
-DATA ·d+177592(SB)/8,$"\x65\x63\x74\x2d\x70\x72\x65\x73"
-DATA ·d+177600(SB)/8,$"\x73\x65\x64\x2d\x74\x65\x78\x74"
-DATA ·d+177608(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x7d\x0a"
-DATA ·d+177616(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x2e\x61"
-DATA ·d+177624(SB)/8,$"\x63\x74\x69\x76\x65\x20\x7b\x0a"
-DATA ·d+177632(SB)/8,$"\x20\x20\x20\x20\x20\x20\x62\x61"
-DATA ·d+177640(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
-DATA ·d+177648(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+177656(SB)/8,$"\x24\x6c\x61\x6e\x67\x2d\x73\x65"
-DATA ·d+177664(SB)/8,$"\x6c\x65\x63\x74\x2d\x61\x63\x74"
-DATA ·d+177672(SB)/8,$"\x69\x76\x65\x2d\x62\x67\x3b\x0a"
-DATA ·d+177680(SB)/8,$"\x20\x20\x20\x20\x20\x20\x63\x6f"
-DATA ·d+177688(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x6c\x61"
-DATA ·d+177696(SB)/8,$"\x6e\x67\x2d\x73\x65\x6c\x65\x63"
-DATA ·d+177704(SB)/8,$"\x74\x2d\x61\x63\x74\x69\x76\x65"
-DATA ·d+177712(SB)/8,$"\x2d\x74\x65\x78\x74\x3b\x0a\x20"
-DATA ·d+177720(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x7d"
-DATA ·d+177728(SB)/8,$"\x0a\x0a\x20\x20\x26\x3a\x61\x66"
-DATA ·d+177736(SB)/8,$"\x74\x65\x72\x20\x7b\x0a\x20\x20"
-DATA ·d+177744(SB)/8,$"\x20\x20\x63\x6f\x6e\x74\x65\x6e"
-DATA ·d+177752(SB)/8,$"\x74\x3a\x20\x27\x27\x3b\x0a\x20"
-DATA ·d+177760(SB)/8,$"\x20\x20\x20\x63\x6c\x65\x61\x72"
-DATA ·d+177768(SB)/8,$"\x3a\x20\x62\x6f\x74\x68\x3b\x0a"
-DATA ·d+177776(SB)/8,$"\x20\x20\x20\x20\x64\x69\x73\x70"
-DATA ·d+177784(SB)/8,$"\x6c\x61\x79\x3a\x20\x62\x6c\x6f"
-DATA ·d+177792(SB)/8,$"\x63\x6b\x3b\x0a\x20\x20\x7d\x0a"
-DATA ·d+177800(SB)/8,$"\x7d\x0a\x0a\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177808(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177816(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177824(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177832(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177840(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177848(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177856(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177864(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177872(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177880(SB)/8,$"\x2f\x2f\x2f\x0a\x2f\x2f\x20\x43"
-DATA ·d+177888(SB)/8,$"\x4f\x4e\x54\x45\x4e\x54\x20\x53"
-DATA ·d+177896(SB)/8,$"\x54\x59\x4c\x45\x53\x0a\x2f\x2f"
-DATA ·d+177904(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177912(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177920(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177928(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177936(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177944(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177952(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177960(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177968(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+177976(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x2f"
-DATA ·d+177984(SB)/8,$"\x2f\x20\x54\x68\x69\x73\x20\x69"
-DATA ·d+177992(SB)/8,$"\x73\x20\x61\x6c\x6c\x20\x74\x68"
-DATA ·d+178000(SB)/8,$"\x65\x20\x73\x74\x75\x66\x66\x20"
-DATA ·d+178008(SB)/8,$"\x77\x69\x74\x68\x20\x74\x68\x65"
-DATA ·d+178016(SB)/8,$"\x20\x6c\x69\x67\x68\x74\x20\x62"
-DATA ·d+178024(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
-DATA ·d+178032(SB)/8,$"\x64\x20\x69\x6e\x20\x74\x68\x65"
-DATA ·d+178040(SB)/8,$"\x20\x6c\x65\x66\x74\x20\x68\x61"
-DATA ·d+178048(SB)/8,$"\x6c\x66\x20\x6f\x66\x20\x74\x68"
-DATA ·d+178056(SB)/8,$"\x65\x20\x70\x61\x67\x65\x0a\x0a"
-DATA ·d+178064(SB)/8,$"\x2e\x63\x6f\x6e\x74\x65\x6e\x74"
-DATA ·d+178072(SB)/8,$"\x20\x7b\x0a\x20\x20\x2f\x2f\x20"
-DATA ·d+178080(SB)/8,$"\x66\x69\x78\x65\x73\x20\x77\x65"
-DATA ·d+178088(SB)/8,$"\x62\x6b\x69\x74\x20\x72\x65\x6e"
-DATA ·d+178096(SB)/8,$"\x64\x65\x72\x69\x6e\x67\x20\x62"
-DATA ·d+178104(SB)/8,$"\x75\x67\x20\x66\x6f\x72\x20\x73"
-DATA ·d+178112(SB)/8,$"\x6f\x6d\x65\x3a\x20\x73\x65\x65"
-DATA ·d+178120(SB)/8,$"\x20\x23\x35\x33\x38\x0a\x20\x20"
-DATA ·d+178128(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
-DATA ·d+178136(SB)/8,$"\x74\x72\x61\x6e\x73\x66\x6f\x72"
-DATA ·d+178144(SB)/8,$"\x6d\x3a\x20\x74\x72\x61\x6e\x73"
-DATA ·d+178152(SB)/8,$"\x6c\x61\x74\x65\x5a\x28\x30\x29"
-DATA ·d+178160(SB)/8,$"\x3b\x0a\x20\x20\x2f\x2f\x20\x74"
-DATA ·d+178168(SB)/8,$"\x6f\x20\x70\x6c\x61\x63\x65\x20"
-DATA ·d+178176(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x20"
-DATA ·d+178184(SB)/8,$"\x61\x62\x6f\x76\x65\x20\x74\x68"
-DATA ·d+178192(SB)/8,$"\x65\x20\x64\x61\x72\x6b\x20\x62"
-DATA ·d+178200(SB)/8,$"\x6f\x78\x0a\x20\x20\x70\x6f\x73"
-DATA ·d+178208(SB)/8,$"\x69\x74\x69\x6f\x6e\x3a\x20\x72"
-DATA ·d+178216(SB)/8,$"\x65\x6c\x61\x74\x69\x76\x65\x3b"
-DATA ·d+178224(SB)/8,$"\x0a\x20\x20\x7a\x2d\x69\x6e\x64"
-DATA ·d+178232(SB)/8,$"\x65\x78\x3a\x20\x33\x30\x3b\x0a"
-DATA ·d+178240(SB)/8,$"\x0a\x20\x20\x26\x3a\x61\x66\x74"
-DATA ·d+178248(SB)/8,$"\x65\x72\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+178256(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
-DATA ·d+178264(SB)/8,$"\x3a\x20\x27\x27\x3b\x0a\x20\x20"
-DATA ·d+178272(SB)/8,$"\x20\x20\x64\x69\x73\x70\x6c\x61"
-DATA ·d+178280(SB)/8,$"\x79\x3a\x20\x62\x6c\x6f\x63\x6b"
-DATA ·d+178288(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x63\x6c"
-DATA ·d+178296(SB)/8,$"\x65\x61\x72\x3a\x20\x62\x6f\x74"
-DATA ·d+178304(SB)/8,$"\x68\x3b\x0a\x20\x20\x7d\x0a\x0a"
-DATA ·d+178312(SB)/8,$"\x20\x20\x26\x3e\x68\x31\x2c\x20"
-DATA ·d+178320(SB)/8,$"\x26\x3e\x68\x32\x2c\x20\x26\x3e"
-DATA ·d+178328(SB)/8,$"\x68\x33\x2c\x20\x26\x3e\x68\x34"
-DATA ·d+178336(SB)/8,$"\x2c\x20\x26\x3e\x68\x35\x2c\x20"
-DATA ·d+178344(SB)/8,$"\x26\x3e\x68\x36\x2c\x20\x26\x3e"
-DATA ·d+178352(SB)/8,$"\x70\x2c\x20\x26\x3e\x74\x61\x62"
-DATA ·d+178360(SB)/8,$"\x6c\x65\x2c\x20\x26\x3e\x75\x6c"
-DATA ·d+178368(SB)/8,$"\x2c\x20\x26\x3e\x6f\x6c\x2c\x20"
-DATA ·d+178376(SB)/8,$"\x26\x3e\x61\x73\x69\x64\x65\x2c"
-DATA ·d+178384(SB)/8,$"\x20\x26\x3e\x64\x6c\x20\x7b\x0a"
-DATA ·d+178392(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+178400(SB)/8,$"\x69\x6e\x2d\x72\x69\x67\x68\x74"
-DATA ·d+178408(SB)/8,$"\x3a\x20\x24\x65\x78\x61\x6d\x70"
-DATA ·d+178416(SB)/8,$"\x6c\x65\x73\x2d\x77\x69\x64\x74"
-DATA ·d+178424(SB)/8,$"\x68\x3b\x0a\x20\x20\x20\x20\x70"
-DATA ·d+178432(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x3a\x20"
-DATA ·d+178440(SB)/8,$"\x30\x20\x24\x6d\x61\x69\x6e\x2d"
-DATA ·d+178448(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3b"
-DATA ·d+178456(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x78"
-DATA ·d+178464(SB)/8,$"\x2d\x73\x69\x7a\x69\x6e\x67\x3a"
-DATA ·d+178472(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
-DATA ·d+178480(SB)/8,$"\x62\x6f\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+178488(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
-DATA ·d+178496(SB)/8,$"\x3a\x20\x62\x6c\x6f\x63\x6b\x3b"
-DATA ·d+178504(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x40\x65"
-DATA ·d+178512(SB)/8,$"\x78\x74\x65\x6e\x64\x20\x25\x6c"
-DATA ·d+178520(SB)/8,$"\x65\x66\x74\x2d\x63\x6f\x6c\x3b"
-DATA ·d+178528(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+178536(SB)/8,$"\x26\x3e\x75\x6c\x2c\x20\x26\x3e"
-DATA ·d+178544(SB)/8,$"\x6f\x6c\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+178552(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+178560(SB)/8,$"\x2d\x6c\x65\x66\x74\x3a\x20\x24"
-DATA ·d+178568(SB)/8,$"\x6d\x61\x69\x6e\x2d\x70\x61\x64"
-DATA ·d+178576(SB)/8,$"\x64\x69\x6e\x67\x20\x2b\x20\x31"
-DATA ·d+178584(SB)/8,$"\x35\x70\x78\x3b\x0a\x20\x20\x7d"
-DATA ·d+178592(SB)/8,$"\x0a\x0a\x20\x20\x2f\x2f\x20\x74"
-DATA ·d+178600(SB)/8,$"\x68\x65\x20\x64\x69\x76\x20\x69"
-DATA ·d+178608(SB)/8,$"\x73\x20\x74\x68\x65\x20\x74\x6f"
-DATA ·d+178616(SB)/8,$"\x63\x69\x66\x79\x20\x68\x69\x64"
-DATA ·d+178624(SB)/8,$"\x64\x65\x6e\x20\x64\x69\x76\x20"
-DATA ·d+178632(SB)/8,$"\x66\x6f\x72\x20\x70\x6c\x61\x63"
-DATA ·d+178640(SB)/8,$"\x65\x68\x6f\x6c\x64\x69\x6e\x67"
-DATA ·d+178648(SB)/8,$"\x20\x73\x74\x75\x66\x66\x0a\x20"
-DATA ·d+178656(SB)/8,$"\x20\x26\x3e\x68\x31\x2c\x20\x26"
-DATA ·d+178664(SB)/8,$"\x3e\x68\x32\x2c\x20\x26\x3e\x64"
-DATA ·d+178672(SB)/8,$"\x69\x76\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+178680(SB)/8,$"\x20\x63\x6c\x65\x61\x72\x3a\x62"
-DATA ·d+178688(SB)/8,$"\x6f\x74\x68\x3b\x0a\x20\x20\x7d"
-DATA ·d+178696(SB)/8,$"\x0a\x0a\x20\x20\x68\x31\x20\x7b"
-DATA ·d+178704(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
-DATA ·d+178712(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x68\x65"
-DATA ·d+178720(SB)/8,$"\x61\x64\x65\x72\x2d\x66\x6f\x6e"
-DATA ·d+178728(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x66"
-DATA ·d+178736(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
-DATA ·d+178744(SB)/8,$"\x3a\x20\x32\x35\x70\x78\x3b\x0a"
-DATA ·d+178752(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+178760(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
-DATA ·d+178768(SB)/8,$"\x20\x30\x2e\x35\x65\x6d\x3b\x0a"
-DATA ·d+178776(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+178784(SB)/8,$"\x69\x6e\x67\x2d\x62\x6f\x74\x74"
-DATA ·d+178792(SB)/8,$"\x6f\x6d\x3a\x20\x30\x2e\x35\x65"
-DATA ·d+178800(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x6d"
-DATA ·d+178808(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x62\x6f"
-DATA ·d+178816(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x24\x68"
-DATA ·d+178824(SB)/8,$"\x31\x2d\x6d\x61\x72\x67\x69\x6e"
-DATA ·d+178832(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3b"
-DATA ·d+178840(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+178848(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
-DATA ·d+178856(SB)/8,$"\x20\x32\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+178864(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+178872(SB)/8,$"\x2d\x74\x6f\x70\x3a\x20\x31\x70"
-DATA ·d+178880(SB)/8,$"\x78\x20\x73\x6f\x6c\x69\x64\x20"
-DATA ·d+178888(SB)/8,$"\x23\x63\x63\x63\x3b\x0a\x20\x20"
-DATA ·d+178896(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+178904(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
-DATA ·d+178912(SB)/8,$"\x20\x31\x70\x78\x20\x73\x6f\x6c"
-DATA ·d+178920(SB)/8,$"\x69\x64\x20\x23\x63\x63\x63\x3b"
-DATA ·d+178928(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x61\x63"
-DATA ·d+178936(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2d"
-DATA ·d+178944(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x23"
-DATA ·d+178952(SB)/8,$"\x66\x64\x66\x64\x66\x64\x3b\x0a"
-DATA ·d+178960(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x68"
-DATA ·d+178968(SB)/8,$"\x31\x3a\x66\x69\x72\x73\x74\x2d"
-DATA ·d+178976(SB)/8,$"\x63\x68\x69\x6c\x64\x2c\x20\x64"
-DATA ·d+178984(SB)/8,$"\x69\x76\x3a\x66\x69\x72\x73\x74"
-DATA ·d+178992(SB)/8,$"\x2d\x63\x68\x69\x6c\x64\x20\x2b"
-DATA ·d+179000(SB)/8,$"\x20\x68\x31\x20\x7b\x0a\x20\x20"
-DATA ·d+179008(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+179016(SB)/8,$"\x2d\x74\x6f\x70\x2d\x77\x69\x64"
-DATA ·d+179024(SB)/8,$"\x74\x68\x3a\x20\x30\x3b\x0a\x20"
-DATA ·d+179032(SB)/8,$"\x20\x20\x20\x6d\x61\x72\x67\x69"
-DATA ·d+179040(SB)/8,$"\x6e\x2d\x74\x6f\x70\x3a\x20\x30"
-DATA ·d+179048(SB)/8,$"\x3b\x0a\x20\x20\x7d\x0a\x0a\x20"
-DATA ·d+179056(SB)/8,$"\x20\x68\x32\x20\x7b\x0a\x20\x20"
-DATA ·d+179064(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
-DATA ·d+179072(SB)/8,$"\x64\x20\x25\x68\x65\x61\x64\x65"
-DATA ·d+179080(SB)/8,$"\x72\x2d\x66\x6f\x6e\x74\x3b\x0a"
-DATA ·d+179088(SB)/8,$"\x20\x20\x20\x20\x66\x6f\x6e\x74"
-DATA ·d+179096(SB)/8,$"\x2d\x73\x69\x7a\x65\x3a\x20\x31"
-DATA ·d+179104(SB)/8,$"\x39\x70\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+179112(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
-DATA ·d+179120(SB)/8,$"\x74\x6f\x70\x3a\x20\x34\x65\x6d"
-DATA ·d+179128(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6d\x61"
-DATA ·d+179136(SB)/8,$"\x72\x67\x69\x6e\x2d\x62\x6f\x74"
-DATA ·d+179144(SB)/8,$"\x74\x6f\x6d\x3a\x20\x30\x3b\x0a"
-DATA ·d+179152(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x72\x64"
-DATA ·d+179160(SB)/8,$"\x65\x72\x2d\x74\x6f\x70\x3a\x20"
-DATA ·d+179168(SB)/8,$"\x31\x70\x78\x20\x73\x6f\x6c\x69"
-DATA ·d+179176(SB)/8,$"\x64\x20\x23\x63\x63\x63\x3b\x0a"
-DATA ·d+179184(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+179192(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
-DATA ·d+179200(SB)/8,$"\x20\x31\x2e\x32\x65\x6d\x3b\x0a"
-DATA ·d+179208(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+179216(SB)/8,$"\x69\x6e\x67\x2d\x62\x6f\x74\x74"
-DATA ·d+179224(SB)/8,$"\x6f\x6d\x3a\x20\x31\x2e\x32\x65"
-DATA ·d+179232(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x62"
-DATA ·d+179240(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
-DATA ·d+179248(SB)/8,$"\x64\x2d\x69\x6d\x61\x67\x65\x3a"
-DATA ·d+179256(SB)/8,$"\x20\x6c\x69\x6e\x65\x61\x72\x2d"
-DATA ·d+179264(SB)/8,$"\x67\x72\x61\x64\x69\x65\x6e\x74"
-DATA ·d+179272(SB)/8,$"\x28\x74\x6f\x20\x62\x6f\x74\x74"
-DATA ·d+179280(SB)/8,$"\x6f\x6d\x2c\x20\x72\x67\x62\x61"
-DATA ·d+179288(SB)/8,$"\x28\x23\x66\x66\x66\x2c\x20\x30"
-DATA ·d+179296(SB)/8,$"\x2e\x32\x29\x2c\x20\x72\x67\x62"
-DATA ·d+179304(SB)/8,$"\x61\x28\x23\x66\x66\x66\x2c\x20"
-DATA ·d+179312(SB)/8,$"\x30\x29\x29\x3b\x0a\x20\x20\x7d"
-DATA ·d+179320(SB)/8,$"\x0a\x0a\x20\x20\x2f\x2f\x20\x68"
-DATA ·d+179328(SB)/8,$"\x32\x73\x20\x72\x69\x67\x68\x74"
-DATA ·d+179336(SB)/8,$"\x20\x61\x66\x74\x65\x72\x20\x68"
-DATA ·d+179344(SB)/8,$"\x31\x73\x20\x73\x68\x6f\x75\x6c"
-DATA ·d+179352(SB)/8,$"\x64\x20\x62\x75\x6d\x70\x20\x72"
-DATA ·d+179360(SB)/8,$"\x69\x67\x68\x74\x20\x75\x70\x0a"
-DATA ·d+179368(SB)/8,$"\x20\x20\x2f\x2f\x20\x61\x67\x61"
-DATA ·d+179376(SB)/8,$"\x69\x6e\x73\x74\x20\x74\x68\x65"
-DATA ·d+179384(SB)/8,$"\x20\x68\x31\x73\x2e\x0a\x20\x20"
-DATA ·d+179392(SB)/8,$"\x68\x31\x20\x2b\x20\x68\x32\x2c"
-DATA ·d+179400(SB)/8,$"\x20\x68\x31\x20\x2b\x20\x64\x69"
-DATA ·d+179408(SB)/8,$"\x76\x20\x2b\x20\x68\x32\x20\x7b"
-DATA ·d+179416(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+179424(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
-DATA ·d+179432(SB)/8,$"\x20\x24\x68\x31\x2d\x6d\x61\x72"
-DATA ·d+179440(SB)/8,$"\x67\x69\x6e\x2d\x62\x6f\x74\x74"
-DATA ·d+179448(SB)/8,$"\x6f\x6d\x20\x2a\x20\x2d\x31\x3b"
-DATA ·d+179456(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
-DATA ·d+179464(SB)/8,$"\x64\x65\x72\x2d\x74\x6f\x70\x3a"
-DATA ·d+179472(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
-DATA ·d+179480(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x33"
-DATA ·d+179488(SB)/8,$"\x2c\x20\x68\x34\x2c\x20\x68\x35"
-DATA ·d+179496(SB)/8,$"\x2c\x20\x68\x36\x20\x7b\x0a\x20"
-DATA ·d+179504(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
-DATA ·d+179512(SB)/8,$"\x6e\x64\x20\x25\x68\x65\x61\x64"
-DATA ·d+179520(SB)/8,$"\x65\x72\x2d\x66\x6f\x6e\x74\x3b"
-DATA ·d+179528(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
-DATA ·d+179536(SB)/8,$"\x74\x2d\x73\x69\x7a\x65\x3a\x20"
-DATA ·d+179544(SB)/8,$"\x31\x35\x70\x78\x3b\x0a\x20\x20"
-DATA ·d+179552(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
-DATA ·d+179560(SB)/8,$"\x2d\x74\x6f\x70\x3a\x20\x32\x2e"
-DATA ·d+179568(SB)/8,$"\x35\x65\x6d\x3b\x0a\x20\x20\x20"
-DATA ·d+179576(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
-DATA ·d+179584(SB)/8,$"\x62\x6f\x74\x74\x6f\x6d\x3a\x20"
-DATA ·d+179592(SB)/8,$"\x30\x2e\x38\x65\x6d\x3b\x0a\x20"
-DATA ·d+179600(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x34"
-DATA ·d+179608(SB)/8,$"\x2c\x20\x68\x35\x2c\x20\x68\x36"
-DATA ·d+179616(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x66"
-DATA ·d+179624(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
-DATA ·d+179632(SB)/8,$"\x3a\x20\x31\x30\x70\x78\x3b\x0a"
-DATA ·d+179640(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x68"
-DATA ·d+179648(SB)/8,$"\x72\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+179656(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x3a\x20"
-DATA ·d+179664(SB)/8,$"\x32\x65\x6d\x20\x30\x3b\x0a\x20"
-DATA ·d+179672(SB)/8,$"\x20\x20\x20\x62\x6f\x72\x64\x65"
-DATA ·d+179680(SB)/8,$"\x72\x2d\x74\x6f\x70\x3a\x20\x32"
-DATA ·d+179688(SB)/8,$"\x70\x78\x20\x73\x6f\x6c\x69\x64"
-DATA ·d+179696(SB)/8,$"\x20\x24\x65\x78\x61\x6d\x70\x6c"
-DATA ·d+179704(SB)/8,$"\x65\x73\x2d\x62\x67\x3b\x0a\x20"
-DATA ·d+179712(SB)/8,$"\x20\x20\x20\x62\x6f\x72\x64\x65"
-DATA ·d+179720(SB)/8,$"\x72\x2d\x62\x6f\x74\x74\x6f\x6d"
-DATA ·d+179728(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x73\x6f"
-DATA ·d+179736(SB)/8,$"\x6c\x69\x64\x20\x24\x6d\x61\x69"
-DATA ·d+179744(SB)/8,$"\x6e\x2d\x62\x67\x3b\x0a\x20\x20"
-DATA ·d+179752(SB)/8,$"\x7d\x0a\x0a\x20\x20\x74\x61\x62"
-DATA ·d+179760(SB)/8,$"\x6c\x65\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+179768(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
-DATA ·d+179776(SB)/8,$"\x62\x6f\x74\x74\x6f\x6d\x3a\x20"
-DATA ·d+179784(SB)/8,$"\x31\x65\x6d\x3b\x0a\x20\x20\x20"
-DATA ·d+179792(SB)/8,$"\x20\x6f\x76\x65\x72\x66\x6c\x6f"
-DATA ·d+179800(SB)/8,$"\x77\x3a\x20\x61\x75\x74\x6f\x3b"
-DATA ·d+179808(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x68\x2c"
-DATA ·d+179816(SB)/8,$"\x74\x64\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+179824(SB)/8,$"\x20\x20\x20\x74\x65\x78\x74\x2d"
-DATA ·d+179832(SB)/8,$"\x61\x6c\x69\x67\x6e\x3a\x20\x6c"
-DATA ·d+179840(SB)/8,$"\x65\x66\x74\x3b\x0a\x20\x20\x20"
-DATA ·d+179848(SB)/8,$"\x20\x20\x20\x76\x65\x72\x74\x69"
-DATA ·d+179856(SB)/8,$"\x63\x61\x6c\x2d\x61\x6c\x69\x67"
-DATA ·d+179864(SB)/8,$"\x6e\x3a\x20\x74\x6f\x70\x3b\x0a"
-DATA ·d+179872(SB)/8,$"\x20\x20\x20\x20\x20\x20\x6c\x69"
-DATA ·d+179880(SB)/8,$"\x6e\x65\x2d\x68\x65\x69\x67\x68"
-DATA ·d+179888(SB)/8,$"\x74\x3a\x20\x31\x2e\x36\x3b\x0a"
-DATA ·d+179896(SB)/8,$"\x20\x20\x20\x20\x20\x20\x63\x6f"
-DATA ·d+179904(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+179912(SB)/8,$"\x20\x20\x20\x20\x20\x77\x68\x69"
-DATA ·d+179920(SB)/8,$"\x74\x65\x2d\x73\x70\x61\x63\x65"
-DATA ·d+179928(SB)/8,$"\x3a\x20\x6e\x6f\x77\x72\x61\x70"
-DATA ·d+179936(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+179944(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x7d\x0a"
-DATA ·d+179952(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x68\x20"
-DATA ·d+179960(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x20\x20"
-DATA ·d+179968(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
-DATA ·d+179976(SB)/8,$"\x20\x35\x70\x78\x20\x31\x30\x70"
-DATA ·d+179984(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x20"
-DATA ·d+179992(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
-DATA ·d+180000(SB)/8,$"\x62\x6f\x74\x74\x6f\x6d\x3a\x20"
-DATA ·d+180008(SB)/8,$"\x31\x70\x78\x20\x73\x6f\x6c\x69"
-DATA ·d+180016(SB)/8,$"\x64\x20\x23\x63\x63\x63\x3b\x0a"
-DATA ·d+180024(SB)/8,$"\x20\x20\x20\x20\x20\x20\x76\x65"
-DATA ·d+180032(SB)/8,$"\x72\x74\x69\x63\x61\x6c\x2d\x61"
-DATA ·d+180040(SB)/8,$"\x6c\x69\x67\x6e\x3a\x20\x62\x6f"
-DATA ·d+180048(SB)/8,$"\x74\x74\x6f\x6d\x3b\x0a\x20\x20"
-DATA ·d+180056(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x20"
-DATA ·d+180064(SB)/8,$"\x20\x74\x64\x20\x7b\x0a\x20\x20"
-DATA ·d+180072(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+180080(SB)/8,$"\x69\x6e\x67\x3a\x20\x31\x30\x70"
-DATA ·d+180088(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x7d"
-DATA ·d+180096(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x74\x72"
-DATA ·d+180104(SB)/8,$"\x3a\x6c\x61\x73\x74\x2d\x63\x68"
-DATA ·d+180112(SB)/8,$"\x69\x6c\x64\x20\x7b\x0a\x20\x20"
-DATA ·d+180120(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x72\x64"
-DATA ·d+180128(SB)/8,$"\x65\x72\x2d\x62\x6f\x74\x74\x6f"
-DATA ·d+180136(SB)/8,$"\x6d\x3a\x20\x31\x70\x78\x20\x73"
-DATA ·d+180144(SB)/8,$"\x6f\x6c\x69\x64\x20\x23\x63\x63"
-DATA ·d+180152(SB)/8,$"\x63\x3b\x0a\x20\x20\x20\x20\x7d"
-DATA ·d+180160(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x74\x72"
-DATA ·d+180168(SB)/8,$"\x3a\x6e\x74\x68\x2d\x63\x68\x69"
-DATA ·d+180176(SB)/8,$"\x6c\x64\x28\x6f\x64\x64\x29\x3e"
-DATA ·d+180184(SB)/8,$"\x74\x64\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+180192(SB)/8,$"\x20\x20\x20\x62\x61\x63\x6b\x67"
-DATA ·d+180200(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
-DATA ·d+180208(SB)/8,$"\x6c\x6f\x72\x3a\x20\x6c\x69\x67"
-DATA ·d+180216(SB)/8,$"\x68\x74\x65\x6e\x28\x24\x6d\x61"
-DATA ·d+180224(SB)/8,$"\x69\x6e\x2d\x62\x67\x2c\x34\x2e"
-DATA ·d+180232(SB)/8,$"\x32\x25\x29\x3b\x0a\x20\x20\x20"
-DATA ·d+180240(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x20\x20"
-DATA ·d+180248(SB)/8,$"\x74\x72\x3a\x6e\x74\x68\x2d\x63"
-DATA ·d+180256(SB)/8,$"\x68\x69\x6c\x64\x28\x65\x76\x65"
-DATA ·d+180264(SB)/8,$"\x6e\x29\x3e\x74\x64\x20\x7b\x0a"
-DATA ·d+180272(SB)/8,$"\x20\x20\x20\x20\x20\x20\x62\x61"
-DATA ·d+180280(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
-DATA ·d+180288(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+180296(SB)/8,$"\x6c\x69\x67\x68\x74\x65\x6e\x28"
-DATA ·d+180304(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x62\x67"
-DATA ·d+180312(SB)/8,$"\x2c\x32\x2e\x34\x25\x29\x3b\x0a"
-DATA ·d+180320(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x20\x20"
-DATA ·d+180328(SB)/8,$"\x7d\x0a\x0a\x20\x20\x64\x74\x20"
-DATA ·d+180336(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x66\x6f"
-DATA ·d+180344(SB)/8,$"\x6e\x74\x2d\x77\x65\x69\x67\x68"
-DATA ·d+180352(SB)/8,$"\x74\x3a\x20\x62\x6f\x6c\x64\x3b"
-DATA ·d+180360(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+180368(SB)/8,$"\x64\x64\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+180376(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
-DATA ·d+180384(SB)/8,$"\x6c\x65\x66\x74\x3a\x20\x31\x35"
-DATA ·d+180392(SB)/8,$"\x70\x78\x3b\x0a\x20\x20\x7d\x0a"
-DATA ·d+180400(SB)/8,$"\x0a\x20\x20\x70\x2c\x20\x6c\x69"
-DATA ·d+180408(SB)/8,$"\x2c\x20\x64\x74\x2c\x20\x64\x64"
-DATA ·d+180416(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6c"
-DATA ·d+180424(SB)/8,$"\x69\x6e\x65\x2d\x68\x65\x69\x67"
-DATA ·d+180432(SB)/8,$"\x68\x74\x3a\x20\x31\x2e\x36\x3b"
-DATA ·d+180440(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+180448(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
-DATA ·d+180456(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x7d\x0a"
-DATA ·d+180464(SB)/8,$"\x0a\x20\x20\x69\x6d\x67\x20\x7b"
-DATA ·d+180472(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x78"
-DATA ·d+180480(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3a\x20"
-DATA ·d+180488(SB)/8,$"\x31\x30\x30\x25\x3b\x0a\x20\x20"
-DATA ·d+180496(SB)/8,$"\x7d\x0a\x0a\x20\x20\x63\x6f\x64"
-DATA ·d+180504(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+180512(SB)/8,$"\x62\x61\x63\x6b\x67\x72\x6f\x75"
-DATA ·d+180520(SB)/8,$"\x6e\x64\x2d\x63\x6f\x6c\x6f\x72"
-DATA ·d+180528(SB)/8,$"\x3a\x20\x72\x67\x62\x61\x28\x30"
-DATA ·d+180536(SB)/8,$"\x2c\x30\x2c\x30\x2c\x30\x2e\x30"
-DATA ·d+180544(SB)/8,$"\x35\x29\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+180552(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
-DATA ·d+180560(SB)/8,$"\x20\x33\x70\x78\x3b\x0a\x20\x20"
+DATA ·d+166616(SB)/8,$"\x2f\x0a\x2f\x2f\x20\x43\x55\x53"
+DATA ·d+166624(SB)/8,$"\x54\x4f\x4d\x49\x5a\x45\x20\x53"
+DATA ·d+166632(SB)/8,$"\x4c\x41\x54\x45\x0a\x2f\x2f\x2f"
+DATA ·d+166640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166664(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166672(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166680(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166688(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166696(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166704(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166712(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x0a\x2f\x2f"
+DATA ·d+166720(SB)/8,$"\x20\x55\x73\x65\x20\x74\x68\x65"
+DATA ·d+166728(SB)/8,$"\x73\x65\x20\x73\x65\x74\x74\x69"
+DATA ·d+166736(SB)/8,$"\x6e\x67\x73\x20\x74\x6f\x20\x68"
+DATA ·d+166744(SB)/8,$"\x65\x6c\x70\x20\x61\x64\x6a\x75"
+DATA ·d+166752(SB)/8,$"\x73\x74\x20\x74\x68\x65\x20\x61"
+DATA ·d+166760(SB)/8,$"\x70\x70\x65\x61\x72\x61\x6e\x63"
+DATA ·d+166768(SB)/8,$"\x65\x20\x6f\x66\x20\x53\x6c\x61"
+DATA ·d+166776(SB)/8,$"\x74\x65\x0a\x0a\x0a\x2f\x2f\x20"
+DATA ·d+166784(SB)/8,$"\x42\x41\x43\x4b\x47\x52\x4f\x55"
+DATA ·d+166792(SB)/8,$"\x4e\x44\x20\x43\x4f\x4c\x4f\x52"
+DATA ·d+166800(SB)/8,$"\x53\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166808(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+166816(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x24"
+DATA ·d+166824(SB)/8,$"\x6e\x61\x76\x2d\x62\x67\x3a\x20"
+DATA ·d+166832(SB)/8,$"\x23\x32\x45\x33\x33\x33\x36\x20"
+DATA ·d+166840(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+166848(SB)/8,$"\x3b\x0a\x24\x65\x78\x61\x6d\x70"
+DATA ·d+166856(SB)/8,$"\x6c\x65\x73\x2d\x62\x67\x3a\x20"
+DATA ·d+166864(SB)/8,$"\x23\x32\x45\x33\x33\x33\x36\x20"
+DATA ·d+166872(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+166880(SB)/8,$"\x3b\x0a\x24\x63\x6f\x64\x65\x2d"
+DATA ·d+166888(SB)/8,$"\x62\x67\x3a\x20\x23\x31\x45\x32"
+DATA ·d+166896(SB)/8,$"\x32\x32\x34\x20\x21\x64\x65\x66"
+DATA ·d+166904(SB)/8,$"\x61\x75\x6c\x74\x3b\x0a\x24\x63"
+DATA ·d+166912(SB)/8,$"\x6f\x64\x65\x2d\x61\x6e\x6e\x6f"
+DATA ·d+166920(SB)/8,$"\x74\x61\x74\x69\x6f\x6e\x2d\x62"
+DATA ·d+166928(SB)/8,$"\x67\x3a\x20\x23\x31\x39\x31\x44"
+DATA ·d+166936(SB)/8,$"\x31\x46\x20\x21\x64\x65\x66\x61"
+DATA ·d+166944(SB)/8,$"\x75\x6c\x74\x3b\x0a\x24\x6e\x61"
+DATA ·d+166952(SB)/8,$"\x76\x2d\x73\x75\x62\x69\x74\x65"
+DATA ·d+166960(SB)/8,$"\x6d\x2d\x62\x67\x3a\x20\x23\x31"
+DATA ·d+166968(SB)/8,$"\x45\x32\x32\x32\x34\x20\x21\x64"
+DATA ·d+166976(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
+DATA ·d+166984(SB)/8,$"\x24\x6e\x61\x76\x2d\x61\x63\x74"
+DATA ·d+166992(SB)/8,$"\x69\x76\x65\x2d\x62\x67\x3a\x20"
+DATA ·d+167000(SB)/8,$"\x23\x30\x46\x37\x35\x44\x34\x20"
+DATA ·d+167008(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+167016(SB)/8,$"\x3b\x0a\x24\x6e\x61\x76\x2d\x61"
+DATA ·d+167024(SB)/8,$"\x63\x74\x69\x76\x65\x2d\x70\x61"
+DATA ·d+167032(SB)/8,$"\x72\x65\x6e\x74\x2d\x62\x67\x3a"
+DATA ·d+167040(SB)/8,$"\x20\x23\x31\x45\x32\x32\x32\x34"
+DATA ·d+167048(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
+DATA ·d+167056(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x70\x61"
+DATA ·d+167064(SB)/8,$"\x72\x65\x6e\x74\x20\x6c\x69\x6e"
+DATA ·d+167072(SB)/8,$"\x6b\x73\x20\x6f\x66\x20\x74\x68"
+DATA ·d+167080(SB)/8,$"\x65\x20\x63\x75\x72\x72\x65\x6e"
+DATA ·d+167088(SB)/8,$"\x74\x20\x73\x65\x63\x74\x69\x6f"
+DATA ·d+167096(SB)/8,$"\x6e\x0a\x24\x6c\x61\x6e\x67\x2d"
+DATA ·d+167104(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x2d\x62"
+DATA ·d+167112(SB)/8,$"\x6f\x72\x64\x65\x72\x3a\x20\x23"
+DATA ·d+167120(SB)/8,$"\x30\x30\x30\x20\x21\x64\x65\x66"
+DATA ·d+167128(SB)/8,$"\x61\x75\x6c\x74\x3b\x0a\x24\x6c"
+DATA ·d+167136(SB)/8,$"\x61\x6e\x67\x2d\x73\x65\x6c\x65"
+DATA ·d+167144(SB)/8,$"\x63\x74\x2d\x62\x67\x3a\x20\x23"
+DATA ·d+167152(SB)/8,$"\x31\x45\x32\x32\x32\x34\x20\x21"
+DATA ·d+167160(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
+DATA ·d+167168(SB)/8,$"\x0a\x24\x6c\x61\x6e\x67\x2d\x73"
+DATA ·d+167176(SB)/8,$"\x65\x6c\x65\x63\x74\x2d\x61\x63"
+DATA ·d+167184(SB)/8,$"\x74\x69\x76\x65\x2d\x62\x67\x3a"
+DATA ·d+167192(SB)/8,$"\x20\x24\x65\x78\x61\x6d\x70\x6c"
+DATA ·d+167200(SB)/8,$"\x65\x73\x2d\x62\x67\x20\x21\x64"
+DATA ·d+167208(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x20"
+DATA ·d+167216(SB)/8,$"\x2f\x2f\x20\x66\x65\x65\x6c\x20"
+DATA ·d+167224(SB)/8,$"\x66\x72\x65\x65\x20\x74\x6f\x20"
+DATA ·d+167232(SB)/8,$"\x63\x68\x61\x6e\x67\x65\x20\x74"
+DATA ·d+167240(SB)/8,$"\x68\x69\x73\x20\x74\x6f\x20\x62"
+DATA ·d+167248(SB)/8,$"\x6c\x75\x65\x20\x6f\x72\x20\x73"
+DATA ·d+167256(SB)/8,$"\x6f\x6d\x65\x74\x68\x69\x6e\x67"
+DATA ·d+167264(SB)/8,$"\x0a\x24\x6c\x61\x6e\x67\x2d\x73"
+DATA ·d+167272(SB)/8,$"\x65\x6c\x65\x63\x74\x2d\x70\x72"
+DATA ·d+167280(SB)/8,$"\x65\x73\x73\x65\x64\x2d\x62\x67"
+DATA ·d+167288(SB)/8,$"\x3a\x20\x23\x31\x31\x31\x20\x21"
+DATA ·d+167296(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
+DATA ·d+167304(SB)/8,$"\x20\x2f\x2f\x20\x63\x6f\x6c\x6f"
+DATA ·d+167312(SB)/8,$"\x72\x20\x6f\x66\x20\x6c\x61\x6e"
+DATA ·d+167320(SB)/8,$"\x67\x75\x61\x67\x65\x20\x74\x61"
+DATA ·d+167328(SB)/8,$"\x62\x20\x62\x67\x20\x77\x68\x65"
+DATA ·d+167336(SB)/8,$"\x6e\x20\x6d\x6f\x75\x73\x65\x20"
+DATA ·d+167344(SB)/8,$"\x69\x73\x20\x70\x72\x65\x73\x73"
+DATA ·d+167352(SB)/8,$"\x65\x64\x0a\x24\x6d\x61\x69\x6e"
+DATA ·d+167360(SB)/8,$"\x2d\x62\x67\x3a\x20\x23\x46\x33"
+DATA ·d+167368(SB)/8,$"\x46\x37\x46\x39\x20\x21\x64\x65"
+DATA ·d+167376(SB)/8,$"\x66\x61\x75\x6c\x74\x3b\x0a\x24"
+DATA ·d+167384(SB)/8,$"\x61\x73\x69\x64\x65\x2d\x6e\x6f"
+DATA ·d+167392(SB)/8,$"\x74\x69\x63\x65\x2d\x62\x67\x3a"
+DATA ·d+167400(SB)/8,$"\x20\x23\x38\x66\x62\x63\x64\x34"
+DATA ·d+167408(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
+DATA ·d+167416(SB)/8,$"\x74\x3b\x0a\x24\x61\x73\x69\x64"
+DATA ·d+167424(SB)/8,$"\x65\x2d\x77\x61\x72\x6e\x69\x6e"
+DATA ·d+167432(SB)/8,$"\x67\x2d\x62\x67\x3a\x20\x23\x63"
+DATA ·d+167440(SB)/8,$"\x39\x37\x61\x37\x65\x20\x21\x64"
+DATA ·d+167448(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
+DATA ·d+167456(SB)/8,$"\x24\x61\x73\x69\x64\x65\x2d\x73"
+DATA ·d+167464(SB)/8,$"\x75\x63\x63\x65\x73\x73\x2d\x62"
+DATA ·d+167472(SB)/8,$"\x67\x3a\x20\x23\x36\x61\x63\x31"
+DATA ·d+167480(SB)/8,$"\x37\x34\x20\x21\x64\x65\x66\x61"
+DATA ·d+167488(SB)/8,$"\x75\x6c\x74\x3b\x0a\x24\x73\x65"
+DATA ·d+167496(SB)/8,$"\x61\x72\x63\x68\x2d\x6e\x6f\x74"
+DATA ·d+167504(SB)/8,$"\x69\x63\x65\x2d\x62\x67\x3a\x20"
+DATA ·d+167512(SB)/8,$"\x23\x63\x39\x37\x61\x37\x65\x20"
+DATA ·d+167520(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+167528(SB)/8,$"\x3b\x0a\x0a\x0a\x2f\x2f\x20\x54"
+DATA ·d+167536(SB)/8,$"\x45\x58\x54\x20\x43\x4f\x4c\x4f"
+DATA ·d+167544(SB)/8,$"\x52\x53\x0a\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+167552(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+167560(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
+DATA ·d+167568(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x74\x65"
+DATA ·d+167576(SB)/8,$"\x78\x74\x3a\x20\x23\x33\x33\x33"
+DATA ·d+167584(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
+DATA ·d+167592(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x6d\x61"
+DATA ·d+167600(SB)/8,$"\x69\x6e\x20\x63\x6f\x6e\x74\x65"
+DATA ·d+167608(SB)/8,$"\x6e\x74\x20\x74\x65\x78\x74\x20"
+DATA ·d+167616(SB)/8,$"\x63\x6f\x6c\x6f\x72\x0a\x24\x6e"
+DATA ·d+167624(SB)/8,$"\x61\x76\x2d\x74\x65\x78\x74\x3a"
+DATA ·d+167632(SB)/8,$"\x20\x23\x66\x66\x66\x20\x21\x64"
+DATA ·d+167640(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
+DATA ·d+167648(SB)/8,$"\x24\x6e\x61\x76\x2d\x61\x63\x74"
+DATA ·d+167656(SB)/8,$"\x69\x76\x65\x2d\x74\x65\x78\x74"
+DATA ·d+167664(SB)/8,$"\x3a\x20\x23\x66\x66\x66\x20\x21"
+DATA ·d+167672(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
+DATA ·d+167680(SB)/8,$"\x0a\x24\x6e\x61\x76\x2d\x61\x63"
+DATA ·d+167688(SB)/8,$"\x74\x69\x76\x65\x2d\x70\x61\x72"
+DATA ·d+167696(SB)/8,$"\x65\x6e\x74\x2d\x74\x65\x78\x74"
+DATA ·d+167704(SB)/8,$"\x3a\x20\x23\x66\x66\x66\x20\x21"
+DATA ·d+167712(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
+DATA ·d+167720(SB)/8,$"\x20\x2f\x2f\x20\x70\x61\x72\x65"
+DATA ·d+167728(SB)/8,$"\x6e\x74\x20\x6c\x69\x6e\x6b\x73"
+DATA ·d+167736(SB)/8,$"\x20\x6f\x66\x20\x74\x68\x65\x20"
+DATA ·d+167744(SB)/8,$"\x63\x75\x72\x72\x65\x6e\x74\x20"
+DATA ·d+167752(SB)/8,$"\x73\x65\x63\x74\x69\x6f\x6e\x0a"
+DATA ·d+167760(SB)/8,$"\x24\x6c\x61\x6e\x67\x2d\x73\x65"
+DATA ·d+167768(SB)/8,$"\x6c\x65\x63\x74\x2d\x74\x65\x78"
+DATA ·d+167776(SB)/8,$"\x74\x3a\x20\x23\x66\x66\x66\x20"
+DATA ·d+167784(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+167792(SB)/8,$"\x3b\x20\x2f\x2f\x20\x63\x6f\x6c"
+DATA ·d+167800(SB)/8,$"\x6f\x72\x20\x6f\x66\x20\x75\x6e"
+DATA ·d+167808(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x65\x64"
+DATA ·d+167816(SB)/8,$"\x20\x6c\x61\x6e\x67\x75\x61\x67"
+DATA ·d+167824(SB)/8,$"\x65\x20\x74\x61\x62\x20\x74\x65"
+DATA ·d+167832(SB)/8,$"\x78\x74\x0a\x24\x6c\x61\x6e\x67"
+DATA ·d+167840(SB)/8,$"\x2d\x73\x65\x6c\x65\x63\x74\x2d"
+DATA ·d+167848(SB)/8,$"\x61\x63\x74\x69\x76\x65\x2d\x74"
+DATA ·d+167856(SB)/8,$"\x65\x78\x74\x3a\x20\x23\x66\x66"
+DATA ·d+167864(SB)/8,$"\x66\x20\x21\x64\x65\x66\x61\x75"
+DATA ·d+167872(SB)/8,$"\x6c\x74\x3b\x20\x2f\x2f\x20\x63"
+DATA ·d+167880(SB)/8,$"\x6f\x6c\x6f\x72\x20\x6f\x66\x20"
+DATA ·d+167888(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x65\x64"
+DATA ·d+167896(SB)/8,$"\x20\x6c\x61\x6e\x67\x75\x61\x67"
+DATA ·d+167904(SB)/8,$"\x65\x20\x74\x61\x62\x20\x74\x65"
+DATA ·d+167912(SB)/8,$"\x78\x74\x0a\x24\x6c\x61\x6e\x67"
+DATA ·d+167920(SB)/8,$"\x2d\x73\x65\x6c\x65\x63\x74\x2d"
+DATA ·d+167928(SB)/8,$"\x70\x72\x65\x73\x73\x65\x64\x2d"
+DATA ·d+167936(SB)/8,$"\x74\x65\x78\x74\x3a\x20\x23\x66"
+DATA ·d+167944(SB)/8,$"\x66\x66\x20\x21\x64\x65\x66\x61"
+DATA ·d+167952(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
+DATA ·d+167960(SB)/8,$"\x63\x6f\x6c\x6f\x72\x20\x6f\x66"
+DATA ·d+167968(SB)/8,$"\x20\x6c\x61\x6e\x67\x75\x61\x67"
+DATA ·d+167976(SB)/8,$"\x65\x20\x74\x61\x62\x20\x74\x65"
+DATA ·d+167984(SB)/8,$"\x78\x74\x20\x77\x68\x65\x6e\x20"
+DATA ·d+167992(SB)/8,$"\x6d\x6f\x75\x73\x65\x20\x69\x73"
+DATA ·d+168000(SB)/8,$"\x20\x70\x72\x65\x73\x73\x65\x64"
+DATA ·d+168008(SB)/8,$"\x0a\x0a\x0a\x2f\x2f\x20\x53\x49"
+DATA ·d+168016(SB)/8,$"\x5a\x45\x53\x0a\x2f\x2f\x2f\x2f"
+DATA ·d+168024(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+168032(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+168040(SB)/8,$"\x0a\x24\x6e\x61\x76\x2d\x77\x69"
+DATA ·d+168048(SB)/8,$"\x64\x74\x68\x3a\x20\x32\x33\x30"
+DATA ·d+168056(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
+DATA ·d+168064(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
+DATA ·d+168072(SB)/8,$"\x77\x69\x64\x74\x68\x20\x6f\x66"
+DATA ·d+168080(SB)/8,$"\x20\x74\x68\x65\x20\x6e\x61\x76"
+DATA ·d+168088(SB)/8,$"\x62\x61\x72\x0a\x24\x65\x78\x61"
+DATA ·d+168096(SB)/8,$"\x6d\x70\x6c\x65\x73\x2d\x77\x69"
+DATA ·d+168104(SB)/8,$"\x64\x74\x68\x3a\x20\x35\x30\x25"
+DATA ·d+168112(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
+DATA ·d+168120(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x70\x6f"
+DATA ·d+168128(SB)/8,$"\x72\x74\x69\x6f\x6e\x20\x6f\x66"
+DATA ·d+168136(SB)/8,$"\x20\x74\x68\x65\x20\x73\x63\x72"
+DATA ·d+168144(SB)/8,$"\x65\x65\x6e\x20\x74\x61\x6b\x65"
+DATA ·d+168152(SB)/8,$"\x6e\x20\x75\x70\x20\x62\x79\x20"
+DATA ·d+168160(SB)/8,$"\x63\x6f\x64\x65\x20\x65\x78\x61"
+DATA ·d+168168(SB)/8,$"\x6d\x70\x6c\x65\x73\x0a\x24\x6c"
+DATA ·d+168176(SB)/8,$"\x6f\x67\x6f\x2d\x6d\x61\x72\x67"
+DATA ·d+168184(SB)/8,$"\x69\x6e\x3a\x20\x30\x70\x78\x20"
+DATA ·d+168192(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+168200(SB)/8,$"\x3b\x20\x2f\x2f\x20\x6d\x61\x72"
+DATA ·d+168208(SB)/8,$"\x67\x69\x6e\x20\x62\x65\x6c\x6f"
+DATA ·d+168216(SB)/8,$"\x77\x20\x6c\x6f\x67\x6f\x0a\x24"
+DATA ·d+168224(SB)/8,$"\x6d\x61\x69\x6e\x2d\x70\x61\x64"
+DATA ·d+168232(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x32\x38"
+DATA ·d+168240(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
+DATA ·d+168248(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
+DATA ·d+168256(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
+DATA ·d+168264(SB)/8,$"\x74\x6f\x20\x6c\x65\x66\x74\x20"
+DATA ·d+168272(SB)/8,$"\x61\x6e\x64\x20\x72\x69\x67\x68"
+DATA ·d+168280(SB)/8,$"\x74\x20\x6f\x66\x20\x63\x6f\x6e"
+DATA ·d+168288(SB)/8,$"\x74\x65\x6e\x74\x20\x26\x20\x65"
+DATA ·d+168296(SB)/8,$"\x78\x61\x6d\x70\x6c\x65\x73\x0a"
+DATA ·d+168304(SB)/8,$"\x24\x6e\x61\x76\x2d\x70\x61\x64"
+DATA ·d+168312(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x31\x35"
+DATA ·d+168320(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
+DATA ·d+168328(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
+DATA ·d+168336(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
+DATA ·d+168344(SB)/8,$"\x74\x6f\x20\x6c\x65\x66\x74\x20"
+DATA ·d+168352(SB)/8,$"\x61\x6e\x64\x20\x72\x69\x67\x68"
+DATA ·d+168360(SB)/8,$"\x74\x20\x6f\x66\x20\x6e\x61\x76"
+DATA ·d+168368(SB)/8,$"\x62\x61\x72\x0a\x24\x6e\x61\x76"
+DATA ·d+168376(SB)/8,$"\x2d\x76\x2d\x70\x61\x64\x64\x69"
+DATA ·d+168384(SB)/8,$"\x6e\x67\x3a\x20\x31\x30\x70\x78"
+DATA ·d+168392(SB)/8,$"\x20\x21\x64\x65\x66\x61\x75\x6c"
+DATA ·d+168400(SB)/8,$"\x74\x3b\x20\x2f\x2f\x20\x70\x61"
+DATA ·d+168408(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x75\x73"
+DATA ·d+168416(SB)/8,$"\x65\x64\x20\x76\x65\x72\x74\x69"
+DATA ·d+168424(SB)/8,$"\x63\x61\x6c\x6c\x79\x20\x61\x72"
+DATA ·d+168432(SB)/8,$"\x6f\x75\x6e\x64\x20\x73\x65\x61"
+DATA ·d+168440(SB)/8,$"\x72\x63\x68\x20\x62\x6f\x78\x65"
+DATA ·d+168448(SB)/8,$"\x73\x20\x61\x6e\x64\x20\x72\x65"
+DATA ·d+168456(SB)/8,$"\x73\x75\x6c\x74\x73\x0a\x24\x6e"
+DATA ·d+168464(SB)/8,$"\x61\x76\x2d\x69\x6e\x64\x65\x6e"
+DATA ·d+168472(SB)/8,$"\x74\x3a\x20\x31\x30\x70\x78\x20"
+DATA ·d+168480(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+168488(SB)/8,$"\x3b\x20\x2f\x2f\x20\x65\x78\x74"
+DATA ·d+168496(SB)/8,$"\x72\x61\x20\x70\x61\x64\x64\x69"
+DATA ·d+168504(SB)/8,$"\x6e\x67\x20\x66\x6f\x72\x20\x54"
+DATA ·d+168512(SB)/8,$"\x6f\x43\x20\x73\x75\x62\x69\x74"
+DATA ·d+168520(SB)/8,$"\x65\x6d\x73\x0a\x24\x63\x6f\x64"
+DATA ·d+168528(SB)/8,$"\x65\x2d\x61\x6e\x6e\x6f\x74\x61"
+DATA ·d+168536(SB)/8,$"\x74\x69\x6f\x6e\x2d\x70\x61\x64"
+DATA ·d+168544(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x31\x33"
+DATA ·d+168552(SB)/8,$"\x70\x78\x20\x21\x64\x65\x66\x61"
+DATA ·d+168560(SB)/8,$"\x75\x6c\x74\x3b\x20\x2f\x2f\x20"
+DATA ·d+168568(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x20"
+DATA ·d+168576(SB)/8,$"\x69\x6e\x73\x69\x64\x65\x20\x63"
+DATA ·d+168584(SB)/8,$"\x6f\x64\x65\x20\x61\x6e\x6e\x6f"
+DATA ·d+168592(SB)/8,$"\x74\x61\x74\x69\x6f\x6e\x73\x0a"
+DATA ·d+168600(SB)/8,$"\x24\x68\x31\x2d\x6d\x61\x72\x67"
+DATA ·d+168608(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
+DATA ·d+168616(SB)/8,$"\x6d\x3a\x20\x32\x31\x70\x78\x20"
+DATA ·d+168624(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+168632(SB)/8,$"\x3b\x20\x2f\x2f\x20\x70\x61\x64"
+DATA ·d+168640(SB)/8,$"\x64\x69\x6e\x67\x20\x75\x6e\x64"
+DATA ·d+168648(SB)/8,$"\x65\x72\x20\x74\x68\x65\x20\x6c"
+DATA ·d+168656(SB)/8,$"\x61\x72\x67\x65\x73\x74\x20\x68"
+DATA ·d+168664(SB)/8,$"\x65\x61\x64\x65\x72\x20\x74\x61"
+DATA ·d+168672(SB)/8,$"\x67\x73\x0a\x24\x74\x61\x62\x6c"
+DATA ·d+168680(SB)/8,$"\x65\x74\x2d\x77\x69\x64\x74\x68"
+DATA ·d+168688(SB)/8,$"\x3a\x20\x39\x33\x30\x70\x78\x20"
+DATA ·d+168696(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+168704(SB)/8,$"\x3b\x20\x2f\x2f\x20\x6d\x69\x6e"
+DATA ·d+168712(SB)/8,$"\x20\x77\x69\x64\x74\x68\x20\x62"
+DATA ·d+168720(SB)/8,$"\x65\x66\x6f\x72\x65\x20\x72\x65"
+DATA ·d+168728(SB)/8,$"\x76\x65\x72\x74\x69\x6e\x67\x20"
+DATA ·d+168736(SB)/8,$"\x74\x6f\x20\x74\x61\x62\x6c\x65"
+DATA ·d+168744(SB)/8,$"\x74\x20\x73\x69\x7a\x65\x0a\x24"
+DATA ·d+168752(SB)/8,$"\x70\x68\x6f\x6e\x65\x2d\x77\x69"
+DATA ·d+168760(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x74\x61"
+DATA ·d+168768(SB)/8,$"\x62\x6c\x65\x74\x2d\x77\x69\x64"
+DATA ·d+168776(SB)/8,$"\x74\x68\x20\x2d\x20\x24\x6e\x61"
+DATA ·d+168784(SB)/8,$"\x76\x2d\x77\x69\x64\x74\x68\x20"
+DATA ·d+168792(SB)/8,$"\x21\x64\x65\x66\x61\x75\x6c\x74"
+DATA ·d+168800(SB)/8,$"\x3b\x20\x2f\x2f\x20\x6d\x69\x6e"
+DATA ·d+168808(SB)/8,$"\x20\x77\x69\x64\x74\x68\x20\x62"
+DATA ·d+168816(SB)/8,$"\x65\x66\x6f\x72\x65\x20\x72\x65"
+DATA ·d+168824(SB)/8,$"\x76\x65\x72\x74\x69\x6e\x67\x20"
+DATA ·d+168832(SB)/8,$"\x74\x6f\x20\x6d\x6f\x62\x69\x6c"
+DATA ·d+168840(SB)/8,$"\x65\x20\x73\x69\x7a\x65\x0a\x0a"
+DATA ·d+168848(SB)/8,$"\x0a\x2f\x2f\x20\x46\x4f\x4e\x54"
+DATA ·d+168856(SB)/8,$"\x53\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+168864(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+168872(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x25"
+DATA ·d+168880(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x2d"
+DATA ·d+168888(SB)/8,$"\x66\x6f\x6e\x74\x20\x7b\x0a\x20"
+DATA ·d+168896(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x66\x61"
+DATA ·d+168904(SB)/8,$"\x6d\x69\x6c\x79\x3a\x20\x2d\x61"
+DATA ·d+168912(SB)/8,$"\x70\x70\x6c\x65\x2d\x73\x79\x73"
+DATA ·d+168920(SB)/8,$"\x74\x65\x6d\x2c\x20\x42\x6c\x69"
+DATA ·d+168928(SB)/8,$"\x6e\x6b\x4d\x61\x63\x53\x79\x73"
+DATA ·d+168936(SB)/8,$"\x74\x65\x6d\x46\x6f\x6e\x74\x2c"
+DATA ·d+168944(SB)/8,$"\x20\x22\x53\x65\x67\x6f\x65\x20"
+DATA ·d+168952(SB)/8,$"\x55\x49\x22\x2c\x20\x52\x6f\x62"
+DATA ·d+168960(SB)/8,$"\x6f\x74\x6f\x2c\x20\x48\x65\x6c"
+DATA ·d+168968(SB)/8,$"\x76\x65\x74\x69\x63\x61\x2c\x20"
+DATA ·d+168976(SB)/8,$"\x41\x72\x69\x61\x6c\x2c\x20\x73"
+DATA ·d+168984(SB)/8,$"\x61\x6e\x73\x2d\x73\x65\x72\x69"
+DATA ·d+168992(SB)/8,$"\x66\x2c\x20\x22\x41\x70\x70\x6c"
+DATA ·d+169000(SB)/8,$"\x65\x20\x43\x6f\x6c\x6f\x72\x20"
+DATA ·d+169008(SB)/8,$"\x45\x6d\x6f\x6a\x69\x22\x2c\x20"
+DATA ·d+169016(SB)/8,$"\x22\x53\x65\x67\x6f\x65\x20\x55"
+DATA ·d+169024(SB)/8,$"\x49\x20\x45\x6d\x6f\x6a\x69\x22"
+DATA ·d+169032(SB)/8,$"\x2c\x20\x22\x53\x65\x67\x6f\x65"
+DATA ·d+169040(SB)/8,$"\x20\x55\x49\x20\x53\x79\x6d\x62"
+DATA ·d+169048(SB)/8,$"\x6f\x6c\x22\x3b\x0a\x20\x20\x66"
+DATA ·d+169056(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
+DATA ·d+169064(SB)/8,$"\x3a\x20\x31\x34\x70\x78\x3b\x0a"
+DATA ·d+169072(SB)/8,$"\x7d\x0a\x0a\x25\x68\x65\x61\x64"
+DATA ·d+169080(SB)/8,$"\x65\x72\x2d\x66\x6f\x6e\x74\x20"
+DATA ·d+169088(SB)/8,$"\x7b\x0a\x20\x20\x40\x65\x78\x74"
+DATA ·d+169096(SB)/8,$"\x65\x6e\x64\x20\x25\x64\x65\x66"
+DATA ·d+169104(SB)/8,$"\x61\x75\x6c\x74\x2d\x66\x6f\x6e"
+DATA ·d+169112(SB)/8,$"\x74\x3b\x0a\x20\x20\x66\x6f\x6e"
+DATA ·d+169120(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
+DATA ·d+169128(SB)/8,$"\x3a\x20\x62\x6f\x6c\x64\x3b\x0a"
+DATA ·d+169136(SB)/8,$"\x7d\x0a\x0a\x25\x63\x6f\x64\x65"
+DATA ·d+169144(SB)/8,$"\x2d\x66\x6f\x6e\x74\x20\x7b\x0a"
+DATA ·d+169152(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x66"
+DATA ·d+169160(SB)/8,$"\x61\x6d\x69\x6c\x79\x3a\x20\x43"
+DATA ·d+169168(SB)/8,$"\x6f\x6e\x73\x6f\x6c\x61\x73\x2c"
+DATA ·d+169176(SB)/8,$"\x20\x4d\x65\x6e\x6c\x6f\x2c\x20"
+DATA ·d+169184(SB)/8,$"\x4d\x6f\x6e\x61\x63\x6f\x2c\x20"
+DATA ·d+169192(SB)/8,$"\x22\x4c\x75\x63\x69\x64\x61\x20"
+DATA ·d+169200(SB)/8,$"\x43\x6f\x6e\x73\x6f\x6c\x65\x22"
+DATA ·d+169208(SB)/8,$"\x2c\x20\x22\x4c\x69\x62\x65\x72"
+DATA ·d+169216(SB)/8,$"\x61\x74\x69\x6f\x6e\x20\x4d\x6f"
+DATA ·d+169224(SB)/8,$"\x6e\x6f\x22\x2c\x20\x22\x44\x65"
+DATA ·d+169232(SB)/8,$"\x6a\x61\x56\x75\x20\x53\x61\x6e"
+DATA ·d+169240(SB)/8,$"\x73\x20\x4d\x6f\x6e\x6f\x22\x2c"
+DATA ·d+169248(SB)/8,$"\x20\x22\x42\x69\x74\x73\x74\x72"
+DATA ·d+169256(SB)/8,$"\x65\x61\x6d\x20\x56\x65\x72\x61"
+DATA ·d+169264(SB)/8,$"\x20\x53\x61\x6e\x73\x20\x4d\x6f"
+DATA ·d+169272(SB)/8,$"\x6e\x6f\x22\x2c\x20\x22\x43\x6f"
+DATA ·d+169280(SB)/8,$"\x75\x72\x69\x65\x72\x20\x4e\x65"
+DATA ·d+169288(SB)/8,$"\x77\x22\x2c\x20\x6d\x6f\x6e\x6f"
+DATA ·d+169296(SB)/8,$"\x73\x70\x61\x63\x65\x2c\x20\x73"
+DATA ·d+169304(SB)/8,$"\x65\x72\x69\x66\x3b\x0a\x20\x20"
+DATA ·d+169312(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
+DATA ·d+169320(SB)/8,$"\x65\x3a\x20\x31\x32\x70\x78\x3b"
+DATA ·d+169328(SB)/8,$"\x0a\x20\x20\x6c\x69\x6e\x65\x2d"
+DATA ·d+169336(SB)/8,$"\x68\x65\x69\x67\x68\x74\x3a\x20"
+DATA ·d+169344(SB)/8,$"\x31\x2e\x35\x3b\x0a\x7d\x0a\x0a"
+DATA ·d+169352(SB)/8,$"\x0a\x2f\x2f\x20\x4f\x54\x48\x45"
+DATA ·d+169360(SB)/8,$"\x52\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169368(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169376(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x24"
+DATA ·d+169384(SB)/8,$"\x6e\x61\x76\x2d\x66\x6f\x6f\x74"
+DATA ·d+169392(SB)/8,$"\x65\x72\x2d\x62\x6f\x72\x64\x65"
+DATA ·d+169400(SB)/8,$"\x72\x2d\x63\x6f\x6c\x6f\x72\x3a"
+DATA ·d+169408(SB)/8,$"\x20\x23\x36\x36\x36\x20\x21\x64"
+DATA ·d+169416(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x3b\x0a"
+DATA ·d+169424(SB)/8,$"\x24\x73\x65\x61\x72\x63\x68\x2d"
+DATA ·d+169432(SB)/8,$"\x62\x6f\x78\x2d\x62\x6f\x72\x64"
+DATA ·d+169440(SB)/8,$"\x65\x72\x2d\x63\x6f\x6c\x6f\x72"
+DATA ·d+169448(SB)/8,$"\x3a\x20\x23\x36\x36\x36\x20\x21"
+DATA ·d+169456(SB)/8,$"\x64\x65\x66\x61\x75\x6c\x74\x3b"
+DATA ·d+169464(SB)/8,$"\x0a\x0a\x0a\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169472(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169480(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169488(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169504(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169536(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169544(SB)/8,$"\x2f\x2f\x2f\x0a\x2f\x2f\x20\x49"
+DATA ·d+169552(SB)/8,$"\x4e\x54\x45\x52\x4e\x41\x4c\x0a"
+DATA ·d+169560(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169568(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169576(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169584(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169592(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169600(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169608(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169616(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169624(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169632(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+169640(SB)/8,$"\x0a\x2f\x2f\x20\x54\x68\x65\x73"
+DATA ·d+169648(SB)/8,$"\x65\x20\x73\x65\x74\x74\x69\x6e"
+DATA ·d+169656(SB)/8,$"\x67\x73\x20\x61\x72\x65\x20\x70"
+DATA ·d+169664(SB)/8,$"\x72\x6f\x62\x61\x62\x6c\x79\x20"
+DATA ·d+169672(SB)/8,$"\x62\x65\x73\x74\x20\x6c\x65\x66"
+DATA ·d+169680(SB)/8,$"\x74\x20\x61\x6c\x6f\x6e\x65\x2e"
+DATA ·d+169688(SB)/8,$"\x0a\x0a\x25\x62\x72\x65\x61\x6b"
+DATA ·d+169696(SB)/8,$"\x2d\x77\x6f\x72\x64\x73\x20\x7b"
+DATA ·d+169704(SB)/8,$"\x0a\x20\x20\x20\x20\x77\x6f\x72"
+DATA ·d+169712(SB)/8,$"\x64\x2d\x62\x72\x65\x61\x6b\x3a"
+DATA ·d+169720(SB)/8,$"\x20\x62\x72\x65\x61\x6b\x2d\x61"
+DATA ·d+169728(SB)/8,$"\x6c\x6c\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+169736(SB)/8,$"\x68\x79\x70\x68\x65\x6e\x73\x3a"
+DATA ·d+169744(SB)/8,$"\x20\x61\x75\x74\x6f\x3b\x0a\x7d"
+DATA ·d+169752(SB)/8,$"\x0a\x00\x00\x00\x00\x00\x00\x00"
+DATA ·d+169760(SB)/8,$"\x40\x63\x68\x61\x72\x73\x65\x74"
+DATA ·d+169768(SB)/8,$"\x20\x22\x75\x74\x66\x2d\x38\x22"
+DATA ·d+169776(SB)/8,$"\x3b\x0a\x40\x69\x6d\x70\x6f\x72"
+DATA ·d+169784(SB)/8,$"\x74\x20\x27\x6e\x6f\x72\x6d\x61"
+DATA ·d+169792(SB)/8,$"\x6c\x69\x7a\x65\x27\x3b\x0a\x40"
+DATA ·d+169800(SB)/8,$"\x69\x6d\x70\x6f\x72\x74\x20\x27"
+DATA ·d+169808(SB)/8,$"\x76\x61\x72\x69\x61\x62\x6c\x65"
+DATA ·d+169816(SB)/8,$"\x73\x27\x3b\x0a\x40\x69\x6d\x70"
+DATA ·d+169824(SB)/8,$"\x6f\x72\x74\x20\x27\x69\x63\x6f"
+DATA ·d+169832(SB)/8,$"\x6e\x2d\x66\x6f\x6e\x74\x27\x3b"
+DATA ·d+169840(SB)/8,$"\x0a\x0a\x2f\x2a\x0a\x43\x6f\x70"
+DATA ·d+169848(SB)/8,$"\x79\x72\x69\x67\x68\x74\x20\x32"
+DATA ·d+169856(SB)/8,$"\x30\x30\x38\x2d\x32\x30\x31\x33"
+DATA ·d+169864(SB)/8,$"\x20\x43\x6f\x6e\x63\x75\x72\x20"
+DATA ·d+169872(SB)/8,$"\x54\x65\x63\x68\x6e\x6f\x6c\x6f"
+DATA ·d+169880(SB)/8,$"\x67\x69\x65\x73\x2c\x20\x49\x6e"
+DATA ·d+169888(SB)/8,$"\x63\x2e\x0a\x0a\x4c\x69\x63\x65"
+DATA ·d+169896(SB)/8,$"\x6e\x73\x65\x64\x20\x75\x6e\x64"
+DATA ·d+169904(SB)/8,$"\x65\x72\x20\x74\x68\x65\x20\x41"
+DATA ·d+169912(SB)/8,$"\x70\x61\x63\x68\x65\x20\x4c\x69"
+DATA ·d+169920(SB)/8,$"\x63\x65\x6e\x73\x65\x2c\x20\x56"
+DATA ·d+169928(SB)/8,$"\x65\x72\x73\x69\x6f\x6e\x20\x32"
+DATA ·d+169936(SB)/8,$"\x2e\x30\x20\x28\x74\x68\x65\x20"
+DATA ·d+169944(SB)/8,$"\x22\x4c\x69\x63\x65\x6e\x73\x65"
+DATA ·d+169952(SB)/8,$"\x22\x29\x3b\x20\x79\x6f\x75\x20"
+DATA ·d+169960(SB)/8,$"\x6d\x61\x79\x0a\x6e\x6f\x74\x20"
+DATA ·d+169968(SB)/8,$"\x75\x73\x65\x20\x74\x68\x69\x73"
+DATA ·d+169976(SB)/8,$"\x20\x66\x69\x6c\x65\x20\x65\x78"
+DATA ·d+169984(SB)/8,$"\x63\x65\x70\x74\x20\x69\x6e\x20"
+DATA ·d+169992(SB)/8,$"\x63\x6f\x6d\x70\x6c\x69\x61\x6e"
+DATA ·d+170000(SB)/8,$"\x63\x65\x20\x77\x69\x74\x68\x20"
+DATA ·d+170008(SB)/8,$"\x74\x68\x65\x20\x4c\x69\x63\x65"
+DATA ·d+170016(SB)/8,$"\x6e\x73\x65\x2e\x20\x59\x6f\x75"
+DATA ·d+170024(SB)/8,$"\x20\x6d\x61\x79\x20\x6f\x62\x74"
+DATA ·d+170032(SB)/8,$"\x61\x69\x6e\x0a\x61\x20\x63\x6f"
+DATA ·d+170040(SB)/8,$"\x70\x79\x20\x6f\x66\x20\x74\x68"
+DATA ·d+170048(SB)/8,$"\x65\x20\x4c\x69\x63\x65\x6e\x73"
+DATA ·d+170056(SB)/8,$"\x65\x20\x61\x74\x0a\x0a\x20\x20"
+DATA ·d+170064(SB)/8,$"\x68\x74\x74\x70\x3a\x2f\x2f\x77"
+DATA ·d+170072(SB)/8,$"\x77\x77\x2e\x61\x70\x61\x63\x68"
+DATA ·d+170080(SB)/8,$"\x65\x2e\x6f\x72\x67\x2f\x6c\x69"
+DATA ·d+170088(SB)/8,$"\x63\x65\x6e\x73\x65\x73\x2f\x4c"
+DATA ·d+170096(SB)/8,$"\x49\x43\x45\x4e\x53\x45\x2d\x32"
+DATA ·d+170104(SB)/8,$"\x2e\x30\x0a\x0a\x55\x6e\x6c\x65"
+DATA ·d+170112(SB)/8,$"\x73\x73\x20\x72\x65\x71\x75\x69"
+DATA ·d+170120(SB)/8,$"\x72\x65\x64\x20\x62\x79\x20\x61"
+DATA ·d+170128(SB)/8,$"\x70\x70\x6c\x69\x63\x61\x62\x6c"
+DATA ·d+170136(SB)/8,$"\x65\x20\x6c\x61\x77\x20\x6f\x72"
+DATA ·d+170144(SB)/8,$"\x20\x61\x67\x72\x65\x65\x64\x20"
+DATA ·d+170152(SB)/8,$"\x74\x6f\x20\x69\x6e\x20\x77\x72"
+DATA ·d+170160(SB)/8,$"\x69\x74\x69\x6e\x67\x2c\x20\x73"
+DATA ·d+170168(SB)/8,$"\x6f\x66\x74\x77\x61\x72\x65\x0a"
+DATA ·d+170176(SB)/8,$"\x64\x69\x73\x74\x72\x69\x62\x75"
+DATA ·d+170184(SB)/8,$"\x74\x65\x64\x20\x75\x6e\x64\x65"
+DATA ·d+170192(SB)/8,$"\x72\x20\x74\x68\x65\x20\x4c\x69"
+DATA ·d+170200(SB)/8,$"\x63\x65\x6e\x73\x65\x20\x69\x73"
+DATA ·d+170208(SB)/8,$"\x20\x64\x69\x73\x74\x72\x69\x62"
+DATA ·d+170216(SB)/8,$"\x75\x74\x65\x64\x20\x6f\x6e\x20"
+DATA ·d+170224(SB)/8,$"\x61\x6e\x20\x22\x41\x53\x20\x49"
+DATA ·d+170232(SB)/8,$"\x53\x22\x20\x42\x41\x53\x49\x53"
+DATA ·d+170240(SB)/8,$"\x2c\x20\x57\x49\x54\x48\x4f\x55"
+DATA ·d+170248(SB)/8,$"\x54\x0a\x57\x41\x52\x52\x41\x4e"
+DATA ·d+170256(SB)/8,$"\x54\x49\x45\x53\x20\x4f\x52\x20"
+DATA ·d+170264(SB)/8,$"\x43\x4f\x4e\x44\x49\x54\x49\x4f"
+DATA ·d+170272(SB)/8,$"\x4e\x53\x20\x4f\x46\x20\x41\x4e"
+DATA ·d+170280(SB)/8,$"\x59\x20\x4b\x49\x4e\x44\x2c\x20"
+DATA ·d+170288(SB)/8,$"\x65\x69\x74\x68\x65\x72\x20\x65"
+DATA ·d+170296(SB)/8,$"\x78\x70\x72\x65\x73\x73\x20\x6f"
+DATA ·d+170304(SB)/8,$"\x72\x20\x69\x6d\x70\x6c\x69\x65"
+DATA ·d+170312(SB)/8,$"\x64\x2e\x20\x53\x65\x65\x20\x74"
+DATA ·d+170320(SB)/8,$"\x68\x65\x0a\x4c\x69\x63\x65\x6e"
+DATA ·d+170328(SB)/8,$"\x73\x65\x20\x66\x6f\x72\x20\x74"
+DATA ·d+170336(SB)/8,$"\x68\x65\x20\x73\x70\x65\x63\x69"
+DATA ·d+170344(SB)/8,$"\x66\x69\x63\x20\x6c\x61\x6e\x67"
+DATA ·d+170352(SB)/8,$"\x75\x61\x67\x65\x20\x67\x6f\x76"
+DATA ·d+170360(SB)/8,$"\x65\x72\x6e\x69\x6e\x67\x20\x70"
+DATA ·d+170368(SB)/8,$"\x65\x72\x6d\x69\x73\x73\x69\x6f"
+DATA ·d+170376(SB)/8,$"\x6e\x73\x20\x61\x6e\x64\x20\x6c"
+DATA ·d+170384(SB)/8,$"\x69\x6d\x69\x74\x61\x74\x69\x6f"
+DATA ·d+170392(SB)/8,$"\x6e\x73\x0a\x75\x6e\x64\x65\x72"
+DATA ·d+170400(SB)/8,$"\x20\x74\x68\x65\x20\x4c\x69\x63"
+DATA ·d+170408(SB)/8,$"\x65\x6e\x73\x65\x2e\x0a\x2a\x2f"
+DATA ·d+170416(SB)/8,$"\x0a\x0a\x24\x70\x72\x69\x6e\x74"
+DATA ·d+170424(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+170432(SB)/8,$"\x23\x39\x39\x39\x3b\x0a\x24\x70"
+DATA ·d+170440(SB)/8,$"\x72\x69\x6e\x74\x2d\x63\x6f\x6c"
+DATA ·d+170448(SB)/8,$"\x6f\x72\x2d\x6c\x69\x67\x68\x74"
+DATA ·d+170456(SB)/8,$"\x3a\x20\x23\x63\x63\x63\x3b\x0a"
+DATA ·d+170464(SB)/8,$"\x24\x70\x72\x69\x6e\x74\x2d\x66"
+DATA ·d+170472(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
+DATA ·d+170480(SB)/8,$"\x3a\x20\x31\x32\x70\x78\x3b\x0a"
+DATA ·d+170488(SB)/8,$"\x0a\x62\x6f\x64\x79\x20\x7b\x0a"
+DATA ·d+170496(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
+DATA ·d+170504(SB)/8,$"\x64\x20\x25\x64\x65\x66\x61\x75"
+DATA ·d+170512(SB)/8,$"\x6c\x74\x2d\x66\x6f\x6e\x74\x3b"
+DATA ·d+170520(SB)/8,$"\x0a\x7d\x0a\x0a\x2e\x74\x6f\x63"
+DATA ·d+170528(SB)/8,$"\x69\x66\x79\x2c\x20\x2e\x74\x6f"
+DATA ·d+170536(SB)/8,$"\x63\x2d\x66\x6f\x6f\x74\x65\x72"
+DATA ·d+170544(SB)/8,$"\x2c\x20\x2e\x6c\x61\x6e\x67\x2d"
+DATA ·d+170552(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x6f\x72"
+DATA ·d+170560(SB)/8,$"\x2c\x20\x2e\x73\x65\x61\x72\x63"
+DATA ·d+170568(SB)/8,$"\x68\x2c\x20\x23\x6e\x61\x76\x2d"
+DATA ·d+170576(SB)/8,$"\x62\x75\x74\x74\x6f\x6e\x20\x7b"
+DATA ·d+170584(SB)/8,$"\x0a\x20\x20\x64\x69\x73\x70\x6c"
+DATA ·d+170592(SB)/8,$"\x61\x79\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+170600(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2e\x74\x6f"
+DATA ·d+170608(SB)/8,$"\x63\x69\x66\x79\x2d\x77\x72\x61"
+DATA ·d+170616(SB)/8,$"\x70\x70\x65\x72\x3e\x69\x6d\x67"
+DATA ·d+170624(SB)/8,$"\x20\x7b\x0a\x20\x20\x6d\x61\x72"
+DATA ·d+170632(SB)/8,$"\x67\x69\x6e\x3a\x20\x30\x20\x61"
+DATA ·d+170640(SB)/8,$"\x75\x74\x6f\x3b\x0a\x20\x20\x64"
+DATA ·d+170648(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x3a\x20"
+DATA ·d+170656(SB)/8,$"\x62\x6c\x6f\x63\x6b\x3b\x0a\x7d"
+DATA ·d+170664(SB)/8,$"\x0a\x0a\x2e\x63\x6f\x6e\x74\x65"
+DATA ·d+170672(SB)/8,$"\x6e\x74\x20\x7b\x0a\x20\x20\x66"
+DATA ·d+170680(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
+DATA ·d+170688(SB)/8,$"\x3a\x20\x31\x32\x70\x78\x3b\x0a"
+DATA ·d+170696(SB)/8,$"\x0a\x20\x20\x70\x72\x65\x2c\x20"
+DATA ·d+170704(SB)/8,$"\x63\x6f\x64\x65\x20\x7b\x0a\x20"
+DATA ·d+170712(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
+DATA ·d+170720(SB)/8,$"\x6e\x64\x20\x25\x63\x6f\x64\x65"
+DATA ·d+170728(SB)/8,$"\x2d\x66\x6f\x6e\x74\x3b\x0a\x20"
+DATA ·d+170736(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
+DATA ·d+170744(SB)/8,$"\x6e\x64\x20\x25\x62\x72\x65\x61"
+DATA ·d+170752(SB)/8,$"\x6b\x2d\x77\x6f\x72\x64\x73\x3b"
+DATA ·d+170760(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
+DATA ·d+170768(SB)/8,$"\x64\x65\x72\x3a\x20\x31\x70\x78"
+DATA ·d+170776(SB)/8,$"\x20\x73\x6f\x6c\x69\x64\x20\x24"
+DATA ·d+170784(SB)/8,$"\x70\x72\x69\x6e\x74\x2d\x63\x6f"
+DATA ·d+170792(SB)/8,$"\x6c\x6f\x72\x3b\x0a\x20\x20\x20"
+DATA ·d+170800(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+170808(SB)/8,$"\x72\x61\x64\x69\x75\x73\x3a\x20"
+DATA ·d+170816(SB)/8,$"\x35\x70\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+170824(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x73\x69"
+DATA ·d+170832(SB)/8,$"\x7a\x65\x3a\x20\x30\x2e\x38\x65"
+DATA ·d+170840(SB)/8,$"\x6d\x3b\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+170848(SB)/8,$"\x20\x20\x70\x72\x65\x20\x7b\x0a"
+DATA ·d+170856(SB)/8,$"\x20\x20\x20\x20\x63\x6f\x64\x65"
+DATA ·d+170864(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+170872(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x3a"
+DATA ·d+170880(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+170888(SB)/8,$"\x7d\x0a\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+170896(SB)/8,$"\x20\x70\x72\x65\x20\x7b\x0a\x20"
+DATA ·d+170904(SB)/8,$"\x20\x20\x20\x70\x61\x64\x64\x69"
+DATA ·d+170912(SB)/8,$"\x6e\x67\x3a\x20\x31\x2e\x33\x65"
+DATA ·d+170920(SB)/8,$"\x6d\x3b\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+170928(SB)/8,$"\x20\x20\x63\x6f\x64\x65\x20\x7b"
+DATA ·d+170936(SB)/8,$"\x0a\x20\x20\x20\x20\x70\x61\x64"
+DATA ·d+170944(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x30\x2e"
+DATA ·d+170952(SB)/8,$"\x32\x65\x6d\x3b\x0a\x20\x20\x7d"
+DATA ·d+170960(SB)/8,$"\x0a\x0a\x20\x20\x74\x61\x62\x6c"
+DATA ·d+170968(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+170976(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x3a\x20"
+DATA ·d+170984(SB)/8,$"\x31\x70\x78\x20\x73\x6f\x6c\x69"
+DATA ·d+170992(SB)/8,$"\x64\x20\x24\x70\x72\x69\x6e\x74"
+DATA ·d+171000(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3b\x0a"
+DATA ·d+171008(SB)/8,$"\x20\x20\x20\x20\x74\x72\x20\x7b"
+DATA ·d+171016(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x62"
+DATA ·d+171024(SB)/8,$"\x6f\x72\x64\x65\x72\x2d\x62\x6f"
+DATA ·d+171032(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x31\x70"
+DATA ·d+171040(SB)/8,$"\x78\x20\x73\x6f\x6c\x69\x64\x20"
+DATA ·d+171048(SB)/8,$"\x24\x70\x72\x69\x6e\x74\x2d\x63"
+DATA ·d+171056(SB)/8,$"\x6f\x6c\x6f\x72\x3b\x0a\x20\x20"
+DATA ·d+171064(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x20\x20"
+DATA ·d+171072(SB)/8,$"\x74\x64\x2c\x74\x68\x20\x7b\x0a"
+DATA ·d+171080(SB)/8,$"\x20\x20\x20\x20\x20\x20\x70\x61"
+DATA ·d+171088(SB)/8,$"\x64\x64\x69\x6e\x67\x3a\x20\x30"
+DATA ·d+171096(SB)/8,$"\x2e\x37\x65\x6d\x3b\x0a\x20\x20"
+DATA ·d+171104(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x7d\x0a"
+DATA ·d+171112(SB)/8,$"\x0a\x20\x20\x70\x20\x7b\x0a\x20"
+DATA ·d+171120(SB)/8,$"\x20\x20\x20\x6c\x69\x6e\x65\x2d"
+DATA ·d+171128(SB)/8,$"\x68\x65\x69\x67\x68\x74\x3a\x20"
+DATA ·d+171136(SB)/8,$"\x31\x2e\x35\x3b\x0a\x20\x20\x7d"
+DATA ·d+171144(SB)/8,$"\x0a\x0a\x20\x20\x61\x20\x7b\x0a"
+DATA ·d+171152(SB)/8,$"\x20\x20\x20\x20\x74\x65\x78\x74"
+DATA ·d+171160(SB)/8,$"\x2d\x64\x65\x63\x6f\x72\x61\x74"
+DATA ·d+171168(SB)/8,$"\x69\x6f\x6e\x3a\x20\x6e\x6f\x6e"
+DATA ·d+171176(SB)/8,$"\x65\x3b\x0a\x20\x20\x20\x20\x63"
+DATA ·d+171184(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x23\x30"
+DATA ·d+171192(SB)/8,$"\x30\x30\x3b\x0a\x20\x20\x7d\x0a"
+DATA ·d+171200(SB)/8,$"\x0a\x20\x20\x68\x31\x20\x7b\x0a"
+DATA ·d+171208(SB)/8,$"\x20\x20\x20\x20\x40\x65\x78\x74"
+DATA ·d+171216(SB)/8,$"\x65\x6e\x64\x20\x25\x68\x65\x61"
+DATA ·d+171224(SB)/8,$"\x64\x65\x72\x2d\x66\x6f\x6e\x74"
+DATA ·d+171232(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x66\x6f"
+DATA ·d+171240(SB)/8,$"\x6e\x74\x2d\x73\x69\x7a\x65\x3a"
+DATA ·d+171248(SB)/8,$"\x20\x32\x2e\x35\x65\x6d\x3b\x0a"
+DATA ·d+171256(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+171264(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
+DATA ·d+171272(SB)/8,$"\x20\x30\x2e\x35\x65\x6d\x3b\x0a"
+DATA ·d+171280(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+171288(SB)/8,$"\x69\x6e\x67\x2d\x62\x6f\x74\x74"
+DATA ·d+171296(SB)/8,$"\x6f\x6d\x3a\x20\x30\x2e\x35\x65"
+DATA ·d+171304(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x6d"
+DATA ·d+171312(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x74\x6f"
+DATA ·d+171320(SB)/8,$"\x70\x3a\x20\x31\x65\x6d\x3b\x0a"
+DATA ·d+171328(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+171336(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
+DATA ·d+171344(SB)/8,$"\x6d\x3a\x20\x24\x68\x31\x2d\x6d"
+DATA ·d+171352(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x62\x6f"
+DATA ·d+171360(SB)/8,$"\x74\x74\x6f\x6d\x3b\x0a\x20\x20"
+DATA ·d+171368(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+171376(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x73\x6f"
+DATA ·d+171384(SB)/8,$"\x6c\x69\x64\x20\x24\x70\x72\x69"
+DATA ·d+171392(SB)/8,$"\x6e\x74\x2d\x63\x6f\x6c\x6f\x72"
+DATA ·d+171400(SB)/8,$"\x2d\x6c\x69\x67\x68\x74\x3b\x0a"
+DATA ·d+171408(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x72\x64"
+DATA ·d+171416(SB)/8,$"\x65\x72\x2d\x77\x69\x64\x74\x68"
+DATA ·d+171424(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x30\x3b"
+DATA ·d+171432(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x65\x78"
+DATA ·d+171440(SB)/8,$"\x74\x2d\x61\x6c\x69\x67\x6e\x3a"
+DATA ·d+171448(SB)/8,$"\x20\x63\x65\x6e\x74\x65\x72\x3b"
+DATA ·d+171456(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+171464(SB)/8,$"\x68\x32\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+171472(SB)/8,$"\x20\x40\x65\x78\x74\x65\x6e\x64"
+DATA ·d+171480(SB)/8,$"\x20\x25\x68\x65\x61\x64\x65\x72"
+DATA ·d+171488(SB)/8,$"\x2d\x66\x6f\x6e\x74\x3b\x0a\x20"
+DATA ·d+171496(SB)/8,$"\x20\x20\x20\x66\x6f\x6e\x74\x2d"
+DATA ·d+171504(SB)/8,$"\x73\x69\x7a\x65\x3a\x20\x31\x2e"
+DATA ·d+171512(SB)/8,$"\x38\x65\x6d\x3b\x0a\x20\x20\x20"
+DATA ·d+171520(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
+DATA ·d+171528(SB)/8,$"\x74\x6f\x70\x3a\x20\x32\x65\x6d"
+DATA ·d+171536(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x62\x6f"
+DATA ·d+171544(SB)/8,$"\x72\x64\x65\x72\x2d\x74\x6f\x70"
+DATA ·d+171552(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x73\x6f"
+DATA ·d+171560(SB)/8,$"\x6c\x69\x64\x20\x24\x70\x72\x69"
+DATA ·d+171568(SB)/8,$"\x6e\x74\x2d\x63\x6f\x6c\x6f\x72"
+DATA ·d+171576(SB)/8,$"\x2d\x6c\x69\x67\x68\x74\x3b\x0a"
+DATA ·d+171584(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+171592(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
+DATA ·d+171600(SB)/8,$"\x20\x30\x2e\x38\x65\x6d\x3b\x0a"
+DATA ·d+171608(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x68"
+DATA ·d+171616(SB)/8,$"\x31\x2b\x68\x32\x2c\x20\x68\x31"
+DATA ·d+171624(SB)/8,$"\x2b\x64\x69\x76\x2b\x68\x32\x20"
+DATA ·d+171632(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x6f"
+DATA ·d+171640(SB)/8,$"\x72\x64\x65\x72\x2d\x74\x6f\x70"
+DATA ·d+171648(SB)/8,$"\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a"
+DATA ·d+171656(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+171664(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
+DATA ·d+171672(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+171680(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x74"
+DATA ·d+171688(SB)/8,$"\x6f\x70\x3a\x20\x30\x3b\x0a\x20"
+DATA ·d+171696(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x33"
+DATA ·d+171704(SB)/8,$"\x2c\x20\x68\x34\x20\x7b\x0a\x20"
+DATA ·d+171712(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
+DATA ·d+171720(SB)/8,$"\x6e\x64\x20\x25\x68\x65\x61\x64"
+DATA ·d+171728(SB)/8,$"\x65\x72\x2d\x66\x6f\x6e\x74\x3b"
+DATA ·d+171736(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
+DATA ·d+171744(SB)/8,$"\x74\x2d\x73\x69\x7a\x65\x3a\x20"
+DATA ·d+171752(SB)/8,$"\x30\x2e\x38\x65\x6d\x3b\x0a\x20"
+DATA ·d+171760(SB)/8,$"\x20\x20\x20\x6d\x61\x72\x67\x69"
+DATA ·d+171768(SB)/8,$"\x6e\x2d\x74\x6f\x70\x3a\x20\x31"
+DATA ·d+171776(SB)/8,$"\x2e\x35\x65\x6d\x3b\x0a\x20\x20"
+DATA ·d+171784(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
+DATA ·d+171792(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
+DATA ·d+171800(SB)/8,$"\x20\x30\x2e\x38\x65\x6d\x3b\x0a"
+DATA ·d+171808(SB)/8,$"\x20\x20\x20\x20\x74\x65\x78\x74"
+DATA ·d+171816(SB)/8,$"\x2d\x74\x72\x61\x6e\x73\x66\x6f"
+DATA ·d+171824(SB)/8,$"\x72\x6d\x3a\x20\x75\x70\x70\x65"
+DATA ·d+171832(SB)/8,$"\x72\x63\x61\x73\x65\x3b\x0a\x20"
+DATA ·d+171840(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x35"
+DATA ·d+171848(SB)/8,$"\x2c\x20\x68\x36\x20\x7b\x0a\x20"
+DATA ·d+171856(SB)/8,$"\x20\x20\x20\x74\x65\x78\x74\x2d"
+DATA ·d+171864(SB)/8,$"\x74\x72\x61\x6e\x73\x66\x6f\x72"
+DATA ·d+171872(SB)/8,$"\x6d\x3a\x20\x75\x70\x70\x65\x72"
+DATA ·d+171880(SB)/8,$"\x63\x61\x73\x65\x3b\x0a\x20\x20"
+DATA ·d+171888(SB)/8,$"\x7d\x0a\x0a\x20\x20\x61\x73\x69"
+DATA ·d+171896(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+171904(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+171912(SB)/8,$"\x3a\x20\x31\x65\x6d\x3b\x0a\x20"
+DATA ·d+171920(SB)/8,$"\x20\x20\x20\x62\x6f\x72\x64\x65"
+DATA ·d+171928(SB)/8,$"\x72\x3a\x20\x31\x70\x78\x20\x73"
+DATA ·d+171936(SB)/8,$"\x6f\x6c\x69\x64\x20\x24\x70\x72"
+DATA ·d+171944(SB)/8,$"\x69\x6e\x74\x2d\x63\x6f\x6c\x6f"
+DATA ·d+171952(SB)/8,$"\x72\x2d\x6c\x69\x67\x68\x74\x3b"
+DATA ·d+171960(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
+DATA ·d+171968(SB)/8,$"\x64\x65\x72\x2d\x72\x61\x64\x69"
+DATA ·d+171976(SB)/8,$"\x75\x73\x3a\x20\x35\x70\x78\x3b"
+DATA ·d+171984(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+171992(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
+DATA ·d+172000(SB)/8,$"\x20\x31\x2e\x35\x65\x6d\x3b\x0a"
+DATA ·d+172008(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+172016(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
+DATA ·d+172024(SB)/8,$"\x6d\x3a\x20\x31\x2e\x35\x65\x6d"
+DATA ·d+172032(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6c\x69"
+DATA ·d+172040(SB)/8,$"\x6e\x65\x2d\x68\x65\x69\x67\x68"
+DATA ·d+172048(SB)/8,$"\x74\x3a\x20\x31\x2e\x36\x3b\x0a"
+DATA ·d+172056(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x61"
+DATA ·d+172064(SB)/8,$"\x73\x69\x64\x65\x3a\x62\x65\x66"
+DATA ·d+172072(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
+DATA ·d+172080(SB)/8,$"\x20\x20\x76\x65\x72\x74\x69\x63"
+DATA ·d+172088(SB)/8,$"\x61\x6c\x2d\x61\x6c\x69\x67\x6e"
+DATA ·d+172096(SB)/8,$"\x3a\x20\x6d\x69\x64\x64\x6c\x65"
+DATA ·d+172104(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x70\x61"
+DATA ·d+172112(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x72\x69"
+DATA ·d+172120(SB)/8,$"\x67\x68\x74\x3a\x20\x30\x2e\x35"
+DATA ·d+172128(SB)/8,$"\x65\x6d\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+172136(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
+DATA ·d+172144(SB)/8,$"\x65\x3a\x20\x31\x34\x70\x78\x3b"
+DATA ·d+172152(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+172160(SB)/8,$"\x61\x73\x69\x64\x65\x2e\x6e\x6f"
+DATA ·d+172168(SB)/8,$"\x74\x69\x63\x65\x3a\x62\x65\x66"
+DATA ·d+172176(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
+DATA ·d+172184(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
+DATA ·d+172192(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x2d"
+DATA ·d+172200(SB)/8,$"\x69\x6e\x66\x6f\x2d\x73\x69\x67"
+DATA ·d+172208(SB)/8,$"\x6e\x3b\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+172216(SB)/8,$"\x20\x20\x61\x73\x69\x64\x65\x2e"
+DATA ·d+172224(SB)/8,$"\x77\x61\x72\x6e\x69\x6e\x67\x3a"
+DATA ·d+172232(SB)/8,$"\x62\x65\x66\x6f\x72\x65\x20\x7b"
+DATA ·d+172240(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
+DATA ·d+172248(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x69\x63"
+DATA ·d+172256(SB)/8,$"\x6f\x6e\x2d\x65\x78\x63\x6c\x61"
+DATA ·d+172264(SB)/8,$"\x6d\x61\x74\x69\x6f\x6e\x2d\x73"
+DATA ·d+172272(SB)/8,$"\x69\x67\x6e\x3b\x0a\x20\x20\x7d"
+DATA ·d+172280(SB)/8,$"\x0a\x0a\x20\x20\x61\x73\x69\x64"
+DATA ·d+172288(SB)/8,$"\x65\x2e\x73\x75\x63\x63\x65\x73"
+DATA ·d+172296(SB)/8,$"\x73\x3a\x62\x65\x66\x6f\x72\x65"
+DATA ·d+172304(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x40"
+DATA ·d+172312(SB)/8,$"\x65\x78\x74\x65\x6e\x64\x20\x25"
+DATA ·d+172320(SB)/8,$"\x69\x63\x6f\x6e\x2d\x6f\x6b\x2d"
+DATA ·d+172328(SB)/8,$"\x73\x69\x67\x6e\x3b\x0a\x20\x20"
+DATA ·d+172336(SB)/8,$"\x7d\x0a\x7d\x00\x00\x00\x00\x00"
+DATA ·d+172344(SB)/8,$"\x40\x63\x68\x61\x72\x73\x65\x74"
+DATA ·d+172352(SB)/8,$"\x20\x22\x75\x74\x66\x2d\x38\x22"
+DATA ·d+172360(SB)/8,$"\x3b\x0a\x40\x69\x6d\x70\x6f\x72"
+DATA ·d+172368(SB)/8,$"\x74\x20\x27\x6e\x6f\x72\x6d\x61"
+DATA ·d+172376(SB)/8,$"\x6c\x69\x7a\x65\x27\x3b\x0a\x40"
+DATA ·d+172384(SB)/8,$"\x69\x6d\x70\x6f\x72\x74\x20\x27"
+DATA ·d+172392(SB)/8,$"\x76\x61\x72\x69\x61\x62\x6c\x65"
+DATA ·d+172400(SB)/8,$"\x73\x27\x3b\x0a\x40\x69\x6d\x70"
+DATA ·d+172408(SB)/8,$"\x6f\x72\x74\x20\x27\x69\x63\x6f"
+DATA ·d+172416(SB)/8,$"\x6e\x2d\x66\x6f\x6e\x74\x27\x3b"
+DATA ·d+172424(SB)/8,$"\x0a\x2f\x2f\x20\x40\x69\x6d\x70"
+DATA ·d+172432(SB)/8,$"\x6f\x72\x74\x20\x27\x72\x74\x6c"
+DATA ·d+172440(SB)/8,$"\x27\x3b\x20\x2f\x2f\x20\x75\x6e"
+DATA ·d+172448(SB)/8,$"\x63\x6f\x6d\x6d\x65\x6e\x74\x20"
+DATA ·d+172456(SB)/8,$"\x74\x6f\x20\x73\x77\x69\x74\x63"
+DATA ·d+172464(SB)/8,$"\x68\x20\x74\x6f\x20\x52\x54\x4c"
+DATA ·d+172472(SB)/8,$"\x20\x66\x6f\x72\x6d\x61\x74\x0a"
+DATA ·d+172480(SB)/8,$"\x0a\x2f\x2a\x0a\x43\x6f\x70\x79"
+DATA ·d+172488(SB)/8,$"\x72\x69\x67\x68\x74\x20\x32\x30"
+DATA ·d+172496(SB)/8,$"\x30\x38\x2d\x32\x30\x31\x33\x20"
+DATA ·d+172504(SB)/8,$"\x43\x6f\x6e\x63\x75\x72\x20\x54"
+DATA ·d+172512(SB)/8,$"\x65\x63\x68\x6e\x6f\x6c\x6f\x67"
+DATA ·d+172520(SB)/8,$"\x69\x65\x73\x2c\x20\x49\x6e\x63"
+DATA ·d+172528(SB)/8,$"\x2e\x0a\x0a\x4c\x69\x63\x65\x6e"
+DATA ·d+172536(SB)/8,$"\x73\x65\x64\x20\x75\x6e\x64\x65"
+DATA ·d+172544(SB)/8,$"\x72\x20\x74\x68\x65\x20\x41\x70"
+DATA ·d+172552(SB)/8,$"\x61\x63\x68\x65\x20\x4c\x69\x63"
+DATA ·d+172560(SB)/8,$"\x65\x6e\x73\x65\x2c\x20\x56\x65"
+DATA ·d+172568(SB)/8,$"\x72\x73\x69\x6f\x6e\x20\x32\x2e"
+DATA ·d+172576(SB)/8,$"\x30\x20\x28\x74\x68\x65\x20\x22"
+DATA ·d+172584(SB)/8,$"\x4c\x69\x63\x65\x6e\x73\x65\x22"
+DATA ·d+172592(SB)/8,$"\x29\x3b\x20\x79\x6f\x75\x20\x6d"
+DATA ·d+172600(SB)/8,$"\x61\x79\x0a\x6e\x6f\x74\x20\x75"
+DATA ·d+172608(SB)/8,$"\x73\x65\x20\x74\x68\x69\x73\x20"
+DATA ·d+172616(SB)/8,$"\x66\x69\x6c\x65\x20\x65\x78\x63"
+DATA ·d+172624(SB)/8,$"\x65\x70\x74\x20\x69\x6e\x20\x63"
+DATA ·d+172632(SB)/8,$"\x6f\x6d\x70\x6c\x69\x61\x6e\x63"
+DATA ·d+172640(SB)/8,$"\x65\x20\x77\x69\x74\x68\x20\x74"
+DATA ·d+172648(SB)/8,$"\x68\x65\x20\x4c\x69\x63\x65\x6e"
+DATA ·d+172656(SB)/8,$"\x73\x65\x2e\x20\x59\x6f\x75\x20"
+DATA ·d+172664(SB)/8,$"\x6d\x61\x79\x20\x6f\x62\x74\x61"
+DATA ·d+172672(SB)/8,$"\x69\x6e\x0a\x61\x20\x63\x6f\x70"
+DATA ·d+172680(SB)/8,$"\x79\x20\x6f\x66\x20\x74\x68\x65"
+DATA ·d+172688(SB)/8,$"\x20\x4c\x69\x63\x65\x6e\x73\x65"
+DATA ·d+172696(SB)/8,$"\x20\x61\x74\x0a\x0a\x20\x20\x68"
+DATA ·d+172704(SB)/8,$"\x74\x74\x70\x3a\x2f\x2f\x77\x77"
+DATA ·d+172712(SB)/8,$"\x77\x2e\x61\x70\x61\x63\x68\x65"
+DATA ·d+172720(SB)/8,$"\x2e\x6f\x72\x67\x2f\x6c\x69\x63"
+DATA ·d+172728(SB)/8,$"\x65\x6e\x73\x65\x73\x2f\x4c\x49"
+DATA ·d+172736(SB)/8,$"\x43\x45\x4e\x53\x45\x2d\x32\x2e"
+DATA ·d+172744(SB)/8,$"\x30\x0a\x0a\x55\x6e\x6c\x65\x73"
+DATA ·d+172752(SB)/8,$"\x73\x20\x72\x65\x71\x75\x69\x72"
+DATA ·d+172760(SB)/8,$"\x65\x64\x20\x62\x79\x20\x61\x70"
+DATA ·d+172768(SB)/8,$"\x70\x6c\x69\x63\x61\x62\x6c\x65"
+DATA ·d+172776(SB)/8,$"\x20\x6c\x61\x77\x20\x6f\x72\x20"
+DATA ·d+172784(SB)/8,$"\x61\x67\x72\x65\x65\x64\x20\x74"
+DATA ·d+172792(SB)/8,$"\x6f\x20\x69\x6e\x20\x77\x72\x69"
+DATA ·d+172800(SB)/8,$"\x74\x69\x6e\x67\x2c\x20\x73\x6f"
+DATA ·d+172808(SB)/8,$"\x66\x74\x77\x61\x72\x65\x0a\x64"
+DATA ·d+172816(SB)/8,$"\x69\x73\x74\x72\x69\x62\x75\x74"
+DATA ·d+172824(SB)/8,$"\x65\x64\x20\x75\x6e\x64\x65\x72"
+DATA ·d+172832(SB)/8,$"\x20\x74\x68\x65\x20\x4c\x69\x63"
+DATA ·d+172840(SB)/8,$"\x65\x6e\x73\x65\x20\x69\x73\x20"
+DATA ·d+172848(SB)/8,$"\x64\x69\x73\x74\x72\x69\x62\x75"
+DATA ·d+172856(SB)/8,$"\x74\x65\x64\x20\x6f\x6e\x20\x61"
+DATA ·d+172864(SB)/8,$"\x6e\x20\x22\x41\x53\x20\x49\x53"
+DATA ·d+172872(SB)/8,$"\x22\x20\x42\x41\x53\x49\x53\x2c"
+DATA ·d+172880(SB)/8,$"\x20\x57\x49\x54\x48\x4f\x55\x54"
+DATA ·d+172888(SB)/8,$"\x0a\x57\x41\x52\x52\x41\x4e\x54"
+DATA ·d+172896(SB)/8,$"\x49\x45\x53\x20\x4f\x52\x20\x43"
+DATA ·d+172904(SB)/8,$"\x4f\x4e\x44\x49\x54\x49\x4f\x4e"
+DATA ·d+172912(SB)/8,$"\x53\x20\x4f\x46\x20\x41\x4e\x59"
+DATA ·d+172920(SB)/8,$"\x20\x4b\x49\x4e\x44\x2c\x20\x65"
+DATA ·d+172928(SB)/8,$"\x69\x74\x68\x65\x72\x20\x65\x78"
+DATA ·d+172936(SB)/8,$"\x70\x72\x65\x73\x73\x20\x6f\x72"
+DATA ·d+172944(SB)/8,$"\x20\x69\x6d\x70\x6c\x69\x65\x64"
+DATA ·d+172952(SB)/8,$"\x2e\x20\x53\x65\x65\x20\x74\x68"
+DATA ·d+172960(SB)/8,$"\x65\x0a\x4c\x69\x63\x65\x6e\x73"
+DATA ·d+172968(SB)/8,$"\x65\x20\x66\x6f\x72\x20\x74\x68"
+DATA ·d+172976(SB)/8,$"\x65\x20\x73\x70\x65\x63\x69\x66"
+DATA ·d+172984(SB)/8,$"\x69\x63\x20\x6c\x61\x6e\x67\x75"
+DATA ·d+172992(SB)/8,$"\x61\x67\x65\x20\x67\x6f\x76\x65"
+DATA ·d+173000(SB)/8,$"\x72\x6e\x69\x6e\x67\x20\x70\x65"
+DATA ·d+173008(SB)/8,$"\x72\x6d\x69\x73\x73\x69\x6f\x6e"
+DATA ·d+173016(SB)/8,$"\x73\x20\x61\x6e\x64\x20\x6c\x69"
+DATA ·d+173024(SB)/8,$"\x6d\x69\x74\x61\x74\x69\x6f\x6e"
+DATA ·d+173032(SB)/8,$"\x73\x0a\x75\x6e\x64\x65\x72\x20"
+DATA ·d+173040(SB)/8,$"\x74\x68\x65\x20\x4c\x69\x63\x65"
+DATA ·d+173048(SB)/8,$"\x6e\x73\x65\x2e\x0a\x2a\x2f\x0a"
+DATA ·d+173056(SB)/8,$"\x0a\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173064(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173072(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173080(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173088(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173096(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173104(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173112(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173120(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173128(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173136(SB)/8,$"\x2f\x0a\x2f\x2f\x20\x47\x45\x4e"
+DATA ·d+173144(SB)/8,$"\x45\x52\x41\x4c\x20\x53\x54\x55"
+DATA ·d+173152(SB)/8,$"\x46\x46\x0a\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173160(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173168(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173176(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173184(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173192(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173200(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173208(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173216(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173224(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173232(SB)/8,$"\x2f\x2f\x2f\x0a\x0a\x68\x74\x6d"
+DATA ·d+173240(SB)/8,$"\x6c\x2c\x20\x62\x6f\x64\x79\x20"
+DATA ·d+173248(SB)/8,$"\x7b\x0a\x20\x20\x63\x6f\x6c\x6f"
+DATA ·d+173256(SB)/8,$"\x72\x3a\x20\x24\x6d\x61\x69\x6e"
+DATA ·d+173264(SB)/8,$"\x2d\x74\x65\x78\x74\x3b\x0a\x20"
+DATA ·d+173272(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+173280(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x6d"
+DATA ·d+173288(SB)/8,$"\x61\x72\x67\x69\x6e\x3a\x20\x30"
+DATA ·d+173296(SB)/8,$"\x3b\x0a\x20\x20\x2d\x77\x65\x62"
+DATA ·d+173304(SB)/8,$"\x6b\x69\x74\x2d\x66\x6f\x6e\x74"
+DATA ·d+173312(SB)/8,$"\x2d\x73\x6d\x6f\x6f\x74\x68\x69"
+DATA ·d+173320(SB)/8,$"\x6e\x67\x3a\x20\x61\x6e\x74\x69"
+DATA ·d+173328(SB)/8,$"\x61\x6c\x69\x61\x73\x65\x64\x3b"
+DATA ·d+173336(SB)/8,$"\x0a\x20\x20\x2d\x6d\x6f\x7a\x2d"
+DATA ·d+173344(SB)/8,$"\x6f\x73\x78\x2d\x66\x6f\x6e\x74"
+DATA ·d+173352(SB)/8,$"\x2d\x73\x6d\x6f\x6f\x74\x68\x69"
+DATA ·d+173360(SB)/8,$"\x6e\x67\x3a\x20\x67\x72\x61\x79"
+DATA ·d+173368(SB)/8,$"\x73\x63\x61\x6c\x65\x3b\x0a\x20"
+DATA ·d+173376(SB)/8,$"\x20\x40\x65\x78\x74\x65\x6e\x64"
+DATA ·d+173384(SB)/8,$"\x20\x25\x64\x65\x66\x61\x75\x6c"
+DATA ·d+173392(SB)/8,$"\x74\x2d\x66\x6f\x6e\x74\x3b\x0a"
+DATA ·d+173400(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
+DATA ·d+173408(SB)/8,$"\x6f\x75\x6e\x64\x2d\x63\x6f\x6c"
+DATA ·d+173416(SB)/8,$"\x6f\x72\x3a\x20\x24\x6d\x61\x69"
+DATA ·d+173424(SB)/8,$"\x6e\x2d\x62\x67\x3b\x0a\x20\x20"
+DATA ·d+173432(SB)/8,$"\x68\x65\x69\x67\x68\x74\x3a\x20"
+DATA ·d+173440(SB)/8,$"\x31\x30\x30\x25\x3b\x0a\x20\x20"
+DATA ·d+173448(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
+DATA ·d+173456(SB)/8,$"\x74\x65\x78\x74\x2d\x73\x69\x7a"
+DATA ·d+173464(SB)/8,$"\x65\x2d\x61\x64\x6a\x75\x73\x74"
+DATA ·d+173472(SB)/8,$"\x3a\x20\x6e\x6f\x6e\x65\x3b\x20"
+DATA ·d+173480(SB)/8,$"\x2f\x2a\x20\x4e\x65\x76\x65\x72"
+DATA ·d+173488(SB)/8,$"\x20\x61\x75\x74\x6f\x72\x65\x73"
+DATA ·d+173496(SB)/8,$"\x69\x7a\x65\x20\x74\x65\x78\x74"
+DATA ·d+173504(SB)/8,$"\x20\x2a\x2f\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+173512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173536(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173544(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173552(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173560(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173568(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173576(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173584(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
+DATA ·d+173592(SB)/8,$"\x2f\x2f\x20\x54\x41\x42\x4c\x45"
+DATA ·d+173600(SB)/8,$"\x20\x4f\x46\x20\x43\x4f\x4e\x54"
+DATA ·d+173608(SB)/8,$"\x45\x4e\x54\x53\x0a\x2f\x2f\x2f"
+DATA ·d+173616(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173624(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173632(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173664(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173672(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173680(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+173688(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x0a\x0a\x23"
+DATA ·d+173696(SB)/8,$"\x74\x6f\x63\x20\x3e\x20\x75\x6c"
+DATA ·d+173704(SB)/8,$"\x20\x3e\x20\x6c\x69\x20\x3e\x20"
+DATA ·d+173712(SB)/8,$"\x61\x20\x3e\x20\x73\x70\x61\x6e"
+DATA ·d+173720(SB)/8,$"\x20\x7b\x0a\x20\x20\x66\x6c\x6f"
+DATA ·d+173728(SB)/8,$"\x61\x74\x3a\x20\x72\x69\x67\x68"
+DATA ·d+173736(SB)/8,$"\x74\x3b\x0a\x20\x20\x62\x61\x63"
+DATA ·d+173744(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2d"
+DATA ·d+173752(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x23"
+DATA ·d+173760(SB)/8,$"\x32\x34\x38\x34\x46\x46\x3b\x0a"
+DATA ·d+173768(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+173776(SB)/8,$"\x2d\x72\x61\x64\x69\x75\x73\x3a"
+DATA ·d+173784(SB)/8,$"\x20\x34\x30\x70\x78\x3b\x0a\x20"
+DATA ·d+173792(SB)/8,$"\x20\x77\x69\x64\x74\x68\x3a\x20"
+DATA ·d+173800(SB)/8,$"\x32\x30\x70\x78\x3b\x0a\x7d\x0a"
+DATA ·d+173808(SB)/8,$"\x0a\x2e\x74\x6f\x63\x2d\x77\x72"
+DATA ·d+173816(SB)/8,$"\x61\x70\x70\x65\x72\x20\x7b\x0a"
+DATA ·d+173824(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
+DATA ·d+173832(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6c\x65"
+DATA ·d+173840(SB)/8,$"\x66\x74\x20\x30\x2e\x33\x73\x20"
+DATA ·d+173848(SB)/8,$"\x65\x61\x73\x65\x2d\x69\x6e\x2d"
+DATA ·d+173856(SB)/8,$"\x6f\x75\x74\x3b\x0a\x0a\x20\x20"
+DATA ·d+173864(SB)/8,$"\x6f\x76\x65\x72\x66\x6c\x6f\x77"
+DATA ·d+173872(SB)/8,$"\x2d\x79\x3a\x20\x61\x75\x74\x6f"
+DATA ·d+173880(SB)/8,$"\x3b\x0a\x20\x20\x6f\x76\x65\x72"
+DATA ·d+173888(SB)/8,$"\x66\x6c\x6f\x77\x2d\x78\x3a\x20"
+DATA ·d+173896(SB)/8,$"\x68\x69\x64\x64\x65\x6e\x3b\x0a"
+DATA ·d+173904(SB)/8,$"\x20\x20\x70\x6f\x73\x69\x74\x69"
+DATA ·d+173912(SB)/8,$"\x6f\x6e\x3a\x20\x66\x69\x78\x65"
+DATA ·d+173920(SB)/8,$"\x64\x3b\x0a\x20\x20\x7a\x2d\x69"
+DATA ·d+173928(SB)/8,$"\x6e\x64\x65\x78\x3a\x20\x33\x30"
+DATA ·d+173936(SB)/8,$"\x3b\x0a\x20\x20\x74\x6f\x70\x3a"
+DATA ·d+173944(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x6c\x65"
+DATA ·d+173952(SB)/8,$"\x66\x74\x3a\x20\x30\x3b\x0a\x20"
+DATA ·d+173960(SB)/8,$"\x20\x62\x6f\x74\x74\x6f\x6d\x3a"
+DATA ·d+173968(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x77\x69"
+DATA ·d+173976(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x6e\x61"
+DATA ·d+173984(SB)/8,$"\x76\x2d\x77\x69\x64\x74\x68\x3b"
+DATA ·d+173992(SB)/8,$"\x0a\x20\x20\x62\x61\x63\x6b\x67"
+DATA ·d+174000(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
+DATA ·d+174008(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x6e\x61"
+DATA ·d+174016(SB)/8,$"\x76\x2d\x62\x67\x3b\x0a\x20\x20"
+DATA ·d+174024(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
+DATA ·d+174032(SB)/8,$"\x65\x3a\x20\x31\x33\x70\x78\x3b"
+DATA ·d+174040(SB)/8,$"\x0a\x20\x20\x66\x6f\x6e\x74\x2d"
+DATA ·d+174048(SB)/8,$"\x77\x65\x69\x67\x68\x74\x3a\x20"
+DATA ·d+174056(SB)/8,$"\x62\x6f\x6c\x64\x3b\x0a\x0a\x20"
+DATA ·d+174064(SB)/8,$"\x20\x2f\x2f\x20\x6c\x61\x6e\x67"
+DATA ·d+174072(SB)/8,$"\x75\x61\x67\x65\x20\x73\x65\x6c"
+DATA ·d+174080(SB)/8,$"\x65\x63\x74\x6f\x72\x20\x66\x6f"
+DATA ·d+174088(SB)/8,$"\x72\x20\x6d\x6f\x62\x69\x6c\x65"
+DATA ·d+174096(SB)/8,$"\x20\x64\x65\x76\x69\x63\x65\x73"
+DATA ·d+174104(SB)/8,$"\x0a\x20\x20\x2e\x6c\x61\x6e\x67"
+DATA ·d+174112(SB)/8,$"\x2d\x73\x65\x6c\x65\x63\x74\x6f"
+DATA ·d+174120(SB)/8,$"\x72\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+174128(SB)/8,$"\x64\x69\x73\x70\x6c\x61\x79\x3a"
+DATA ·d+174136(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
+DATA ·d+174144(SB)/8,$"\x20\x20\x20\x61\x20\x7b\x0a\x20"
+DATA ·d+174152(SB)/8,$"\x20\x20\x20\x20\x20\x70\x61\x64"
+DATA ·d+174160(SB)/8,$"\x64\x69\x6e\x67\x2d\x74\x6f\x70"
+DATA ·d+174168(SB)/8,$"\x3a\x20\x30\x2e\x35\x65\x6d\x3b"
+DATA ·d+174176(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x70"
+DATA ·d+174184(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x2d\x62"
+DATA ·d+174192(SB)/8,$"\x6f\x74\x74\x6f\x6d\x3a\x20\x30"
+DATA ·d+174200(SB)/8,$"\x2e\x35\x65\x6d\x3b\x0a\x20\x20"
+DATA ·d+174208(SB)/8,$"\x20\x20\x7d\x0a\x20\x20\x7d\x0a"
+DATA ·d+174216(SB)/8,$"\x0a\x20\x20\x2f\x2f\x20\x54\x68"
+DATA ·d+174224(SB)/8,$"\x69\x73\x20\x69\x73\x20\x74\x68"
+DATA ·d+174232(SB)/8,$"\x65\x20\x6c\x6f\x67\x6f\x20\x61"
+DATA ·d+174240(SB)/8,$"\x74\x20\x74\x68\x65\x20\x74\x6f"
+DATA ·d+174248(SB)/8,$"\x70\x20\x6f\x66\x20\x74\x68\x65"
+DATA ·d+174256(SB)/8,$"\x20\x54\x6f\x43\x0a\x20\x20\x2e"
+DATA ·d+174264(SB)/8,$"\x6c\x6f\x67\x6f\x20\x7b\x0a\x20"
+DATA ·d+174272(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
+DATA ·d+174280(SB)/8,$"\x61\x79\x3a\x20\x62\x6c\x6f\x63"
+DATA ·d+174288(SB)/8,$"\x6b\x3b\x0a\x20\x20\x20\x20\x6d"
+DATA ·d+174296(SB)/8,$"\x61\x78\x2d\x77\x69\x64\x74\x68"
+DATA ·d+174304(SB)/8,$"\x3a\x20\x31\x30\x30\x25\x3b\x0a"
+DATA ·d+174312(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+174320(SB)/8,$"\x69\x6e\x2d\x62\x6f\x74\x74\x6f"
+DATA ·d+174328(SB)/8,$"\x6d\x3a\x20\x24\x6c\x6f\x67\x6f"
+DATA ·d+174336(SB)/8,$"\x2d\x6d\x61\x72\x67\x69\x6e\x3b"
+DATA ·d+174344(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+174352(SB)/8,$"\x2f\x2f\x20\x76\x65\x72\x73\x69"
+DATA ·d+174360(SB)/8,$"\x6f\x6e\x20\x61\x6e\x64\x20\x6c"
+DATA ·d+174368(SB)/8,$"\x6f\x63\x61\x6c\x65\x20\x73\x65"
+DATA ·d+174376(SB)/8,$"\x6c\x65\x63\x74\x6f\x72\x73\x0a"
+DATA ·d+174384(SB)/8,$"\x20\x20\x2e\x76\x65\x72\x73\x69"
+DATA ·d+174392(SB)/8,$"\x6f\x6e\x2d\x73\x65\x6c\x65\x63"
+DATA ·d+174400(SB)/8,$"\x74\x6f\x72\x2c\x20\x2e\x6c\x6f"
+DATA ·d+174408(SB)/8,$"\x63\x61\x6c\x65\x2d\x73\x65\x6c"
+DATA ·d+174416(SB)/8,$"\x65\x63\x74\x6f\x72\x20\x7b\x0a"
+DATA ·d+174424(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+174432(SB)/8,$"\x69\x6e\x3a\x20\x24\x6e\x61\x76"
+DATA ·d+174440(SB)/8,$"\x2d\x76\x2d\x70\x61\x64\x64\x69"
+DATA ·d+174448(SB)/8,$"\x6e\x67\x20\x24\x6e\x61\x76\x2d"
+DATA ·d+174456(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3b"
+DATA ·d+174464(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x73\x65"
+DATA ·d+174472(SB)/8,$"\x6c\x65\x63\x74\x20\x7b\x0a\x20"
+DATA ·d+174480(SB)/8,$"\x20\x20\x20\x20\x20\x77\x69\x64"
+DATA ·d+174488(SB)/8,$"\x74\x68\x3a\x20\x31\x30\x30\x25"
+DATA ·d+174496(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+174504(SB)/8,$"\x62\x61\x63\x6b\x67\x72\x6f\x75"
+DATA ·d+174512(SB)/8,$"\x6e\x64\x3a\x20\x24\x6e\x61\x76"
+DATA ·d+174520(SB)/8,$"\x2d\x62\x67\x3b\x0a\x20\x20\x20"
+DATA ·d+174528(SB)/8,$"\x20\x20\x20\x63\x6f\x6c\x6f\x72"
+DATA ·d+174536(SB)/8,$"\x3a\x20\x24\x6e\x61\x76\x2d\x74"
+DATA ·d+174544(SB)/8,$"\x65\x78\x74\x3b\x0a\x20\x20\x20"
+DATA ·d+174552(SB)/8,$"\x20\x20\x20\x62\x6f\x72\x64\x65"
+DATA ·d+174560(SB)/8,$"\x72\x3a\x20\x31\x70\x78\x20\x73"
+DATA ·d+174568(SB)/8,$"\x6f\x6c\x69\x64\x20\x24\x73\x65"
+DATA ·d+174576(SB)/8,$"\x61\x72\x63\x68\x2d\x62\x6f\x78"
+DATA ·d+174584(SB)/8,$"\x2d\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+174592(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3b\x0a\x20"
+DATA ·d+174600(SB)/8,$"\x20\x20\x20\x20\x20\x70\x61\x64"
+DATA ·d+174608(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x34\x70"
+DATA ·d+174616(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x7d"
+DATA ·d+174624(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+174632(SB)/8,$"\x26\x3e\x2e\x73\x65\x61\x72\x63"
+DATA ·d+174640(SB)/8,$"\x68\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+174648(SB)/8,$"\x70\x6f\x73\x69\x74\x69\x6f\x6e"
+DATA ·d+174656(SB)/8,$"\x3a\x20\x72\x65\x6c\x61\x74\x69"
+DATA ·d+174664(SB)/8,$"\x76\x65\x3b\x0a\x0a\x20\x20\x20"
+DATA ·d+174672(SB)/8,$"\x20\x69\x6e\x70\x75\x74\x20\x7b"
+DATA ·d+174680(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x62"
+DATA ·d+174688(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
+DATA ·d+174696(SB)/8,$"\x64\x3a\x20\x24\x6e\x61\x76\x2d"
+DATA ·d+174704(SB)/8,$"\x62\x67\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+174712(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+174720(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3a\x20"
+DATA ·d+174728(SB)/8,$"\x30\x20\x30\x20\x31\x70\x78\x20"
+DATA ·d+174736(SB)/8,$"\x30\x3b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+174744(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+174752(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
+DATA ·d+174760(SB)/8,$"\x73\x65\x61\x72\x63\x68\x2d\x62"
+DATA ·d+174768(SB)/8,$"\x6f\x78\x2d\x62\x6f\x72\x64\x65"
+DATA ·d+174776(SB)/8,$"\x72\x2d\x63\x6f\x6c\x6f\x72\x3b"
+DATA ·d+174784(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x70"
+DATA ·d+174792(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x3a\x20"
+DATA ·d+174800(SB)/8,$"\x36\x70\x78\x20\x30\x20\x36\x70"
+DATA ·d+174808(SB)/8,$"\x78\x20\x32\x30\x70\x78\x3b\x0a"
+DATA ·d+174816(SB)/8,$"\x20\x20\x20\x20\x20\x20\x62\x6f"
+DATA ·d+174824(SB)/8,$"\x78\x2d\x73\x69\x7a\x69\x6e\x67"
+DATA ·d+174832(SB)/8,$"\x3a\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+174840(SB)/8,$"\x2d\x62\x6f\x78\x3b\x0a\x20\x20"
+DATA ·d+174848(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+174856(SB)/8,$"\x69\x6e\x3a\x20\x24\x6e\x61\x76"
+DATA ·d+174864(SB)/8,$"\x2d\x76\x2d\x70\x61\x64\x64\x69"
+DATA ·d+174872(SB)/8,$"\x6e\x67\x20\x24\x6e\x61\x76\x2d"
+DATA ·d+174880(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3b"
+DATA ·d+174888(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x77"
+DATA ·d+174896(SB)/8,$"\x69\x64\x74\x68\x3a\x20\x24\x6e"
+DATA ·d+174904(SB)/8,$"\x61\x76\x2d\x77\x69\x64\x74\x68"
+DATA ·d+174912(SB)/8,$"\x20\x2d\x20\x28\x24\x6e\x61\x76"
+DATA ·d+174920(SB)/8,$"\x2d\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+174928(SB)/8,$"\x2a\x32\x29\x3b\x0a\x20\x20\x20"
+DATA ·d+174936(SB)/8,$"\x20\x20\x20\x6f\x75\x74\x6c\x69"
+DATA ·d+174944(SB)/8,$"\x6e\x65\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+174952(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+174960(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
+DATA ·d+174968(SB)/8,$"\x6e\x61\x76\x2d\x74\x65\x78\x74"
+DATA ·d+174976(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+174984(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x2d\x72"
+DATA ·d+174992(SB)/8,$"\x61\x64\x69\x75\x73\x3a\x20\x30"
+DATA ·d+175000(SB)/8,$"\x3b\x20\x2f\x2a\x20\x69\x6f\x73"
+DATA ·d+175008(SB)/8,$"\x20\x68\x61\x73\x20\x61\x20\x64"
+DATA ·d+175016(SB)/8,$"\x65\x66\x61\x75\x6c\x74\x20\x62"
+DATA ·d+175024(SB)/8,$"\x6f\x72\x64\x65\x72\x20\x72\x61"
+DATA ·d+175032(SB)/8,$"\x64\x69\x75\x73\x20\x2a\x2f\x0a"
+DATA ·d+175040(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+175048(SB)/8,$"\x20\x20\x20\x26\x3a\x62\x65\x66"
+DATA ·d+175056(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
+DATA ·d+175064(SB)/8,$"\x20\x20\x20\x20\x70\x6f\x73\x69"
+DATA ·d+175072(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x61\x62"
+DATA ·d+175080(SB)/8,$"\x73\x6f\x6c\x75\x74\x65\x3b\x0a"
+DATA ·d+175088(SB)/8,$"\x20\x20\x20\x20\x20\x20\x74\x6f"
+DATA ·d+175096(SB)/8,$"\x70\x3a\x20\x31\x37\x70\x78\x3b"
+DATA ·d+175104(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x6c"
+DATA ·d+175112(SB)/8,$"\x65\x66\x74\x3a\x20\x24\x6e\x61"
+DATA ·d+175120(SB)/8,$"\x76\x2d\x70\x61\x64\x64\x69\x6e"
+DATA ·d+175128(SB)/8,$"\x67\x3b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+175136(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+175144(SB)/8,$"\x24\x6e\x61\x76\x2d\x74\x65\x78"
+DATA ·d+175152(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+175160(SB)/8,$"\x20\x40\x65\x78\x74\x65\x6e\x64"
+DATA ·d+175168(SB)/8,$"\x20\x25\x69\x63\x6f\x6e\x2d\x73"
+DATA ·d+175176(SB)/8,$"\x65\x61\x72\x63\x68\x3b\x0a\x20"
+DATA ·d+175184(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x7d"
+DATA ·d+175192(SB)/8,$"\x0a\x0a\x20\x20\x2e\x73\x65\x61"
+DATA ·d+175200(SB)/8,$"\x72\x63\x68\x2d\x72\x65\x73\x75"
+DATA ·d+175208(SB)/8,$"\x6c\x74\x73\x20\x7b\x0a\x20\x20"
+DATA ·d+175216(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
+DATA ·d+175224(SB)/8,$"\x2d\x74\x6f\x70\x3a\x20\x30\x3b"
+DATA ·d+175232(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x78"
+DATA ·d+175240(SB)/8,$"\x2d\x73\x69\x7a\x69\x6e\x67\x3a"
+DATA ·d+175248(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+175256(SB)/8,$"\x62\x6f\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+175264(SB)/8,$"\x20\x68\x65\x69\x67\x68\x74\x3a"
+DATA ·d+175272(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+175280(SB)/8,$"\x6f\x76\x65\x72\x66\x6c\x6f\x77"
+DATA ·d+175288(SB)/8,$"\x2d\x79\x3a\x20\x61\x75\x74\x6f"
+DATA ·d+175296(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6f\x76"
+DATA ·d+175304(SB)/8,$"\x65\x72\x66\x6c\x6f\x77\x2d\x78"
+DATA ·d+175312(SB)/8,$"\x3a\x20\x68\x69\x64\x64\x65\x6e"
+DATA ·d+175320(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x74\x72"
+DATA ·d+175328(SB)/8,$"\x61\x6e\x73\x69\x74\x69\x6f\x6e"
+DATA ·d+175336(SB)/8,$"\x2d\x70\x72\x6f\x70\x65\x72\x74"
+DATA ·d+175344(SB)/8,$"\x79\x3a\x20\x68\x65\x69\x67\x68"
+DATA ·d+175352(SB)/8,$"\x74\x2c\x20\x6d\x61\x72\x67\x69"
+DATA ·d+175360(SB)/8,$"\x6e\x3b\x0a\x20\x20\x20\x20\x74"
+DATA ·d+175368(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
+DATA ·d+175376(SB)/8,$"\x6e\x2d\x64\x75\x72\x61\x74\x69"
+DATA ·d+175384(SB)/8,$"\x6f\x6e\x3a\x20\x31\x38\x30\x6d"
+DATA ·d+175392(SB)/8,$"\x73\x3b\x0a\x20\x20\x20\x20\x74"
+DATA ·d+175400(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
+DATA ·d+175408(SB)/8,$"\x6e\x2d\x74\x69\x6d\x69\x6e\x67"
+DATA ·d+175416(SB)/8,$"\x2d\x66\x75\x6e\x63\x74\x69\x6f"
+DATA ·d+175424(SB)/8,$"\x6e\x3a\x20\x65\x61\x73\x65\x2d"
+DATA ·d+175432(SB)/8,$"\x69\x6e\x2d\x6f\x75\x74\x3b\x0a"
+DATA ·d+175440(SB)/8,$"\x20\x20\x20\x20\x62\x61\x63\x6b"
+DATA ·d+175448(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x3a\x20"
+DATA ·d+175456(SB)/8,$"\x24\x6e\x61\x76\x2d\x73\x75\x62"
+DATA ·d+175464(SB)/8,$"\x69\x74\x65\x6d\x2d\x62\x67\x3b"
+DATA ·d+175472(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x2e\x76"
+DATA ·d+175480(SB)/8,$"\x69\x73\x69\x62\x6c\x65\x20\x7b"
+DATA ·d+175488(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x68"
+DATA ·d+175496(SB)/8,$"\x65\x69\x67\x68\x74\x3a\x20\x33"
+DATA ·d+175504(SB)/8,$"\x30\x25\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+175512(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
+DATA ·d+175520(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
+DATA ·d+175528(SB)/8,$"\x20\x31\x65\x6d\x3b\x0a\x20\x20"
+DATA ·d+175536(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x20"
+DATA ·d+175544(SB)/8,$"\x20\x6c\x69\x20\x7b\x0a\x20\x20"
+DATA ·d+175552(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+175560(SB)/8,$"\x69\x6e\x3a\x20\x31\x65\x6d\x20"
+DATA ·d+175568(SB)/8,$"\x24\x6e\x61\x76\x2d\x70\x61\x64"
+DATA ·d+175576(SB)/8,$"\x64\x69\x6e\x67\x3b\x0a\x20\x20"
+DATA ·d+175584(SB)/8,$"\x20\x20\x20\x20\x6c\x69\x6e\x65"
+DATA ·d+175592(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
+DATA ·d+175600(SB)/8,$"\x20\x31\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+175608(SB)/8,$"\x7d\x0a\x0a\x20\x20\x20\x20\x61"
+DATA ·d+175616(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+175624(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+175632(SB)/8,$"\x24\x6e\x61\x76\x2d\x74\x65\x78"
+DATA ·d+175640(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+175648(SB)/8,$"\x20\x74\x65\x78\x74\x2d\x64\x65"
+DATA ·d+175656(SB)/8,$"\x63\x6f\x72\x61\x74\x69\x6f\x6e"
+DATA ·d+175664(SB)/8,$"\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a"
+DATA ·d+175672(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x26"
+DATA ·d+175680(SB)/8,$"\x3a\x68\x6f\x76\x65\x72\x20\x7b"
+DATA ·d+175688(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x20"
+DATA ·d+175696(SB)/8,$"\x20\x74\x65\x78\x74\x2d\x64\x65"
+DATA ·d+175704(SB)/8,$"\x63\x6f\x72\x61\x74\x69\x6f\x6e"
+DATA ·d+175712(SB)/8,$"\x3a\x20\x75\x6e\x64\x65\x72\x6c"
+DATA ·d+175720(SB)/8,$"\x69\x6e\x65\x3b\x0a\x20\x20\x20"
+DATA ·d+175728(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x20"
+DATA ·d+175736(SB)/8,$"\x20\x7d\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+175744(SB)/8,$"\x0a\x20\x20\x2f\x2f\x20\x54\x68"
+DATA ·d+175752(SB)/8,$"\x65\x20\x54\x61\x62\x6c\x65\x20"
+DATA ·d+175760(SB)/8,$"\x6f\x66\x20\x43\x6f\x6e\x74\x65"
+DATA ·d+175768(SB)/8,$"\x6e\x74\x73\x20\x69\x73\x20\x63"
+DATA ·d+175776(SB)/8,$"\x6f\x6d\x70\x6f\x73\x65\x64\x20"
+DATA ·d+175784(SB)/8,$"\x6f\x66\x20\x6d\x75\x6c\x74\x69"
+DATA ·d+175792(SB)/8,$"\x70\x6c\x65\x20\x6e\x65\x73\x74"
+DATA ·d+175800(SB)/8,$"\x65\x64\x0a\x20\x20\x2f\x2f\x20"
+DATA ·d+175808(SB)/8,$"\x75\x6e\x6f\x72\x64\x65\x72\x65"
+DATA ·d+175816(SB)/8,$"\x64\x20\x6c\x69\x73\x74\x73\x2e"
+DATA ·d+175824(SB)/8,$"\x20\x20\x54\x68\x65\x73\x65\x20"
+DATA ·d+175832(SB)/8,$"\x73\x74\x79\x6c\x65\x73\x20\x72"
+DATA ·d+175840(SB)/8,$"\x65\x6d\x6f\x76\x65\x20\x74\x68"
+DATA ·d+175848(SB)/8,$"\x65\x20\x64\x65\x66\x61\x75\x6c"
+DATA ·d+175856(SB)/8,$"\x74\x0a\x20\x20\x2f\x2f\x20\x73"
+DATA ·d+175864(SB)/8,$"\x74\x79\x6c\x69\x6e\x67\x20\x6f"
+DATA ·d+175872(SB)/8,$"\x66\x20\x61\x6e\x20\x75\x6e\x6f"
+DATA ·d+175880(SB)/8,$"\x72\x64\x65\x72\x65\x64\x20\x6c"
+DATA ·d+175888(SB)/8,$"\x69\x73\x74\x20\x62\x65\x63\x61"
+DATA ·d+175896(SB)/8,$"\x75\x73\x65\x20\x69\x74\x20\x69"
+DATA ·d+175904(SB)/8,$"\x73\x20\x75\x67\x6c\x79\x2e\x0a"
+DATA ·d+175912(SB)/8,$"\x20\x20\x75\x6c\x2c\x20\x6c\x69"
+DATA ·d+175920(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6c"
+DATA ·d+175928(SB)/8,$"\x69\x73\x74\x2d\x73\x74\x79\x6c"
+DATA ·d+175936(SB)/8,$"\x65\x3a\x20\x6e\x6f\x6e\x65\x3b"
+DATA ·d+175944(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+175952(SB)/8,$"\x67\x69\x6e\x3a\x20\x30\x3b\x0a"
+DATA ·d+175960(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+175968(SB)/8,$"\x69\x6e\x67\x3a\x20\x30\x3b\x0a"
+DATA ·d+175976(SB)/8,$"\x20\x20\x20\x20\x6c\x69\x6e\x65"
+DATA ·d+175984(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
+DATA ·d+175992(SB)/8,$"\x20\x32\x38\x70\x78\x3b\x0a\x20"
+DATA ·d+176000(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x6c\x69"
+DATA ·d+176008(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x63"
+DATA ·d+176016(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x6e"
+DATA ·d+176024(SB)/8,$"\x61\x76\x2d\x74\x65\x78\x74\x3b"
+DATA ·d+176032(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x72\x61"
+DATA ·d+176040(SB)/8,$"\x6e\x73\x69\x74\x69\x6f\x6e\x2d"
+DATA ·d+176048(SB)/8,$"\x70\x72\x6f\x70\x65\x72\x74\x79"
+DATA ·d+176056(SB)/8,$"\x3a\x20\x62\x61\x63\x6b\x67\x72"
+DATA ·d+176064(SB)/8,$"\x6f\x75\x6e\x64\x3b\x0a\x20\x20"
+DATA ·d+176072(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
+DATA ·d+176080(SB)/8,$"\x74\x69\x6f\x6e\x2d\x74\x69\x6d"
+DATA ·d+176088(SB)/8,$"\x69\x6e\x67\x2d\x66\x75\x6e\x63"
+DATA ·d+176096(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6c\x69"
+DATA ·d+176104(SB)/8,$"\x6e\x65\x61\x72\x3b\x0a\x20\x20"
+DATA ·d+176112(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
+DATA ·d+176120(SB)/8,$"\x74\x69\x6f\x6e\x2d\x64\x75\x72"
+DATA ·d+176128(SB)/8,$"\x61\x74\x69\x6f\x6e\x3a\x20\x32"
+DATA ·d+176136(SB)/8,$"\x30\x30\x6d\x73\x3b\x0a\x20\x20"
+DATA ·d+176144(SB)/8,$"\x7d\x0a\x0a\x20\x20\x2f\x2f\x20"
+DATA ·d+176152(SB)/8,$"\x54\x68\x69\x73\x20\x69\x73\x20"
+DATA ·d+176160(SB)/8,$"\x74\x68\x65\x20\x63\x75\x72\x72"
+DATA ·d+176168(SB)/8,$"\x65\x6e\x74\x6c\x79\x20\x73\x65"
+DATA ·d+176176(SB)/8,$"\x6c\x65\x63\x74\x65\x64\x20\x54"
+DATA ·d+176184(SB)/8,$"\x6f\x43\x20\x65\x6e\x74\x72\x79"
+DATA ·d+176192(SB)/8,$"\x0a\x20\x20\x2e\x74\x6f\x63\x2d"
+DATA ·d+176200(SB)/8,$"\x6c\x69\x6e\x6b\x2e\x61\x63\x74"
+DATA ·d+176208(SB)/8,$"\x69\x76\x65\x20\x7b\x0a\x20\x20"
+DATA ·d+176216(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
+DATA ·d+176224(SB)/8,$"\x6f\x75\x6e\x64\x2d\x63\x6f\x6c"
+DATA ·d+176232(SB)/8,$"\x6f\x72\x3a\x20\x24\x6e\x61\x76"
+DATA ·d+176240(SB)/8,$"\x2d\x61\x63\x74\x69\x76\x65\x2d"
+DATA ·d+176248(SB)/8,$"\x62\x67\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+176256(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
+DATA ·d+176264(SB)/8,$"\x6e\x61\x76\x2d\x61\x63\x74\x69"
+DATA ·d+176272(SB)/8,$"\x76\x65\x2d\x74\x65\x78\x74\x3b"
+DATA ·d+176280(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+176288(SB)/8,$"\x2f\x2f\x20\x74\x68\x69\x73\x20"
+DATA ·d+176296(SB)/8,$"\x69\x73\x20\x70\x61\x72\x65\x6e"
+DATA ·d+176304(SB)/8,$"\x74\x20\x6c\x69\x6e\x6b\x73\x20"
+DATA ·d+176312(SB)/8,$"\x6f\x66\x20\x74\x68\x65\x20\x63"
+DATA ·d+176320(SB)/8,$"\x75\x72\x72\x65\x6e\x74\x6c\x79"
+DATA ·d+176328(SB)/8,$"\x20\x73\x65\x6c\x65\x63\x74\x65"
+DATA ·d+176336(SB)/8,$"\x64\x20\x54\x6f\x43\x20\x65\x6e"
+DATA ·d+176344(SB)/8,$"\x74\x72\x79\x0a\x20\x20\x2e\x74"
+DATA ·d+176352(SB)/8,$"\x6f\x63\x2d\x6c\x69\x6e\x6b\x2e"
+DATA ·d+176360(SB)/8,$"\x61\x63\x74\x69\x76\x65\x2d\x70"
+DATA ·d+176368(SB)/8,$"\x61\x72\x65\x6e\x74\x20\x7b\x0a"
+DATA ·d+176376(SB)/8,$"\x20\x20\x20\x20\x62\x61\x63\x6b"
+DATA ·d+176384(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x2d\x63"
+DATA ·d+176392(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x6e"
+DATA ·d+176400(SB)/8,$"\x61\x76\x2d\x61\x63\x74\x69\x76"
+DATA ·d+176408(SB)/8,$"\x65\x2d\x70\x61\x72\x65\x6e\x74"
+DATA ·d+176416(SB)/8,$"\x2d\x62\x67\x3b\x0a\x20\x20\x20"
+DATA ·d+176424(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+176432(SB)/8,$"\x24\x6e\x61\x76\x2d\x61\x63\x74"
+DATA ·d+176440(SB)/8,$"\x69\x76\x65\x2d\x70\x61\x72\x65"
+DATA ·d+176448(SB)/8,$"\x6e\x74\x2d\x74\x65\x78\x74\x3b"
+DATA ·d+176456(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+176464(SB)/8,$"\x2e\x74\x6f\x63\x2d\x6c\x69\x73"
+DATA ·d+176472(SB)/8,$"\x74\x2d\x68\x32\x20\x7b\x0a\x20"
+DATA ·d+176480(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
+DATA ·d+176488(SB)/8,$"\x61\x79\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+176496(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x62\x61"
+DATA ·d+176504(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
+DATA ·d+176512(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+176520(SB)/8,$"\x24\x6e\x61\x76\x2d\x73\x75\x62"
+DATA ·d+176528(SB)/8,$"\x69\x74\x65\x6d\x2d\x62\x67\x3b"
+DATA ·d+176536(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
+DATA ·d+176544(SB)/8,$"\x74\x2d\x77\x65\x69\x67\x68\x74"
+DATA ·d+176552(SB)/8,$"\x3a\x20\x35\x30\x30\x3b\x0a\x20"
+DATA ·d+176560(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x2e\x74"
+DATA ·d+176568(SB)/8,$"\x6f\x63\x2d\x68\x32\x20\x7b\x0a"
+DATA ·d+176576(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+176584(SB)/8,$"\x69\x6e\x67\x2d\x6c\x65\x66\x74"
+DATA ·d+176592(SB)/8,$"\x3a\x20\x24\x6e\x61\x76\x2d\x70"
+DATA ·d+176600(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x20\x2b"
+DATA ·d+176608(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x69\x6e"
+DATA ·d+176616(SB)/8,$"\x64\x65\x6e\x74\x3b\x0a\x20\x20"
+DATA ·d+176624(SB)/8,$"\x20\x20\x66\x6f\x6e\x74\x2d\x73"
+DATA ·d+176632(SB)/8,$"\x69\x7a\x65\x3a\x20\x31\x32\x70"
+DATA ·d+176640(SB)/8,$"\x78\x3b\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+176648(SB)/8,$"\x20\x20\x2e\x74\x6f\x63\x2d\x66"
+DATA ·d+176656(SB)/8,$"\x6f\x6f\x74\x65\x72\x20\x7b\x0a"
+DATA ·d+176664(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+176672(SB)/8,$"\x69\x6e\x67\x3a\x20\x31\x65\x6d"
+DATA ·d+176680(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+176688(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x74"
+DATA ·d+176696(SB)/8,$"\x6f\x70\x3a\x20\x31\x65\x6d\x3b"
+DATA ·d+176704(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
+DATA ·d+176712(SB)/8,$"\x64\x65\x72\x2d\x74\x6f\x70\x3a"
+DATA ·d+176720(SB)/8,$"\x20\x31\x70\x78\x20\x64\x61\x73"
+DATA ·d+176728(SB)/8,$"\x68\x65\x64\x20\x24\x6e\x61\x76"
+DATA ·d+176736(SB)/8,$"\x2d\x66\x6f\x6f\x74\x65\x72\x2d"
+DATA ·d+176744(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x2d\x63"
+DATA ·d+176752(SB)/8,$"\x6f\x6c\x6f\x72\x3b\x0a\x0a\x20"
+DATA ·d+176760(SB)/8,$"\x20\x20\x20\x6c\x69\x2c\x61\x20"
+DATA ·d+176768(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+176776(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
+DATA ·d+176784(SB)/8,$"\x6e\x61\x76\x2d\x74\x65\x78\x74"
+DATA ·d+176792(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+176800(SB)/8,$"\x74\x65\x78\x74\x2d\x64\x65\x63"
+DATA ·d+176808(SB)/8,$"\x6f\x72\x61\x74\x69\x6f\x6e\x3a"
+DATA ·d+176816(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
+DATA ·d+176824(SB)/8,$"\x20\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+176832(SB)/8,$"\x20\x20\x61\x3a\x68\x6f\x76\x65"
+DATA ·d+176840(SB)/8,$"\x72\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+176848(SB)/8,$"\x20\x20\x74\x65\x78\x74\x2d\x64"
+DATA ·d+176856(SB)/8,$"\x65\x63\x6f\x72\x61\x74\x69\x6f"
+DATA ·d+176864(SB)/8,$"\x6e\x3a\x20\x75\x6e\x64\x65\x72"
+DATA ·d+176872(SB)/8,$"\x6c\x69\x6e\x65\x3b\x0a\x20\x20"
+DATA ·d+176880(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x20"
+DATA ·d+176888(SB)/8,$"\x20\x6c\x69\x20\x7b\x0a\x20\x20"
+DATA ·d+176896(SB)/8,$"\x20\x20\x20\x20\x66\x6f\x6e\x74"
+DATA ·d+176904(SB)/8,$"\x2d\x73\x69\x7a\x65\x3a\x20\x30"
+DATA ·d+176912(SB)/8,$"\x2e\x38\x65\x6d\x3b\x0a\x20\x20"
+DATA ·d+176920(SB)/8,$"\x20\x20\x20\x20\x6c\x69\x6e\x65"
+DATA ·d+176928(SB)/8,$"\x2d\x68\x65\x69\x67\x68\x74\x3a"
+DATA ·d+176936(SB)/8,$"\x20\x31\x2e\x37\x3b\x0a\x20\x20"
+DATA ·d+176944(SB)/8,$"\x20\x20\x20\x20\x74\x65\x78\x74"
+DATA ·d+176952(SB)/8,$"\x2d\x64\x65\x63\x6f\x72\x61\x74"
+DATA ·d+176960(SB)/8,$"\x69\x6f\x6e\x3a\x20\x6e\x6f\x6e"
+DATA ·d+176968(SB)/8,$"\x65\x3b\x0a\x20\x20\x20\x20\x7d"
+DATA ·d+176976(SB)/8,$"\x0a\x20\x20\x7d\x0a\x7d\x0a\x0a"
+DATA ·d+176984(SB)/8,$"\x2e\x74\x6f\x63\x2d\x6c\x69\x6e"
+DATA ·d+176992(SB)/8,$"\x6b\x2c\x20\x2e\x74\x6f\x63\x2d"
+DATA ·d+177000(SB)/8,$"\x66\x6f\x6f\x74\x65\x72\x20\x6c"
+DATA ·d+177008(SB)/8,$"\x69\x20\x7b\x0a\x20\x20\x70\x61"
+DATA ·d+177016(SB)/8,$"\x64\x64\x69\x6e\x67\x3a\x20\x30"
+DATA ·d+177024(SB)/8,$"\x20\x24\x6e\x61\x76\x2d\x70\x61"
+DATA ·d+177032(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x30\x20"
+DATA ·d+177040(SB)/8,$"\x24\x6e\x61\x76\x2d\x70\x61\x64"
+DATA ·d+177048(SB)/8,$"\x64\x69\x6e\x67\x3b\x0a\x20\x20"
+DATA ·d+177056(SB)/8,$"\x64\x69\x73\x70\x6c\x61\x79\x3a"
+DATA ·d+177064(SB)/8,$"\x20\x62\x6c\x6f\x63\x6b\x3b\x0a"
+DATA ·d+177072(SB)/8,$"\x20\x20\x6f\x76\x65\x72\x66\x6c"
+DATA ·d+177080(SB)/8,$"\x6f\x77\x2d\x78\x3a\x20\x68\x69"
+DATA ·d+177088(SB)/8,$"\x64\x64\x65\x6e\x3b\x0a\x20\x20"
+DATA ·d+177096(SB)/8,$"\x77\x68\x69\x74\x65\x2d\x73\x70"
+DATA ·d+177104(SB)/8,$"\x61\x63\x65\x3a\x20\x6e\x6f\x77"
+DATA ·d+177112(SB)/8,$"\x72\x61\x70\x3b\x0a\x20\x20\x74"
+DATA ·d+177120(SB)/8,$"\x65\x78\x74\x2d\x6f\x76\x65\x72"
+DATA ·d+177128(SB)/8,$"\x66\x6c\x6f\x77\x3a\x20\x65\x6c"
+DATA ·d+177136(SB)/8,$"\x6c\x69\x70\x73\x69\x73\x3b\x0a"
+DATA ·d+177144(SB)/8,$"\x20\x20\x74\x65\x78\x74\x2d\x64"
+DATA ·d+177152(SB)/8,$"\x65\x63\x6f\x72\x61\x74\x69\x6f"
+DATA ·d+177160(SB)/8,$"\x6e\x3a\x20\x6e\x6f\x6e\x65\x3b"
+DATA ·d+177168(SB)/8,$"\x0a\x20\x20\x63\x6f\x6c\x6f\x72"
+DATA ·d+177176(SB)/8,$"\x3a\x20\x24\x6e\x61\x76\x2d\x74"
+DATA ·d+177184(SB)/8,$"\x65\x78\x74\x3b\x0a\x20\x20\x74"
+DATA ·d+177192(SB)/8,$"\x72\x61\x6e\x73\x69\x74\x69\x6f"
+DATA ·d+177200(SB)/8,$"\x6e\x2d\x70\x72\x6f\x70\x65\x72"
+DATA ·d+177208(SB)/8,$"\x74\x79\x3a\x20\x62\x61\x63\x6b"
+DATA ·d+177216(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x3b\x0a"
+DATA ·d+177224(SB)/8,$"\x20\x20\x74\x72\x61\x6e\x73\x69"
+DATA ·d+177232(SB)/8,$"\x74\x69\x6f\x6e\x2d\x74\x69\x6d"
+DATA ·d+177240(SB)/8,$"\x69\x6e\x67\x2d\x66\x75\x6e\x63"
+DATA ·d+177248(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6c\x69"
+DATA ·d+177256(SB)/8,$"\x6e\x65\x61\x72\x3b\x0a\x20\x20"
+DATA ·d+177264(SB)/8,$"\x74\x72\x61\x6e\x73\x69\x74\x69"
+DATA ·d+177272(SB)/8,$"\x6f\x6e\x2d\x64\x75\x72\x61\x74"
+DATA ·d+177280(SB)/8,$"\x69\x6f\x6e\x3a\x20\x31\x33\x30"
+DATA ·d+177288(SB)/8,$"\x6d\x73\x3b\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+177296(SB)/8,$"\x2f\x20\x62\x75\x74\x74\x6f\x6e"
+DATA ·d+177304(SB)/8,$"\x20\x74\x6f\x20\x73\x68\x6f\x77"
+DATA ·d+177312(SB)/8,$"\x20\x6e\x61\x76\x69\x67\x61\x74"
+DATA ·d+177320(SB)/8,$"\x69\x6f\x6e\x20\x6f\x6e\x20\x6d"
+DATA ·d+177328(SB)/8,$"\x6f\x62\x69\x6c\x65\x20\x64\x65"
+DATA ·d+177336(SB)/8,$"\x76\x69\x63\x65\x73\x0a\x23\x6e"
+DATA ·d+177344(SB)/8,$"\x61\x76\x2d\x62\x75\x74\x74\x6f"
+DATA ·d+177352(SB)/8,$"\x6e\x20\x7b\x0a\x20\x20\x73\x70"
+DATA ·d+177360(SB)/8,$"\x61\x6e\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+177368(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
+DATA ·d+177376(SB)/8,$"\x3a\x20\x62\x6c\x6f\x63\x6b\x3b"
+DATA ·d+177384(SB)/8,$"\x0a\x20\x20\x20\x20\x24\x73\x69"
+DATA ·d+177392(SB)/8,$"\x64\x65\x2d\x70\x61\x64\x3a\x20"
+DATA ·d+177400(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x70\x61"
+DATA ·d+177408(SB)/8,$"\x64\x64\x69\x6e\x67\x20\x2f\x20"
+DATA ·d+177416(SB)/8,$"\x32\x20\x2d\x20\x38\x70\x78\x3b"
+DATA ·d+177424(SB)/8,$"\x0a\x20\x20\x20\x20\x70\x61\x64"
+DATA ·d+177432(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x24\x73"
+DATA ·d+177440(SB)/8,$"\x69\x64\x65\x2d\x70\x61\x64\x20"
+DATA ·d+177448(SB)/8,$"\x24\x73\x69\x64\x65\x2d\x70\x61"
+DATA ·d+177456(SB)/8,$"\x64\x20\x24\x73\x69\x64\x65\x2d"
+DATA ·d+177464(SB)/8,$"\x70\x61\x64\x3b\x0a\x20\x20\x20"
+DATA ·d+177472(SB)/8,$"\x20\x62\x61\x63\x6b\x67\x72\x6f"
+DATA ·d+177480(SB)/8,$"\x75\x6e\x64\x2d\x63\x6f\x6c\x6f"
+DATA ·d+177488(SB)/8,$"\x72\x3a\x20\x72\x67\x62\x61\x28"
+DATA ·d+177496(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x62\x67"
+DATA ·d+177504(SB)/8,$"\x2c\x20\x30\x2e\x37\x29\x3b\x0a"
+DATA ·d+177512(SB)/8,$"\x20\x20\x20\x20\x74\x72\x61\x6e"
+DATA ·d+177520(SB)/8,$"\x73\x66\x6f\x72\x6d\x2d\x6f\x72"
+DATA ·d+177528(SB)/8,$"\x69\x67\x69\x6e\x3a\x20\x30\x20"
+DATA ·d+177536(SB)/8,$"\x30\x3b\x0a\x20\x20\x20\x20\x74"
+DATA ·d+177544(SB)/8,$"\x72\x61\x6e\x73\x66\x6f\x72\x6d"
+DATA ·d+177552(SB)/8,$"\x3a\x20\x72\x6f\x74\x61\x74\x65"
+DATA ·d+177560(SB)/8,$"\x28\x2d\x39\x30\x64\x65\x67\x29"
+DATA ·d+177568(SB)/8,$"\x20\x74\x72\x61\x6e\x73\x6c\x61"
+DATA ·d+177576(SB)/8,$"\x74\x65\x28\x2d\x31\x30\x30\x25"
+DATA ·d+177584(SB)/8,$"\x2c\x20\x30\x29\x3b\x0a\x20\x20"
+DATA ·d+177592(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+177600(SB)/8,$"\x2d\x72\x61\x64\x69\x75\x73\x3a"
+DATA ·d+177608(SB)/8,$"\x20\x30\x20\x30\x20\x30\x20\x35"
+DATA ·d+177616(SB)/8,$"\x70\x78\x3b\x0a\x20\x20\x7d\x0a"
+DATA ·d+177624(SB)/8,$"\x20\x20\x70\x61\x64\x64\x69\x6e"
+DATA ·d+177632(SB)/8,$"\x67\x3a\x20\x30\x20\x31\x2e\x35"
+DATA ·d+177640(SB)/8,$"\x65\x6d\x20\x35\x65\x6d\x20\x30"
+DATA ·d+177648(SB)/8,$"\x3b\x20\x2f\x2f\x20\x69\x6e\x63"
+DATA ·d+177656(SB)/8,$"\x72\x65\x61\x73\x65\x20\x74\x6f"
+DATA ·d+177664(SB)/8,$"\x75\x63\x68\x20\x73\x69\x7a\x65"
+DATA ·d+177672(SB)/8,$"\x20\x61\x72\x65\x61\x0a\x20\x20"
+DATA ·d+177680(SB)/8,$"\x64\x69\x73\x70\x6c\x61\x79\x3a"
+DATA ·d+177688(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
+DATA ·d+177696(SB)/8,$"\x20\x70\x6f\x73\x69\x74\x69\x6f"
+DATA ·d+177704(SB)/8,$"\x6e\x3a\x20\x66\x69\x78\x65\x64"
+DATA ·d+177712(SB)/8,$"\x3b\x0a\x20\x20\x74\x6f\x70\x3a"
+DATA ·d+177720(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x6c\x65"
+DATA ·d+177728(SB)/8,$"\x66\x74\x3a\x20\x30\x3b\x0a\x20"
+DATA ·d+177736(SB)/8,$"\x20\x7a\x2d\x69\x6e\x64\x65\x78"
+DATA ·d+177744(SB)/8,$"\x3a\x20\x31\x30\x30\x3b\x0a\x20"
+DATA ·d+177752(SB)/8,$"\x20\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+177760(SB)/8,$"\x23\x30\x30\x30\x3b\x0a\x20\x20"
+DATA ·d+177768(SB)/8,$"\x74\x65\x78\x74\x2d\x64\x65\x63"
+DATA ·d+177776(SB)/8,$"\x6f\x72\x61\x74\x69\x6f\x6e\x3a"
+DATA ·d+177784(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
+DATA ·d+177792(SB)/8,$"\x20\x66\x6f\x6e\x74\x2d\x77\x65"
+DATA ·d+177800(SB)/8,$"\x69\x67\x68\x74\x3a\x20\x62\x6f"
+DATA ·d+177808(SB)/8,$"\x6c\x64\x3b\x0a\x20\x20\x6f\x70"
+DATA ·d+177816(SB)/8,$"\x61\x63\x69\x74\x79\x3a\x20\x30"
+DATA ·d+177824(SB)/8,$"\x2e\x37\x3b\x0a\x20\x20\x6c\x69"
+DATA ·d+177832(SB)/8,$"\x6e\x65\x2d\x68\x65\x69\x67\x68"
+DATA ·d+177840(SB)/8,$"\x74\x3a\x20\x31\x36\x70\x78\x3b"
+DATA ·d+177848(SB)/8,$"\x0a\x20\x20\x69\x6d\x67\x20\x7b"
+DATA ·d+177856(SB)/8,$"\x0a\x20\x20\x20\x20\x68\x65\x69"
+DATA ·d+177864(SB)/8,$"\x67\x68\x74\x3a\x20\x31\x36\x70"
+DATA ·d+177872(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x76"
+DATA ·d+177880(SB)/8,$"\x65\x72\x74\x69\x63\x61\x6c\x2d"
+DATA ·d+177888(SB)/8,$"\x61\x6c\x69\x67\x6e\x3a\x20\x62"
+DATA ·d+177896(SB)/8,$"\x6f\x74\x74\x6f\x6d\x3b\x0a\x20"
+DATA ·d+177904(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x74\x72"
+DATA ·d+177912(SB)/8,$"\x61\x6e\x73\x69\x74\x69\x6f\x6e"
+DATA ·d+177920(SB)/8,$"\x3a\x20\x6c\x65\x66\x74\x20\x30"
+DATA ·d+177928(SB)/8,$"\x2e\x33\x73\x20\x65\x61\x73\x65"
+DATA ·d+177936(SB)/8,$"\x2d\x69\x6e\x2d\x6f\x75\x74\x3b"
+DATA ·d+177944(SB)/8,$"\x0a\x0a\x20\x20\x26\x3a\x68\x6f"
+DATA ·d+177952(SB)/8,$"\x76\x65\x72\x20\x7b\x20\x6f\x70"
+DATA ·d+177960(SB)/8,$"\x61\x63\x69\x74\x79\x3a\x20\x31"
+DATA ·d+177968(SB)/8,$"\x3b\x20\x7d\x0a\x20\x20\x26\x2e"
+DATA ·d+177976(SB)/8,$"\x6f\x70\x65\x6e\x20\x7b\x6c\x65"
+DATA ·d+177984(SB)/8,$"\x66\x74\x3a\x20\x24\x6e\x61\x76"
+DATA ·d+177992(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x7d\x0a"
+DATA ·d+178000(SB)/8,$"\x7d\x0a\x0a\x0a\x2f\x2f\x2f\x2f"
+DATA ·d+178008(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178016(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178024(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178032(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178040(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178048(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178056(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178064(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178072(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178080(SB)/8,$"\x2f\x2f\x2f\x2f\x0a\x2f\x2f\x20"
+DATA ·d+178088(SB)/8,$"\x50\x41\x47\x45\x20\x4c\x41\x59"
+DATA ·d+178096(SB)/8,$"\x4f\x55\x54\x20\x41\x4e\x44\x20"
+DATA ·d+178104(SB)/8,$"\x43\x4f\x44\x45\x20\x53\x41\x4d"
+DATA ·d+178112(SB)/8,$"\x50\x4c\x45\x20\x42\x41\x43\x4b"
+DATA ·d+178120(SB)/8,$"\x47\x52\x4f\x55\x4e\x44\x0a\x2f"
+DATA ·d+178128(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178136(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178144(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178152(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178160(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178168(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178176(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178184(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178192(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+178200(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
+DATA ·d+178208(SB)/8,$"\x0a\x2e\x70\x61\x67\x65\x2d\x77"
+DATA ·d+178216(SB)/8,$"\x72\x61\x70\x70\x65\x72\x20\x7b"
+DATA ·d+178224(SB)/8,$"\x0a\x20\x20\x6d\x61\x72\x67\x69"
+DATA ·d+178232(SB)/8,$"\x6e\x2d\x6c\x65\x66\x74\x3a\x20"
+DATA ·d+178240(SB)/8,$"\x24\x6e\x61\x76\x2d\x77\x69\x64"
+DATA ·d+178248(SB)/8,$"\x74\x68\x3b\x0a\x20\x20\x70\x6f"
+DATA ·d+178256(SB)/8,$"\x73\x69\x74\x69\x6f\x6e\x3a\x20"
+DATA ·d+178264(SB)/8,$"\x72\x65\x6c\x61\x74\x69\x76\x65"
+DATA ·d+178272(SB)/8,$"\x3b\x0a\x20\x20\x7a\x2d\x69\x6e"
+DATA ·d+178280(SB)/8,$"\x64\x65\x78\x3a\x20\x31\x30\x3b"
+DATA ·d+178288(SB)/8,$"\x0a\x20\x20\x62\x61\x63\x6b\x67"
+DATA ·d+178296(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
+DATA ·d+178304(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x6d\x61"
+DATA ·d+178312(SB)/8,$"\x69\x6e\x2d\x62\x67\x3b\x0a\x20"
+DATA ·d+178320(SB)/8,$"\x20\x6d\x69\x6e\x2d\x68\x65\x69"
+DATA ·d+178328(SB)/8,$"\x67\x68\x74\x3a\x20\x31\x30\x30"
+DATA ·d+178336(SB)/8,$"\x25\x3b\x0a\x0a\x20\x20\x70\x61"
+DATA ·d+178344(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x62\x6f"
+DATA ·d+178352(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x31\x70"
+DATA ·d+178360(SB)/8,$"\x78\x3b\x20\x2f\x2f\x20\x70\x72"
+DATA ·d+178368(SB)/8,$"\x65\x76\x65\x6e\x74\x20\x6d\x61"
+DATA ·d+178376(SB)/8,$"\x72\x67\x69\x6e\x20\x6f\x76\x65"
+DATA ·d+178384(SB)/8,$"\x72\x66\x6c\x6f\x77\x0a\x0a\x20"
+DATA ·d+178392(SB)/8,$"\x20\x2f\x2f\x20\x54\x68\x65\x20"
+DATA ·d+178400(SB)/8,$"\x64\x61\x72\x6b\x20\x62\x6f\x78"
+DATA ·d+178408(SB)/8,$"\x20\x69\x73\x20\x77\x68\x61\x74"
+DATA ·d+178416(SB)/8,$"\x20\x67\x69\x76\x65\x73\x20\x74"
+DATA ·d+178424(SB)/8,$"\x68\x65\x20\x63\x6f\x64\x65\x20"
+DATA ·d+178432(SB)/8,$"\x73\x61\x6d\x70\x6c\x65\x73\x20"
+DATA ·d+178440(SB)/8,$"\x74\x68\x65\x69\x72\x20\x64\x61"
+DATA ·d+178448(SB)/8,$"\x72\x6b\x20\x62\x61\x63\x6b\x67"
+DATA ·d+178456(SB)/8,$"\x72\x6f\x75\x6e\x64\x2e\x0a\x20"
+DATA ·d+178464(SB)/8,$"\x20\x2f\x2f\x20\x49\x74\x20\x73"
+DATA ·d+178472(SB)/8,$"\x69\x74\x73\x20\x65\x73\x73\x65"
+DATA ·d+178480(SB)/8,$"\x6e\x74\x69\x61\x6c\x6c\x79\x20"
+DATA ·d+178488(SB)/8,$"\x75\x6e\x64\x65\x72\x20\x74\x68"
+DATA ·d+178496(SB)/8,$"\x65\x20\x61\x63\x74\x75\x61\x6c"
+DATA ·d+178504(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
+DATA ·d+178512(SB)/8,$"\x20\x62\x6c\x6f\x63\x6b\x2c\x20"
+DATA ·d+178520(SB)/8,$"\x77\x68\x69\x63\x68\x20\x68\x61"
+DATA ·d+178528(SB)/8,$"\x73\x20\x61\x0a\x20\x20\x2f\x2f"
+DATA ·d+178536(SB)/8,$"\x20\x74\x72\x61\x6e\x73\x70\x61"
+DATA ·d+178544(SB)/8,$"\x72\x65\x6e\x74\x20\x62\x61\x63"
+DATA ·d+178552(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2e"
+DATA ·d+178560(SB)/8,$"\x0a\x20\x20\x2f\x2f\x20\x49\x20"
+DATA ·d+178568(SB)/8,$"\x6b\x6e\x6f\x77\x2c\x20\x69\x74"
+DATA ·d+178576(SB)/8,$"\x27\x73\x20\x68\x61\x63\x6b\x69"
+DATA ·d+178584(SB)/8,$"\x73\x68\x2c\x20\x62\x75\x74\x20"
+DATA ·d+178592(SB)/8,$"\x69\x74\x27\x73\x20\x74\x68\x65"
+DATA ·d+178600(SB)/8,$"\x20\x73\x69\x6d\x70\x6c\x69\x73"
+DATA ·d+178608(SB)/8,$"\x74\x20\x77\x61\x79\x20\x74\x6f"
+DATA ·d+178616(SB)/8,$"\x20\x6d\x61\x6b\x65\x20\x74\x68"
+DATA ·d+178624(SB)/8,$"\x65\x20\x6c\x65\x66\x74\x0a\x20"
+DATA ·d+178632(SB)/8,$"\x20\x2f\x2f\x20\x68\x61\x6c\x66"
+DATA ·d+178640(SB)/8,$"\x20\x6f\x66\x20\x74\x68\x65\x20"
+DATA ·d+178648(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x20"
+DATA ·d+178656(SB)/8,$"\x61\x6c\x77\x61\x79\x73\x20\x74"
+DATA ·d+178664(SB)/8,$"\x68\x69\x73\x20\x62\x61\x63\x6b"
+DATA ·d+178672(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x20\x63"
+DATA ·d+178680(SB)/8,$"\x6f\x6c\x6f\x72\x2e\x0a\x20\x20"
+DATA ·d+178688(SB)/8,$"\x2e\x64\x61\x72\x6b\x2d\x62\x6f"
+DATA ·d+178696(SB)/8,$"\x78\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+178704(SB)/8,$"\x77\x69\x64\x74\x68\x3a\x20\x24"
+DATA ·d+178712(SB)/8,$"\x65\x78\x61\x6d\x70\x6c\x65\x73"
+DATA ·d+178720(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3b\x0a"
+DATA ·d+178728(SB)/8,$"\x20\x20\x20\x20\x62\x61\x63\x6b"
+DATA ·d+178736(SB)/8,$"\x67\x72\x6f\x75\x6e\x64\x2d\x63"
+DATA ·d+178744(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x65"
+DATA ·d+178752(SB)/8,$"\x78\x61\x6d\x70\x6c\x65\x73\x2d"
+DATA ·d+178760(SB)/8,$"\x62\x67\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+178768(SB)/8,$"\x70\x6f\x73\x69\x74\x69\x6f\x6e"
+DATA ·d+178776(SB)/8,$"\x3a\x20\x61\x62\x73\x6f\x6c\x75"
+DATA ·d+178784(SB)/8,$"\x74\x65\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+178792(SB)/8,$"\x72\x69\x67\x68\x74\x3a\x20\x30"
+DATA ·d+178800(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x74\x6f"
+DATA ·d+178808(SB)/8,$"\x70\x3a\x20\x30\x3b\x0a\x20\x20"
+DATA ·d+178816(SB)/8,$"\x20\x20\x62\x6f\x74\x74\x6f\x6d"
+DATA ·d+178824(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x7d"
+DATA ·d+178832(SB)/8,$"\x0a\x0a\x20\x20\x2e\x6c\x61\x6e"
+DATA ·d+178840(SB)/8,$"\x67\x2d\x73\x65\x6c\x65\x63\x74"
+DATA ·d+178848(SB)/8,$"\x6f\x72\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+178856(SB)/8,$"\x20\x70\x6f\x73\x69\x74\x69\x6f"
+DATA ·d+178864(SB)/8,$"\x6e\x3a\x20\x66\x69\x78\x65\x64"
+DATA ·d+178872(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x7a\x2d"
+DATA ·d+178880(SB)/8,$"\x69\x6e\x64\x65\x78\x3a\x20\x35"
+DATA ·d+178888(SB)/8,$"\x30\x3b\x0a\x20\x20\x20\x20\x62"
+DATA ·d+178896(SB)/8,$"\x6f\x72\x64\x65\x72\x2d\x62\x6f"
+DATA ·d+178904(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x35\x70"
+DATA ·d+178912(SB)/8,$"\x78\x20\x73\x6f\x6c\x69\x64\x20"
+DATA ·d+178920(SB)/8,$"\x24\x6c\x61\x6e\x67\x2d\x73\x65"
+DATA ·d+178928(SB)/8,$"\x6c\x65\x63\x74\x2d\x61\x63\x74"
+DATA ·d+178936(SB)/8,$"\x69\x76\x65\x2d\x62\x67\x3b\x0a"
+DATA ·d+178944(SB)/8,$"\x20\x20\x7d\x0a\x7d\x0a\x0a\x2e"
+DATA ·d+178952(SB)/8,$"\x6c\x61\x6e\x67\x2d\x73\x65\x6c"
+DATA ·d+178960(SB)/8,$"\x65\x63\x74\x6f\x72\x20\x7b\x0a"
+DATA ·d+178968(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
+DATA ·d+178976(SB)/8,$"\x6f\x75\x6e\x64\x2d\x63\x6f\x6c"
+DATA ·d+178984(SB)/8,$"\x6f\x72\x3a\x20\x24\x6c\x61\x6e"
+DATA ·d+178992(SB)/8,$"\x67\x2d\x73\x65\x6c\x65\x63\x74"
+DATA ·d+179000(SB)/8,$"\x2d\x62\x67\x3b\x0a\x20\x20\x77"
+DATA ·d+179008(SB)/8,$"\x69\x64\x74\x68\x3a\x20\x31\x30"
+DATA ·d+179016(SB)/8,$"\x30\x25\x3b\x0a\x20\x20\x66\x6f"
+DATA ·d+179024(SB)/8,$"\x6e\x74\x2d\x77\x65\x69\x67\x68"
+DATA ·d+179032(SB)/8,$"\x74\x3a\x20\x62\x6f\x6c\x64\x3b"
+DATA ·d+179040(SB)/8,$"\x0a\x20\x20\x61\x20\x7b\x0a\x20"
+DATA ·d+179048(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
+DATA ·d+179056(SB)/8,$"\x61\x79\x3a\x20\x62\x6c\x6f\x63"
+DATA ·d+179064(SB)/8,$"\x6b\x3b\x0a\x20\x20\x20\x20\x66"
+DATA ·d+179072(SB)/8,$"\x6c\x6f\x61\x74\x3a\x6c\x65\x66"
+DATA ·d+179080(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x63"
+DATA ·d+179088(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x24\x6c"
+DATA ·d+179096(SB)/8,$"\x61\x6e\x67\x2d\x73\x65\x6c\x65"
+DATA ·d+179104(SB)/8,$"\x63\x74\x2d\x74\x65\x78\x74\x3b"
+DATA ·d+179112(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x65\x78"
+DATA ·d+179120(SB)/8,$"\x74\x2d\x64\x65\x63\x6f\x72\x61"
+DATA ·d+179128(SB)/8,$"\x74\x69\x6f\x6e\x3a\x20\x6e\x6f"
+DATA ·d+179136(SB)/8,$"\x6e\x65\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+179144(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
+DATA ·d+179152(SB)/8,$"\x20\x30\x20\x31\x30\x70\x78\x3b"
+DATA ·d+179160(SB)/8,$"\x0a\x20\x20\x20\x20\x6c\x69\x6e"
+DATA ·d+179168(SB)/8,$"\x65\x2d\x68\x65\x69\x67\x68\x74"
+DATA ·d+179176(SB)/8,$"\x3a\x20\x33\x30\x70\x78\x3b\x0a"
+DATA ·d+179184(SB)/8,$"\x20\x20\x20\x20\x6f\x75\x74\x6c"
+DATA ·d+179192(SB)/8,$"\x69\x6e\x65\x3a\x20\x30\x3b\x0a"
+DATA ·d+179200(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x3a\x61"
+DATA ·d+179208(SB)/8,$"\x63\x74\x69\x76\x65\x2c\x20\x26"
+DATA ·d+179216(SB)/8,$"\x3a\x66\x6f\x63\x75\x73\x20\x7b"
+DATA ·d+179224(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x62"
+DATA ·d+179232(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
+DATA ·d+179240(SB)/8,$"\x64\x2d\x63\x6f\x6c\x6f\x72\x3a"
+DATA ·d+179248(SB)/8,$"\x20\x24\x6c\x61\x6e\x67\x2d\x73"
+DATA ·d+179256(SB)/8,$"\x65\x6c\x65\x63\x74\x2d\x70\x72"
+DATA ·d+179264(SB)/8,$"\x65\x73\x73\x65\x64\x2d\x62\x67"
+DATA ·d+179272(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+179280(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
+DATA ·d+179288(SB)/8,$"\x6c\x61\x6e\x67\x2d\x73\x65\x6c"
+DATA ·d+179296(SB)/8,$"\x65\x63\x74\x2d\x70\x72\x65\x73"
+DATA ·d+179304(SB)/8,$"\x73\x65\x64\x2d\x74\x65\x78\x74"
+DATA ·d+179312(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x7d\x0a"
+DATA ·d+179320(SB)/8,$"\x0a\x20\x20\x20\x20\x26\x2e\x61"
+DATA ·d+179328(SB)/8,$"\x63\x74\x69\x76\x65\x20\x7b\x0a"
+DATA ·d+179336(SB)/8,$"\x20\x20\x20\x20\x20\x20\x62\x61"
+DATA ·d+179344(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
+DATA ·d+179352(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+179360(SB)/8,$"\x24\x6c\x61\x6e\x67\x2d\x73\x65"
+DATA ·d+179368(SB)/8,$"\x6c\x65\x63\x74\x2d\x61\x63\x74"
+DATA ·d+179376(SB)/8,$"\x69\x76\x65\x2d\x62\x67\x3b\x0a"
+DATA ·d+179384(SB)/8,$"\x20\x20\x20\x20\x20\x20\x63\x6f"
+DATA ·d+179392(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x6c\x61"
+DATA ·d+179400(SB)/8,$"\x6e\x67\x2d\x73\x65\x6c\x65\x63"
+DATA ·d+179408(SB)/8,$"\x74\x2d\x61\x63\x74\x69\x76\x65"
+DATA ·d+179416(SB)/8,$"\x2d\x74\x65\x78\x74\x3b\x0a\x20"
+DATA ·d+179424(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x7d"
+DATA ·d+179432(SB)/8,$"\x0a\x0a\x20\x20\x26\x3a\x61\x66"
+DATA ·d+179440(SB)/8,$"\x74\x65\x72\x20\x7b\x0a\x20\x20"
+DATA ·d+179448(SB)/8,$"\x20\x20\x63\x6f\x6e\x74\x65\x6e"
+DATA ·d+179456(SB)/8,$"\x74\x3a\x20\x27\x27\x3b\x0a\x20"
+DATA ·d+179464(SB)/8,$"\x20\x20\x20\x63\x6c\x65\x61\x72"
+DATA ·d+179472(SB)/8,$"\x3a\x20\x62\x6f\x74\x68\x3b\x0a"
+DATA ·d+179480(SB)/8,$"\x20\x20\x20\x20\x64\x69\x73\x70"
+DATA ·d+179488(SB)/8,$"\x6c\x61\x79\x3a\x20\x62\x6c\x6f"
+DATA ·d+179496(SB)/8,$"\x63\x6b\x3b\x0a\x20\x20\x7d\x0a"
+DATA ·d+179504(SB)/8,$"\x7d\x0a\x0a\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179536(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179544(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179552(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179560(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179568(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179576(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179584(SB)/8,$"\x2f\x2f\x2f\x0a\x2f\x2f\x20\x43"
+DATA ·d+179592(SB)/8,$"\x4f\x4e\x54\x45\x4e\x54\x20\x53"
+DATA ·d+179600(SB)/8,$"\x54\x59\x4c\x45\x53\x0a\x2f\x2f"
+DATA ·d+179608(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179616(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179624(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179632(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179664(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179672(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+179680(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x2f"
+DATA ·d+179688(SB)/8,$"\x2f\x20\x54\x68\x69\x73\x20\x69"
+DATA ·d+179696(SB)/8,$"\x73\x20\x61\x6c\x6c\x20\x74\x68"
+DATA ·d+179704(SB)/8,$"\x65\x20\x73\x74\x75\x66\x66\x20"
+DATA ·d+179712(SB)/8,$"\x77\x69\x74\x68\x20\x74\x68\x65"
+DATA ·d+179720(SB)/8,$"\x20\x6c\x69\x67\x68\x74\x20\x62"
+DATA ·d+179728(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
+DATA ·d+179736(SB)/8,$"\x64\x20\x69\x6e\x20\x74\x68\x65"
+DATA ·d+179744(SB)/8,$"\x20\x6c\x65\x66\x74\x20\x68\x61"
+DATA ·d+179752(SB)/8,$"\x6c\x66\x20\x6f\x66\x20\x74\x68"
+DATA ·d+179760(SB)/8,$"\x65\x20\x70\x61\x67\x65\x0a\x0a"
+DATA ·d+179768(SB)/8,$"\x2e\x63\x6f\x6e\x74\x65\x6e\x74"
+DATA ·d+179776(SB)/8,$"\x20\x7b\x0a\x20\x20\x2f\x2f\x20"
+DATA ·d+179784(SB)/8,$"\x66\x69\x78\x65\x73\x20\x77\x65"
+DATA ·d+179792(SB)/8,$"\x62\x6b\x69\x74\x20\x72\x65\x6e"
+DATA ·d+179800(SB)/8,$"\x64\x65\x72\x69\x6e\x67\x20\x62"
+DATA ·d+179808(SB)/8,$"\x75\x67\x20\x66\x6f\x72\x20\x73"
+DATA ·d+179816(SB)/8,$"\x6f\x6d\x65\x3a\x20\x73\x65\x65"
+DATA ·d+179824(SB)/8,$"\x20\x23\x35\x33\x38\x0a\x20\x20"
+DATA ·d+179832(SB)/8,$"\x2d\x77\x65\x62\x6b\x69\x74\x2d"
+DATA ·d+179840(SB)/8,$"\x74\x72\x61\x6e\x73\x66\x6f\x72"
+DATA ·d+179848(SB)/8,$"\x6d\x3a\x20\x74\x72\x61\x6e\x73"
+DATA ·d+179856(SB)/8,$"\x6c\x61\x74\x65\x5a\x28\x30\x29"
+DATA ·d+179864(SB)/8,$"\x3b\x0a\x20\x20\x2f\x2f\x20\x74"
+DATA ·d+179872(SB)/8,$"\x6f\x20\x70\x6c\x61\x63\x65\x20"
+DATA ·d+179880(SB)/8,$"\x63\x6f\x6e\x74\x65\x6e\x74\x20"
+DATA ·d+179888(SB)/8,$"\x61\x62\x6f\x76\x65\x20\x74\x68"
+DATA ·d+179896(SB)/8,$"\x65\x20\x64\x61\x72\x6b\x20\x62"
+DATA ·d+179904(SB)/8,$"\x6f\x78\x0a\x20\x20\x70\x6f\x73"
+DATA ·d+179912(SB)/8,$"\x69\x74\x69\x6f\x6e\x3a\x20\x72"
+DATA ·d+179920(SB)/8,$"\x65\x6c\x61\x74\x69\x76\x65\x3b"
+DATA ·d+179928(SB)/8,$"\x0a\x20\x20\x7a\x2d\x69\x6e\x64"
+DATA ·d+179936(SB)/8,$"\x65\x78\x3a\x20\x33\x30\x3b\x0a"
+DATA ·d+179944(SB)/8,$"\x0a\x20\x20\x26\x3a\x61\x66\x74"
+DATA ·d+179952(SB)/8,$"\x65\x72\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+179960(SB)/8,$"\x20\x63\x6f\x6e\x74\x65\x6e\x74"
+DATA ·d+179968(SB)/8,$"\x3a\x20\x27\x27\x3b\x0a\x20\x20"
+DATA ·d+179976(SB)/8,$"\x20\x20\x64\x69\x73\x70\x6c\x61"
+DATA ·d+179984(SB)/8,$"\x79\x3a\x20\x62\x6c\x6f\x63\x6b"
+DATA ·d+179992(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x63\x6c"
+DATA ·d+180000(SB)/8,$"\x65\x61\x72\x3a\x20\x62\x6f\x74"
+DATA ·d+180008(SB)/8,$"\x68\x3b\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+180016(SB)/8,$"\x20\x20\x26\x3e\x68\x31\x2c\x20"
+DATA ·d+180024(SB)/8,$"\x26\x3e\x68\x32\x2c\x20\x26\x3e"
+DATA ·d+180032(SB)/8,$"\x68\x33\x2c\x20\x26\x3e\x68\x34"
+DATA ·d+180040(SB)/8,$"\x2c\x20\x26\x3e\x68\x35\x2c\x20"
+DATA ·d+180048(SB)/8,$"\x26\x3e\x68\x36\x2c\x20\x26\x3e"
+DATA ·d+180056(SB)/8,$"\x70\x2c\x20\x26\x3e\x74\x61\x62"
+DATA ·d+180064(SB)/8,$"\x6c\x65\x2c\x20\x26\x3e\x75\x6c"
+DATA ·d+180072(SB)/8,$"\x2c\x20\x26\x3e\x6f\x6c\x2c\x20"
+DATA ·d+180080(SB)/8,$"\x26\x3e\x61\x73\x69\x64\x65\x2c"
+DATA ·d+180088(SB)/8,$"\x20\x26\x3e\x64\x6c\x20\x7b\x0a"
+DATA ·d+180096(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
+DATA ·d+180104(SB)/8,$"\x69\x6e\x2d\x72\x69\x67\x68\x74"
+DATA ·d+180112(SB)/8,$"\x3a\x20\x24\x65\x78\x61\x6d\x70"
+DATA ·d+180120(SB)/8,$"\x6c\x65\x73\x2d\x77\x69\x64\x74"
+DATA ·d+180128(SB)/8,$"\x68\x3b\x0a\x20\x20\x20\x20\x70"
+DATA ·d+180136(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x3a\x20"
+DATA ·d+180144(SB)/8,$"\x30\x20\x24\x6d\x61\x69\x6e\x2d"
+DATA ·d+180152(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3b"
+DATA ·d+180160(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x78"
+DATA ·d+180168(SB)/8,$"\x2d\x73\x69\x7a\x69\x6e\x67\x3a"
+DATA ·d+180176(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+180184(SB)/8,$"\x62\x6f\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+180192(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
+DATA ·d+180200(SB)/8,$"\x3a\x20\x62\x6c\x6f\x63\x6b\x3b"
+DATA ·d+180208(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x40\x65"
+DATA ·d+180216(SB)/8,$"\x78\x74\x65\x6e\x64\x20\x25\x6c"
+DATA ·d+180224(SB)/8,$"\x65\x66\x74\x2d\x63\x6f\x6c\x3b"
+DATA ·d+180232(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+180240(SB)/8,$"\x26\x3e\x75\x6c\x2c\x20\x26\x3e"
+DATA ·d+180248(SB)/8,$"\x6f\x6c\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+180256(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+180264(SB)/8,$"\x2d\x6c\x65\x66\x74\x3a\x20\x24"
+DATA ·d+180272(SB)/8,$"\x6d\x61\x69\x6e\x2d\x70\x61\x64"
+DATA ·d+180280(SB)/8,$"\x64\x69\x6e\x67\x20\x2b\x20\x31"
+DATA ·d+180288(SB)/8,$"\x35\x70\x78\x3b\x0a\x20\x20\x7d"
+DATA ·d+180296(SB)/8,$"\x0a\x0a\x20\x20\x2f\x2f\x20\x74"
+DATA ·d+180304(SB)/8,$"\x68\x65\x20\x64\x69\x76\x20\x69"
+DATA ·d+180312(SB)/8,$"\x73\x20\x74\x68\x65\x20\x74\x6f"
+DATA ·d+180320(SB)/8,$"\x63\x69\x66\x79\x20\x68\x69\x64"
+DATA ·d+180328(SB)/8,$"\x64\x65\x6e\x20\x64\x69\x76\x20"
+DATA ·d+180336(SB)/8,$"\x66\x6f\x72\x20\x70\x6c\x61\x63"
+DATA ·d+180344(SB)/8,$"\x65\x68\x6f\x6c\x64\x69\x6e\x67"
+DATA ·d+180352(SB)/8,$"\x20\x73\x74\x75\x66\x66\x0a\x20"
+DATA ·d+180360(SB)/8,$"\x20\x26\x3e\x68\x31\x2c\x20\x26"
+DATA ·d+180368(SB)/8,$"\x3e\x68\x32\x2c\x20\x26\x3e\x64"
+DATA ·d+180376(SB)/8,$"\x69\x76\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+180384(SB)/8,$"\x20\x63\x6c\x65\x61\x72\x3a\x62"
+DATA ·d+180392(SB)/8,$"\x6f\x74\x68\x3b\x0a\x20\x20\x7d"
+DATA ·d+180400(SB)/8,$"\x0a\x0a\x20\x20\x68\x31\x20\x7b"
+DATA ·d+180408(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
+DATA ·d+180416(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x68\x65"
+DATA ·d+180424(SB)/8,$"\x61\x64\x65\x72\x2d\x66\x6f\x6e"
+DATA ·d+180432(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x66"
+DATA ·d+180440(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
+DATA ·d+180448(SB)/8,$"\x3a\x20\x32\x35\x70\x78\x3b\x0a"
+DATA ·d+180456(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+180464(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
+DATA ·d+180472(SB)/8,$"\x20\x30\x2e\x35\x65\x6d\x3b\x0a"
+DATA ·d+180480(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+180488(SB)/8,$"\x69\x6e\x67\x2d\x62\x6f\x74\x74"
+DATA ·d+180496(SB)/8,$"\x6f\x6d\x3a\x20\x30\x2e\x35\x65"
+DATA ·d+180504(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x6d"
+DATA ·d+180512(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x62\x6f"
+DATA ·d+180520(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x24\x68"
+DATA ·d+180528(SB)/8,$"\x31\x2d\x6d\x61\x72\x67\x69\x6e"
+DATA ·d+180536(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3b"
+DATA ·d+180544(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+180552(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
+DATA ·d+180560(SB)/8,$"\x20\x32\x65\x6d\x3b\x0a\x20\x20"
 DATA ·d+180568(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
-DATA ·d+180576(SB)/8,$"\x2d\x72\x61\x64\x69\x75\x73\x3a"
-DATA ·d+180584(SB)/8,$"\x20\x33\x70\x78\x3b\x0a\x20\x20"
-DATA ·d+180592(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
-DATA ·d+180600(SB)/8,$"\x64\x20\x25\x62\x72\x65\x61\x6b"
-DATA ·d+180608(SB)/8,$"\x2d\x77\x6f\x72\x64\x73\x3b\x0a"
-DATA ·d+180616(SB)/8,$"\x20\x20\x20\x20\x40\x65\x78\x74"
-DATA ·d+180624(SB)/8,$"\x65\x6e\x64\x20\x25\x63\x6f\x64"
-DATA ·d+180632(SB)/8,$"\x65\x2d\x66\x6f\x6e\x74\x3b\x0a"
-DATA ·d+180640(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x70"
-DATA ·d+180648(SB)/8,$"\x72\x65\x3e\x63\x6f\x64\x65\x20"
-DATA ·d+180656(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x61"
-DATA ·d+180664(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
-DATA ·d+180672(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
-DATA ·d+180680(SB)/8,$"\x74\x72\x61\x6e\x73\x70\x61\x72"
-DATA ·d+180688(SB)/8,$"\x65\x6e\x74\x3b\x0a\x20\x20\x20"
-DATA ·d+180696(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+180704(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x7d"
-DATA ·d+180712(SB)/8,$"\x0a\x0a\x20\x20\x61\x73\x69\x64"
-DATA ·d+180720(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+180728(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x2d"
-DATA ·d+180736(SB)/8,$"\x74\x6f\x70\x3a\x20\x31\x65\x6d"
-DATA ·d+180744(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x70\x61"
-DATA ·d+180752(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x62\x6f"
-DATA ·d+180760(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x31\x65"
-DATA ·d+180768(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x6d"
-DATA ·d+180776(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x74\x6f"
-DATA ·d+180784(SB)/8,$"\x70\x3a\x20\x31\x2e\x35\x65\x6d"
-DATA ·d+180792(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6d\x61"
-DATA ·d+180800(SB)/8,$"\x72\x67\x69\x6e\x2d\x62\x6f\x74"
-DATA ·d+180808(SB)/8,$"\x74\x6f\x6d\x3a\x20\x31\x2e\x35"
-DATA ·d+180816(SB)/8,$"\x65\x6d\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+180824(SB)/8,$"\x62\x61\x63\x6b\x67\x72\x6f\x75"
-DATA ·d+180832(SB)/8,$"\x6e\x64\x3a\x20\x24\x61\x73\x69"
-DATA ·d+180840(SB)/8,$"\x64\x65\x2d\x6e\x6f\x74\x69\x63"
-DATA ·d+180848(SB)/8,$"\x65\x2d\x62\x67\x3b\x0a\x20\x20"
-DATA ·d+180856(SB)/8,$"\x20\x20\x6c\x69\x6e\x65\x2d\x68"
-DATA ·d+180864(SB)/8,$"\x65\x69\x67\x68\x74\x3a\x20\x31"
-DATA ·d+180872(SB)/8,$"\x2e\x36\x3b\x0a\x0a\x20\x20\x20"
-DATA ·d+180880(SB)/8,$"\x20\x26\x2e\x77\x61\x72\x6e\x69"
-DATA ·d+180888(SB)/8,$"\x6e\x67\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+180896(SB)/8,$"\x20\x20\x20\x62\x61\x63\x6b\x67"
-DATA ·d+180904(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
-DATA ·d+180912(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x61\x73"
-DATA ·d+180920(SB)/8,$"\x69\x64\x65\x2d\x77\x61\x72\x6e"
-DATA ·d+180928(SB)/8,$"\x69\x6e\x67\x2d\x62\x67\x3b\x0a"
-DATA ·d+180936(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x0a\x20"
-DATA ·d+180944(SB)/8,$"\x20\x20\x20\x26\x2e\x73\x75\x63"
-DATA ·d+180952(SB)/8,$"\x63\x65\x73\x73\x20\x7b\x0a\x20"
-DATA ·d+180960(SB)/8,$"\x20\x20\x20\x20\x20\x62\x61\x63"
-DATA ·d+180968(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2d"
-DATA ·d+180976(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
-DATA ·d+180984(SB)/8,$"\x61\x73\x69\x64\x65\x2d\x73\x75"
-DATA ·d+180992(SB)/8,$"\x63\x63\x65\x73\x73\x2d\x62\x67"
-DATA ·d+181000(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x7d\x0a"
-DATA ·d+181008(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x61"
-DATA ·d+181016(SB)/8,$"\x73\x69\x64\x65\x3a\x62\x65\x66"
-DATA ·d+181024(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
-DATA ·d+181032(SB)/8,$"\x20\x20\x76\x65\x72\x74\x69\x63"
-DATA ·d+181040(SB)/8,$"\x61\x6c\x2d\x61\x6c\x69\x67\x6e"
-DATA ·d+181048(SB)/8,$"\x3a\x20\x6d\x69\x64\x64\x6c\x65"
-DATA ·d+181056(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x70\x61"
-DATA ·d+181064(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x72\x69"
-DATA ·d+181072(SB)/8,$"\x67\x68\x74\x3a\x20\x30\x2e\x35"
-DATA ·d+181080(SB)/8,$"\x65\x6d\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+181088(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
-DATA ·d+181096(SB)/8,$"\x65\x3a\x20\x31\x34\x70\x78\x3b"
-DATA ·d+181104(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+181112(SB)/8,$"\x61\x73\x69\x64\x65\x2e\x6e\x6f"
-DATA ·d+181120(SB)/8,$"\x74\x69\x63\x65\x3a\x62\x65\x66"
-DATA ·d+181128(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
-DATA ·d+181136(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
-DATA ·d+181144(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x2d"
-DATA ·d+181152(SB)/8,$"\x69\x6e\x66\x6f\x2d\x73\x69\x67"
-DATA ·d+181160(SB)/8,$"\x6e\x3b\x0a\x20\x20\x7d\x0a\x0a"
-DATA ·d+181168(SB)/8,$"\x20\x20\x61\x73\x69\x64\x65\x2e"
-DATA ·d+181176(SB)/8,$"\x77\x61\x72\x6e\x69\x6e\x67\x3a"
-DATA ·d+181184(SB)/8,$"\x62\x65\x66\x6f\x72\x65\x20\x7b"
-DATA ·d+181192(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
-DATA ·d+181200(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x69\x63"
-DATA ·d+181208(SB)/8,$"\x6f\x6e\x2d\x65\x78\x63\x6c\x61"
-DATA ·d+181216(SB)/8,$"\x6d\x61\x74\x69\x6f\x6e\x2d\x73"
-DATA ·d+181224(SB)/8,$"\x69\x67\x6e\x3b\x0a\x20\x20\x7d"
-DATA ·d+181232(SB)/8,$"\x0a\x0a\x20\x20\x61\x73\x69\x64"
-DATA ·d+181240(SB)/8,$"\x65\x2e\x73\x75\x63\x63\x65\x73"
-DATA ·d+181248(SB)/8,$"\x73\x3a\x62\x65\x66\x6f\x72\x65"
-DATA ·d+181256(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x40"
-DATA ·d+181264(SB)/8,$"\x65\x78\x74\x65\x6e\x64\x20\x25"
-DATA ·d+181272(SB)/8,$"\x69\x63\x6f\x6e\x2d\x6f\x6b\x2d"
-DATA ·d+181280(SB)/8,$"\x73\x69\x67\x6e\x3b\x0a\x20\x20"
-DATA ·d+181288(SB)/8,$"\x7d\x0a\x0a\x20\x20\x2e\x73\x65"
-DATA ·d+181296(SB)/8,$"\x61\x72\x63\x68\x2d\x68\x69\x67"
-DATA ·d+181304(SB)/8,$"\x68\x6c\x69\x67\x68\x74\x20\x7b"
-DATA ·d+181312(SB)/8,$"\x0a\x20\x20\x20\x20\x70\x61\x64"
-DATA ·d+181320(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x32\x70"
-DATA ·d+181328(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x6d"
-DATA ·d+181336(SB)/8,$"\x61\x72\x67\x69\x6e\x3a\x20\x2d"
-DATA ·d+181344(SB)/8,$"\x33\x70\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+181352(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
-DATA ·d+181360(SB)/8,$"\x72\x61\x64\x69\x75\x73\x3a\x20"
-DATA ·d+181368(SB)/8,$"\x34\x70\x78\x3b\x0a\x20\x20\x20"
-DATA ·d+181376(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x3a"
-DATA ·d+181384(SB)/8,$"\x20\x31\x70\x78\x20\x73\x6f\x6c"
-DATA ·d+181392(SB)/8,$"\x69\x64\x20\x23\x46\x37\x45\x36"
-DATA ·d+181400(SB)/8,$"\x33\x33\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+181408(SB)/8,$"\x62\x61\x63\x6b\x67\x72\x6f\x75"
-DATA ·d+181416(SB)/8,$"\x6e\x64\x3a\x20\x6c\x69\x6e\x65"
-DATA ·d+181424(SB)/8,$"\x61\x72\x2d\x67\x72\x61\x64\x69"
-DATA ·d+181432(SB)/8,$"\x65\x6e\x74\x28\x74\x6f\x20\x74"
-DATA ·d+181440(SB)/8,$"\x6f\x70\x20\x6c\x65\x66\x74\x2c"
-DATA ·d+181448(SB)/8,$"\x20\x23\x46\x37\x45\x36\x33\x33"
-DATA ·d+181456(SB)/8,$"\x20\x30\x25\x2c\x20\x23\x46\x31"
-DATA ·d+181464(SB)/8,$"\x44\x33\x32\x46\x20\x31\x30\x30"
-DATA ·d+181472(SB)/8,$"\x25\x29\x3b\x0a\x20\x20\x7d\x0a"
-DATA ·d+181480(SB)/8,$"\x7d\x0a\x0a\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181488(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181504(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181536(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181544(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181552(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181560(SB)/8,$"\x2f\x2f\x2f\x0a\x2f\x2f\x20\x43"
-DATA ·d+181568(SB)/8,$"\x4f\x44\x45\x20\x53\x41\x4d\x50"
-DATA ·d+181576(SB)/8,$"\x4c\x45\x20\x53\x54\x59\x4c\x45"
-DATA ·d+181584(SB)/8,$"\x53\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181592(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181600(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181608(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181616(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181624(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181632(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+181664(SB)/8,$"\x2f\x2f\x0a\x2f\x2f\x20\x54\x68"
-DATA ·d+181672(SB)/8,$"\x69\x73\x20\x69\x73\x20\x61\x6c"
-DATA ·d+181680(SB)/8,$"\x6c\x20\x74\x68\x65\x20\x73\x74"
-DATA ·d+181688(SB)/8,$"\x75\x66\x66\x20\x74\x68\x61\x74"
-DATA ·d+181696(SB)/8,$"\x20\x61\x70\x70\x65\x61\x72\x73"
-DATA ·d+181704(SB)/8,$"\x20\x69\x6e\x20\x74\x68\x65\x20"
-DATA ·d+181712(SB)/8,$"\x72\x69\x67\x68\x74\x20\x68\x61"
-DATA ·d+181720(SB)/8,$"\x6c\x66\x20\x6f\x66\x20\x74\x68"
-DATA ·d+181728(SB)/8,$"\x65\x20\x70\x61\x67\x65\x0a\x0a"
-DATA ·d+181736(SB)/8,$"\x2e\x63\x6f\x6e\x74\x65\x6e\x74"
-DATA ·d+181744(SB)/8,$"\x20\x7b\x0a\x20\x20\x70\x72\x65"
-DATA ·d+181752(SB)/8,$"\x2c\x20\x62\x6c\x6f\x63\x6b\x71"
-DATA ·d+181760(SB)/8,$"\x75\x6f\x74\x65\x20\x7b\x0a\x20"
-DATA ·d+181768(SB)/8,$"\x20\x20\x20\x62\x61\x63\x6b\x67"
-DATA ·d+181776(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
-DATA ·d+181784(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x63\x6f"
-DATA ·d+181792(SB)/8,$"\x64\x65\x2d\x62\x67\x3b\x0a\x20"
-DATA ·d+181800(SB)/8,$"\x20\x20\x20\x63\x6f\x6c\x6f\x72"
-DATA ·d+181808(SB)/8,$"\x3a\x20\x23\x66\x66\x66\x3b\x0a"
-DATA ·d+181816(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+181824(SB)/8,$"\x67\x69\x6e\x3a\x20\x30\x3b\x0a"
-DATA ·d+181832(SB)/8,$"\x20\x20\x20\x20\x77\x69\x64\x74"
-DATA ·d+181840(SB)/8,$"\x68\x3a\x20\x24\x65\x78\x61\x6d"
-DATA ·d+181848(SB)/8,$"\x70\x6c\x65\x73\x2d\x77\x69\x64"
-DATA ·d+181856(SB)/8,$"\x74\x68\x3b\x0a\x0a\x20\x20\x20"
-DATA ·d+181864(SB)/8,$"\x20\x66\x6c\x6f\x61\x74\x3a\x72"
-DATA ·d+181872(SB)/8,$"\x69\x67\x68\x74\x3b\x0a\x20\x20"
-DATA ·d+181880(SB)/8,$"\x20\x20\x63\x6c\x65\x61\x72\x3a"
-DATA ·d+181888(SB)/8,$"\x72\x69\x67\x68\x74\x3b\x0a\x0a"
-DATA ·d+181896(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x78\x2d"
-DATA ·d+181904(SB)/8,$"\x73\x69\x7a\x69\x6e\x67\x3a\x20"
-DATA ·d+181912(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x2d\x62"
-DATA ·d+181920(SB)/8,$"\x6f\x78\x3b\x0a\x0a\x20\x20\x20"
-DATA ·d+181928(SB)/8,$"\x20\x40\x65\x78\x74\x65\x6e\x64"
-DATA ·d+181936(SB)/8,$"\x20\x25\x72\x69\x67\x68\x74\x2d"
-DATA ·d+181944(SB)/8,$"\x63\x6f\x6c\x3b\x0a\x0a\x20\x20"
-DATA ·d+181952(SB)/8,$"\x20\x20\x26\x3e\x70\x20\x7b\x20"
-DATA ·d+181960(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x3a\x20"
-DATA ·d+181968(SB)/8,$"\x30\x3b\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+181976(SB)/8,$"\x20\x20\x61\x20\x7b\x0a\x20\x20"
-DATA ·d+181984(SB)/8,$"\x20\x20\x20\x20\x63\x6f\x6c\x6f"
-DATA ·d+181992(SB)/8,$"\x72\x3a\x20\x23\x66\x66\x66\x3b"
-DATA ·d+182000(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x74"
-DATA ·d+182008(SB)/8,$"\x65\x78\x74\x2d\x64\x65\x63\x6f"
-DATA ·d+182016(SB)/8,$"\x72\x61\x74\x69\x6f\x6e\x3a\x20"
-DATA ·d+182024(SB)/8,$"\x6e\x6f\x6e\x65\x3b\x0a\x20\x20"
-DATA ·d+182032(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x72\x64"
-DATA ·d+182040(SB)/8,$"\x65\x72\x2d\x62\x6f\x74\x74\x6f"
-DATA ·d+182048(SB)/8,$"\x6d\x3a\x20\x64\x61\x73\x68\x65"
-DATA ·d+182056(SB)/8,$"\x64\x20\x31\x70\x78\x20\x23\x63"
-DATA ·d+182064(SB)/8,$"\x63\x63\x3b\x0a\x20\x20\x20\x20"
-DATA ·d+182072(SB)/8,$"\x7d\x0a\x20\x20\x7d\x0a\x0a\x20"
-DATA ·d+182080(SB)/8,$"\x20\x70\x72\x65\x20\x7b\x0a\x20"
-DATA ·d+182088(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
-DATA ·d+182096(SB)/8,$"\x6e\x64\x20\x25\x63\x6f\x64\x65"
-DATA ·d+182104(SB)/8,$"\x2d\x66\x6f\x6e\x74\x3b\x0a\x20"
-DATA ·d+182112(SB)/8,$"\x20\x20\x20\x70\x61\x64\x64\x69"
-DATA ·d+182120(SB)/8,$"\x6e\x67\x2d\x74\x6f\x70\x3a\x20"
-DATA ·d+182128(SB)/8,$"\x32\x65\x6d\x3b\x0a\x20\x20\x20"
-DATA ·d+182136(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+182144(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
-DATA ·d+182152(SB)/8,$"\x20\x32\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+182160(SB)/8,$"\x20\x20\x70\x61\x64\x64\x69\x6e"
-DATA ·d+182168(SB)/8,$"\x67\x3a\x20\x32\x65\x6d\x20\x24"
-DATA ·d+182176(SB)/8,$"\x6d\x61\x69\x6e\x2d\x70\x61\x64"
-DATA ·d+182184(SB)/8,$"\x64\x69\x6e\x67\x3b\x0a\x20\x20"
-DATA ·d+182192(SB)/8,$"\x7d\x0a\x0a\x20\x20\x62\x6c\x6f"
-DATA ·d+182200(SB)/8,$"\x63\x6b\x71\x75\x6f\x74\x65\x20"
-DATA ·d+182208(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x26\x3e"
-DATA ·d+182216(SB)/8,$"\x70\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+182224(SB)/8,$"\x20\x20\x62\x61\x63\x6b\x67\x72"
-DATA ·d+182232(SB)/8,$"\x6f\x75\x6e\x64\x2d\x63\x6f\x6c"
-DATA ·d+182240(SB)/8,$"\x6f\x72\x3a\x20\x24\x63\x6f\x64"
-DATA ·d+182248(SB)/8,$"\x65\x2d\x61\x6e\x6e\x6f\x74\x61"
-DATA ·d+182256(SB)/8,$"\x74\x69\x6f\x6e\x2d\x62\x67\x3b"
-DATA ·d+182264(SB)/8,$"\x0a\x20\x20\x20\x20\x20\x20\x70"
-DATA ·d+182272(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x3a\x20"
-DATA ·d+182280(SB)/8,$"\x24\x63\x6f\x64\x65\x2d\x61\x6e"
-DATA ·d+182288(SB)/8,$"\x6e\x6f\x74\x61\x74\x69\x6f\x6e"
-DATA ·d+182296(SB)/8,$"\x2d\x70\x61\x64\x64\x69\x6e\x67"
-DATA ·d+182304(SB)/8,$"\x20\x32\x65\x6d\x3b\x0a\x20\x20"
-DATA ·d+182312(SB)/8,$"\x20\x20\x20\x20\x63\x6f\x6c\x6f"
-DATA ·d+182320(SB)/8,$"\x72\x3a\x20\x23\x65\x65\x65\x3b"
-DATA ·d+182328(SB)/8,$"\x0a\x20\x20\x20\x20\x7d\x0a\x20"
-DATA ·d+182336(SB)/8,$"\x20\x7d\x0a\x7d\x0a\x0a\x2f\x2f"
-DATA ·d+182344(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182352(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182360(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182368(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182376(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182384(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182392(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182400(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182408(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182416(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x2f"
-DATA ·d+182424(SB)/8,$"\x2f\x20\x52\x45\x53\x50\x4f\x4e"
-DATA ·d+182432(SB)/8,$"\x53\x49\x56\x45\x20\x44\x45\x53"
-DATA ·d+182440(SB)/8,$"\x49\x47\x4e\x0a\x2f\x2f\x2f\x2f"
-DATA ·d+182448(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182456(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182464(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182472(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182480(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182488(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182504(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
-DATA ·d+182520(SB)/8,$"\x2f\x2f\x2f\x2f\x0a\x2f\x2f\x20"
-DATA ·d+182528(SB)/8,$"\x54\x68\x65\x73\x65\x20\x61\x72"
-DATA ·d+182536(SB)/8,$"\x65\x20\x74\x68\x65\x20\x73\x74"
-DATA ·d+182544(SB)/8,$"\x79\x6c\x65\x73\x20\x66\x6f\x72"
-DATA ·d+182552(SB)/8,$"\x20\x70\x68\x6f\x6e\x65\x73\x20"
-DATA ·d+182560(SB)/8,$"\x61\x6e\x64\x20\x74\x61\x62\x6c"
-DATA ·d+182568(SB)/8,$"\x65\x74\x73\x0a\x2f\x2f\x20\x54"
-DATA ·d+182576(SB)/8,$"\x68\x65\x72\x65\x20\x61\x72\x65"
-DATA ·d+182584(SB)/8,$"\x20\x61\x6c\x73\x6f\x20\x61\x20"
-DATA ·d+182592(SB)/8,$"\x63\x6f\x75\x70\x6c\x65\x20\x73"
-DATA ·d+182600(SB)/8,$"\x74\x79\x6c\x65\x73\x20\x64\x69"
-DATA ·d+182608(SB)/8,$"\x73\x70\x65\x72\x65\x73\x65\x64"
-DATA ·d+182616(SB)/8,$"\x0a\x0a\x40\x6d\x65\x64\x69\x61"
-DATA ·d+182624(SB)/8,$"\x20\x28\x6d\x61\x78\x2d\x77\x69"
-DATA ·d+182632(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x74\x61"
-DATA ·d+182640(SB)/8,$"\x62\x6c\x65\x74\x2d\x77\x69\x64"
-DATA ·d+182648(SB)/8,$"\x74\x68\x29\x20\x7b\x0a\x20\x20"
-DATA ·d+182656(SB)/8,$"\x2e\x74\x6f\x63\x2d\x77\x72\x61"
-DATA ·d+182664(SB)/8,$"\x70\x70\x65\x72\x20\x7b\x0a\x20"
-DATA ·d+182672(SB)/8,$"\x20\x20\x20\x6c\x65\x66\x74\x3a"
-DATA ·d+182680(SB)/8,$"\x20\x2d\x24\x6e\x61\x76\x2d\x77"
-DATA ·d+182688(SB)/8,$"\x69\x64\x74\x68\x3b\x0a\x0a\x20"
-DATA ·d+182696(SB)/8,$"\x20\x20\x20\x26\x2e\x6f\x70\x65"
-DATA ·d+182704(SB)/8,$"\x6e\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+182712(SB)/8,$"\x20\x20\x6c\x65\x66\x74\x3a\x20"
-DATA ·d+182720(SB)/8,$"\x30\x3b\x0a\x20\x20\x20\x20\x7d"
-DATA ·d+182728(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
-DATA ·d+182736(SB)/8,$"\x2e\x70\x61\x67\x65\x2d\x77\x72"
-DATA ·d+182744(SB)/8,$"\x61\x70\x70\x65\x72\x20\x7b\x0a"
-DATA ·d+182752(SB)/8,$"\x20\x20\x20\x20\x6d\x61\x72\x67"
-DATA ·d+182760(SB)/8,$"\x69\x6e\x2d\x6c\x65\x66\x74\x3a"
-DATA ·d+182768(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x7d\x0a"
-DATA ·d+182776(SB)/8,$"\x0a\x20\x20\x23\x6e\x61\x76\x2d"
-DATA ·d+182784(SB)/8,$"\x62\x75\x74\x74\x6f\x6e\x20\x7b"
-DATA ·d+182792(SB)/8,$"\x0a\x20\x20\x20\x20\x64\x69\x73"
-DATA ·d+182800(SB)/8,$"\x70\x6c\x61\x79\x3a\x20\x62\x6c"
-DATA ·d+182808(SB)/8,$"\x6f\x63\x6b\x3b\x0a\x20\x20\x7d"
-DATA ·d+182816(SB)/8,$"\x0a\x0a\x20\x20\x2e\x74\x6f\x63"
-DATA ·d+182824(SB)/8,$"\x2d\x6c\x69\x6e\x6b\x20\x7b\x0a"
-DATA ·d+182832(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+182840(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
-DATA ·d+182848(SB)/8,$"\x20\x30\x2e\x33\x65\x6d\x3b\x0a"
-DATA ·d+182856(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
-DATA ·d+182864(SB)/8,$"\x69\x6e\x67\x2d\x62\x6f\x74\x74"
-DATA ·d+182872(SB)/8,$"\x6f\x6d\x3a\x20\x30\x2e\x33\x65"
-DATA ·d+182880(SB)/8,$"\x6d\x3b\x0a\x20\x20\x7d\x0a\x7d"
-DATA ·d+182888(SB)/8,$"\x0a\x0a\x40\x6d\x65\x64\x69\x61"
-DATA ·d+182896(SB)/8,$"\x20\x28\x6d\x61\x78\x2d\x77\x69"
-DATA ·d+182904(SB)/8,$"\x64\x74\x68\x3a\x20\x24\x70\x68"
-DATA ·d+182912(SB)/8,$"\x6f\x6e\x65\x2d\x77\x69\x64\x74"
-DATA ·d+182920(SB)/8,$"\x68\x29\x20\x7b\x0a\x20\x20\x2e"
-DATA ·d+182928(SB)/8,$"\x64\x61\x72\x6b\x2d\x62\x6f\x78"
-DATA ·d+182936(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x64"
-DATA ·d+182944(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x3a\x20"
-DATA ·d+182952(SB)/8,$"\x6e\x6f\x6e\x65\x3b\x0a\x20\x20"
-DATA ·d+182960(SB)/8,$"\x7d\x0a\x0a\x20\x20\x25\x6c\x65"
-DATA ·d+182968(SB)/8,$"\x66\x74\x2d\x63\x6f\x6c\x20\x7b"
-DATA ·d+182976(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
-DATA ·d+182984(SB)/8,$"\x67\x69\x6e\x2d\x72\x69\x67\x68"
-DATA ·d+182992(SB)/8,$"\x74\x3a\x20\x30\x3b\x0a\x20\x20"
-DATA ·d+183000(SB)/8,$"\x7d\x0a\x0a\x20\x20\x2e\x74\x6f"
-DATA ·d+183008(SB)/8,$"\x63\x2d\x77\x72\x61\x70\x70\x65"
-DATA ·d+183016(SB)/8,$"\x72\x20\x2e\x6c\x61\x6e\x67\x2d"
-DATA ·d+183024(SB)/8,$"\x73\x65\x6c\x65\x63\x74\x6f\x72"
-DATA ·d+183032(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x64"
-DATA ·d+183040(SB)/8,$"\x69\x73\x70\x6c\x61\x79\x3a\x20"
-DATA ·d+183048(SB)/8,$"\x62\x6c\x6f\x63\x6b\x3b\x0a\x20"
-DATA ·d+183056(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x2e\x70"
-DATA ·d+183064(SB)/8,$"\x61\x67\x65\x2d\x77\x72\x61\x70"
-DATA ·d+183072(SB)/8,$"\x70\x65\x72\x20\x2e\x6c\x61\x6e"
-DATA ·d+183080(SB)/8,$"\x67\x2d\x73\x65\x6c\x65\x63\x74"
-DATA ·d+183088(SB)/8,$"\x6f\x72\x20\x7b\x0a\x20\x20\x20"
-DATA ·d+183096(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
-DATA ·d+183104(SB)/8,$"\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a"
-DATA ·d+183112(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x25"
-DATA ·d+183120(SB)/8,$"\x72\x69\x67\x68\x74\x2d\x63\x6f"
-DATA ·d+183128(SB)/8,$"\x6c\x20\x7b\x0a\x20\x20\x20\x20"
-DATA ·d+183136(SB)/8,$"\x77\x69\x64\x74\x68\x3a\x20\x61"
-DATA ·d+183144(SB)/8,$"\x75\x74\x6f\x3b\x0a\x20\x20\x20"
-DATA ·d+183152(SB)/8,$"\x20\x66\x6c\x6f\x61\x74\x3a\x20"
-DATA ·d+183160(SB)/8,$"\x6e\x6f\x6e\x65\x3b\x0a\x20\x20"
-DATA ·d+183168(SB)/8,$"\x7d\x0a\x0a\x20\x20\x25\x72\x69"
-DATA ·d+183176(SB)/8,$"\x67\x68\x74\x2d\x63\x6f\x6c\x20"
-DATA ·d+183184(SB)/8,$"\x2b\x20\x25\x6c\x65\x66\x74\x2d"
-DATA ·d+183192(SB)/8,$"\x63\x6f\x6c\x20\x7b\x0a\x20\x20"
-DATA ·d+183200(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
-DATA ·d+183208(SB)/8,$"\x2d\x74\x6f\x70\x3a\x20\x24\x6d"
-DATA ·d+183216(SB)/8,$"\x61\x69\x6e\x2d\x70\x61\x64\x64"
-DATA ·d+183224(SB)/8,$"\x69\x6e\x67\x3b\x0a\x20\x20\x7d"
-DATA ·d+183232(SB)/8,$"\x0a\x7d\x0a\x0a\x2e\x68\x69\x67"
-DATA ·d+183240(SB)/8,$"\x68\x6c\x69\x67\x68\x74\x20\x2e"
-DATA ·d+183248(SB)/8,$"\x63\x2c\x20\x2e\x68\x69\x67\x68"
-DATA ·d+183256(SB)/8,$"\x6c\x69\x67\x68\x74\x20\x2e\x63"
-DATA ·d+183264(SB)/8,$"\x6d\x2c\x20\x2e\x68\x69\x67\x68"
-DATA ·d+183272(SB)/8,$"\x6c\x69\x67\x68\x74\x20\x2e\x63"
-DATA ·d+183280(SB)/8,$"\x31\x2c\x20\x2e\x68\x69\x67\x68"
-DATA ·d+183288(SB)/8,$"\x6c\x69\x67\x68\x74\x20\x2e\x63"
-DATA ·d+183296(SB)/8,$"\x73\x20\x7b\x0a\x20\x20\x63\x6f"
-DATA ·d+183304(SB)/8,$"\x6c\x6f\x72\x3a\x20\x23\x39\x30"
-DATA ·d+183312(SB)/8,$"\x39\x30\x39\x30\x3b\x0a\x7d\x0a"
-DATA ·d+183320(SB)/8,$"\x0a\x2e\x68\x69\x67\x68\x6c\x69"
-DATA ·d+183328(SB)/8,$"\x67\x68\x74\x2c\x20\x2e\x68\x69"
-DATA ·d+183336(SB)/8,$"\x67\x68\x6c\x69\x67\x68\x74\x20"
-DATA ·d+183344(SB)/8,$"\x2e\x77\x20\x7b\x0a\x20\x20\x62"
-DATA ·d+183352(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
-DATA ·d+183360(SB)/8,$"\x64\x2d\x63\x6f\x6c\x6f\x72\x3a"
-DATA ·d+183368(SB)/8,$"\x20\x24\x63\x6f\x64\x65\x2d\x62"
-DATA ·d+183376(SB)/8,$"\x67\x3b\x0a\x7d\x0a\x00\x00\x00"
-GLOBL ·d(SB),RODATA,$183384
+DATA ·d+180576(SB)/8,$"\x2d\x74\x6f\x70\x3a\x20\x31\x70"
+DATA ·d+180584(SB)/8,$"\x78\x20\x73\x6f\x6c\x69\x64\x20"
+DATA ·d+180592(SB)/8,$"\x23\x63\x63\x63\x3b\x0a\x20\x20"
+DATA ·d+180600(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+180608(SB)/8,$"\x2d\x62\x6f\x74\x74\x6f\x6d\x3a"
+DATA ·d+180616(SB)/8,$"\x20\x31\x70\x78\x20\x73\x6f\x6c"
+DATA ·d+180624(SB)/8,$"\x69\x64\x20\x23\x63\x63\x63\x3b"
+DATA ·d+180632(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x61\x63"
+DATA ·d+180640(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2d"
+DATA ·d+180648(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x23"
+DATA ·d+180656(SB)/8,$"\x66\x64\x66\x64\x66\x64\x3b\x0a"
+DATA ·d+180664(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x68"
+DATA ·d+180672(SB)/8,$"\x31\x3a\x66\x69\x72\x73\x74\x2d"
+DATA ·d+180680(SB)/8,$"\x63\x68\x69\x6c\x64\x2c\x20\x64"
+DATA ·d+180688(SB)/8,$"\x69\x76\x3a\x66\x69\x72\x73\x74"
+DATA ·d+180696(SB)/8,$"\x2d\x63\x68\x69\x6c\x64\x20\x2b"
+DATA ·d+180704(SB)/8,$"\x20\x68\x31\x20\x7b\x0a\x20\x20"
+DATA ·d+180712(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+180720(SB)/8,$"\x2d\x74\x6f\x70\x2d\x77\x69\x64"
+DATA ·d+180728(SB)/8,$"\x74\x68\x3a\x20\x30\x3b\x0a\x20"
+DATA ·d+180736(SB)/8,$"\x20\x20\x20\x6d\x61\x72\x67\x69"
+DATA ·d+180744(SB)/8,$"\x6e\x2d\x74\x6f\x70\x3a\x20\x30"
+DATA ·d+180752(SB)/8,$"\x3b\x0a\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+180760(SB)/8,$"\x20\x68\x32\x20\x7b\x0a\x20\x20"
+DATA ·d+180768(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
+DATA ·d+180776(SB)/8,$"\x64\x20\x25\x68\x65\x61\x64\x65"
+DATA ·d+180784(SB)/8,$"\x72\x2d\x66\x6f\x6e\x74\x3b\x0a"
+DATA ·d+180792(SB)/8,$"\x20\x20\x20\x20\x66\x6f\x6e\x74"
+DATA ·d+180800(SB)/8,$"\x2d\x73\x69\x7a\x65\x3a\x20\x31"
+DATA ·d+180808(SB)/8,$"\x39\x70\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+180816(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
+DATA ·d+180824(SB)/8,$"\x74\x6f\x70\x3a\x20\x34\x65\x6d"
+DATA ·d+180832(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6d\x61"
+DATA ·d+180840(SB)/8,$"\x72\x67\x69\x6e\x2d\x62\x6f\x74"
+DATA ·d+180848(SB)/8,$"\x74\x6f\x6d\x3a\x20\x30\x3b\x0a"
+DATA ·d+180856(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x72\x64"
+DATA ·d+180864(SB)/8,$"\x65\x72\x2d\x74\x6f\x70\x3a\x20"
+DATA ·d+180872(SB)/8,$"\x31\x70\x78\x20\x73\x6f\x6c\x69"
+DATA ·d+180880(SB)/8,$"\x64\x20\x23\x63\x63\x63\x3b\x0a"
+DATA ·d+180888(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+180896(SB)/8,$"\x69\x6e\x67\x2d\x74\x6f\x70\x3a"
+DATA ·d+180904(SB)/8,$"\x20\x31\x2e\x32\x65\x6d\x3b\x0a"
+DATA ·d+180912(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+180920(SB)/8,$"\x69\x6e\x67\x2d\x62\x6f\x74\x74"
+DATA ·d+180928(SB)/8,$"\x6f\x6d\x3a\x20\x31\x2e\x32\x65"
+DATA ·d+180936(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x62"
+DATA ·d+180944(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
+DATA ·d+180952(SB)/8,$"\x64\x2d\x69\x6d\x61\x67\x65\x3a"
+DATA ·d+180960(SB)/8,$"\x20\x6c\x69\x6e\x65\x61\x72\x2d"
+DATA ·d+180968(SB)/8,$"\x67\x72\x61\x64\x69\x65\x6e\x74"
+DATA ·d+180976(SB)/8,$"\x28\x74\x6f\x20\x62\x6f\x74\x74"
+DATA ·d+180984(SB)/8,$"\x6f\x6d\x2c\x20\x72\x67\x62\x61"
+DATA ·d+180992(SB)/8,$"\x28\x23\x66\x66\x66\x2c\x20\x30"
+DATA ·d+181000(SB)/8,$"\x2e\x32\x29\x2c\x20\x72\x67\x62"
+DATA ·d+181008(SB)/8,$"\x61\x28\x23\x66\x66\x66\x2c\x20"
+DATA ·d+181016(SB)/8,$"\x30\x29\x29\x3b\x0a\x20\x20\x7d"
+DATA ·d+181024(SB)/8,$"\x0a\x0a\x20\x20\x2f\x2f\x20\x68"
+DATA ·d+181032(SB)/8,$"\x32\x73\x20\x72\x69\x67\x68\x74"
+DATA ·d+181040(SB)/8,$"\x20\x61\x66\x74\x65\x72\x20\x68"
+DATA ·d+181048(SB)/8,$"\x31\x73\x20\x73\x68\x6f\x75\x6c"
+DATA ·d+181056(SB)/8,$"\x64\x20\x62\x75\x6d\x70\x20\x72"
+DATA ·d+181064(SB)/8,$"\x69\x67\x68\x74\x20\x75\x70\x0a"
+DATA ·d+181072(SB)/8,$"\x20\x20\x2f\x2f\x20\x61\x67\x61"
+DATA ·d+181080(SB)/8,$"\x69\x6e\x73\x74\x20\x74\x68\x65"
+DATA ·d+181088(SB)/8,$"\x20\x68\x31\x73\x2e\x0a\x20\x20"
+DATA ·d+181096(SB)/8,$"\x68\x31\x20\x2b\x20\x68\x32\x2c"
+DATA ·d+181104(SB)/8,$"\x20\x68\x31\x20\x2b\x20\x64\x69"
+DATA ·d+181112(SB)/8,$"\x76\x20\x2b\x20\x68\x32\x20\x7b"
+DATA ·d+181120(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+181128(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
+DATA ·d+181136(SB)/8,$"\x20\x24\x68\x31\x2d\x6d\x61\x72"
+DATA ·d+181144(SB)/8,$"\x67\x69\x6e\x2d\x62\x6f\x74\x74"
+DATA ·d+181152(SB)/8,$"\x6f\x6d\x20\x2a\x20\x2d\x31\x3b"
+DATA ·d+181160(SB)/8,$"\x0a\x20\x20\x20\x20\x62\x6f\x72"
+DATA ·d+181168(SB)/8,$"\x64\x65\x72\x2d\x74\x6f\x70\x3a"
+DATA ·d+181176(SB)/8,$"\x20\x6e\x6f\x6e\x65\x3b\x0a\x20"
+DATA ·d+181184(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x33"
+DATA ·d+181192(SB)/8,$"\x2c\x20\x68\x34\x2c\x20\x68\x35"
+DATA ·d+181200(SB)/8,$"\x2c\x20\x68\x36\x20\x7b\x0a\x20"
+DATA ·d+181208(SB)/8,$"\x20\x20\x20\x40\x65\x78\x74\x65"
+DATA ·d+181216(SB)/8,$"\x6e\x64\x20\x25\x68\x65\x61\x64"
+DATA ·d+181224(SB)/8,$"\x65\x72\x2d\x66\x6f\x6e\x74\x3b"
+DATA ·d+181232(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6f\x6e"
+DATA ·d+181240(SB)/8,$"\x74\x2d\x73\x69\x7a\x65\x3a\x20"
+DATA ·d+181248(SB)/8,$"\x31\x35\x70\x78\x3b\x0a\x20\x20"
+DATA ·d+181256(SB)/8,$"\x20\x20\x6d\x61\x72\x67\x69\x6e"
+DATA ·d+181264(SB)/8,$"\x2d\x74\x6f\x70\x3a\x20\x32\x2e"
+DATA ·d+181272(SB)/8,$"\x35\x65\x6d\x3b\x0a\x20\x20\x20"
+DATA ·d+181280(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
+DATA ·d+181288(SB)/8,$"\x62\x6f\x74\x74\x6f\x6d\x3a\x20"
+DATA ·d+181296(SB)/8,$"\x30\x2e\x38\x65\x6d\x3b\x0a\x20"
+DATA ·d+181304(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x68\x34"
+DATA ·d+181312(SB)/8,$"\x2c\x20\x68\x35\x2c\x20\x68\x36"
+DATA ·d+181320(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x66"
+DATA ·d+181328(SB)/8,$"\x6f\x6e\x74\x2d\x73\x69\x7a\x65"
+DATA ·d+181336(SB)/8,$"\x3a\x20\x31\x30\x70\x78\x3b\x0a"
+DATA ·d+181344(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x68"
+DATA ·d+181352(SB)/8,$"\x72\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+181360(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x3a\x20"
+DATA ·d+181368(SB)/8,$"\x32\x65\x6d\x20\x30\x3b\x0a\x20"
+DATA ·d+181376(SB)/8,$"\x20\x20\x20\x62\x6f\x72\x64\x65"
+DATA ·d+181384(SB)/8,$"\x72\x2d\x74\x6f\x70\x3a\x20\x32"
+DATA ·d+181392(SB)/8,$"\x70\x78\x20\x73\x6f\x6c\x69\x64"
+DATA ·d+181400(SB)/8,$"\x20\x24\x65\x78\x61\x6d\x70\x6c"
+DATA ·d+181408(SB)/8,$"\x65\x73\x2d\x62\x67\x3b\x0a\x20"
+DATA ·d+181416(SB)/8,$"\x20\x20\x20\x62\x6f\x72\x64\x65"
+DATA ·d+181424(SB)/8,$"\x72\x2d\x62\x6f\x74\x74\x6f\x6d"
+DATA ·d+181432(SB)/8,$"\x3a\x20\x32\x70\x78\x20\x73\x6f"
+DATA ·d+181440(SB)/8,$"\x6c\x69\x64\x20\x24\x6d\x61\x69"
+DATA ·d+181448(SB)/8,$"\x6e\x2d\x62\x67\x3b\x0a\x20\x20"
+DATA ·d+181456(SB)/8,$"\x7d\x0a\x0a\x20\x20\x74\x61\x62"
+DATA ·d+181464(SB)/8,$"\x6c\x65\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+181472(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
+DATA ·d+181480(SB)/8,$"\x62\x6f\x74\x74\x6f\x6d\x3a\x20"
+DATA ·d+181488(SB)/8,$"\x31\x65\x6d\x3b\x0a\x20\x20\x20"
+DATA ·d+181496(SB)/8,$"\x20\x6f\x76\x65\x72\x66\x6c\x6f"
+DATA ·d+181504(SB)/8,$"\x77\x3a\x20\x61\x75\x74\x6f\x3b"
+DATA ·d+181512(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x68\x2c"
+DATA ·d+181520(SB)/8,$"\x74\x64\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+181528(SB)/8,$"\x20\x20\x20\x74\x65\x78\x74\x2d"
+DATA ·d+181536(SB)/8,$"\x61\x6c\x69\x67\x6e\x3a\x20\x6c"
+DATA ·d+181544(SB)/8,$"\x65\x66\x74\x3b\x0a\x20\x20\x20"
+DATA ·d+181552(SB)/8,$"\x20\x20\x20\x76\x65\x72\x74\x69"
+DATA ·d+181560(SB)/8,$"\x63\x61\x6c\x2d\x61\x6c\x69\x67"
+DATA ·d+181568(SB)/8,$"\x6e\x3a\x20\x74\x6f\x70\x3b\x0a"
+DATA ·d+181576(SB)/8,$"\x20\x20\x20\x20\x20\x20\x6c\x69"
+DATA ·d+181584(SB)/8,$"\x6e\x65\x2d\x68\x65\x69\x67\x68"
+DATA ·d+181592(SB)/8,$"\x74\x3a\x20\x31\x2e\x36\x3b\x0a"
+DATA ·d+181600(SB)/8,$"\x20\x20\x20\x20\x20\x20\x63\x6f"
+DATA ·d+181608(SB)/8,$"\x64\x65\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+181616(SB)/8,$"\x20\x20\x20\x20\x20\x77\x68\x69"
+DATA ·d+181624(SB)/8,$"\x74\x65\x2d\x73\x70\x61\x63\x65"
+DATA ·d+181632(SB)/8,$"\x3a\x20\x6e\x6f\x77\x72\x61\x70"
+DATA ·d+181640(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+181648(SB)/8,$"\x7d\x0a\x20\x20\x20\x20\x7d\x0a"
+DATA ·d+181656(SB)/8,$"\x0a\x20\x20\x20\x20\x74\x68\x20"
+DATA ·d+181664(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+181672(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
+DATA ·d+181680(SB)/8,$"\x20\x35\x70\x78\x20\x31\x30\x70"
+DATA ·d+181688(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x20"
+DATA ·d+181696(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+181704(SB)/8,$"\x62\x6f\x74\x74\x6f\x6d\x3a\x20"
+DATA ·d+181712(SB)/8,$"\x31\x70\x78\x20\x73\x6f\x6c\x69"
+DATA ·d+181720(SB)/8,$"\x64\x20\x23\x63\x63\x63\x3b\x0a"
+DATA ·d+181728(SB)/8,$"\x20\x20\x20\x20\x20\x20\x76\x65"
+DATA ·d+181736(SB)/8,$"\x72\x74\x69\x63\x61\x6c\x2d\x61"
+DATA ·d+181744(SB)/8,$"\x6c\x69\x67\x6e\x3a\x20\x62\x6f"
+DATA ·d+181752(SB)/8,$"\x74\x74\x6f\x6d\x3b\x0a\x20\x20"
+DATA ·d+181760(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x20"
+DATA ·d+181768(SB)/8,$"\x20\x74\x64\x20\x7b\x0a\x20\x20"
+DATA ·d+181776(SB)/8,$"\x20\x20\x20\x20\x70\x61\x64\x64"
+DATA ·d+181784(SB)/8,$"\x69\x6e\x67\x3a\x20\x31\x30\x70"
+DATA ·d+181792(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x7d"
+DATA ·d+181800(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x74\x72"
+DATA ·d+181808(SB)/8,$"\x3a\x6c\x61\x73\x74\x2d\x63\x68"
+DATA ·d+181816(SB)/8,$"\x69\x6c\x64\x20\x7b\x0a\x20\x20"
+DATA ·d+181824(SB)/8,$"\x20\x20\x20\x20\x62\x6f\x72\x64"
+DATA ·d+181832(SB)/8,$"\x65\x72\x2d\x62\x6f\x74\x74\x6f"
+DATA ·d+181840(SB)/8,$"\x6d\x3a\x20\x31\x70\x78\x20\x73"
+DATA ·d+181848(SB)/8,$"\x6f\x6c\x69\x64\x20\x23\x63\x63"
+DATA ·d+181856(SB)/8,$"\x63\x3b\x0a\x20\x20\x20\x20\x7d"
+DATA ·d+181864(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x74\x72"
+DATA ·d+181872(SB)/8,$"\x3a\x6e\x74\x68\x2d\x63\x68\x69"
+DATA ·d+181880(SB)/8,$"\x6c\x64\x28\x6f\x64\x64\x29\x3e"
+DATA ·d+181888(SB)/8,$"\x74\x64\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+181896(SB)/8,$"\x20\x20\x20\x62\x61\x63\x6b\x67"
+DATA ·d+181904(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
+DATA ·d+181912(SB)/8,$"\x6c\x6f\x72\x3a\x20\x6c\x69\x67"
+DATA ·d+181920(SB)/8,$"\x68\x74\x65\x6e\x28\x24\x6d\x61"
+DATA ·d+181928(SB)/8,$"\x69\x6e\x2d\x62\x67\x2c\x34\x2e"
+DATA ·d+181936(SB)/8,$"\x32\x25\x29\x3b\x0a\x20\x20\x20"
+DATA ·d+181944(SB)/8,$"\x20\x7d\x0a\x0a\x20\x20\x20\x20"
+DATA ·d+181952(SB)/8,$"\x74\x72\x3a\x6e\x74\x68\x2d\x63"
+DATA ·d+181960(SB)/8,$"\x68\x69\x6c\x64\x28\x65\x76\x65"
+DATA ·d+181968(SB)/8,$"\x6e\x29\x3e\x74\x64\x20\x7b\x0a"
+DATA ·d+181976(SB)/8,$"\x20\x20\x20\x20\x20\x20\x62\x61"
+DATA ·d+181984(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
+DATA ·d+181992(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+182000(SB)/8,$"\x6c\x69\x67\x68\x74\x65\x6e\x28"
+DATA ·d+182008(SB)/8,$"\x24\x6d\x61\x69\x6e\x2d\x62\x67"
+DATA ·d+182016(SB)/8,$"\x2c\x32\x2e\x34\x25\x29\x3b\x0a"
+DATA ·d+182024(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x20\x20"
+DATA ·d+182032(SB)/8,$"\x7d\x0a\x0a\x20\x20\x64\x74\x20"
+DATA ·d+182040(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x66\x6f"
+DATA ·d+182048(SB)/8,$"\x6e\x74\x2d\x77\x65\x69\x67\x68"
+DATA ·d+182056(SB)/8,$"\x74\x3a\x20\x62\x6f\x6c\x64\x3b"
+DATA ·d+182064(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+182072(SB)/8,$"\x64\x64\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+182080(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
+DATA ·d+182088(SB)/8,$"\x6c\x65\x66\x74\x3a\x20\x31\x35"
+DATA ·d+182096(SB)/8,$"\x70\x78\x3b\x0a\x20\x20\x7d\x0a"
+DATA ·d+182104(SB)/8,$"\x0a\x20\x20\x70\x2c\x20\x6c\x69"
+DATA ·d+182112(SB)/8,$"\x2c\x20\x64\x74\x2c\x20\x64\x64"
+DATA ·d+182120(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6c"
+DATA ·d+182128(SB)/8,$"\x69\x6e\x65\x2d\x68\x65\x69\x67"
+DATA ·d+182136(SB)/8,$"\x68\x74\x3a\x20\x31\x2e\x36\x3b"
+DATA ·d+182144(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x72"
+DATA ·d+182152(SB)/8,$"\x67\x69\x6e\x2d\x74\x6f\x70\x3a"
+DATA ·d+182160(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x7d\x0a"
+DATA ·d+182168(SB)/8,$"\x0a\x20\x20\x69\x6d\x67\x20\x7b"
+DATA ·d+182176(SB)/8,$"\x0a\x20\x20\x20\x20\x6d\x61\x78"
+DATA ·d+182184(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3a\x20"
+DATA ·d+182192(SB)/8,$"\x31\x30\x30\x25\x3b\x0a\x20\x20"
+DATA ·d+182200(SB)/8,$"\x7d\x0a\x0a\x20\x20\x63\x6f\x64"
+DATA ·d+182208(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+182216(SB)/8,$"\x62\x61\x63\x6b\x67\x72\x6f\x75"
+DATA ·d+182224(SB)/8,$"\x6e\x64\x2d\x63\x6f\x6c\x6f\x72"
+DATA ·d+182232(SB)/8,$"\x3a\x20\x72\x67\x62\x61\x28\x30"
+DATA ·d+182240(SB)/8,$"\x2c\x30\x2c\x30\x2c\x30\x2e\x30"
+DATA ·d+182248(SB)/8,$"\x35\x29\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+182256(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3a"
+DATA ·d+182264(SB)/8,$"\x20\x33\x70\x78\x3b\x0a\x20\x20"
+DATA ·d+182272(SB)/8,$"\x20\x20\x62\x6f\x72\x64\x65\x72"
+DATA ·d+182280(SB)/8,$"\x2d\x72\x61\x64\x69\x75\x73\x3a"
+DATA ·d+182288(SB)/8,$"\x20\x33\x70\x78\x3b\x0a\x20\x20"
+DATA ·d+182296(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
+DATA ·d+182304(SB)/8,$"\x64\x20\x25\x62\x72\x65\x61\x6b"
+DATA ·d+182312(SB)/8,$"\x2d\x77\x6f\x72\x64\x73\x3b\x0a"
+DATA ·d+182320(SB)/8,$"\x20\x20\x20\x20\x40\x65\x78\x74"
+DATA ·d+182328(SB)/8,$"\x65\x6e\x64\x20\x25\x63\x6f\x64"
+DATA ·d+182336(SB)/8,$"\x65\x2d\x66\x6f\x6e\x74\x3b\x0a"
+DATA ·d+182344(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x70"
+DATA ·d+182352(SB)/8,$"\x72\x65\x3e\x63\x6f\x64\x65\x20"
+DATA ·d+182360(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x62\x61"
+DATA ·d+182368(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
+DATA ·d+182376(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+182384(SB)/8,$"\x74\x72\x61\x6e\x73\x70\x61\x72"
+DATA ·d+182392(SB)/8,$"\x65\x6e\x74\x3b\x0a\x20\x20\x20"
+DATA ·d+182400(SB)/8,$"\x20\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+182408(SB)/8,$"\x3a\x20\x30\x3b\x0a\x20\x20\x7d"
+DATA ·d+182416(SB)/8,$"\x0a\x0a\x20\x20\x61\x73\x69\x64"
+DATA ·d+182424(SB)/8,$"\x65\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+182432(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x2d"
+DATA ·d+182440(SB)/8,$"\x74\x6f\x70\x3a\x20\x31\x65\x6d"
+DATA ·d+182448(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x70\x61"
+DATA ·d+182456(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x62\x6f"
+DATA ·d+182464(SB)/8,$"\x74\x74\x6f\x6d\x3a\x20\x31\x65"
+DATA ·d+182472(SB)/8,$"\x6d\x3b\x0a\x20\x20\x20\x20\x6d"
+DATA ·d+182480(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x74\x6f"
+DATA ·d+182488(SB)/8,$"\x70\x3a\x20\x31\x2e\x35\x65\x6d"
+DATA ·d+182496(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6d\x61"
+DATA ·d+182504(SB)/8,$"\x72\x67\x69\x6e\x2d\x62\x6f\x74"
+DATA ·d+182512(SB)/8,$"\x74\x6f\x6d\x3a\x20\x31\x2e\x35"
+DATA ·d+182520(SB)/8,$"\x65\x6d\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+182528(SB)/8,$"\x62\x61\x63\x6b\x67\x72\x6f\x75"
+DATA ·d+182536(SB)/8,$"\x6e\x64\x3a\x20\x24\x61\x73\x69"
+DATA ·d+182544(SB)/8,$"\x64\x65\x2d\x6e\x6f\x74\x69\x63"
+DATA ·d+182552(SB)/8,$"\x65\x2d\x62\x67\x3b\x0a\x20\x20"
+DATA ·d+182560(SB)/8,$"\x20\x20\x6c\x69\x6e\x65\x2d\x68"
+DATA ·d+182568(SB)/8,$"\x65\x69\x67\x68\x74\x3a\x20\x31"
+DATA ·d+182576(SB)/8,$"\x2e\x36\x3b\x0a\x0a\x20\x20\x20"
+DATA ·d+182584(SB)/8,$"\x20\x26\x2e\x77\x61\x72\x6e\x69"
+DATA ·d+182592(SB)/8,$"\x6e\x67\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+182600(SB)/8,$"\x20\x20\x20\x62\x61\x63\x6b\x67"
+DATA ·d+182608(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
+DATA ·d+182616(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x61\x73"
+DATA ·d+182624(SB)/8,$"\x69\x64\x65\x2d\x77\x61\x72\x6e"
+DATA ·d+182632(SB)/8,$"\x69\x6e\x67\x2d\x62\x67\x3b\x0a"
+DATA ·d+182640(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+182648(SB)/8,$"\x20\x20\x20\x26\x2e\x73\x75\x63"
+DATA ·d+182656(SB)/8,$"\x63\x65\x73\x73\x20\x7b\x0a\x20"
+DATA ·d+182664(SB)/8,$"\x20\x20\x20\x20\x20\x62\x61\x63"
+DATA ·d+182672(SB)/8,$"\x6b\x67\x72\x6f\x75\x6e\x64\x2d"
+DATA ·d+182680(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x24"
+DATA ·d+182688(SB)/8,$"\x61\x73\x69\x64\x65\x2d\x73\x75"
+DATA ·d+182696(SB)/8,$"\x63\x63\x65\x73\x73\x2d\x62\x67"
+DATA ·d+182704(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x7d\x0a"
+DATA ·d+182712(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x61"
+DATA ·d+182720(SB)/8,$"\x73\x69\x64\x65\x3a\x62\x65\x66"
+DATA ·d+182728(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
+DATA ·d+182736(SB)/8,$"\x20\x20\x76\x65\x72\x74\x69\x63"
+DATA ·d+182744(SB)/8,$"\x61\x6c\x2d\x61\x6c\x69\x67\x6e"
+DATA ·d+182752(SB)/8,$"\x3a\x20\x6d\x69\x64\x64\x6c\x65"
+DATA ·d+182760(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x70\x61"
+DATA ·d+182768(SB)/8,$"\x64\x64\x69\x6e\x67\x2d\x72\x69"
+DATA ·d+182776(SB)/8,$"\x67\x68\x74\x3a\x20\x30\x2e\x35"
+DATA ·d+182784(SB)/8,$"\x65\x6d\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+182792(SB)/8,$"\x66\x6f\x6e\x74\x2d\x73\x69\x7a"
+DATA ·d+182800(SB)/8,$"\x65\x3a\x20\x31\x34\x70\x78\x3b"
+DATA ·d+182808(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+182816(SB)/8,$"\x61\x73\x69\x64\x65\x2e\x6e\x6f"
+DATA ·d+182824(SB)/8,$"\x74\x69\x63\x65\x3a\x62\x65\x66"
+DATA ·d+182832(SB)/8,$"\x6f\x72\x65\x20\x7b\x0a\x20\x20"
+DATA ·d+182840(SB)/8,$"\x20\x20\x40\x65\x78\x74\x65\x6e"
+DATA ·d+182848(SB)/8,$"\x64\x20\x25\x69\x63\x6f\x6e\x2d"
+DATA ·d+182856(SB)/8,$"\x69\x6e\x66\x6f\x2d\x73\x69\x67"
+DATA ·d+182864(SB)/8,$"\x6e\x3b\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+182872(SB)/8,$"\x20\x20\x61\x73\x69\x64\x65\x2e"
+DATA ·d+182880(SB)/8,$"\x77\x61\x72\x6e\x69\x6e\x67\x3a"
+DATA ·d+182888(SB)/8,$"\x62\x65\x66\x6f\x72\x65\x20\x7b"
+DATA ·d+182896(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
+DATA ·d+182904(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x69\x63"
+DATA ·d+182912(SB)/8,$"\x6f\x6e\x2d\x65\x78\x63\x6c\x61"
+DATA ·d+182920(SB)/8,$"\x6d\x61\x74\x69\x6f\x6e\x2d\x73"
+DATA ·d+182928(SB)/8,$"\x69\x67\x6e\x3b\x0a\x20\x20\x7d"
+DATA ·d+182936(SB)/8,$"\x0a\x0a\x20\x20\x61\x73\x69\x64"
+DATA ·d+182944(SB)/8,$"\x65\x2e\x73\x75\x63\x63\x65\x73"
+DATA ·d+182952(SB)/8,$"\x73\x3a\x62\x65\x66\x6f\x72\x65"
+DATA ·d+182960(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x40"
+DATA ·d+182968(SB)/8,$"\x65\x78\x74\x65\x6e\x64\x20\x25"
+DATA ·d+182976(SB)/8,$"\x69\x63\x6f\x6e\x2d\x6f\x6b\x2d"
+DATA ·d+182984(SB)/8,$"\x73\x69\x67\x6e\x3b\x0a\x20\x20"
+DATA ·d+182992(SB)/8,$"\x7d\x0a\x0a\x20\x20\x2e\x73\x65"
+DATA ·d+183000(SB)/8,$"\x61\x72\x63\x68\x2d\x68\x69\x67"
+DATA ·d+183008(SB)/8,$"\x68\x6c\x69\x67\x68\x74\x20\x7b"
+DATA ·d+183016(SB)/8,$"\x0a\x20\x20\x20\x20\x70\x61\x64"
+DATA ·d+183024(SB)/8,$"\x64\x69\x6e\x67\x3a\x20\x32\x70"
+DATA ·d+183032(SB)/8,$"\x78\x3b\x0a\x20\x20\x20\x20\x6d"
+DATA ·d+183040(SB)/8,$"\x61\x72\x67\x69\x6e\x3a\x20\x2d"
+DATA ·d+183048(SB)/8,$"\x33\x70\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+183056(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x2d"
+DATA ·d+183064(SB)/8,$"\x72\x61\x64\x69\x75\x73\x3a\x20"
+DATA ·d+183072(SB)/8,$"\x34\x70\x78\x3b\x0a\x20\x20\x20"
+DATA ·d+183080(SB)/8,$"\x20\x62\x6f\x72\x64\x65\x72\x3a"
+DATA ·d+183088(SB)/8,$"\x20\x31\x70\x78\x20\x73\x6f\x6c"
+DATA ·d+183096(SB)/8,$"\x69\x64\x20\x23\x46\x37\x45\x36"
+DATA ·d+183104(SB)/8,$"\x33\x33\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+183112(SB)/8,$"\x62\x61\x63\x6b\x67\x72\x6f\x75"
+DATA ·d+183120(SB)/8,$"\x6e\x64\x3a\x20\x6c\x69\x6e\x65"
+DATA ·d+183128(SB)/8,$"\x61\x72\x2d\x67\x72\x61\x64\x69"
+DATA ·d+183136(SB)/8,$"\x65\x6e\x74\x28\x74\x6f\x20\x74"
+DATA ·d+183144(SB)/8,$"\x6f\x70\x20\x6c\x65\x66\x74\x2c"
+DATA ·d+183152(SB)/8,$"\x20\x23\x46\x37\x45\x36\x33\x33"
+DATA ·d+183160(SB)/8,$"\x20\x30\x25\x2c\x20\x23\x46\x31"
+DATA ·d+183168(SB)/8,$"\x44\x33\x32\x46\x20\x31\x30\x30"
+DATA ·d+183176(SB)/8,$"\x25\x29\x3b\x0a\x20\x20\x7d\x0a"
+DATA ·d+183184(SB)/8,$"\x0a\x20\x20\x2f\x2f\x20\x70\x72"
+DATA ·d+183192(SB)/8,$"\x65\x76\x69\x6f\x75\x73\x20\x61"
+DATA ·d+183200(SB)/8,$"\x6e\x64\x20\x6e\x65\x78\x74\x20"
+DATA ·d+183208(SB)/8,$"\x70\x61\x67\x65\x20\x6c\x69\x6e"
+DATA ·d+183216(SB)/8,$"\x6b\x73\x20\x6f\x66\x20\x64\x6f"
+DATA ·d+183224(SB)/8,$"\x63\x75\x6d\x65\x6e\x74\x61\x74"
+DATA ·d+183232(SB)/8,$"\x69\x6f\x6e\x20\x73\x70\x6c\x69"
+DATA ·d+183240(SB)/8,$"\x74\x20\x74\x6f\x20\x70\x61\x67"
+DATA ·d+183248(SB)/8,$"\x65\x73\x0a\x20\x20\x26\x3e\x2e"
+DATA ·d+183256(SB)/8,$"\x70\x61\x67\x65\x2d\x6e\x61\x76"
+DATA ·d+183264(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6d"
+DATA ·d+183272(SB)/8,$"\x61\x72\x67\x69\x6e\x2d\x72\x69"
+DATA ·d+183280(SB)/8,$"\x67\x68\x74\x3a\x20\x24\x65\x78"
+DATA ·d+183288(SB)/8,$"\x61\x6d\x70\x6c\x65\x73\x2d\x77"
+DATA ·d+183296(SB)/8,$"\x69\x64\x74\x68\x3b\x0a\x20\x20"
+DATA ·d+183304(SB)/8,$"\x20\x20\x70\x61\x64\x64\x69\x6e"
+DATA ·d+183312(SB)/8,$"\x67\x3a\x20\x32\x65\x6d\x20\x24"
+DATA ·d+183320(SB)/8,$"\x6d\x61\x69\x6e\x2d\x70\x61\x64"
+DATA ·d+183328(SB)/8,$"\x64\x69\x6e\x67\x3b\x0a\x20\x20"
+DATA ·d+183336(SB)/8,$"\x20\x20\x62\x6f\x78\x2d\x73\x69"
+DATA ·d+183344(SB)/8,$"\x7a\x69\x6e\x67\x3a\x20\x62\x6f"
+DATA ·d+183352(SB)/8,$"\x72\x64\x65\x72\x2d\x62\x6f\x78"
+DATA ·d+183360(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x62\x6f"
+DATA ·d+183368(SB)/8,$"\x72\x64\x65\x72\x2d\x74\x6f\x70"
+DATA ·d+183376(SB)/8,$"\x3a\x20\x31\x70\x78\x20\x73\x6f"
+DATA ·d+183384(SB)/8,$"\x6c\x69\x64\x20\x23\x63\x63\x63"
+DATA ·d+183392(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x6f\x76"
+DATA ·d+183400(SB)/8,$"\x65\x72\x66\x6c\x6f\x77\x3a\x20"
+DATA ·d+183408(SB)/8,$"\x68\x69\x64\x64\x65\x6e\x3b\x0a"
+DATA ·d+183416(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
+DATA ·d+183424(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x6c\x65"
+DATA ·d+183432(SB)/8,$"\x66\x74\x2d\x63\x6f\x6c\x3b\x0a"
+DATA ·d+183440(SB)/8,$"\x0a\x20\x20\x20\x20\x2e\x70\x61"
+DATA ·d+183448(SB)/8,$"\x67\x65\x2d\x6e\x61\x76\x2d\x6e"
+DATA ·d+183456(SB)/8,$"\x65\x78\x74\x20\x7b\x0a\x20\x20"
+DATA ·d+183464(SB)/8,$"\x20\x20\x20\x20\x66\x6c\x6f\x61"
+DATA ·d+183472(SB)/8,$"\x74\x3a\x20\x72\x69\x67\x68\x74"
+DATA ·d+183480(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x7d\x0a"
+DATA ·d+183488(SB)/8,$"\x20\x20\x7d\x0a\x7d\x0a\x0a\x2f"
+DATA ·d+183496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183504(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183536(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183544(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183552(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183560(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183568(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x0a"
+DATA ·d+183576(SB)/8,$"\x2f\x2f\x20\x43\x4f\x44\x45\x20"
+DATA ·d+183584(SB)/8,$"\x53\x41\x4d\x50\x4c\x45\x20\x53"
+DATA ·d+183592(SB)/8,$"\x54\x59\x4c\x45\x53\x0a\x2f\x2f"
+DATA ·d+183600(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183608(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183616(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183624(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183632(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183640(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183648(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183656(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183664(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+183672(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x0a\x2f"
+DATA ·d+183680(SB)/8,$"\x2f\x20\x54\x68\x69\x73\x20\x69"
+DATA ·d+183688(SB)/8,$"\x73\x20\x61\x6c\x6c\x20\x74\x68"
+DATA ·d+183696(SB)/8,$"\x65\x20\x73\x74\x75\x66\x66\x20"
+DATA ·d+183704(SB)/8,$"\x74\x68\x61\x74\x20\x61\x70\x70"
+DATA ·d+183712(SB)/8,$"\x65\x61\x72\x73\x20\x69\x6e\x20"
+DATA ·d+183720(SB)/8,$"\x74\x68\x65\x20\x72\x69\x67\x68"
+DATA ·d+183728(SB)/8,$"\x74\x20\x68\x61\x6c\x66\x20\x6f"
+DATA ·d+183736(SB)/8,$"\x66\x20\x74\x68\x65\x20\x70\x61"
+DATA ·d+183744(SB)/8,$"\x67\x65\x0a\x0a\x2e\x63\x6f\x6e"
+DATA ·d+183752(SB)/8,$"\x74\x65\x6e\x74\x20\x7b\x0a\x20"
+DATA ·d+183760(SB)/8,$"\x20\x70\x72\x65\x2c\x20\x62\x6c"
+DATA ·d+183768(SB)/8,$"\x6f\x63\x6b\x71\x75\x6f\x74\x65"
+DATA ·d+183776(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x62"
+DATA ·d+183784(SB)/8,$"\x61\x63\x6b\x67\x72\x6f\x75\x6e"
+DATA ·d+183792(SB)/8,$"\x64\x2d\x63\x6f\x6c\x6f\x72\x3a"
+DATA ·d+183800(SB)/8,$"\x20\x24\x63\x6f\x64\x65\x2d\x62"
+DATA ·d+183808(SB)/8,$"\x67\x3b\x0a\x20\x20\x20\x20\x63"
+DATA ·d+183816(SB)/8,$"\x6f\x6c\x6f\x72\x3a\x20\x23\x66"
+DATA ·d+183824(SB)/8,$"\x66\x66\x3b\x0a\x0a\x20\x20\x20"
+DATA ·d+183832(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x3a"
+DATA ·d+183840(SB)/8,$"\x20\x30\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+183848(SB)/8,$"\x77\x69\x64\x74\x68\x3a\x20\x24"
+DATA ·d+183856(SB)/8,$"\x65\x78\x61\x6d\x70\x6c\x65\x73"
+DATA ·d+183864(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x3b\x0a"
+DATA ·d+183872(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6c\x6f"
+DATA ·d+183880(SB)/8,$"\x61\x74\x3a\x72\x69\x67\x68\x74"
+DATA ·d+183888(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x63\x6c"
+DATA ·d+183896(SB)/8,$"\x65\x61\x72\x3a\x72\x69\x67\x68"
+DATA ·d+183904(SB)/8,$"\x74\x3b\x0a\x0a\x20\x20\x20\x20"
+DATA ·d+183912(SB)/8,$"\x62\x6f\x78\x2d\x73\x69\x7a\x69"
+DATA ·d+183920(SB)/8,$"\x6e\x67\x3a\x20\x62\x6f\x72\x64"
+DATA ·d+183928(SB)/8,$"\x65\x72\x2d\x62\x6f\x78\x3b\x0a"
+DATA ·d+183936(SB)/8,$"\x0a\x20\x20\x20\x20\x40\x65\x78"
+DATA ·d+183944(SB)/8,$"\x74\x65\x6e\x64\x20\x25\x72\x69"
+DATA ·d+183952(SB)/8,$"\x67\x68\x74\x2d\x63\x6f\x6c\x3b"
+DATA ·d+183960(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x26\x3e"
+DATA ·d+183968(SB)/8,$"\x70\x20\x7b\x20\x6d\x61\x72\x67"
+DATA ·d+183976(SB)/8,$"\x69\x6e\x3a\x20\x30\x3b\x20\x7d"
+DATA ·d+183984(SB)/8,$"\x0a\x0a\x20\x20\x20\x20\x61\x20"
+DATA ·d+183992(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+184000(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x23"
+DATA ·d+184008(SB)/8,$"\x66\x66\x66\x3b\x0a\x20\x20\x20"
+DATA ·d+184016(SB)/8,$"\x20\x20\x20\x74\x65\x78\x74\x2d"
+DATA ·d+184024(SB)/8,$"\x64\x65\x63\x6f\x72\x61\x74\x69"
+DATA ·d+184032(SB)/8,$"\x6f\x6e\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+184040(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+184048(SB)/8,$"\x62\x6f\x72\x64\x65\x72\x2d\x62"
+DATA ·d+184056(SB)/8,$"\x6f\x74\x74\x6f\x6d\x3a\x20\x64"
+DATA ·d+184064(SB)/8,$"\x61\x73\x68\x65\x64\x20\x31\x70"
+DATA ·d+184072(SB)/8,$"\x78\x20\x23\x63\x63\x63\x3b\x0a"
+DATA ·d+184080(SB)/8,$"\x20\x20\x20\x20\x7d\x0a\x20\x20"
+DATA ·d+184088(SB)/8,$"\x7d\x0a\x0a\x20\x20\x70\x72\x65"
+DATA ·d+184096(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x40"
+DATA ·d+184104(SB)/8,$"\x65\x78\x74\x65\x6e\x64\x20\x25"
+DATA ·d+184112(SB)/8,$"\x63\x6f\x64\x65\x2d\x66\x6f\x6e"
+DATA ·d+184120(SB)/8,$"\x74\x3b\x0a\x20\x20\x20\x20\x70"
+DATA ·d+184128(SB)/8,$"\x61\x64\x64\x69\x6e\x67\x2d\x74"
+DATA ·d+184136(SB)/8,$"\x6f\x70\x3a\x20\x32\x65\x6d\x3b"
+DATA ·d+184144(SB)/8,$"\x0a\x20\x20\x20\x20\x70\x61\x64"
+DATA ·d+184152(SB)/8,$"\x64\x69\x6e\x67\x2d\x62\x6f\x74"
+DATA ·d+184160(SB)/8,$"\x74\x6f\x6d\x3a\x20\x32\x65\x6d"
+DATA ·d+184168(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x70\x61"
+DATA ·d+184176(SB)/8,$"\x64\x64\x69\x6e\x67\x3a\x20\x32"
+DATA ·d+184184(SB)/8,$"\x65\x6d\x20\x24\x6d\x61\x69\x6e"
+DATA ·d+184192(SB)/8,$"\x2d\x70\x61\x64\x64\x69\x6e\x67"
+DATA ·d+184200(SB)/8,$"\x3b\x0a\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+184208(SB)/8,$"\x20\x62\x6c\x6f\x63\x6b\x71\x75"
+DATA ·d+184216(SB)/8,$"\x6f\x74\x65\x20\x7b\x0a\x20\x20"
+DATA ·d+184224(SB)/8,$"\x20\x20\x26\x3e\x70\x20\x7b\x0a"
+DATA ·d+184232(SB)/8,$"\x20\x20\x20\x20\x20\x20\x62\x61"
+DATA ·d+184240(SB)/8,$"\x63\x6b\x67\x72\x6f\x75\x6e\x64"
+DATA ·d+184248(SB)/8,$"\x2d\x63\x6f\x6c\x6f\x72\x3a\x20"
+DATA ·d+184256(SB)/8,$"\x24\x63\x6f\x64\x65\x2d\x61\x6e"
+DATA ·d+184264(SB)/8,$"\x6e\x6f\x74\x61\x74\x69\x6f\x6e"
+DATA ·d+184272(SB)/8,$"\x2d\x62\x67\x3b\x0a\x20\x20\x20"
+DATA ·d+184280(SB)/8,$"\x20\x20\x20\x70\x61\x64\x64\x69"
+DATA ·d+184288(SB)/8,$"\x6e\x67\x3a\x20\x24\x63\x6f\x64"
+DATA ·d+184296(SB)/8,$"\x65\x2d\x61\x6e\x6e\x6f\x74\x61"
+DATA ·d+184304(SB)/8,$"\x74\x69\x6f\x6e\x2d\x70\x61\x64"
+DATA ·d+184312(SB)/8,$"\x64\x69\x6e\x67\x20\x32\x65\x6d"
+DATA ·d+184320(SB)/8,$"\x3b\x0a\x20\x20\x20\x20\x20\x20"
+DATA ·d+184328(SB)/8,$"\x63\x6f\x6c\x6f\x72\x3a\x20\x23"
+DATA ·d+184336(SB)/8,$"\x65\x65\x65\x3b\x0a\x20\x20\x20"
+DATA ·d+184344(SB)/8,$"\x20\x7d\x0a\x20\x20\x7d\x0a\x7d"
+DATA ·d+184352(SB)/8,$"\x0a\x0a\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184360(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184368(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184376(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184384(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184392(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184400(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184408(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184416(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184424(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184432(SB)/8,$"\x2f\x2f\x0a\x2f\x2f\x20\x52\x45"
+DATA ·d+184440(SB)/8,$"\x53\x50\x4f\x4e\x53\x49\x56\x45"
+DATA ·d+184448(SB)/8,$"\x20\x44\x45\x53\x49\x47\x4e\x0a"
+DATA ·d+184456(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184464(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184472(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184480(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184488(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184496(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184504(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184512(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184520(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184528(SB)/8,$"\x2f\x2f\x2f\x2f\x2f\x2f\x2f\x2f"
+DATA ·d+184536(SB)/8,$"\x0a\x2f\x2f\x20\x54\x68\x65\x73"
+DATA ·d+184544(SB)/8,$"\x65\x20\x61\x72\x65\x20\x74\x68"
+DATA ·d+184552(SB)/8,$"\x65\x20\x73\x74\x79\x6c\x65\x73"
+DATA ·d+184560(SB)/8,$"\x20\x66\x6f\x72\x20\x70\x68\x6f"
+DATA ·d+184568(SB)/8,$"\x6e\x65\x73\x20\x61\x6e\x64\x20"
+DATA ·d+184576(SB)/8,$"\x74\x61\x62\x6c\x65\x74\x73\x0a"
+DATA ·d+184584(SB)/8,$"\x2f\x2f\x20\x54\x68\x65\x72\x65"
+DATA ·d+184592(SB)/8,$"\x20\x61\x72\x65\x20\x61\x6c\x73"
+DATA ·d+184600(SB)/8,$"\x6f\x20\x61\x20\x63\x6f\x75\x70"
+DATA ·d+184608(SB)/8,$"\x6c\x65\x20\x73\x74\x79\x6c\x65"
+DATA ·d+184616(SB)/8,$"\x73\x20\x64\x69\x73\x70\x65\x72"
+DATA ·d+184624(SB)/8,$"\x65\x73\x65\x64\x0a\x0a\x40\x6d"
+DATA ·d+184632(SB)/8,$"\x65\x64\x69\x61\x20\x28\x6d\x61"
+DATA ·d+184640(SB)/8,$"\x78\x2d\x77\x69\x64\x74\x68\x3a"
+DATA ·d+184648(SB)/8,$"\x20\x24\x74\x61\x62\x6c\x65\x74"
+DATA ·d+184656(SB)/8,$"\x2d\x77\x69\x64\x74\x68\x29\x20"
+DATA ·d+184664(SB)/8,$"\x7b\x0a\x20\x20\x2e\x74\x6f\x63"
+DATA ·d+184672(SB)/8,$"\x2d\x77\x72\x61\x70\x70\x65\x72"
+DATA ·d+184680(SB)/8,$"\x20\x7b\x0a\x20\x20\x20\x20\x6c"
+DATA ·d+184688(SB)/8,$"\x65\x66\x74\x3a\x20\x2d\x24\x6e"
+DATA ·d+184696(SB)/8,$"\x61\x76\x2d\x77\x69\x64\x74\x68"
+DATA ·d+184704(SB)/8,$"\x3b\x0a\x0a\x20\x20\x20\x20\x26"
+DATA ·d+184712(SB)/8,$"\x2e\x6f\x70\x65\x6e\x20\x7b\x0a"
+DATA ·d+184720(SB)/8,$"\x20\x20\x20\x20\x20\x20\x6c\x65"
+DATA ·d+184728(SB)/8,$"\x66\x74\x3a\x20\x30\x3b\x0a\x20"
+DATA ·d+184736(SB)/8,$"\x20\x20\x20\x7d\x0a\x20\x20\x7d"
+DATA ·d+184744(SB)/8,$"\x0a\x0a\x20\x20\x2e\x70\x61\x67"
+DATA ·d+184752(SB)/8,$"\x65\x2d\x77\x72\x61\x70\x70\x65"
+DATA ·d+184760(SB)/8,$"\x72\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+184768(SB)/8,$"\x6d\x61\x72\x67\x69\x6e\x2d\x6c"
+DATA ·d+184776(SB)/8,$"\x65\x66\x74\x3a\x20\x30\x3b\x0a"
+DATA ·d+184784(SB)/8,$"\x20\x20\x7d\x0a\x0a\x20\x20\x23"
+DATA ·d+184792(SB)/8,$"\x6e\x61\x76\x2d\x62\x75\x74\x74"
+DATA ·d+184800(SB)/8,$"\x6f\x6e\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+184808(SB)/8,$"\x20\x64\x69\x73\x70\x6c\x61\x79"
+DATA ·d+184816(SB)/8,$"\x3a\x20\x62\x6c\x6f\x63\x6b\x3b"
+DATA ·d+184824(SB)/8,$"\x0a\x20\x20\x7d\x0a\x0a\x20\x20"
+DATA ·d+184832(SB)/8,$"\x2e\x74\x6f\x63\x2d\x6c\x69\x6e"
+DATA ·d+184840(SB)/8,$"\x6b\x20\x7b\x0a\x20\x20\x20\x20"
+DATA ·d+184848(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x2d"
+DATA ·d+184856(SB)/8,$"\x74\x6f\x70\x3a\x20\x30\x2e\x33"
+DATA ·d+184864(SB)/8,$"\x65\x6d\x3b\x0a\x20\x20\x20\x20"
+DATA ·d+184872(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x2d"
+DATA ·d+184880(SB)/8,$"\x62\x6f\x74\x74\x6f\x6d\x3a\x20"
+DATA ·d+184888(SB)/8,$"\x30\x2e\x33\x65\x6d\x3b\x0a\x20"
+DATA ·d+184896(SB)/8,$"\x20\x7d\x0a\x7d\x0a\x0a\x40\x6d"
+DATA ·d+184904(SB)/8,$"\x65\x64\x69\x61\x20\x28\x6d\x61"
+DATA ·d+184912(SB)/8,$"\x78\x2d\x77\x69\x64\x74\x68\x3a"
+DATA ·d+184920(SB)/8,$"\x20\x24\x70\x68\x6f\x6e\x65\x2d"
+DATA ·d+184928(SB)/8,$"\x77\x69\x64\x74\x68\x29\x20\x7b"
+DATA ·d+184936(SB)/8,$"\x0a\x20\x20\x2e\x64\x61\x72\x6b"
+DATA ·d+184944(SB)/8,$"\x2d\x62\x6f\x78\x20\x7b\x0a\x20"
+DATA ·d+184952(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
+DATA ·d+184960(SB)/8,$"\x61\x79\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+184968(SB)/8,$"\x3b\x0a\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+184976(SB)/8,$"\x20\x25\x6c\x65\x66\x74\x2d\x63"
+DATA ·d+184984(SB)/8,$"\x6f\x6c\x20\x7b\x0a\x20\x20\x20"
+DATA ·d+184992(SB)/8,$"\x20\x6d\x61\x72\x67\x69\x6e\x2d"
+DATA ·d+185000(SB)/8,$"\x72\x69\x67\x68\x74\x3a\x20\x30"
+DATA ·d+185008(SB)/8,$"\x3b\x0a\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+185016(SB)/8,$"\x20\x2e\x74\x6f\x63\x2d\x77\x72"
+DATA ·d+185024(SB)/8,$"\x61\x70\x70\x65\x72\x20\x2e\x6c"
+DATA ·d+185032(SB)/8,$"\x61\x6e\x67\x2d\x73\x65\x6c\x65"
+DATA ·d+185040(SB)/8,$"\x63\x74\x6f\x72\x20\x7b\x0a\x20"
+DATA ·d+185048(SB)/8,$"\x20\x20\x20\x64\x69\x73\x70\x6c"
+DATA ·d+185056(SB)/8,$"\x61\x79\x3a\x20\x62\x6c\x6f\x63"
+DATA ·d+185064(SB)/8,$"\x6b\x3b\x0a\x20\x20\x7d\x0a\x0a"
+DATA ·d+185072(SB)/8,$"\x20\x20\x2e\x70\x61\x67\x65\x2d"
+DATA ·d+185080(SB)/8,$"\x77\x72\x61\x70\x70\x65\x72\x20"
+DATA ·d+185088(SB)/8,$"\x2e\x6c\x61\x6e\x67\x2d\x73\x65"
+DATA ·d+185096(SB)/8,$"\x6c\x65\x63\x74\x6f\x72\x20\x7b"
+DATA ·d+185104(SB)/8,$"\x0a\x20\x20\x20\x20\x64\x69\x73"
+DATA ·d+185112(SB)/8,$"\x70\x6c\x61\x79\x3a\x20\x6e\x6f"
+DATA ·d+185120(SB)/8,$"\x6e\x65\x3b\x0a\x20\x20\x7d\x0a"
+DATA ·d+185128(SB)/8,$"\x0a\x20\x20\x25\x72\x69\x67\x68"
+DATA ·d+185136(SB)/8,$"\x74\x2d\x63\x6f\x6c\x20\x7b\x0a"
+DATA ·d+185144(SB)/8,$"\x20\x20\x20\x20\x77\x69\x64\x74"
+DATA ·d+185152(SB)/8,$"\x68\x3a\x20\x61\x75\x74\x6f\x3b"
+DATA ·d+185160(SB)/8,$"\x0a\x20\x20\x20\x20\x66\x6c\x6f"
+DATA ·d+185168(SB)/8,$"\x61\x74\x3a\x20\x6e\x6f\x6e\x65"
+DATA ·d+185176(SB)/8,$"\x3b\x0a\x20\x20\x7d\x0a\x0a\x20"
+DATA ·d+185184(SB)/8,$"\x20\x25\x72\x69\x67\x68\x74\x2d"
+DATA ·d+185192(SB)/8,$"\x63\x6f\x6c\x20\x2b\x20\x25\x6c"
+DATA ·d+185200(SB)/8,$"\x65\x66\x74\x2d\x63\x6f\x6c\x20"
+DATA ·d+185208(SB)/8,$"\x7b\x0a\x20\x20\x20\x20\x6d\x61"
+DATA ·d+185216(SB)/8,$"\x72\x67\x69\x6e\x2d\x74\x6f\x70"
+DATA ·d+185224(SB)/8,$"\x3a\x20\x24\x6d\x61\x69\x6e\x2d"
+DATA ·d+185232(SB)/8,$"\x70\x61\x64\x64\x69\x6e\x67\x3b"
+DATA ·d+185240(SB)/8,$"\x0a\x20\x20\x7d\x0a\x7d\x0a\x0a"
+DATA ·d+185248(SB)/8,$"\x2e\x68\x69\x67\x68\x6c\x69\x67"
+DATA ·d+185256(SB)/8,$"\x68\x74\x20\x2e\x63\x2c\x20\x2e"
+DATA ·d+185264(SB)/8,$"\x68\x69\x67\x68\x6c\x69\x67\x68"
+DATA ·d+185272(SB)/8,$"\x74\x20\x2e\x63\x6d\x2c\x20\x2e"
+DATA ·d+185280(SB)/8,$"\x68\x69\x67\x68\x6c\x69\x67\x68"
+DATA ·d+185288(SB)/8,$"\x74\x20\x2e\x63\x31\x2c\x20\x2e"
+DATA ·d+185296(SB)/8,$"\x68\x69\x67\x68\x6c\x69\x67\x68"
+DATA ·d+185304(SB)/8,$"\x74\x20\x2e\x63\x73\x20\x7b\x0a"
+DATA ·d+185312(SB)/8,$"\x20\x20\x63\x6f\x6c\x6f\x72\x3a"
+DATA ·d+185320(SB)/8,$"\x20\x23\x39\x30\x39\x30\x39\x30"
+DATA ·d+185328(SB)/8,$"\x3b\x0a\x7d\x0a\x0a\x2e\x68\x69"
+DATA ·d+185336(SB)/8,$"\x67\x68\x6c\x69\x67\x68\x74\x2c"
+DATA ·d+185344(SB)/8,$"\x20\x2e\x68\x69\x67\x68\x6c\x69"
+DATA ·d+185352(SB)/8,$"\x67\x68\x74\x20\x2e\x77\x20\x7b"
+DATA ·d+185360(SB)/8,$"\x0a\x20\x20\x62\x61\x63\x6b\x67"
+DATA ·d+185368(SB)/8,$"\x72\x6f\x75\x6e\x64\x2d\x63\x6f"
+DATA ·d+185376(SB)/8,$"\x6c\x6f\x72\x3a\x20\x24\x63\x6f"
+DATA ·d+185384(SB)/8,$"\x64\x65\x2d\x62\x67\x3b\x0a\x7d"
+DATA ·d+185392(SB)/8,$"\x0a\x00\x00\x00\x00\x00\x00\x00"
+GLOBL ·d(SB),RODATA,$185400
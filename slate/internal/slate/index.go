@@ -646,9 +646,9 @@ var didx = make(map[string]*directoryAsset)
 var stamp time.Time
 
 func init() {
-	stamp = time.Unix(1557821140, 738068000)
-	bb := blob_bytes(183384)
-	bs := blob_string(183384)
+	stamp = time.Unix(1792195060, 92475135)
+	bb := blob_bytes(185400)
+	bs := blob_string(185400)
 	root = &directoryAsset{
 		dirs: []directoryAsset{
 			{
@@ -850,11 +850,11 @@ func init() {
 				files: []Asset{
 					{
 						name:         "layout.tmpl",
-						blob:         bb[150760:152878],
-						str_blob:     bs[150760:152878],
+						blob:         bb[150760:154297],
+						str_blob:     bs[150760:154297],
 						mime:         "application/binary",
-						tag:          "gqa2hcaksx6vq",
-						size:         2118,
+						tag:          "iq3ajo52pkcse",
+						size:         3537,
 						isCompressed: false,
 					},
 				},
@@ -864,8 +864,8 @@ func init() {
 				files: []Asset{
 					{
 						name:         "_icon-font.scss",
-						blob:         bb[152880:153677],
-						str_blob:     bs[152880:153677],
+						blob:         bb[154304:155101],
+						str_blob:     bs[154304:155101],
 						mime:         "application/binary",
 						tag:          "flig32x2gxww6",
 						size:         797,
@@ -873,8 +873,8 @@ func init() {
 					},
 					{
 						name:         "_normalize.scss",
-						blob:         bb[153680:161606],
-						str_blob:     bs[153680:161606],
+						blob:         bb[155104:163030],
+						str_blob:     bs[155104:163030],
 						mime:         "application/binary",
 						tag:          "7w7nsc2eik5dy",
 						size:         7926,
@@ -882,8 +882,8 @@ func init() {
 					},
 					{
 						name:         "_rtl.scss",
-						blob:         bb[161608:164536],
-						str_blob:     bs[161608:164536],
+						blob:         bb[163032:165960],
+						str_blob:     bs[163032:165960],
 						mime:         "application/binary",
 						tag:          "7ruwsdsbygfls",
 						size:         2928,
@@ -891,8 +891,8 @@ func init() {
 					},
 					{
 						name:         "_variables.scss",
-						blob:         bb[164536:168329],
-						str_blob:     bs[164536:168329],
+						blob:         bb[165960:169753],
+						str_blob:     bs[165960:169753],
 						mime:         "application/binary",
 						tag:          "wv2nchckgitqs",
 						size:         3793,
@@ -900,8 +900,8 @@ func init() {
 					},
 					{
 						name:         "print.css.scss",
-						blob:         bb[168336:170915],
-						str_blob:     bs[168336:170915],
+						blob:         bb[169760:172339],
+						str_blob:     bs[169760:172339],
 						mime:         "application/binary",
 						tag:          "6cazyz5hdscfm",
 						size:         2579,
@@ -909,11 +909,11 @@ func init() {
 					},
 					{
 						name:         "screen.css.scss",
-						blob:         bb[170920:183381],
-						str_blob:     bs[170920:183381],
+						blob:         bb[172344:185393],
+						str_blob:     bs[172344:185393],
 						mime:         "application/binary",
-						tag:          "4bp2yjjknyhau",
-						size:         12461,
+						tag:          "mxgq2vdinmugs",
+						size:         13049,
 						isCompressed: false,
 					},
 				},
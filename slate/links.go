@@ -0,0 +1,121 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// linkSource is the source file and line of an intra-document link
+type linkSource struct {
+	file string
+	line int
+}
+
+func (s linkSource) String() string {
+	if s.line == 0 {
+		return s.file
+	}
+	return fmt.Sprintf("%s:%d", s.file, s.line)
+}
+
+// linkSources records sources of intra-document links, like [errors](#errors), and of
+// cross-references as markdown files are parsed, since nodes do not keep positions
+type linkSources map[*blackfriday.Node]linkSource
+
+// anchorLink returns the anchor of an intra-document link
+func anchorLink(node *blackfriday.Node) (string, bool) {
+	if node.Type != blackfriday.Link || len(node.Destination) < 2 || node.Destination[0] != '#' {
+		return "", false
+	}
+	return string(node.Destination[1:]), true
+}
+
+// add records links of a document parsed from the file data. The line of a link is the line
+// of the same occurrence of its destination in the file, e.g. the second link to #errors
+// is at the line of the second "#errors".
+func (s linkSources) add(file string, data []byte, ast *blackfriday.Node) {
+	seen := make(map[string]int)
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering || node.Type != blackfriday.Link {
+			return blackfriday.GoToNext
+		}
+		var dest string
+		if anchor, ok := anchorLink(node); ok {
+			dest = regexp.QuoteMeta("#" + anchor)
+		} else if target, ok := xrefLink(node); ok && node.FirstChild == nil {
+			// the target is trimmed, see parseXrefs
+			dest = `\[\[\s*` + regexp.QuoteMeta(target) + `\s*\]\]`
+		} else if ok {
+			dest = regexp.QuoteMeta(xrefScheme + target)
+		} else {
+			return blackfriday.GoToNext
+		}
+		src := linkSource{file: file}
+		n := seen[dest]
+		if locs := regexp.MustCompile(dest).FindAllIndex(data, n+1); len(locs) > n {
+			src.line = bytes.Count(data[:locs[n][0]], []byte("\n")) + 1
+		}
+		seen[dest]++
+		s[node] = src
+		return blackfriday.GoToNext
+	})
+}
+
+// htmlIDRE matches id and name attributes of raw HTML, which are link targets as well
+var htmlIDRE = regexp.MustCompile(`\s(?:id|name)\s*=\s*["']?([^"'\s>]+)`)
+
+// anchors returns anchors of a document: heading IDs, see assignHeadingIDs,
+// and IDs of raw HTML elements
+func anchors(ast *blackfriday.Node) map[string]bool {
+	ret := make(map[string]bool)
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering {
+			return blackfriday.GoToNext
+		}
+		switch node.Type {
+		case blackfriday.Heading:
+			if node.HeadingID != "" {
+				ret[node.HeadingID] = true
+			}
+		case blackfriday.HTMLBlock, blackfriday.HTMLSpan:
+			for _, m := range htmlIDRE.FindAllSubmatch(node.Literal, -1) {
+				ret[string(m[1])] = true
+			}
+		}
+		return blackfriday.GoToNext
+	})
+	return ret
+}
+
+// check resolves intra-document links of the document against its anchors.
+// Broken links are logged, or fail rendering when strict.
+func (s linkSources) check(ast *blackfriday.Node, strict bool) error {
+	targets := anchors(ast)
+	var broken []string
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		anchor, ok := anchorLink(node)
+		if !entering || !ok || targets[anchor] {
+			return blackfriday.GoToNext
+		}
+		src, ok := s[node]
+		if !ok {
+			src.file = "generated content"
+		}
+		broken = append(broken, fmt.Sprintf("%s: broken link to #%s", src, anchor))
+		return blackfriday.GoToNext
+	})
+	if len(broken) == 0 {
+		return nil
+	} else if strict {
+		return fmt.Errorf("%s", strings.Join(broken, "\n"))
+	}
+	for _, b := range broken {
+		log.Print(b)
+	}
+	return nil
+}
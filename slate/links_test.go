@@ -0,0 +1,27 @@
+package slate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBrokenLinks(t *testing.T) {
+	files := map[string]string{
+		"index.html.md": "---\ntitle: API\nincludes:\n  - errors\n---\n\n# Kittens\n\n" +
+			"See [the list](#get-all-kittens), [the legend](#legend) and [nothing](#missing).\n\n" +
+			"<div id=\"legend\">Legend</div>\n\n## Get All Kittens\n",
+		"includes/_errors.md": "# Errors\n\nSee [rate limits](#rate-limits).\n",
+	}
+	_, err := loadTestDoc(t, files, Params{Strict: true})
+	if err == nil {
+		t.Fatal("expected broken links to fail rendering")
+	}
+	expected := "index.html.md:9: broken link to #missing\nincludes/_errors.md:3: broken link to #rate-limits"
+	if err.Error() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, err)
+	}
+	// broken links are only logged unless strict
+	if html := renderTestDoc(t, files, Params{}); !strings.Contains(html, `href="#missing"`) {
+		t.Errorf("expected the broken link to be rendered:\n%s", html)
+	}
+}
@@ -0,0 +1,220 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+	"github.com/spf13/afero"
+)
+
+// defaultLocale is the locale of index.html.md unless set with the locale option
+const defaultLocale = "en"
+
+// localeSourceRE matches sources of translated documents, like index.ja.html.md
+var localeSourceRE = regexp.MustCompile(`^index\.([A-Za-z]{2,3}(?:[-_][A-Za-z0-9]+)*)\.html\.md$`)
+
+// uiStrings are built-in layout strings by language, "outdated" takes
+// the version and a link to the latest version
+var uiStrings = map[string]map[string]string{
+	"en": {
+		"language": "English",
+		"nav":      "NAV",
+		"search":   "Search",
+		"latest":   "latest",
+		"outdated": "This is the documentation for version %s. The latest version is %s.",
+	},
+	"de": {
+		"language": "Deutsch",
+		"nav":      "Menü",
+		"search":   "Suchen",
+		"latest":   "aktuell",
+		"outdated": "Dies ist die Dokumentation für Version %s. Die aktuelle Version ist %s.",
+	},
+	"es": {
+		"language": "Español",
+		"nav":      "Menú",
+		"search":   "Buscar",
+		"latest":   "última",
+		"outdated": "Esta es la documentación de la versión %s. La última versión es %s.",
+	},
+	"fr": {
+		"language": "Français",
+		"nav":      "Menu",
+		"search":   "Rechercher",
+		"latest":   "dernière",
+		"outdated": "Ceci est la documentation de la version %s. La dernière version est %s.",
+	},
+	"ja": {
+		"language": "日本語",
+		"nav":      "メニュー",
+		"search":   "検索",
+		"latest":   "最新",
+		"outdated": "これはバージョン %s のドキュメントです。最新バージョンは %s です。",
+	},
+	"ru": {
+		"language": "Русский",
+		"nav":      "Меню",
+		"search":   "Поиск",
+		"latest":   "последняя",
+		"outdated": "Это документация версии %s. Последняя версия — %s.",
+	},
+	"zh": {
+		"language": "中文",
+		"nav":      "导航",
+		"search":   "搜索",
+		"latest":   "最新",
+		"outdated": "这是 %s 版本的文档。最新版本是 %s。",
+	},
+}
+
+// rtlLanguages are languages written right to left
+var rtlLanguages = map[string]bool{"ar": true, "dv": true, "fa": true, "he": true, "ku": true, "ps": true, "ur": true, "yi": true}
+
+// language returns the language subtag of a locale
+func language(locale string) string {
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ToLower(locale)
+}
+
+// localeStrings returns layout strings of the locale, built-in ones overridden
+// by the ui_strings preamble option
+func localeStrings(locale string, custom map[string]string) map[string]string {
+	ret := make(map[string]string)
+	for k, v := range uiStrings[defaultLocale] {
+		ret[k] = v
+	}
+	for k, v := range uiStrings[language(locale)] {
+		ret[k] = v
+	}
+	if _, ok := uiStrings[language(locale)]; !ok {
+		ret["language"] = locale
+	}
+	for k, v := range custom {
+		ret[k] = v
+	}
+	return ret
+}
+
+func localeDir(locale string) string {
+	if rtlLanguages[language(locale)] {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+// localeLink is a link to the document of a locale, available to the layout
+type localeLink struct {
+	Locale string
+	Name   string
+	URL    string
+}
+
+// findLocales returns locales of translated documents of the source
+func findLocales(fs slate.FileSystem) ([]string, error) {
+	dir, err := fs.Open("")
+	if err != nil {
+		return nil, err
+	}
+	files, err := dir.Readdir(-1)
+	dir.Close()
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, fi := range files {
+		if m := localeSourceRE.FindStringSubmatch(fi.Name()); m != nil && !fi.IsDir() {
+			ret = append(ret, m[1])
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// loadLocales loads the document of the default locale followed by
+// translated documents
+func loadLocales(fs slate.FileSystem, params Params, place placement) ([]*content, error) {
+	locales, err := findLocales(fs)
+	if err != nil {
+		return nil, err
+	}
+	place.Locales = locales
+	var ret []*content
+	for _, locale := range append([]string{""}, locales...) {
+		p := place
+		p.Locale = locale
+		if locale != "" {
+			p.Root = "../" + place.Root
+		}
+		c, err := load(fs, params, p)
+		if err != nil && locale != "" {
+			return nil, fmt.Errorf("locale %s: %s", locale, err)
+		} else if err != nil {
+			return nil, err
+		}
+		c.locale = locale
+		ret = append(ret, c)
+	}
+	relinkLocales(ret)
+	return ret, nil
+}
+
+// relinkLocales points locale switcher links to pages missing in the target locale,
+// e.g. when a translated heading splits to a page of another name, to the page at
+// the same position there, or else to the first page
+func relinkLocales(docs []*content) {
+	for _, target := range docs {
+		names := make(map[string]bool)
+		for _, p := range target.pages {
+			names[p.name] = true
+		}
+		for _, d := range docs {
+			if d == target {
+				continue
+			}
+			dir := target.locale + "/"
+			if target.locale == "" {
+				dir = ""
+			}
+			if d.locale != "" {
+				dir = "../" + dir
+			}
+			for i, p := range d.pages {
+				if names[p.name] {
+					continue
+				}
+				name := target.pages[0].name
+				if i < len(target.pages) {
+					name = target.pages[i].name
+				}
+				p.html = bytes.Replace(p.html, []byte(`"`+dir+p.name+`"`), []byte(`"`+dir+name+`"`), -1)
+			}
+		}
+	}
+}
+
+// produceLocales writes the document of the default locale to the target and
+// translated documents to subdirectories named after their locales
+func produceLocales(fs slate.FileSystem, docs []*content, target *afero.Afero, params Params) error {
+	for _, c := range docs {
+		dir := target
+		if c.locale != "" {
+			dir = &afero.Afero{Fs: afero.NewBasePathFs(target.Fs, c.locale)}
+		}
+		if err := makeTargetDirs(dir, "images"); err != nil {
+			return err
+		}
+		if err := c.produce(dir, params.MinifyHTML); err != nil {
+			return err
+		}
+		if err := copyImages(fs, dir, c.Params.Logo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+)
+
+func TestRTLEnabled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, text := range map[string]string{
+		"index.html.md":    "# Kittens\n",
+		"index.ar.html.md": "# قطط\n",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fs, err := slate.NewUnionFS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs, err := loadLocales(fs, Params{}, placement{Source: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rtlEnabled(docs, Params{}) {
+		t.Errorf("expected right-to-left support for an ar translation")
+	}
+	if rtlEnabled(docs[:1], Params{}) {
+		t.Errorf("expected no right-to-left support without right-to-left locales")
+	}
+	disabled := false
+	if rtlEnabled(docs, Params{RTL: &disabled}) {
+		t.Errorf("expected --no-rtl to disable right-to-left support")
+	}
+}
+
+func TestLocaleSwitcherPages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, text := range map[string]string{
+		"index.html.md":    "---\nsplit: h1\n---\n\n# Kittens\n\n# Birds\n\n# Puppies\n",
+		"index.ja.html.md": "---\nsplit: h1\n---\n\n# 子猫\n\n# 鳥 {#birds}\n\n# 子犬\n",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fs, err := slate.NewUnionFS(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs, err := loadLocales(fs, Params{}, placement{Source: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		doc  int
+		page string
+		link string
+	}{
+		{0, "index.html", `"ja/index.html"`},
+		{0, "birds.html", `"ja/birds.html"`},
+		{0, "puppies.html", `"ja/子犬.html"`},
+		{1, "birds.html", `"../birds.html"`},
+		{1, "子犬.html", `"../puppies.html"`},
+	} {
+		found := false
+		for _, p := range docs[c.doc].pages {
+			if p.name == c.page {
+				found = true
+				if !strings.Contains(string(p.html), "value="+c.link) {
+					t.Errorf("%s of locale %d: expected a locale link to %s", c.page, c.doc, c.link)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("page %s of locale %d is missing", c.page, c.doc)
+		}
+	}
+}
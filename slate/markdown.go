@@ -0,0 +1,278 @@
+package slate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// renderMarkdown renders a markdown document AST back to markdown. Headings are
+// written with their IDs, if set, see keepHeadingIDs
+func renderMarkdown(ast *blackfriday.Node) string {
+	return markdownBlocks(ast, "\n\n") + "\n"
+}
+
+// markdownBlocks renders child blocks of the node separated by sep
+func markdownBlocks(node *blackfriday.Node, sep string) string {
+	var blocks []string
+	for c := node.FirstChild; c != nil; c = c.Next {
+		blocks = append(blocks, markdownBlock(c))
+	}
+	return strings.Join(blocks, sep)
+}
+
+// prefixLines prefixes the first line of text with first and the others with rest
+func prefixLines(text, first, rest string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		p := rest
+		if i == 0 {
+			p = first
+		}
+		if line == "" {
+			lines[i] = strings.TrimRight(p, " ")
+		} else {
+			lines[i] = p + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func markdownBlock(node *blackfriday.Node) string {
+	switch node.Type {
+	case blackfriday.Paragraph:
+		return markdownInline(node)
+	case blackfriday.Heading:
+		text := markdownInline(node)
+		if node.HeadingID != "" {
+			text += " {#" + node.HeadingID + "}"
+		}
+		return strings.Repeat("#", node.Level) + " " + text
+	case blackfriday.HorizontalRule:
+		return "* * *"
+	case blackfriday.HTMLBlock:
+		return strings.TrimRight(string(node.Literal), "\n")
+	case blackfriday.CodeBlock:
+		code := strings.TrimRight(string(node.Literal), "\n")
+		if !node.IsFenced {
+			return prefixLines(code, "    ", "    ")
+		}
+		fence := "```"
+		for strings.Contains(code, fence) {
+			fence += "`"
+		}
+		return fence + string(node.Info) + "\n" + code + "\n" + fence
+	case blackfriday.BlockQuote:
+		return prefixLines(markdownBlocks(node, "\n\n"), "> ", "> ")
+	case blackfriday.List:
+		return markdownList(node)
+	case blackfriday.Table:
+		return markdownTable(node)
+	}
+	return markdownInline(node)
+}
+
+func markdownList(list *blackfriday.Node) string {
+	sep, itemSep := "\n\n", "\n\n"
+	if list.Tight {
+		sep, itemSep = "\n", "\n"
+	}
+	var items []string
+	n := 1
+	for item := list.FirstChild; item != nil; item = item.Next {
+		text := markdownBlocks(item, sep)
+		switch {
+		case list.ListFlags&blackfriday.ListTypeDefinition != 0 && item.ListFlags&blackfriday.ListTypeTerm != 0:
+			items = append(items, text)
+		case list.ListFlags&blackfriday.ListTypeDefinition != 0:
+			items = append(items, prefixLines(text, ": ", "  "))
+		case list.ListFlags&blackfriday.ListTypeOrdered != 0:
+			delim := list.Delimiter
+			if delim == 0 {
+				delim = '.'
+			}
+			marker := strconv.Itoa(n) + string(delim) + " "
+			items = append(items, prefixLines(text, marker, strings.Repeat(" ", len(marker))))
+			n++
+		default:
+			bullet := list.BulletChar
+			if bullet == 0 {
+				bullet = '*'
+			}
+			items = append(items, prefixLines(text, string(bullet)+" ", "  "))
+		}
+	}
+	if list.ListFlags&blackfriday.ListTypeDefinition != 0 {
+		return strings.Join(items, "\n")
+	}
+	return strings.Join(items, itemSep)
+}
+
+func markdownTable(table *blackfriday.Node) string {
+	var lines []string
+	table.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if node.Type != blackfriday.TableRow || !entering {
+			return blackfriday.GoToNext
+		}
+		var cells, aligns []string
+		for c := node.FirstChild; c != nil; c = c.Next {
+			cells = append(cells, markdownInline(c))
+			switch c.Align {
+			case blackfriday.TableAlignmentLeft:
+				aligns = append(aligns, ":---")
+			case blackfriday.TableAlignmentRight:
+				aligns = append(aligns, "---:")
+			case blackfriday.TableAlignmentCenter:
+				aligns = append(aligns, ":---:")
+			default:
+				aligns = append(aligns, "---")
+			}
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if node.Parent.Type == blackfriday.TableHead {
+			lines = append(lines, "|"+strings.Join(aligns, "|")+"|")
+		}
+		return blackfriday.SkipChildren
+	})
+	return strings.Join(lines, "\n")
+}
+
+// markdownInline renders inline children of a block as markdown
+func markdownInline(node *blackfriday.Node) string {
+	var buf strings.Builder
+	start := startsLine(node)
+	for c := node.FirstChild; c != nil; c = c.Next {
+		n := buf.Len()
+		switch c.Type {
+		case blackfriday.Text:
+			// blackfriday splits text at escapes, entities are kept apart
+			text := string(c.Literal)
+			for !entityRE.MatchString(text) && c.Next != nil && c.Next.Type == blackfriday.Text && !entityRE.Match(c.Next.Literal) {
+				c = c.Next
+				text += string(c.Literal)
+			}
+			text = escapeMarkdown(text, inTableCell(c))
+			if start {
+				text = escapeLineStart(text)
+			}
+			buf.WriteString(text)
+		case blackfriday.Softbreak:
+			buf.WriteByte(' ')
+		case blackfriday.Hardbreak:
+			buf.WriteString("\\\n")
+		case blackfriday.Emph:
+			buf.WriteString("*" + markdownInline(c) + "*")
+		case blackfriday.Strong:
+			buf.WriteString("**" + markdownInline(c) + "**")
+		case blackfriday.Del:
+			buf.WriteString("~~" + markdownInline(c) + "~~")
+		case blackfriday.Code:
+			code := string(c.Literal)
+			fence := "`"
+			for strings.Contains(code, fence) {
+				fence += "`"
+			}
+			if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+				code = " " + code + " "
+			}
+			buf.WriteString(fence + code + fence)
+		case blackfriday.HTMLSpan:
+			buf.Write(c.Literal)
+		case blackfriday.Link:
+			buf.WriteString("[" + markdownInline(c) + "]" + markdownDestination(c))
+		case blackfriday.Image:
+			buf.WriteString("![" + markdownInline(c) + "]" + markdownDestination(c))
+		default:
+			buf.WriteString(markdownInline(c))
+		}
+		start = c.Type == blackfriday.Hardbreak || start && buf.Len() == n
+	}
+	return buf.String()
+}
+
+func markdownDestination(node *blackfriday.Node) string {
+	dest := string(node.Destination)
+	if strings.ContainsAny(dest, " ()") {
+		dest = "<" + dest + ">"
+	}
+	if len(node.Title) > 0 {
+		return fmt.Sprintf("(%s %q)", dest, node.Title)
+	}
+	return "(" + dest + ")"
+}
+
+// entityRE matches a text node holding an HTML entity, which blackfriday keeps as it is
+var entityRE = regexp.MustCompile(`^&#?[A-Za-z0-9]+;$`)
+
+// escapeMarkdown escapes characters of a text which would otherwise be
+// taken for markdown, underscores only at word boundaries, ampersands only
+// where they could start an entity and pipes only in table cells
+func escapeMarkdown(text string, table bool) string {
+	if entityRE.MatchString(text) {
+		return text
+	}
+	var buf strings.Builder
+	runes := []rune(text)
+	for i, r := range runes {
+		switch r {
+		case '\\', '`', '*', '[', ']', '<':
+			buf.WriteByte('\\')
+		case '_':
+			if i == 0 || i == len(runes)-1 || !isWordRune(runes[i-1]) || !isWordRune(runes[i+1]) {
+				buf.WriteByte('\\')
+			}
+		case '&':
+			if i == len(runes)-1 || runes[i+1] == '#' || isWordRune(runes[i+1]) {
+				buf.WriteByte('\\')
+			}
+		case '|':
+			if table {
+				buf.WriteByte('\\')
+			}
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// orderedItemRE matches a line starting like an ordered list item
+var orderedItemRE = regexp.MustCompile(`^[0-9]+\.`)
+
+// escapeLineStart escapes the start of an escaped text placed at the start of a line,
+// which would otherwise be taken for a heading, a block quote, a list item or a rule
+func escapeLineStart(text string) string {
+	if loc := orderedItemRE.FindStringIndex(text); loc != nil {
+		return text[:loc[1]-1] + "\\" + text[loc[1]-1:]
+	} else if text != "" && strings.IndexByte("#>-+=", text[0]) >= 0 {
+		return "\\" + text
+	}
+	return text
+}
+
+// startsLine reports whether inline content of a node starts a line, rather
+// than following the markup of an emphasis or a link
+func startsLine(node *blackfriday.Node) bool {
+	switch node.Type {
+	case blackfriday.Emph, blackfriday.Strong, blackfriday.Del, blackfriday.Link, blackfriday.Image:
+		return false
+	}
+	return true
+}
+
+// inTableCell reports whether an inline node is placed in a table cell
+func inTableCell(node *blackfriday.Node) bool {
+	for p := node.Parent; p != nil; p = p.Parent {
+		if p.Type == blackfriday.TableCell {
+			return true
+		}
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
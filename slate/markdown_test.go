@@ -0,0 +1,34 @@
+package slate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+func TestRenderMarkdownRoundTrip(t *testing.T) {
+	sources := []string{
+		"\\# Not a heading",
+		"1\\. Not a list, 2019. is a year",
+		"\\> Not a quote\\\n\\- nor a list",
+		"Use \\<b\\> tags, AT&T and \\&copy; for &copy;, &amp;lt; is not &lt;",
+		"*snake_case* and \\_private\\_ with `a*b`",
+		"| Name | Description |\n|------|-------------|\n| `a\\|b` | either a \\| b, \\#1 |",
+		"* 1\\. item\n* \\# item",
+	}
+	html := func(src string) []byte {
+		var buf bytes.Buffer
+		r := blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{})
+		parseMarkdown([]byte(src)).Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+			return r.RenderNode(&buf, node, entering)
+		})
+		return buf.Bytes()
+	}
+	for _, src := range sources {
+		md := renderMarkdown(parseMarkdown([]byte(src)))
+		if got, want := html(md), html(src); !bytes.Equal(got, want) {
+			t.Errorf("%q rendered as %q:\nexpected %s\ngot %s", src, md, want, got)
+		}
+	}
+}
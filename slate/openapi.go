@@ -0,0 +1,280 @@
+package slate
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// preferred content types, in order of preference
+var preferredContentTypes = []string{
+	"application/json",
+	"application/x-www-form-urlencoded",
+	"multipart/form-data",
+	"text/plain",
+}
+
+// loadAPISpec detects the format of an API spec and converts it to apiSpec
+func loadAPISpec(data []byte) (*apiSpec, error) {
+	doc, err := parseSpecDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing API spec: %s", err)
+	}
+	return readAPISpec(doc)
+}
+
+// readAPISpec converts a parsed spec document of a supported format to apiSpec
+func readAPISpec(doc *specDocument) (*apiSpec, error) {
+	switch specFormat(doc) {
+	case "openapi":
+		return readOpenAPI(doc)
+	case "swagger":
+		return readSwagger(doc)
+	case "asyncapi":
+		return readAsyncAPI(doc)
+	case "openrpc":
+		return readOpenRPC(doc)
+	}
+	return nil, fmt.Errorf("unsupported API spec format")
+}
+
+// specFormat returns the format of a spec document by its root version key:
+// openapi, swagger, asyncapi or openrpc, or an empty string if unsupported
+func specFormat(doc *specDocument) string {
+	switch {
+	case strings.HasPrefix(specString(doc.root, "openapi"), "3."):
+		return "openapi"
+	case specString(doc.root, "swagger") == "2.0":
+		return "swagger"
+	case strings.HasPrefix(specString(doc.root, "asyncapi"), "2."):
+		return "asyncapi"
+	case strings.HasPrefix(specString(doc.root, "openrpc"), "1."):
+		return "openrpc"
+	}
+	return ""
+}
+
+func readOpenAPI(doc *specDocument) (*apiSpec, error) {
+	spec := &apiSpec{}
+	info := specMap(specGet(doc.root, "info"))
+	spec.Title = specString(info, "title")
+	spec.Version = specString(info, "version")
+	spec.Description = specString(info, "description")
+	for _, srv := range specList(specGet(doc.root, "servers")) {
+		srv := specMap(srv)
+		url := specString(srv, "url")
+		// substitute server variables with their defaults
+		for _, v := range specMap(specGet(srv, "variables")) {
+			url = strings.Replace(url, "{"+fmt.Sprint(v.Key)+"}", specString(specMap(v.Value), "default"), -1)
+		}
+		spec.Servers = append(spec.Servers, strings.TrimSuffix(url, "/"))
+	}
+	for _, t := range specList(specGet(doc.root, "tags")) {
+		t := specMap(t)
+		spec.Tags = append(spec.Tags, apiTag{
+			Name:        specString(t, "name"),
+			Description: specString(t, "description"),
+			Audience:    specAudience(t),
+		})
+	}
+	components := specMap(specGet(doc.root, "components"))
+	for _, item := range specMap(specGet(components, "securitySchemes")) {
+		m := doc.resolve(item.Value)
+		spec.Security = append(spec.Security, &apiSecurityScheme{
+			Name:        fmt.Sprint(item.Key),
+			Type:        specString(m, "type"),
+			Scheme:      strings.ToLower(specString(m, "scheme")),
+			In:          specString(m, "in"),
+			ParamName:   specString(m, "name"),
+			Description: specString(m, "description"),
+		})
+	}
+	schemas := newSchemaReader(doc, "#/components/schemas/")
+	defaultSecurity := securityRequirements(specGet(doc.root, "security"))
+	for _, item := range specMap(specGet(doc.root, "paths")) {
+		path := fmt.Sprint(item.Key)
+		pathItem := doc.resolve(item.Value)
+		commonParams := specList(specGet(pathItem, "parameters"))
+		for _, method := range httpMethods {
+			m := specMap(specGet(pathItem, method))
+			if m == nil {
+				continue
+			}
+			op := &apiOperation{
+				ID:          specString(m, "operationId"),
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				Summary:     specString(m, "summary"),
+				Description: specString(m, "description"),
+				Tags:        specStrings(m, "tags"),
+				Deprecated:  specBool(m, "deprecated"),
+				Audience:    specAudience(m),
+				Security:    defaultSecurity,
+			}
+			if sec := specGet(m, "security"); sec != nil {
+				op.Security = securityRequirements(sec)
+			}
+			op.Parameters = readOpenAPIParameters(doc, schemas, commonParams, specList(specGet(m, "parameters")))
+			if body := doc.resolve(specGet(m, "requestBody")); body != nil {
+				op.RequestBody = &apiBody{
+					Description: specString(body, "description"),
+					Required:    specBool(body, "required"),
+				}
+				content := specMap(specGet(body, "content"))
+				op.RequestBody.ContentType, op.RequestBody.Schema, op.RequestBody.Example =
+					readOpenAPIContent(doc, schemas, content)
+				op.Consumes = contentTypes(content)
+			}
+			for _, r := range specMap(specGet(m, "responses")) {
+				resp := doc.resolve(r.Value)
+				ar := &apiResponse{
+					Status:      fmt.Sprint(r.Key),
+					Description: specString(resp, "description"),
+				}
+				content := specMap(specGet(resp, "content"))
+				ar.ContentType, ar.Schema, ar.Example = readOpenAPIContent(doc, schemas, content)
+				op.Responses = append(op.Responses, ar)
+				op.Produces = appendUnique(op.Produces, contentTypes(content)...)
+			}
+			spec.Operations = append(spec.Operations, op)
+		}
+	}
+	spec.Schemas = schemas.readAll()
+	return spec, nil
+}
+
+// securityRequirements returns the names of security schemes of a
+// security requirement list
+func securityRequirements(v interface{}) []string {
+	ret := []string{}
+	for _, req := range specList(v) {
+		for _, item := range specMap(req) {
+			ret = append(ret, fmt.Sprint(item.Key))
+		}
+	}
+	return ret
+}
+
+func readOpenAPIParameters(doc *specDocument, schemas *schemaReader, lists ...[]interface{}) []*apiParameter {
+	var ret []*apiParameter
+	index := make(map[string]int)
+	for _, list := range lists {
+		for _, p := range list {
+			m := doc.resolve(p)
+			param := &apiParameter{
+				Name:        specString(m, "name"),
+				In:          specString(m, "in"),
+				Description: specString(m, "description"),
+				Required:    specBool(m, "required"),
+				Deprecated:  specBool(m, "deprecated"),
+				Schema:      schemas.schema(specGet(m, "schema")),
+				Example:     specGet(m, "example"),
+			}
+			if param.Example == nil {
+				param.Example = firstExample(doc, specGet(m, "examples"))
+			}
+			// operation level parameters override path level ones
+			key := param.In + ":" + param.Name
+			if n, ok := index[key]; ok {
+				ret[n] = param
+			} else {
+				index[key] = len(ret)
+				ret = append(ret, param)
+			}
+		}
+	}
+	return ret
+}
+
+// readOpenAPIContent picks the preferred media type from a content map and
+// returns its content type, schema and example
+func readOpenAPIContent(doc *specDocument, schemas *schemaReader, content yaml.MapSlice) (string, *apiSchema, interface{}) {
+	if len(content) == 0 {
+		return "", nil, nil
+	}
+	ct := preferredContentType(contentTypes(content))
+	media := doc.resolve(specGet(content, ct))
+	schema := schemas.schema(specGet(media, "schema"))
+	example := specGet(media, "example")
+	if example == nil {
+		example = firstExample(doc, specGet(media, "examples"))
+	}
+	return ct, schema, example
+}
+
+func contentTypes(content yaml.MapSlice) []string {
+	types := make([]string, len(content))
+	for i, item := range content {
+		types[i] = fmt.Sprint(item.Key)
+	}
+	return types
+}
+
+func appendUnique(list []string, items ...string) []string {
+	for _, item := range items {
+		found := false
+		for _, s := range list {
+			if s == item {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func preferredContentType(types []string) string {
+	for _, p := range preferredContentTypes {
+		for _, t := range types {
+			if t == p {
+				return t
+			}
+		}
+	}
+	for _, t := range types {
+		if strings.HasSuffix(t, "+json") {
+			return t
+		}
+	}
+	return types[0]
+}
+
+// firstExample returns the value of the first example from an examples map
+func firstExample(doc *specDocument, v interface{}) interface{} {
+	examples := specMap(v)
+	if len(examples) == 0 {
+		return nil
+	}
+	return specGet(doc.resolve(examples[0].Value), "value")
+}
+
+// httpStatusText returns the standard reason phrase of an HTTP status
+// code as written in a spec (200, 4XX, default)
+func httpStatusText(status string) string {
+	switch strings.ToUpper(status) {
+	case "DEFAULT":
+		return "Default response"
+	case "1XX":
+		return "Informational"
+	case "2XX":
+		return "Success"
+	case "3XX":
+		return "Redirection"
+	case "4XX":
+		return "Client error"
+	case "5XX":
+		return "Server error"
+	}
+	if code, err := strconv.Atoi(status); err == nil {
+		return http.StatusText(code)
+	}
+	return ""
+}
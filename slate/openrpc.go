@@ -0,0 +1,221 @@
+package slate
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// apiMethod is a JSON-RPC method described by an OpenRPC document
+type apiMethod struct {
+	Name           string
+	Summary        string
+	Description    string
+	Tags           []string
+	Deprecated     bool
+	ParamStructure string // by-name, by-position or either
+	Params         []*apiParameter
+	Result         *apiParameter
+	Errors         []*apiError
+	ParamsExample  yaml.MapSlice // example parameter values by name
+	ResultExample  interface{}
+}
+
+type apiError struct {
+	Code    interface{}
+	Message string
+	Data    interface{}
+}
+
+const defaultMethodTag = "Methods"
+
+func (m *apiMethod) tag() string {
+	if len(m.Tags) > 0 {
+		return m.Tags[0]
+	}
+	return defaultMethodTag
+}
+
+// readOpenRPC converts an OpenRPC 1.x document to apiSpec
+func readOpenRPC(doc *specDocument) (*apiSpec, error) {
+	spec := &apiSpec{}
+	info := specMap(specGet(doc.root, "info"))
+	spec.Title = specString(info, "title")
+	spec.Version = specString(info, "version")
+	spec.Description = specString(info, "description")
+	for _, srv := range specList(specGet(doc.root, "servers")) {
+		srv := doc.resolve(srv)
+		url := specString(srv, "url")
+		for _, v := range specMap(specGet(srv, "variables")) {
+			url = strings.Replace(url, "{"+fmt.Sprint(v.Key)+"}", specString(specMap(v.Value), "default"), -1)
+		}
+		spec.Servers = append(spec.Servers, strings.TrimSuffix(url, "/"))
+	}
+	schemas := newSchemaReader(doc, "#/components/schemas/")
+	declared := make(map[string]bool)
+	for _, v := range specList(specGet(doc.root, "methods")) {
+		m := doc.resolve(v)
+		method := &apiMethod{
+			Name:           specString(m, "name"),
+			Summary:        specString(m, "summary"),
+			Description:    specString(m, "description"),
+			Deprecated:     specBool(m, "deprecated"),
+			ParamStructure: specString(m, "paramStructure"),
+		}
+		for _, t := range specList(specGet(m, "tags")) {
+			t := doc.resolve(t)
+			name := specString(t, "name")
+			method.Tags = append(method.Tags, name)
+			if !declared[name] {
+				declared[name] = true
+				spec.Tags = append(spec.Tags, apiTag{Name: name, Description: specString(t, "description")})
+			}
+		}
+		for _, p := range specList(specGet(m, "params")) {
+			p := doc.resolve(p)
+			method.Params = append(method.Params, &apiParameter{
+				Name:        specString(p, "name"),
+				In:          "params",
+				Description: firstNonEmpty(specString(p, "description"), specString(p, "summary")),
+				Required:    specBool(p, "required"),
+				Deprecated:  specBool(p, "deprecated"),
+				Schema:      schemas.schema(specGet(p, "schema")),
+			})
+		}
+		if r := doc.resolve(specGet(m, "result")); r != nil {
+			method.Result = &apiParameter{
+				Name:        specString(r, "name"),
+				Description: firstNonEmpty(specString(r, "description"), specString(r, "summary")),
+				Schema:      schemas.schema(specGet(r, "schema")),
+			}
+		}
+		for _, e := range specList(specGet(m, "errors")) {
+			e := doc.resolve(e)
+			method.Errors = append(method.Errors, &apiError{
+				Code:    specGet(e, "code"),
+				Message: specString(e, "message"),
+				Data:    specGet(e, "data"),
+			})
+		}
+		if examples := specList(specGet(m, "examples")); len(examples) > 0 {
+			ex := doc.resolve(examples[0])
+			for _, p := range specList(specGet(ex, "params")) {
+				p := doc.resolve(p)
+				method.ParamsExample = append(method.ParamsExample, yaml.MapItem{Key: specString(p, "name"), Value: specGet(p, "value")})
+			}
+			method.ResultExample = specGet(doc.resolve(specGet(ex, "result")), "value")
+		}
+		spec.Methods = append(spec.Methods, method)
+	}
+	spec.Schemas = schemas.readAll()
+	return spec, nil
+}
+
+func firstNonEmpty(s ...string) string {
+	for _, s := range s {
+		if s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// paramsExample returns example method parameters, an object or
+// an array if parameters are passed by position
+func (m *apiMethod) paramsExample() interface{} {
+	var named yaml.MapSlice
+	for _, p := range m.Params {
+		var v interface{}
+		for _, ex := range m.ParamsExample {
+			if ex.Key == p.Name {
+				v = ex.Value
+			}
+		}
+		if v == nil && (p.Required || len(m.ParamsExample) == 0) {
+			v = p.Schema.example()
+		}
+		if v != nil {
+			named = append(named, yaml.MapItem{Key: p.Name, Value: v})
+		}
+	}
+	if m.ParamStructure != "by-position" {
+		return named
+	}
+	positional := make([]interface{}, len(named))
+	for i, item := range named {
+		positional[i] = item.Value
+	}
+	return positional
+}
+
+func (w *apiWriter) method(m *apiMethod) {
+	fmt.Fprintf(&w.buf, "## %s\n\n", m.Name)
+	request := yaml.MapSlice{{Key: "jsonrpc", Value: "2.0"}, {Key: "method", Value: m.Name}}
+	if len(m.Params) > 0 {
+		request = append(request, yaml.MapItem{Key: "params", Value: m.paramsExample()})
+	}
+	request = append(request, yaml.MapItem{Key: "id", Value: 1})
+	writeCodeSamples(&w.buf, w.langs, &sampleRequest{
+		Method:  "POST",
+		URL:     w.server(),
+		Headers: []sampleHeader{{"Content-Type", "application/json"}},
+		Body:    formatJSON(request),
+	})
+	if m.Result != nil {
+		result := bodyExample(m.ResultExample, m.Result.Schema)
+		w.buf.WriteString("> The above command returns JSON structured like this:\n\n")
+		w.code("json", formatJSON(yaml.MapSlice{{Key: "jsonrpc", Value: "2.0"}, {Key: "result", Value: result}, {Key: "id", Value: 1}}))
+	}
+	if len(m.Errors) > 0 {
+		e := m.Errors[0]
+		obj := yaml.MapSlice{{Key: "code", Value: e.Code}, {Key: "message", Value: e.Message}}
+		if e.Data != nil {
+			obj = append(obj, yaml.MapItem{Key: "data", Value: e.Data})
+		}
+		w.buf.WriteString("> Errors are returned like this:\n\n")
+		w.code("json", formatJSON(yaml.MapSlice{{Key: "jsonrpc", Value: "2.0"}, {Key: "error", Value: obj}, {Key: "id", Value: 1}}))
+	}
+	if m.Deprecated {
+		w.buf.WriteString("<aside class=\"warning\">This method is deprecated.</aside>\n\n")
+	}
+	w.paragraph(m.Summary)
+	w.paragraph(m.Description)
+	w.buf.WriteString("### Method\n\n")
+	fmt.Fprintf(&w.buf, "`%s`\n\n", m.Name)
+	if len(m.Params) > 0 {
+		w.buf.WriteString("### Parameters\n\n")
+		switch m.ParamStructure {
+		case "by-position":
+			w.buf.WriteString("Parameters are passed by position, in the order listed.\n\n")
+		case "by-name":
+			w.buf.WriteString("Parameters are passed by name.\n\n")
+		}
+		rows := make([][]string, len(m.Params))
+		for i, p := range m.Params {
+			rows[i] = []string{p.Name, p.Schema.typeName(), fmt.Sprint(p.Required), describe(p.Description, p.Schema, p.Deprecated)}
+		}
+		w.table([]string{"Parameter", "Type", "Required", "Description"}, rows)
+	}
+	if r := m.Result; r != nil {
+		w.buf.WriteString("### Result\n\n")
+		w.paragraph(r.Description)
+		if r.Schema != nil && r.Schema.Name == "" && len(r.Schema.Properties) > 0 {
+			w.properties(r.Schema)
+		} else if r.Schema != nil {
+			fmt.Fprintf(&w.buf, "Type: %s\n\n", r.Schema.typeName())
+		}
+	}
+	if len(m.Errors) > 0 {
+		w.buf.WriteString("### Errors\n\n")
+		rows := make([][]string, len(m.Errors))
+		for i, e := range m.Errors {
+			data := ""
+			if e.Data != nil {
+				data = "`" + formatValue(e.Data) + "`"
+			}
+			rows[i] = []string{formatValue(e.Code), e.Message, data}
+		}
+		w.table([]string{"Code", "Message", "Data"}, rows)
+	}
+}
@@ -0,0 +1,76 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const openRPCTestSpec = `{
+  "openrpc": "1.2.6",
+  "info": {"title": "Kittn RPC", "version": "1.0"},
+  "servers": [{"url": "https://rpc.kittn.com/"}],
+  "methods": [
+    {
+      "name": "kitten.get",
+      "summary": "Returns a kitten",
+      "tags": [{"name": "Kittens"}],
+      "paramStructure": "by-position",
+      "params": [
+        {"name": "id", "required": true, "schema": {"type": "integer"}},
+        {"name": "fields", "schema": {"type": "array", "items": {"type": "string"}}}
+      ],
+      "result": {"name": "kitten", "schema": {"$ref": "#/components/schemas/Kitten"}},
+      "errors": [{"code": -32001, "message": "Kitten not found"}],
+      "examples": [{"name": "Max", "params": [{"name": "id", "value": 2}], "result": {"name": "kitten", "value": {"id": 2, "name": "Max"}}}]
+    },
+    {"name": "ping", "result": {"name": "pong", "schema": {"type": "string"}}}
+  ],
+  "components": {
+    "schemas": {"Kitten": {"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}}}
+  }
+}`
+
+func TestImportOpenRPC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	spec := filepath.Join(dir, "kittn.json")
+	if err = ioutil.WriteFile(spec, []byte(openRPCTestSpec), 0644); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(dir, "doc")
+	if err = ImportOpenRPC(spec, target, ImportOptions{Langs: []string{"shell"}}); err != nil {
+		t.Fatal(err)
+	}
+	read := func(name string) string {
+		data, err := ioutil.ReadFile(filepath.Join(target, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	kittens := read("includes/_kittens.md")
+	for _, s := range []string{
+		"## kitten.get\n",
+		"https://rpc.kittn.com",
+		`"method": "kitten.get"`,
+		"\"params\": [\n    2\n  ]",
+		"\"result\": {\n    \"id\": 2,\n    \"name\": \"Max\"\n  }",
+		"\"code\": -32001",
+		"Parameters are passed by position",
+		"Type: [Kitten](#schema-Kitten)",
+	} {
+		if !strings.Contains(kittens, s) {
+			t.Errorf("expected %q in:\n%s", s, kittens)
+		}
+	}
+	// methods without tags are documented under Methods
+	if methods := read("includes/_methods.md"); !strings.Contains(methods, "## ping\n") {
+		t.Errorf("expected method ping in:\n%s", methods)
+	}
+}
@@ -0,0 +1,144 @@
+package slate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// Modes of splitting documentation to pages
+const (
+	splitNone     = "none"
+	splitH1       = "h1"
+	splitIncludes = "includes"
+)
+
+func checkSplitMode(mode string) error {
+	switch mode {
+	case "", splitNone, splitH1, splitIncludes:
+		return nil
+	}
+	return fmt.Errorf("unknown split mode %s, expected h1, includes or none", mode)
+}
+
+// page is an output HTML page
+type page struct {
+	name    string // output file name
+	title   string // text of the first H1 heading
+	include string // include the page is rendered from when split per include
+	nodes   []*blackfriday.Node
+	html    []byte
+}
+
+// pageLink is a link to the previous or the next page, available to the layout
+type pageLink struct {
+	URL   string
+	Title string
+}
+
+func (p *page) link() *pageLink {
+	return &pageLink{URL: p.name, Title: p.title}
+}
+
+// pageBreak returns a directive starting a new page when the document
+// is split per include
+func pageBreak(name string) *blackfriday.Node {
+	node := blackfriday.NewNode(blackfriday.HTMLBlock)
+	node.Literal = []byte("<!-- page: " + name + " -->")
+	return node
+}
+
+// splitPages distributes top level nodes of the document to pages, the first
+// page is always index.html
+func splitPages(ast *blackfriday.Node, mode string) []*page {
+	current := &page{name: "index.html"}
+	pages := []*page{current}
+	names := map[string]bool{current.name: true}
+	start := func(name string) {
+		name = strings.Trim(strings.Map(func(r rune) rune {
+			if r == '/' || r == '\\' || r == ' ' {
+				return '-'
+			}
+			return r
+		}, name), "-.")
+		if name == "" {
+			name = "page-" + strconv.Itoa(len(pages)+1)
+		}
+		unique := name + ".html"
+		for i := 1; names[unique]; i++ {
+			unique = name + "-" + strconv.Itoa(i) + ".html"
+		}
+		if len(current.nodes) > 0 {
+			current = &page{}
+			pages = append(pages, current)
+		} else if len(pages) == 1 {
+			return
+		}
+		delete(names, current.name)
+		current.name = unique
+		names[unique] = true
+	}
+	for node := ast.FirstChild; node != nil; node = node.Next {
+		if name, arg, ok := directive(node); ok && name == "page" {
+			if mode == splitIncludes {
+				start(arg)
+				current.include = arg
+			}
+			continue
+		}
+		if node.Type == blackfriday.Heading && node.Level == 1 {
+			if mode == splitH1 && current.title != "" {
+				start(node.HeadingID)
+			}
+			if current.title == "" {
+				current.title = nodeText(node)
+			}
+		}
+		current.nodes = append(current.nodes, node)
+	}
+	return pages
+}
+
+// linkPages returns the pages of heading anchors and rewrites links
+// to anchors located on other pages
+func linkPages(pages []*page) map[string]string {
+	anchors := make(map[string]string)
+	for _, p := range pages {
+		for _, n := range p.nodes {
+			n.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+				if entering && node.Type == blackfriday.Heading && node.HeadingID != "" {
+					if _, ok := anchors[node.HeadingID]; !ok {
+						anchors[node.HeadingID] = p.name
+					}
+				}
+				return blackfriday.GoToNext
+			})
+		}
+	}
+	if len(pages) == 1 {
+		return anchors
+	}
+	for _, p := range pages {
+		for _, n := range p.nodes {
+			n.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+				if entering && node.Type == blackfriday.Link && len(node.Destination) > 1 && node.Destination[0] == '#' {
+					if name, ok := anchors[string(node.Destination[1:])]; ok && name != p.name {
+						node.Destination = append([]byte(name), node.Destination...)
+					}
+				}
+				return blackfriday.GoToNext
+			})
+		}
+	}
+	return anchors
+}
+
+// anchor returns a link to a heading from the page
+func (p *page) anchor(anchors map[string]string, id string) string {
+	if name, ok := anchors[id]; ok && name != p.name {
+		return name + "#" + id
+	}
+	return "#" + id
+}
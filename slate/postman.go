@@ -0,0 +1,324 @@
+package slate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// postmanVariableRE matches {{variable}} references of Postman collections
+var postmanVariableRE = regexp.MustCompile(`{{\s*([^{}\s]+)\s*}}`)
+
+// postmanImporter converts a Postman v2.1 collection to markdown
+type postmanImporter struct {
+	apiWriter
+	vars map[string]string
+}
+
+// postmanText returns a description, which is either a string or
+// an object with content
+func postmanText(v interface{}) string {
+	if m := specMap(v); m != nil {
+		return strings.TrimSpace(specString(m, "content"))
+	}
+	if s, ok := v.(string); ok {
+		return strings.TrimSpace(s)
+	}
+	return ""
+}
+
+// expand substitutes collection variables
+func (p *postmanImporter) expand(s string) string {
+	return postmanVariableRE.ReplaceAllStringFunc(s, func(ref string) string {
+		if v, ok := p.vars[postmanVariableRE.FindStringSubmatch(ref)[1]]; ok {
+			return v
+		}
+		return ref
+	})
+}
+
+// postmanPairs returns enabled key-value pairs of a Postman list
+// (headers, query parameters, form fields)
+func postmanPairs(v interface{}) []yaml.MapSlice {
+	var ret []yaml.MapSlice
+	for _, item := range specList(v) {
+		m := specMap(item)
+		if m != nil && !specBool(m, "disabled") {
+			ret = append(ret, m)
+		}
+	}
+	return ret
+}
+
+// url returns the request URL and its query parameters
+func (p *postmanImporter) url(v interface{}) (string, []yaml.MapSlice) {
+	if s, ok := v.(string); ok {
+		return p.expand(s), nil
+	}
+	m := specMap(v)
+	query := postmanPairs(specGet(m, "query"))
+	if raw := specString(m, "raw"); raw != "" {
+		return p.expand(raw), query
+	}
+	var buf bytes.Buffer
+	if protocol := specString(m, "protocol"); protocol != "" {
+		buf.WriteString(protocol + "://")
+	}
+	if host := specStrings(m, "host"); len(host) > 0 {
+		buf.WriteString(strings.Join(host, "."))
+	} else {
+		buf.WriteString(specString(m, "host"))
+	}
+	if port := specString(m, "port"); port != "" {
+		buf.WriteString(":" + port)
+	}
+	if path := specStrings(m, "path"); len(path) > 0 {
+		buf.WriteString("/" + strings.Join(path, "/"))
+	} else if path := specString(m, "path"); path != "" {
+		buf.WriteString("/" + strings.TrimPrefix(path, "/"))
+	}
+	for i, q := range query {
+		if i == 0 {
+			buf.WriteByte('?')
+		} else {
+			buf.WriteByte('&')
+		}
+		buf.WriteString(specString(q, "key") + "=" + specString(q, "value"))
+	}
+	return p.expand(buf.String()), query
+}
+
+// auth applies request authentication to the sample request
+func (p *postmanImporter) auth(req *sampleRequest, auth yaml.MapSlice) {
+	typ := specString(auth, "type")
+	params := make(map[string]string)
+	for _, item := range specList(specGet(auth, typ)) {
+		m := specMap(item)
+		params[specString(m, "key")] = p.expand(specString(m, "value"))
+	}
+	switch typ {
+	case "bearer":
+		req.Headers = append(req.Headers, sampleHeader{"Authorization", "Bearer " + params["token"]})
+	case "basic":
+		cred := base64.StdEncoding.EncodeToString([]byte(params["username"] + ":" + params["password"]))
+		req.Headers = append(req.Headers, sampleHeader{"Authorization", "Basic " + cred})
+	case "apikey":
+		if params["in"] == "query" {
+			sep := "?"
+			if strings.Contains(req.URL, "?") {
+				sep = "&"
+			}
+			req.URL += sep + params["key"] + "=" + params["value"]
+		} else {
+			req.Headers = append(req.Headers, sampleHeader{params["key"], params["value"]})
+		}
+	}
+}
+
+// body returns the request body and its default content type
+func (p *postmanImporter) body(body yaml.MapSlice) (string, string) {
+	switch specString(body, "mode") {
+	case "raw":
+		ct := "text/plain"
+		if lang := specString(specMap(specGet(specMap(specGet(body, "options")), "raw")), "language"); lang == "json" {
+			ct = "application/json"
+		}
+		return p.expand(specString(body, "raw")), ct
+	case "urlencoded", "formdata":
+		form := yaml.MapSlice{}
+		for _, f := range postmanPairs(specGet(body, specString(body, "mode"))) {
+			value := specString(f, "value")
+			if specString(f, "type") == "file" {
+				value = "@" + specString(f, "src")
+			}
+			form = append(form, yaml.MapItem{Key: p.expand(specString(f, "key")), Value: p.expand(value)})
+		}
+		ct := "application/x-www-form-urlencoded"
+		if specString(body, "mode") == "formdata" {
+			ct = "multipart/form-data"
+		}
+		return formatBody(ct, form), ct
+	case "graphql":
+		gql := specMap(specGet(body, "graphql"))
+		req := yaml.MapSlice{{Key: "query", Value: specString(gql, "query")}}
+		if vars := specString(gql, "variables"); vars != "" {
+			if v, err := parseJSON([]byte(vars)); err == nil {
+				req = append(req, yaml.MapItem{Key: "variables", Value: v})
+			}
+		}
+		return formatJSON(req), "application/json"
+	}
+	return "", ""
+}
+
+// formatResponse pretty prints JSON response bodies
+func formatResponse(body string) (string, bool) {
+	if v, err := parseJSON([]byte(body)); err == nil {
+		return formatJSON(v), true
+	}
+	return strings.TrimSpace(body), false
+}
+
+func (p *postmanImporter) request(item yaml.MapSlice, auth yaml.MapSlice) {
+	fmt.Fprintf(&p.buf, "## %s\n\n", specString(item, "name"))
+	r := specMap(specGet(item, "request"))
+	if r == nil {
+		r = yaml.MapSlice{{Key: "url", Value: specGet(item, "request")}}
+	}
+	req := &sampleRequest{Method: strings.ToUpper(specString(r, "method"))}
+	var query []yaml.MapSlice
+	req.URL, query = p.url(specGet(r, "url"))
+	headers := postmanPairs(specGet(r, "header"))
+	hasContentType := false
+	for _, h := range headers {
+		name := specString(h, "key")
+		hasContentType = hasContentType || strings.EqualFold(name, "Content-Type")
+		req.Headers = append(req.Headers, sampleHeader{name, p.expand(specString(h, "value"))})
+	}
+	if a := specMap(specGet(r, "auth")); a != nil {
+		auth = a
+	}
+	p.auth(req, auth)
+	var ct string
+	req.Body, ct = p.body(specMap(specGet(r, "body")))
+	if req.Body != "" && ct != "" && !hasContentType {
+		req.Headers = append(req.Headers, sampleHeader{"Content-Type", ct})
+	}
+	writeCodeSamples(&p.buf, p.langs, req)
+	for i, v := range specList(specGet(item, "response")) {
+		resp := specMap(v)
+		body := specString(resp, "body")
+		if body == "" {
+			continue
+		}
+		text, isJSON := formatResponse(body)
+		lang := ""
+		if isJSON {
+			lang = "json"
+		}
+		switch {
+		case i == 0 && isJSON:
+			p.buf.WriteString("> The above command returns JSON structured like this:\n\n")
+		case i == 0:
+			p.buf.WriteString("> The above command returns a response like this:\n\n")
+		default:
+			fmt.Fprintf(&p.buf, "> Example response \"%s\" (%s):\n\n", specString(resp, "name"), postmanStatus(resp))
+		}
+		p.code(lang, text)
+	}
+	p.paragraph(postmanText(specGet(r, "description")))
+	p.buf.WriteString("### HTTP Request\n\n")
+	u := req.URL
+	if i := strings.IndexByte(u, '?'); i >= 0 {
+		u = u[:i]
+	}
+	fmt.Fprintf(&p.buf, "`%s %s`\n\n", req.method(), u)
+	if vars := postmanPairs(specGet(specMap(specGet(r, "url")), "variable")); len(vars) > 0 {
+		p.buf.WriteString("### URL Parameters\n\n")
+		p.pairs("Parameter", vars)
+	}
+	if len(query) > 0 {
+		p.buf.WriteString("### Query Parameters\n\n")
+		p.pairs("Parameter", query)
+	}
+	if len(headers) > 0 {
+		p.buf.WriteString("### Headers\n\n")
+		p.pairs("Header", headers)
+	}
+	var responses [][]string
+	for _, v := range specList(specGet(item, "response")) {
+		resp := specMap(v)
+		responses = append(responses, []string{postmanStatus(resp), specString(resp, "name")})
+	}
+	if len(responses) > 0 {
+		p.buf.WriteString("### Responses\n\n")
+		p.table([]string{"Status", "Example"}, responses)
+	}
+}
+
+func postmanStatus(resp yaml.MapSlice) string {
+	code := specString(resp, "code")
+	status := specString(resp, "status")
+	if status == "" {
+		status = httpStatusText(code)
+	}
+	return strings.TrimSpace(code + " " + status)
+}
+
+func (p *postmanImporter) pairs(title string, pairs []yaml.MapSlice) {
+	rows := make([][]string, len(pairs))
+	for i, m := range pairs {
+		value := specString(m, "value")
+		if value != "" {
+			value = "`" + value + "`"
+		}
+		rows[i] = []string{specString(m, "key"), value, postmanText(specGet(m, "description"))}
+	}
+	p.table([]string{title, "Example", "Description"}, rows)
+}
+
+// items writes folders and requests: top level folders become H1
+// sections, nested folders and requests H2 sections
+func (p *postmanImporter) items(items []interface{}, level int, auth yaml.MapSlice) {
+	for _, v := range items {
+		item := specMap(v)
+		if specGet(item, "item") == nil {
+			p.request(item, auth)
+			continue
+		}
+		folderAuth := auth
+		if a := specMap(specGet(item, "auth")); a != nil {
+			folderAuth = a
+		}
+		fmt.Fprintf(&p.buf, "%s %s\n\n", strings.Repeat("#", level), specString(item, "name"))
+		p.paragraph(postmanText(specGet(item, "description")))
+		p.items(specList(specGet(item, "item")), 2, folderAuth)
+	}
+}
+
+// ImportPostman converts a Postman v2.1 collection to Slate source files:
+// every top level folder becomes an include file with a section per request
+func ImportPostman(collection string, target string, opts ImportOptions) error {
+	data, err := ioutil.ReadFile(collection)
+	if err != nil {
+		return err
+	}
+	doc, err := parseSpecDocument(data)
+	if err != nil {
+		return fmt.Errorf("%s: error parsing collection: %s", collection, err)
+	}
+	info := specMap(specGet(doc.root, "info"))
+	if schema := specString(info, "schema"); schema != "" && !strings.Contains(schema, "v2.1") && !strings.Contains(schema, "v2.0") {
+		return fmt.Errorf("%s: unsupported collection schema %s", collection, schema)
+	}
+	p := &postmanImporter{apiWriter: apiWriter{langs: opts.langs()}, vars: make(map[string]string)}
+	for _, v := range postmanPairs(specGet(doc.root, "variable")) {
+		p.vars[specString(v, "key")] = specString(v, "value")
+	}
+	ret := newImportedDoc(specString(info, "name"), opts.langs())
+	if desc := postmanText(specGet(info, "description")); desc != "" {
+		fmt.Fprintf(&ret.body, "# Introduction\n\n%s\n\n", desc)
+	}
+	auth := specMap(specGet(doc.root, "auth"))
+	var requests []interface{}
+	for _, v := range specList(specGet(doc.root, "item")) {
+		item := specMap(v)
+		if specGet(item, "item") == nil {
+			requests = append(requests, v)
+			continue
+		}
+		p.items([]interface{}{v}, 1, auth)
+		ret.addInclude(specString(item, "name"), p.text())
+	}
+	if len(requests) > 0 {
+		p.buf.WriteString("# Requests\n\n")
+		p.items(requests, 1, auth)
+		ret.addInclude("Requests", p.text())
+	}
+	return ret.write(target, opts.Overwrite)
+}
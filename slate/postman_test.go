@@ -0,0 +1,94 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const postmanTestCollection = `{
+  "info": {"name": "Kittn", "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},
+  "variable": [{"key": "baseUrl", "value": "https://api.kittn.com"}],
+  "auth": {"type": "bearer", "bearer": [{"key": "token", "value": "meowmeowmeow"}]},
+  "item": [
+    {
+      "name": "Kittens",
+      "item": [
+        {
+          "name": "Get a Specific Kitten",
+          "request": {
+            "method": "GET",
+            "url": {
+              "raw": "{{baseUrl}}/kittens/:id?fields=name",
+              "host": ["{{baseUrl}}"],
+              "path": ["kittens", ":id"],
+              "query": [{"key": "fields", "value": "name"}, {"key": "debug", "value": "1", "disabled": true}],
+              "variable": [{"key": "id", "value": "2", "description": "ID of the kitten"}]
+            }
+          },
+          "response": [
+            {"name": "Found", "code": 200, "body": "{\"id\": 2, \"name\": \"Max\"}"},
+            {"name": "Missing", "code": 404, "body": "{\"error\": \"not found\"}"}
+          ]
+        }
+      ]
+    },
+    {
+      "name": "Ping",
+      "request": {"method": "POST", "url": "{{baseUrl}}/ping", "auth": {"type": "noauth"}, "body": {"mode": "urlencoded", "urlencoded": [{"key": "echo", "value": "hi"}]}}
+    }
+  ]
+}`
+
+func TestImportPostman(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	collection := filepath.Join(dir, "kittn.postman_collection.json")
+	if err = ioutil.WriteFile(collection, []byte(postmanTestCollection), 0644); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(dir, "doc")
+	if err = ImportPostman(collection, target, ImportOptions{Langs: []string{"shell"}}); err != nil {
+		t.Fatal(err)
+	}
+	read := func(name string) string {
+		data, err := ioutil.ReadFile(filepath.Join(target, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	kittens := read("includes/_kittens.md")
+	for _, s := range []string{
+		"# Kittens\n\n## Get a Specific Kitten\n",
+		"https://api.kittn.com/kittens/:id?fields=name",
+		"Authorization: Bearer meowmeowmeow",
+		"> The above command returns JSON structured like this:\n\n```json\n{\n  \"id\": 2,\n  \"name\": \"Max\"\n}\n```",
+		"> Example response \"Missing\" (404 Not Found):",
+		"`GET https://api.kittn.com/kittens/:id`",
+		"### URL Parameters",
+		"ID of the kitten",
+	} {
+		if !strings.Contains(kittens, s) {
+			t.Errorf("expected %q in:\n%s", s, kittens)
+		}
+	}
+	if strings.Contains(kittens, "debug") {
+		t.Errorf("expected the disabled query parameter to be left out:\n%s", kittens)
+	}
+	// requests outside of folders are documented under Requests
+	requests := read("includes/_requests.md")
+	for _, s := range []string{"## Ping\n", "echo=hi", "application/x-www-form-urlencoded"} {
+		if !strings.Contains(requests, s) {
+			t.Errorf("expected %q in:\n%s", s, requests)
+		}
+	}
+	if strings.Contains(requests, "Authorization") {
+		t.Errorf("expected no authorization of a noauth request:\n%s", requests)
+	}
+}
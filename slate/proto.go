@@ -0,0 +1,971 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// protoFile is a parsed .proto file
+type protoFile struct {
+	Package  string
+	Comment  string // leading comment of the package statement
+	Messages []*protoMessage
+	Enums    []*protoEnum
+	Services []*protoService
+	types    map[string]interface{} // *protoMessage or *protoEnum by fully qualified name
+}
+
+type protoMessage struct {
+	Name       string // name qualified with enclosing messages, e.g. Outer.Inner
+	FullName   string // fully qualified name, including package
+	Comment    string
+	Deprecated bool
+	Fields     []*protoField
+	mapEntry   bool
+}
+
+type protoField struct {
+	Name       string
+	JSONName   string
+	Label      string // repeated, optional or required
+	Type       string
+	KeyType    string // map key type
+	Number     string
+	OneOf      string
+	Comment    string
+	Deprecated bool
+	scope      string
+}
+
+type protoEnum struct {
+	Name       string
+	FullName   string
+	Comment    string
+	Deprecated bool
+	Values     []*protoEnumValue
+}
+
+type protoEnumValue struct {
+	Name       string
+	Number     string
+	Comment    string
+	Deprecated bool
+}
+
+type protoService struct {
+	Name       string
+	FullName   string
+	Comment    string
+	Deprecated bool
+	Methods    []*protoMethod
+}
+
+type protoMethod struct {
+	Name            string
+	Comment         string
+	Deprecated      bool
+	Input           string
+	Output          string
+	ClientStreaming bool
+	ServerStreaming bool
+	scope           string
+}
+
+type protoToken struct {
+	kind     byte // 'n' for identifiers, 's' for strings, 'v' for numbers, otherwise punctuator
+	val      string
+	line     int
+	comment  string // leading comment
+	trailing string // comment following the token on the same line
+}
+
+// protoComment strips comment markers from a // or /* */ comment
+func protoComment(text string) string {
+	if strings.HasPrefix(text, "//") {
+		return strings.TrimPrefix(text[2:], " ")
+	}
+	lines := strings.Split(strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/"), "\n")
+	for i, l := range lines {
+		l = strings.TrimSpace(l)
+		l = strings.TrimPrefix(l, "*")
+		lines[i] = strings.TrimPrefix(l, " ")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func protoTokenize(src []byte) ([]protoToken, error) {
+	var toks []protoToken
+	var comment []string
+	line, commentLine := 1, 0
+	isIdent := func(c byte) bool {
+		return c == '_' || c == '.' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+	}
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			if commentLine > 0 && line > commentLine+1 {
+				// a blank line detaches comments from the next token
+				comment, commentLine = nil, 0
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case bytes.HasPrefix(src[i:], []byte("//")) || bytes.HasPrefix(src[i:], []byte("/*")):
+			var end int
+			if src[i+1] == '/' {
+				end = bytes.IndexByte(src[i:], '\n')
+				if end < 0 {
+					end = len(src) - i
+				}
+			} else if end = bytes.Index(src[i+2:], []byte("*/")); end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			} else {
+				end += 4
+			}
+			text := string(src[i : i+end])
+			if n := len(toks); n > 0 && toks[n-1].line == line && commentLine == 0 {
+				toks[n-1].trailing = protoComment(text)
+			} else {
+				comment = append(comment, protoComment(text))
+			}
+			line += strings.Count(text, "\n")
+			commentLine = line
+			i += end
+		case c == '"' || c == '\'':
+			j := i + 1
+			var buf bytes.Buffer
+			for ; j < len(src) && src[j] != c; j++ {
+				if src[j] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated string", line)
+				}
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+				}
+				buf.WriteByte(src[j])
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			toks = append(toks, protoToken{kind: 's', val: buf.String(), line: line, comment: strings.Join(comment, "\n")})
+			comment, commentLine = nil, 0
+			i = j + 1
+		case c == '-' || c == '+' || c >= '0' && c <= '9' || isIdent(c):
+			j := i + 1
+			for j < len(src) && isIdent(src[j]) {
+				j++
+			}
+			kind := byte('n')
+			if c == '-' || c == '+' || c >= '0' && c <= '9' {
+				kind = 'v'
+			}
+			toks = append(toks, protoToken{kind: kind, val: string(src[i:j]), line: line, comment: strings.Join(comment, "\n")})
+			comment, commentLine = nil, 0
+			i = j
+		case strings.IndexByte("{}[]()<>;,=:", c) >= 0:
+			toks = append(toks, protoToken{kind: c, val: string(c), line: line, comment: strings.Join(comment, "\n")})
+			comment, commentLine = nil, 0
+			i++
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	return toks, nil
+}
+
+type protoParser struct {
+	toks []protoToken
+	pos  int
+	file *protoFile
+}
+
+func (p *protoParser) peek() protoToken {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return protoToken{}
+}
+
+func (p *protoParser) next() protoToken {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *protoParser) is(kind byte) bool {
+	return p.peek().kind == kind
+}
+
+// trailing returns the trailing comment of the last consumed token
+func (p *protoParser) trailing() string {
+	if p.pos > 0 && p.pos <= len(p.toks) {
+		return p.toks[p.pos-1].trailing
+	}
+	return ""
+}
+
+func (p *protoParser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if p.pos < len(p.toks) {
+		line = p.toks[p.pos].line
+	} else if len(p.toks) > 0 {
+		line = p.toks[len(p.toks)-1].line
+	}
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *protoParser) expect(kind byte) (protoToken, error) {
+	t := p.peek()
+	if t.kind != kind {
+		if t.kind == 0 {
+			return t, p.errorf("unexpected end of file")
+		}
+		return t, p.errorf("unexpected %q", t.val)
+	}
+	p.pos++
+	return t, nil
+}
+
+func (p *protoParser) name() (string, error) {
+	t, err := p.expect('n')
+	return t.val, err
+}
+
+// skipStatement skips tokens up to the end of the current statement,
+// including a nested block if any
+func (p *protoParser) skipStatement() error {
+	depth := 0
+	for {
+		t := p.next()
+		switch t.kind {
+		case 0:
+			return p.errorf("unexpected end of file")
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				return nil
+			}
+		case ';':
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// option parses an option statement or a field option following
+// the option keyword or the opening bracket
+func (p *protoParser) option() (name, value string, err error) {
+	for !p.is('=') {
+		t := p.next()
+		if t.kind == 0 {
+			return "", "", p.errorf("unexpected end of file")
+		}
+		name += t.val
+	}
+	p.next()
+	if p.is('{') {
+		depth := 0
+		for {
+			t := p.next()
+			if t.kind == 0 {
+				return "", "", p.errorf("unexpected end of file")
+			} else if t.kind == '{' {
+				depth++
+			} else if t.kind == '}' {
+				if depth--; depth == 0 {
+					return name, "", nil
+				}
+			}
+		}
+	}
+	return name, p.next().val, nil
+}
+
+// fieldOptions parses optional field options in brackets
+func (p *protoParser) fieldOptions() (map[string]string, error) {
+	opts := make(map[string]string)
+	if !p.is('[') {
+		return opts, nil
+	}
+	p.next()
+	for !p.is(']') {
+		name, value, err := p.option()
+		if err != nil {
+			return nil, err
+		}
+		opts[name] = value
+		if p.is(',') {
+			p.next()
+		}
+	}
+	p.next()
+	return opts, nil
+}
+
+// parseProto parses a .proto file
+func parseProto(src []byte) (*protoFile, error) {
+	toks, err := protoTokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &protoParser{toks: toks, file: &protoFile{types: make(map[string]interface{})}}
+	for p.pos < len(p.toks) {
+		t := p.next()
+		switch t.val {
+		case ";":
+		case "syntax", "edition":
+			if _, err = p.expect('='); err != nil {
+				return nil, err
+			}
+			if _, err = p.expect('s'); err != nil {
+				return nil, err
+			}
+			if _, err = p.expect(';'); err != nil {
+				return nil, err
+			}
+		case "package":
+			if p.file.Package, err = p.name(); err != nil {
+				return nil, err
+			}
+			p.file.Comment = t.comment
+			if _, err = p.expect(';'); err != nil {
+				return nil, err
+			}
+		case "message":
+			if err = p.message(t.comment, ""); err != nil {
+				return nil, err
+			}
+		case "enum":
+			if err = p.enum(t.comment, ""); err != nil {
+				return nil, err
+			}
+		case "service":
+			if err = p.service(t.comment); err != nil {
+				return nil, err
+			}
+		case "import", "option", "extend":
+			if err = p.skipStatement(); err != nil {
+				return nil, err
+			}
+		default:
+			p.pos--
+			return nil, p.errorf("unexpected %q", t.val)
+		}
+	}
+	return p.file, nil
+}
+
+func (p *protoParser) qualify(name string) string {
+	if p.file.Package == "" {
+		return name
+	}
+	return p.file.Package + "." + name
+}
+
+func (p *protoParser) message(comment, outer string) error {
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	if outer != "" {
+		name = outer + "." + name
+	}
+	m := &protoMessage{Name: name, FullName: p.qualify(name), Comment: comment}
+	p.file.Messages = append(p.file.Messages, m)
+	p.file.types[m.FullName] = m
+	if _, err = p.expect('{'); err != nil {
+		return err
+	}
+	return p.messageBody(m, "")
+}
+
+func (p *protoParser) messageBody(m *protoMessage, oneof string) error {
+	for !p.is('}') {
+		t := p.next()
+		switch t.val {
+		case "":
+			return p.errorf("unexpected end of file")
+		case ";":
+		case "message":
+			if err := p.message(t.comment, m.Name); err != nil {
+				return err
+			}
+		case "enum":
+			if err := p.enum(t.comment, m.Name); err != nil {
+				return err
+			}
+		case "oneof":
+			name, err := p.name()
+			if err != nil {
+				return err
+			}
+			if _, err = p.expect('{'); err != nil {
+				return err
+			}
+			if err = p.messageBody(m, name); err != nil {
+				return err
+			}
+		case "option":
+			name, value, err := p.option()
+			if err != nil {
+				return err
+			}
+			if name == "deprecated" && value == "true" {
+				m.Deprecated = true
+			}
+			if name == "map_entry" && value == "true" {
+				m.mapEntry = true
+			}
+			if _, err = p.expect(';'); err != nil {
+				return err
+			}
+		case "reserved", "extensions", "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			p.pos--
+			f, err := p.field(m.FullName)
+			if err != nil {
+				return err
+			}
+			f.OneOf = oneof
+			m.Fields = append(m.Fields, f)
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *protoParser) field(scope string) (*protoField, error) {
+	var err error
+	f := &protoField{Comment: p.peek().comment, scope: scope}
+	switch p.peek().val {
+	case "repeated", "optional", "required":
+		f.Label = p.next().val
+	}
+	if p.peek().val == "map" {
+		p.next()
+		if _, err = p.expect('<'); err != nil {
+			return nil, err
+		}
+		if f.KeyType, err = p.name(); err != nil {
+			return nil, err
+		}
+		if _, err = p.expect(','); err != nil {
+			return nil, err
+		}
+		if f.Type, err = p.name(); err != nil {
+			return nil, err
+		}
+		if _, err = p.expect('>'); err != nil {
+			return nil, err
+		}
+	} else if f.Type, err = p.name(); err != nil {
+		return nil, err
+	}
+	if f.Name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if _, err = p.expect('='); err != nil {
+		return nil, err
+	}
+	number, err := p.expect('v')
+	if err != nil {
+		return nil, err
+	}
+	f.Number = number.val
+	opts, err := p.fieldOptions()
+	if err != nil {
+		return nil, err
+	}
+	f.Deprecated = opts["deprecated"] == "true"
+	f.JSONName = opts["json_name"]
+	if f.JSONName == "" {
+		f.JSONName = protoJSONName(f.Name)
+	}
+	if _, err = p.expect(';'); err != nil {
+		return nil, err
+	}
+	if f.Comment == "" {
+		f.Comment = p.trailing()
+	}
+	return f, nil
+}
+
+// protoJSONName converts a field name to lowerCamelCase as the proto3 JSON mapping does
+func protoJSONName(name string) string {
+	var buf bytes.Buffer
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+func (p *protoParser) enum(comment, outer string) error {
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	if outer != "" {
+		name = outer + "." + name
+	}
+	e := &protoEnum{Name: name, FullName: p.qualify(name), Comment: comment}
+	p.file.Enums = append(p.file.Enums, e)
+	p.file.types[e.FullName] = e
+	if _, err = p.expect('{'); err != nil {
+		return err
+	}
+	for !p.is('}') {
+		t := p.next()
+		switch t.val {
+		case "":
+			return p.errorf("unexpected end of file")
+		case ";":
+		case "option":
+			name, value, err := p.option()
+			if err != nil {
+				return err
+			}
+			if name == "deprecated" && value == "true" {
+				e.Deprecated = true
+			}
+			if _, err = p.expect(';'); err != nil {
+				return err
+			}
+		case "reserved":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			v := &protoEnumValue{Name: t.val, Comment: t.comment}
+			if _, err = p.expect('='); err != nil {
+				return err
+			}
+			number, err := p.expect('v')
+			if err != nil {
+				return err
+			}
+			v.Number = number.val
+			opts, err := p.fieldOptions()
+			if err != nil {
+				return err
+			}
+			v.Deprecated = opts["deprecated"] == "true"
+			if _, err = p.expect(';'); err != nil {
+				return err
+			}
+			if v.Comment == "" {
+				v.Comment = p.trailing()
+			}
+			e.Values = append(e.Values, v)
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *protoParser) service(comment string) error {
+	name, err := p.name()
+	if err != nil {
+		return err
+	}
+	s := &protoService{Name: name, FullName: p.qualify(name), Comment: comment}
+	p.file.Services = append(p.file.Services, s)
+	if _, err = p.expect('{'); err != nil {
+		return err
+	}
+	for !p.is('}') {
+		t := p.next()
+		switch t.val {
+		case "":
+			return p.errorf("unexpected end of file")
+		case ";":
+		case "option":
+			name, value, err := p.option()
+			if err != nil {
+				return err
+			}
+			if name == "deprecated" && value == "true" {
+				s.Deprecated = true
+			}
+			if _, err = p.expect(';'); err != nil {
+				return err
+			}
+		case "rpc":
+			m, err := p.rpc(t.comment)
+			if err != nil {
+				return err
+			}
+			m.scope = s.FullName
+			s.Methods = append(s.Methods, m)
+		default:
+			p.pos--
+			return p.errorf("unexpected %q", t.val)
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *protoParser) rpc(comment string) (*protoMethod, error) {
+	var err error
+	m := &protoMethod{Comment: comment}
+	if m.Name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if m.Input, m.ClientStreaming, err = p.rpcType(); err != nil {
+		return nil, err
+	}
+	if returns, err := p.name(); err != nil {
+		return nil, err
+	} else if returns != "returns" {
+		p.pos--
+		return nil, p.errorf("unexpected %q", returns)
+	}
+	if m.Output, m.ServerStreaming, err = p.rpcType(); err != nil {
+		return nil, err
+	}
+	if p.is(';') {
+		p.next()
+	} else if _, err = p.expect('{'); err != nil {
+		return nil, err
+	} else {
+		for !p.is('}') {
+			t := p.next()
+			switch t.val {
+			case "":
+				return nil, p.errorf("unexpected end of file")
+			case ";":
+			case "option":
+				name, value, err := p.option()
+				if err != nil {
+					return nil, err
+				}
+				if name == "deprecated" && value == "true" {
+					m.Deprecated = true
+				}
+				if _, err = p.expect(';'); err != nil {
+					return nil, err
+				}
+			default:
+				p.pos--
+				return nil, p.errorf("unexpected %q", t.val)
+			}
+		}
+		p.next()
+	}
+	if m.Comment == "" {
+		m.Comment = p.trailing()
+	}
+	return m, nil
+}
+
+// rpcType parses a parenthesized request or response type of an RPC
+func (p *protoParser) rpcType() (name string, stream bool, err error) {
+	if _, err = p.expect('('); err != nil {
+		return
+	}
+	if name, err = p.name(); err != nil {
+		return
+	}
+	if name == "stream" && p.is('n') {
+		stream = true
+		if name, err = p.name(); err != nil {
+			return
+		}
+	}
+	_, err = p.expect(')')
+	return
+}
+
+// lookup resolves a type name used in scope following protobuf scoping rules
+func (f *protoFile) lookup(scope, name string) (string, interface{}) {
+	if strings.HasPrefix(name, ".") {
+		return name[1:], f.types[name[1:]]
+	}
+	for {
+		full := name
+		if scope != "" {
+			full = scope + "." + name
+		}
+		if t, ok := f.types[full]; ok {
+			return full, t
+		}
+		if scope == "" {
+			return name, nil
+		}
+		if i := strings.LastIndexByte(scope, '.'); i >= 0 {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+// protoWellKnown maps well known types to examples of their JSON representation
+var protoWellKnown = map[string]interface{}{
+	"google.protobuf.Timestamp":   "1970-01-01T00:00:00Z",
+	"google.protobuf.Duration":    "1.5s",
+	"google.protobuf.Empty":       yaml.MapSlice{},
+	"google.protobuf.Struct":      yaml.MapSlice{},
+	"google.protobuf.Value":       "value",
+	"google.protobuf.ListValue":   []interface{}{},
+	"google.protobuf.Any":         yaml.MapSlice{{Key: "@type", Value: "type.googleapis.com/google.protobuf.Empty"}},
+	"google.protobuf.FieldMask":   "path.to.field",
+	"google.protobuf.StringValue": "string",
+	"google.protobuf.BytesValue":  "Ynl0ZXM=",
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.Int32Value":  10,
+	"google.protobuf.UInt32Value": 10,
+	"google.protobuf.Int64Value":  "10",
+	"google.protobuf.UInt64Value": "10",
+	"google.protobuf.FloatValue":  1.5,
+	"google.protobuf.DoubleValue": 1.5,
+}
+
+// protoWriter renders a parsed .proto file as markdown
+type protoWriter struct {
+	apiWriter
+	file   *protoFile
+	server string
+}
+
+// scalarExample returns an example of a scalar type value in proto3 JSON mapping
+func protoScalarExample(typ string) (interface{}, bool) {
+	switch typ {
+	case "double", "float":
+		return 1.5, true
+	case "int32", "uint32", "sint32", "fixed32", "sfixed32":
+		return 10, true
+	case "int64", "uint64", "sint64", "fixed64", "sfixed64":
+		return "10", true
+	case "bool":
+		return true, true
+	case "string":
+		return "string", true
+	case "bytes":
+		return "Ynl0ZXM=", true
+	}
+	return nil, false
+}
+
+func (w *protoWriter) example(scope, typ string, expanding map[string]bool) interface{} {
+	if v, ok := protoScalarExample(typ); ok {
+		return v
+	}
+	full, t := w.file.lookup(scope, typ)
+	if v, ok := protoWellKnown[full]; ok {
+		return v
+	}
+	switch t := t.(type) {
+	case *protoEnum:
+		if len(t.Values) > 0 {
+			return t.Values[0].Name
+		}
+	case *protoMessage:
+		ret := yaml.MapSlice{}
+		if expanding[full] {
+			return ret
+		}
+		expanding[full] = true
+		defer delete(expanding, full)
+		oneofs := make(map[string]bool)
+		for _, f := range t.Fields {
+			if f.OneOf != "" && oneofs[f.OneOf] {
+				continue
+			}
+			oneofs[f.OneOf] = true
+			v := w.example(f.scope, f.Type, expanding)
+			if f.KeyType != "" {
+				key := "key"
+				if k, ok := protoScalarExample(f.KeyType); ok && f.KeyType != "string" {
+					key = fmt.Sprint(k)
+				}
+				v = yaml.MapSlice{{Key: key, Value: v}}
+			} else if f.Label == "repeated" {
+				v = []interface{}{v}
+			}
+			ret = append(ret, yaml.MapItem{Key: f.JSONName, Value: v})
+		}
+		return ret
+	}
+	return yaml.MapSlice{}
+}
+
+func protoAnchor(kind, fullName string) string {
+	return kind + "-" + strings.ToLower(strings.Replace(fullName, ".", "-", -1))
+}
+
+// typeRef renders a type name linking to its documentation
+func (w *protoWriter) typeRef(scope, typ string) string {
+	full, t := w.file.lookup(scope, typ)
+	switch t := t.(type) {
+	case *protoMessage:
+		return "[" + t.Name + "](#" + protoAnchor("message", full) + ")"
+	case *protoEnum:
+		return "[" + t.Name + "](#" + protoAnchor("enum", full) + ")"
+	}
+	return typ
+}
+
+func (w *protoWriter) fieldType(f *protoField) string {
+	typ := w.typeRef(f.scope, f.Type)
+	if f.KeyType != "" {
+		return "map<" + f.KeyType + ", " + typ + ">"
+	}
+	if f.Label == "repeated" {
+		return "repeated " + typ
+	}
+	return typ
+}
+
+func protoDescribe(comment string, deprecated bool) string {
+	if deprecated {
+		return strings.TrimSpace("*Deprecated.* " + comment)
+	}
+	return comment
+}
+
+func (w *protoWriter) method(s *protoService, m *protoMethod) {
+	fmt.Fprintf(&w.buf, "## %s\n\n", m.Name)
+	data, err := marshalJSON(jsonValue(w.example(m.scope, m.Input, make(map[string]bool))), "  ")
+	if err != nil {
+		data = []byte("{}")
+	}
+	w.code("shell", fmt.Sprintf("grpcurl -d '%s' \\\n  %s %s/%s", strings.Replace(string(data), "'", `'\''`, -1), w.server, s.FullName, m.Name))
+	w.buf.WriteString("> The above command returns JSON structured like this:\n\n")
+	w.code("json", formatJSON(w.example(m.scope, m.Output, make(map[string]bool))))
+	if m.Deprecated || s.Deprecated {
+		w.buf.WriteString("<aside class=\"warning\">This method is deprecated.</aside>\n\n")
+	}
+	w.paragraph(m.Comment)
+	w.buf.WriteString("### Method\n\n")
+	fmt.Fprintf(&w.buf, "`%s/%s`\n\n", s.FullName, m.Name)
+	switch {
+	case m.ClientStreaming && m.ServerStreaming:
+		w.buf.WriteString("Bidirectional streaming: the client sends a stream of requests and receives a stream of responses.\n\n")
+	case m.ClientStreaming:
+		w.buf.WriteString("Client streaming: the client sends a stream of requests and receives a single response.\n\n")
+	case m.ServerStreaming:
+		w.buf.WriteString("Server streaming: the client sends a single request and receives a stream of responses.\n\n")
+	}
+	fmt.Fprintf(&w.buf, "Request: %s\n\n", w.typeRef(m.scope, m.Input))
+	w.messageFields(m.scope, m.Input)
+	fmt.Fprintf(&w.buf, "Response: %s\n\n", w.typeRef(m.scope, m.Output))
+}
+
+// messageFields writes the field table of a request message defined in the file
+func (w *protoWriter) messageFields(scope, typ string) {
+	if _, t := w.file.lookup(scope, typ); t != nil {
+		if m, ok := t.(*protoMessage); ok && len(m.Fields) > 0 {
+			w.fields(m)
+		}
+	}
+}
+
+func (w *protoWriter) fields(m *protoMessage) {
+	rows := make([][]string, len(m.Fields))
+	for i, f := range m.Fields {
+		desc := protoDescribe(f.Comment, f.Deprecated)
+		if f.OneOf != "" {
+			desc = strings.TrimSpace(desc + " One of `" + f.OneOf + "`.")
+		}
+		rows[i] = []string{f.JSONName, w.fieldType(f), desc}
+	}
+	w.table([]string{"Field", "Type", "Description"}, rows)
+}
+
+func (w *protoWriter) message(m *protoMessage) {
+	fmt.Fprintf(&w.buf, "## %s {#%s}\n\n", m.Name, protoAnchor("message", m.FullName))
+	if m.Deprecated {
+		w.buf.WriteString("*Deprecated.*\n\n")
+	}
+	w.paragraph(m.Comment)
+	if len(m.Fields) > 0 {
+		w.fields(m)
+	}
+}
+
+func (w *protoWriter) enum(e *protoEnum) {
+	fmt.Fprintf(&w.buf, "## %s {#%s}\n\n", e.Name, protoAnchor("enum", e.FullName))
+	if e.Deprecated {
+		w.buf.WriteString("*Deprecated.*\n\n")
+	}
+	w.paragraph(e.Comment)
+	rows := make([][]string, len(e.Values))
+	for i, v := range e.Values {
+		rows[i] = []string{"`" + v.Name + "`", v.Number, protoDescribe(v.Comment, v.Deprecated)}
+	}
+	w.table([]string{"Value", "Number", "Description"}, rows)
+}
+
+// ImportProto converts services, messages and enums of a .proto file
+// to Slate source files (index.html.md and includes) in the target directory
+func ImportProto(proto string, target string, opts ImportOptions) error {
+	data, err := ioutil.ReadFile(proto)
+	if err != nil {
+		return err
+	}
+	f, err := parseProto(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", proto, err)
+	}
+	doc := newImportedDoc(f.Package, []string{"shell"})
+	w := &protoWriter{file: f, server: "localhost:50051"}
+	w.buf.WriteString("# Introduction\n\n")
+	w.paragraph(f.Comment)
+	if f.Package != "" {
+		fmt.Fprintf(&w.buf, "Package: `%s`\n\n", f.Package)
+	}
+	w.buf.WriteString("Examples use [grpcurl](https://github.com/fullstorydev/grpcurl) and the proto3 JSON mapping of messages.\n\n")
+	doc.body.Write(w.text())
+	for _, s := range f.Services {
+		fmt.Fprintf(&w.buf, "# %s\n\n", s.Name)
+		if s.Deprecated {
+			w.buf.WriteString("*Deprecated.*\n\n")
+		}
+		w.paragraph(s.Comment)
+		for _, m := range s.Methods {
+			w.method(s, m)
+		}
+		doc.addInclude(s.Name, w.text())
+	}
+	var messages []*protoMessage
+	for _, m := range f.Messages {
+		if !m.mapEntry {
+			messages = append(messages, m)
+		}
+	}
+	if len(messages) > 0 {
+		w.buf.WriteString("# Messages\n\n")
+		for _, m := range messages {
+			w.message(m)
+		}
+		doc.addInclude("Messages", w.text())
+	}
+	if len(f.Enums) > 0 {
+		w.buf.WriteString("# Enums\n\n")
+		for _, e := range f.Enums {
+			w.enum(e)
+		}
+		doc.addInclude("Enums", w.text())
+	}
+	return doc.write(target, opts.Overwrite)
+}
@@ -0,0 +1,124 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const protoTestFile = `syntax = "proto3";
+
+// Kittn keeps track of kittens.
+package kittn.v1;
+
+import "google/protobuf/timestamp.proto";
+
+// A kitten up for adoption.
+message Kitten {
+  message Toy {
+    string name = 1;
+  }
+  int64 id = 1;
+  string display_name = 2; // Name shown to adopters.
+  map<string, Toy> toys = 3;
+  google.protobuf.Timestamp birth_date = 4;
+  Breed breed = 5 [deprecated = true];
+  oneof home {
+    string shelter = 6;
+    string owner = 7;
+  }
+}
+
+enum Breed {
+  BREED_UNSPECIFIED = 0;
+  CALICO = 1;
+}
+
+message GetKittenRequest {
+  int64 id = 1;
+}
+
+service KittenService {
+  // Returns a kitten by its ID.
+  rpc GetKitten(GetKittenRequest) returns (Kitten);
+  rpc WatchKittens(stream GetKittenRequest) returns (stream Kitten);
+}
+`
+
+func TestParseProto(t *testing.T) {
+	f, err := parseProto([]byte(protoTestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Package != "kittn.v1" || f.Comment != "Kittn keeps track of kittens." {
+		t.Errorf("unexpected package %q, comment %q", f.Package, f.Comment)
+	}
+	var kitten *protoMessage
+	for _, m := range f.Messages {
+		if m.FullName == "kittn.v1.Kitten" {
+			kitten = m
+		}
+	}
+	if kitten == nil {
+		t.Fatal("message Kitten is missing")
+	}
+	fields := make(map[string]*protoField)
+	for _, field := range kitten.Fields {
+		fields[field.Name] = field
+	}
+	if f := fields["display_name"]; f == nil || f.JSONName != "displayName" || f.Comment != "Name shown to adopters." {
+		t.Errorf("unexpected field display_name %+v", f)
+	}
+	if f := fields["toys"]; f == nil || f.KeyType != "string" || f.Type != "Toy" {
+		t.Errorf("unexpected map field toys %+v", f)
+	}
+	if f := fields["breed"]; f == nil || !f.Deprecated {
+		t.Errorf("expected field breed to be deprecated, got %+v", f)
+	}
+	if f := fields["owner"]; f == nil || f.OneOf != "home" {
+		t.Errorf("expected field owner of oneof home, got %+v", f)
+	}
+	if len(f.Services) != 1 || len(f.Services[0].Methods) != 2 {
+		t.Fatalf("expected service KittenService with 2 methods, got %+v", f.Services)
+	}
+	if m := f.Services[0].Methods[1]; !m.ClientStreaming || !m.ServerStreaming {
+		t.Errorf("expected WatchKittens to be bidirectional streaming, got %+v", m)
+	}
+	if _, err = parseProto([]byte("syntax = \"proto3\";\n\nmessage Kitten {\n  int64 id = ;\n}\n")); err == nil || !strings.HasPrefix(err.Error(), "line 4:") {
+		t.Errorf("expected an error at line 4, got %v", err)
+	}
+}
+
+func TestImportProto(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	proto := filepath.Join(dir, "kittn.proto")
+	if err = ioutil.WriteFile(proto, []byte(protoTestFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(dir, "doc")
+	if err = ImportProto(proto, target, ImportOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(target, "includes", "_kittenservice.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"## GetKitten\n",
+		"grpcurl -d '{\n  \"id\": \"10\"\n}' \\\n  localhost:50051 kittn.v1.KittenService/GetKitten",
+		"\"displayName\": \"string\"",
+		"\"birthDate\": \"1970-01-01T00:00:00Z\"",
+		"Returns a kitten by its ID.",
+		"Bidirectional streaming",
+	} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("expected %q in:\n%s", s, data)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// sampleRequest is an HTTP request to render as a code sample
+type sampleRequest struct {
+	Method  string
+	URL     string
+	Headers []sampleHeader
+	Body    string
+}
+
+type sampleHeader struct {
+	Name  string
+	Value string
+}
+
+// sampleLanguages lists languages writeCodeSamples supports
+var sampleLanguages = map[string]func(*bytes.Buffer, *sampleRequest){
+	"shell":      writeShellSample,
+	"bash":       writeShellSample,
+	"sh":         writeShellSample,
+	"http":       writeHTTPSample,
+	"python":     writePythonSample,
+	"javascript": writeJavaScriptSample,
+	"js":         writeJavaScriptSample,
+	"ruby":       writeRubySample,
+	"go":         writeGoSample,
+}
+
+// writeCodeSamples writes a fenced code block with the request
+// for each of the supported languages
+func writeCodeSamples(buf *bytes.Buffer, langs []string, req *sampleRequest) {
+	for _, lang := range langs {
+		if fn, ok := sampleLanguages[lang]; ok {
+			fmt.Fprintf(buf, "```%s\n", lang)
+			fn(buf, req)
+			buf.WriteString("```\n\n")
+		}
+	}
+}
+
+func (r *sampleRequest) method() string {
+	if r.Method == "" {
+		return "GET"
+	}
+	return strings.ToUpper(r.Method)
+}
+
+func shellQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`")
+	return `"` + r.Replace(s) + `"`
+}
+
+func writeShellSample(buf *bytes.Buffer, r *sampleRequest) {
+	buf.WriteString("curl")
+	if m := r.method(); r.Body == "" && m != "GET" || r.Body != "" && m != "POST" {
+		buf.WriteString(" -X " + m)
+	}
+	buf.WriteString(" " + shellQuote(r.URL))
+	for _, h := range r.Headers {
+		buf.WriteString(" \\\n  -H " + shellQuote(h.Name+": "+h.Value))
+	}
+	if r.Body != "" {
+		buf.WriteString(" \\\n  -d '" + strings.Replace(r.Body, "'", `'\''`, -1) + "'")
+	}
+	buf.WriteByte('\n')
+}
+
+func writeHTTPSample(buf *bytes.Buffer, r *sampleRequest) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		fmt.Fprintf(buf, "%s %s HTTP/1.1\n", r.method(), r.URL)
+	} else {
+		fmt.Fprintf(buf, "%s %s HTTP/1.1\n", r.method(), u.RequestURI())
+		if u.Host != "" {
+			fmt.Fprintf(buf, "Host: %s\n", u.Host)
+		}
+	}
+	for _, h := range r.Headers {
+		fmt.Fprintf(buf, "%s: %s\n", h.Name, h.Value)
+	}
+	if r.Body != "" {
+		buf.WriteString("\n" + r.Body + "\n")
+	}
+}
+
+func writePythonSample(buf *bytes.Buffer, r *sampleRequest) {
+	buf.WriteString("import requests\n\n")
+	args := strconv.Quote(r.URL)
+	if len(r.Headers) > 0 {
+		buf.WriteString("headers = {\n")
+		for _, h := range r.Headers {
+			fmt.Fprintf(buf, "    %s: %s,\n", strconv.Quote(h.Name), strconv.Quote(h.Value))
+		}
+		buf.WriteString("}\n")
+		args += ", headers=headers"
+	}
+	if r.Body != "" {
+		body := strings.NewReplacer(`\`, `\\`, `"""`, `\"\"\"`).Replace(r.Body)
+		buf.WriteString("data = \"\"\"" + body + "\"\"\"\n")
+		args += ", data=data"
+	}
+	fmt.Fprintf(buf, "\nr = requests.request(%s, %s)\n", strconv.Quote(r.method()), args)
+	buf.WriteString("print(r.text)\n")
+}
+
+func writeJavaScriptSample(buf *bytes.Buffer, r *sampleRequest) {
+	fmt.Fprintf(buf, "const response = await fetch(%s, {\n", strconv.Quote(r.URL))
+	fmt.Fprintf(buf, "  method: %s", strconv.Quote(r.method()))
+	if len(r.Headers) > 0 {
+		buf.WriteString(",\n  headers: {\n")
+		for i, h := range r.Headers {
+			fmt.Fprintf(buf, "    %s: %s", strconv.Quote(h.Name), strconv.Quote(h.Value))
+			if i < len(r.Headers)-1 {
+				buf.WriteByte(',')
+			}
+			buf.WriteByte('\n')
+		}
+		buf.WriteString("  }")
+	}
+	if r.Body != "" {
+		body := strings.NewReplacer("\\", "\\\\", "`", "\\`", "${", "\\${").Replace(r.Body)
+		buf.WriteString(",\n  body: `" + body + "`")
+	}
+	buf.WriteString("\n});\nconsole.log(await response.text());\n")
+}
+
+func writeRubySample(buf *bytes.Buffer, r *sampleRequest) {
+	m := r.method()
+	buf.WriteString("require 'net/http'\nrequire 'uri'\n\n")
+	fmt.Fprintf(buf, "uri = URI(%s)\n", strconv.Quote(r.URL))
+	fmt.Fprintf(buf, "request = Net::HTTP::%s.new(uri)\n", m[:1]+strings.ToLower(m[1:]))
+	for _, h := range r.Headers {
+		fmt.Fprintf(buf, "request[%s] = %s\n", strconv.Quote(h.Name), strconv.Quote(h.Value))
+	}
+	if r.Body != "" {
+		buf.WriteString("request.body = <<~'BODY'\n")
+		for _, line := range strings.Split(r.Body, "\n") {
+			buf.WriteString("  " + line + "\n")
+		}
+		buf.WriteString("BODY\n")
+	}
+	buf.WriteString("\nresponse = Net::HTTP.start(uri.hostname, uri.port, use_ssl: uri.scheme == 'https') do |http|\n")
+	buf.WriteString("  http.request(request)\nend\nputs response.body\n")
+}
+
+func writeGoSample(buf *bytes.Buffer, r *sampleRequest) {
+	buf.WriteString("package main\n\nimport (\n\t\"fmt\"\n\t\"io/ioutil\"\n\t\"net/http\"\n")
+	body := "nil"
+	if r.Body != "" {
+		buf.WriteString("\t\"strings\"\n")
+		if strings.Contains(r.Body, "`") {
+			body = "strings.NewReader(" + strconv.Quote(r.Body) + ")"
+		} else {
+			body = "strings.NewReader(`" + r.Body + "`)"
+		}
+	}
+	buf.WriteString(")\n\nfunc main() {\n")
+	fmt.Fprintf(buf, "\treq, err := http.NewRequest(%s, %s, %s)\n", strconv.Quote(r.method()), strconv.Quote(r.URL), body)
+	buf.WriteString("\tif err != nil {\n\t\tpanic(err)\n\t}\n")
+	for _, h := range r.Headers {
+		fmt.Fprintf(buf, "\treq.Header.Set(%s, %s)\n", strconv.Quote(h.Name), strconv.Quote(h.Value))
+	}
+	buf.WriteString("\tresp, err := http.DefaultClient.Do(req)\n\tif err != nil {\n\t\tpanic(err)\n\t}\n")
+	buf.WriteString("\tdefer resp.Body.Close()\n\tdata, _ := ioutil.ReadAll(resp.Body)\n\tfmt.Println(string(data))\n}\n")
+}
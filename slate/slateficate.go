@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"github.com/spf13/afero"
 	"io/ioutil"
+	"path/filepath"
+	"gopkg.in/yaml.v2"
 )
 
 func makeTargetDirs(fs *afero.Afero, dirs ...string) error {
@@ -22,18 +24,36 @@ func makeTargetDirs(fs *afero.Afero, dirs ...string) error {
 
 // Configuration
 type Params struct {
-	MinifyHTML bool   // produce compact HTML
-	MinifyJS   bool   // minify Javascript
-	MinifyCSS  bool   // produce compact CSS
-	StyleFile  string // load SCSS overrides
-	LogoFile   string // use this logo (which should be located in images/ directory)
-	Search     *bool  // if nil, use the default from index.html.md preamble
-	RTL        *bool  // Right-to-Left CSS, if nil, use the default from index.html.md preamble
+	MinifyHTML   bool              // produce compact HTML
+	MinifyJS     bool              // minify Javascript
+	MinifyCSS    bool              // produce compact CSS
+	StyleFile    string            // load SCSS overrides
+	LogoFile     string            // use this logo (which should be located in images/ directory)
+	Search       *bool             // if nil, use the default from index.html.md preamble
+	RTL          *bool             // Right-to-Left CSS, if nil, use the default from index.html.md preamble
+	OpenAPI      string            // render operations from this API spec file, relative to the source directory (overrides openapi option in preamble)
+	AsyncAPI     string            // render events from this AsyncAPI spec file, relative to the source directory (overrides asyncapi option in preamble)
+	Export       *bool             // write Postman collection and OpenAPI document built from shell samples, if nil, use the default from index.html.md preamble
+	Split        string            // split output to a page per "h1" section or per "includes" file, "none" for a single page (overrides split option in preamble)
+	Versions     []Version         // render these versions instead of versions.yaml of the source directory
+	Latest       string            // the latest version, if empty, use the default from versions.yaml or the last version
+	IncludePaths []string          // directories to search for include files, before include_paths of the preamble
+	Variant      string            // audience to render documentation for, "public" if empty
+	Vars         map[string]string // template variables, override vars option in preamble and environment variables
+	Strict       bool              // fail on undefined template variables and broken links
+	Placeholders bool              // keep runtime placeholders to substitute when serving, rather than render their default values
 }
 
 // Go Slate!
 func Slateficate(src string, target *afero.Afero, params Params) error {
 	var err error
+	versions, latest, err := readVersions(src, params)
+	if err != nil {
+		return err
+	}
+	if len(versions) > 0 {
+		return slateficateVersions(src, versions, latest, target, params)
+	}
 	fs, err := slate.NewUnionFS(src)
 	if err != nil {
 		return err
@@ -42,19 +62,92 @@ func Slateficate(src string, target *afero.Afero, params Params) error {
 	if err != nil {
 		return err
 	}
-	input, err := load(fs, params)
+	docs, err := loadLocales(fs, params, placement{Source: src})
 	if err != nil {
 		return err
 	}
-	if err = input.produce(target, params.MinifyHTML); err != nil {
+	if err = produceLocales(fs, docs, target, params); err != nil {
 		return err
 	}
-	if err = copyJavaScripts(fs, target, input.Params.Search, params.MinifyJS); err != nil {
+	if err = produceScripts(fs, target, docs, params.MinifyJS, make(map[bool]bool)); err != nil {
 		return err
 	}
+	if err = produceStylesheets(fs, target, docs, params); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WatchPaths returns directories and files the documentation in src is rendered from,
+// to monitor for changes: the source directories, include paths and API spec files,
+// which may be outside of src. Sources read at git refs are not watched.
+func WatchPaths(src string, params Params) []string {
+	ret := append([]string{src}, params.IncludePaths...)
+	dirs := []string{src}
+	if versions, _, err := readVersions(src, params); err == nil && len(versions) > 0 {
+		dirs = nil
+		for _, v := range versions {
+			if v.Ref == "" {
+				dirs = append(dirs, filepath.Join(src, v.Source))
+			}
+		}
+	}
+	inDir := func(dir, name string) string {
+		if name == "" || filepath.IsAbs(name) {
+			return name
+		}
+		return filepath.Join(dir, name)
+	}
+	for _, dir := range dirs {
+		if dir != src {
+			ret = append(ret, dir)
+		}
+		ret = append(ret, inDir(dir, params.OpenAPI), inDir(dir, params.AsyncAPI))
+		sources, _ := filepath.Glob(filepath.Join(dir, "index*.html.md"))
+		for _, name := range sources {
+			var content ContentParams
+			data, err := ioutil.ReadFile(name)
+			if err != nil {
+				continue
+			}
+			preamble, _ := splitPreamble(data)
+			if yaml.Unmarshal(preamble, &content) != nil {
+				continue
+			}
+			for _, p := range content.IncludePaths {
+				ret = append(ret, inDir(dir, p))
+			}
+			ret = append(ret, inDir(dir, content.OpenAPI), inDir(dir, content.AsyncAPI))
+		}
+	}
+	var paths []string
+	for _, p := range ret {
+		if p != "" {
+			paths = appendUnique(paths, p)
+		}
+	}
+	return paths
+}
+
+// produceScripts compiles javascripts with or without search, as required
+// by documents, unless already done
+func produceScripts(fs slate.FileSystem, target *afero.Afero, docs []*content, minifyJS bool, done map[bool]bool) error {
+	for _, c := range docs {
+		if !done[c.Params.Search] {
+			done[c.Params.Search] = true
+			if err := copyJavaScripts(fs, target, c.Params.Search, minifyJS); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func produceStylesheets(fs slate.FileSystem, target *afero.Afero, docs []*content, params Params) error {
+	content := &docs[0].Params
 	var styles []string
-	if input.Params.Style != "" {
-		styles = append(styles, input.Params.Style)
+	if content.Style != "" {
+		styles = append(styles, content.Style)
 	}
 	if params.StyleFile != "" {
 		file, err := os.OpenFile(params.StyleFile, os.O_RDONLY, 0)
@@ -68,13 +161,21 @@ func Slateficate(src string, target *afero.Afero, params Params) error {
 		}
 		styles = append(styles, string(data))
 	}
-	if err = copyStylesheetsAndFonts(fs, target, styles, input.Params.RTLEnabled, params.MinifyCSS); err != nil {
-		return err
+	return copyStylesheetsAndFonts(fs, target, styles, rtlEnabled(docs, params), params.MinifyCSS)
+}
+
+// rtlEnabled reports whether stylesheets support right-to-left scripts: either set
+// with params, or enabled by the preamble or written right to left for some locale
+func rtlEnabled(docs []*content, params Params) bool {
+	if params.RTL != nil {
+		return *params.RTL
 	}
-	if err = copyImages(fs, target, input.Params.Logo); err != nil {
-		return err
+	for _, d := range docs {
+		if d.Params.RTLEnabled || d.dir == "rtl" {
+			return true
+		}
 	}
-	return nil
+	return false
 }
 
 // Extract embedded slate components to target
@@ -0,0 +1,175 @@
+package slate
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/russross/blackfriday/v2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Styles of heading IDs generated from heading text
+const (
+	slugBlackfriday = "blackfriday" // default, like Getting Started! -> getting-started
+	slugRubySlate   = "ruby-slate"  // compatible with Ruby Slate (middleman, Redcarpet)
+	slugGitHub      = "github"      // compatible with GitHub rendering of markdown
+	slugUnicode     = "unicode"     // letters, marks and digits of any script are kept
+)
+
+func checkSlugStyle(style string) error {
+	switch style {
+	case "", slugBlackfriday, slugRubySlate, slugGitHub, slugUnicode:
+		return nil
+	}
+	return fmt.Errorf("unknown slug style %s, expected blackfriday, ruby-slate, github or unicode", style)
+}
+
+// htmlTagRE matches HTML tags of rendered heading text
+var htmlTagRE = regexp.MustCompile(`<[^>]*>`)
+
+// transliterations of letters Unicode does not decompose to ASCII ones
+var transliterations = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "Æ", "AE", "œ", "oe", "Œ", "OE", "ø", "o", "Ø", "O",
+	"đ", "d", "Đ", "D", "ð", "d", "Ð", "D", "ł", "l", "Ł", "L", "þ", "th", "Þ", "TH",
+)
+
+// parameterizeRE matches runs of characters ActiveSupport parameterize replaces with a dash
+var parameterizeRE = regexp.MustCompile(`[^a-zA-Z0-9\-_]+`)
+
+// rubySlateSlug returns the ID Ruby Slate gives a heading: ActiveSupport parameterize
+// of the rendered HTML text with tags stripped, or the hash of the text if nothing is left
+func rubySlateSlug(html string) string {
+	// Redcarpet escapes apostrophes, blackfriday does not
+	html = strings.Replace(html, "'", "&#39;", -1)
+	text := norm.NFKD.String(transliterations.Replace(htmlTagRE.ReplaceAllString(html, "")))
+	text = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		} else if r > unicode.MaxASCII {
+			return '?'
+		}
+		return r
+	}, text)
+	text = parameterizeRE.ReplaceAllString(text, "-")
+	for strings.Contains(text, "--") {
+		text = strings.Replace(text, "--", "-", -1)
+	}
+	text = strings.ToLower(strings.Trim(text, "-"))
+	if strings.TrimSpace(text) == "" {
+		sum := sha1.Sum([]byte(html))
+		return hex.EncodeToString(sum[:])[:10]
+	}
+	return text
+}
+
+// gitHubSlug returns the ID GitHub gives a heading: the lowercase text without
+// punctuation, spaces replaced with dashes
+func gitHubSlug(text string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == ' ':
+			return '-'
+		case r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, text)
+}
+
+// unicodeSlug returns the lowercase text with runs of other characters than letters,
+// marks and digits replaced with a dash
+func unicodeSlug(text string) string {
+	var buf strings.Builder
+	dash := false
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) {
+			if dash && buf.Len() > 0 {
+				buf.WriteByte('-')
+			}
+			buf.WriteRune(unicode.ToLower(r))
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return buf.String()
+}
+
+// customHeadingID reports whether the heading ID is set explicitly, like {#errors},
+// rather than generated by the parser
+func customHeadingID(node *blackfriday.Node) bool {
+	return node.HeadingID != "" && node.HeadingID != blackfriday.SanitizedAnchorName(markdownInline(node))
+}
+
+// headingSlug returns the ID the slug style generates from the text of a heading
+func headingSlug(r blackfriday.Renderer, node *blackfriday.Node, style string) string {
+	switch style {
+	case slugRubySlate:
+		var buf bytes.Buffer
+		for c := node.FirstChild; c != nil; c = c.Next {
+			c.Walk(func(n *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+				return r.RenderNode(&buf, n, entering)
+			})
+		}
+		return rubySlateSlug(buf.String())
+	case slugGitHub:
+		return gitHubSlug(plainText(node))
+	case slugUnicode:
+		return unicodeSlug(plainText(node))
+	}
+	return blackfriday.SanitizedAnchorName(markdownInline(node))
+}
+
+// assignHeadingIDs sets IDs of headings of a document according to the slug style, so
+// the table of contents, links and rendered headings agree. Explicit IDs are kept as they
+// are; a generated ID already taken gets the lowest free numeric suffix, starting with -2
+// for Ruby Slate and with -1 otherwise.
+func assignHeadingIDs(r blackfriday.Renderer, ast *blackfriday.Node, style string) {
+	var headings []*blackfriday.Node
+	custom := make(map[*blackfriday.Node]bool)
+	taken := make(map[string]bool)
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && node.Type == blackfriday.Heading && !node.IsTitleblock {
+			headings = append(headings, node)
+			if customHeadingID(node) && !taken[node.HeadingID] {
+				custom[node] = true
+				taken[node.HeadingID] = true
+			}
+			return blackfriday.SkipChildren
+		}
+		return blackfriday.GoToNext
+	})
+	first := 1
+	if style == slugRubySlate {
+		first = 2
+	}
+	for _, node := range headings {
+		if custom[node] {
+			continue
+		}
+		id := node.HeadingID
+		if !customHeadingID(node) && style != "" && style != slugBlackfriday {
+			id = headingSlug(r, node, style)
+		}
+		if id == "" && (style == "" || style == slugBlackfriday) {
+			continue
+		} else if id == "" {
+			id = "section"
+		}
+		if taken[id] {
+			for n := first; ; n++ {
+				if s := fmt.Sprintf("%s-%d", id, n); !taken[s] {
+					id = s
+					break
+				}
+			}
+		}
+		taken[id] = true
+		node.HeadingID = id
+	}
+}
@@ -0,0 +1,135 @@
+package slate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// slugHeadings are headings of the golden tests, one per line
+var slugHeadings = []string{
+	"Get All Kittens",
+	"Kittens & Puppies",
+	"Kitten's Toys",
+	"Crème Brûlée",
+	"C++ API",
+	"snake_case name",
+	"`GET /kittens`",
+	"Version 2.0",
+	"Errors",
+	"Errors",
+	"Errors",
+	"Status {#errors-2}",
+	"小猫",
+	"Котята",
+	"API 概要",
+	"हिन्दी पाठ",
+}
+
+// slugGolden are expected IDs of slugHeadings for each slug style. Ruby Slate IDs are
+// those of middleman with Redcarpet and the UniqueHeadCounter renderer, GitHub IDs are
+// those of github-slugger.
+var slugGolden = map[string][]string{
+	slugBlackfriday: {
+		"get-all-kittens",
+		"kittens-puppies",
+		"kitten-s-toys",
+		"crème-brûlée",
+		"c-api",
+		"snake-case-name",
+		"get-kittens",
+		"version-2-0",
+		"errors",
+		"errors-1",
+		"errors-3",
+		"errors-2",
+		"小猫",
+		"котята",
+		"api-概要",
+		"ह-न-द-प-ठ",
+	},
+	slugRubySlate: {
+		"get-all-kittens",
+		"kittens-amp-puppies",
+		"kitten-39-s-toys",
+		"creme-brulee",
+		"c-api",
+		"snake_case-name",
+		"get-kittens",
+		"version-2-0",
+		"errors",
+		"errors-3",
+		"errors-4",
+		"errors-2",
+		"3e5be3b89a",
+		"80862afe61",
+		"api",
+		"2341047726",
+	},
+	slugGitHub: {
+		"get-all-kittens",
+		"kittens--puppies",
+		"kittens-toys",
+		"crème-brûlée",
+		"c-api",
+		"snake_case-name",
+		"get-kittens",
+		"version-20",
+		"errors",
+		"errors-1",
+		"errors-3",
+		"errors-2",
+		"小猫",
+		"котята",
+		"api-概要",
+		"हिन्दी-पाठ",
+	},
+	slugUnicode: {
+		"get-all-kittens",
+		"kittens-puppies",
+		"kitten-s-toys",
+		"crème-brûlée",
+		"c-api",
+		"snake-case-name",
+		"get-kittens",
+		"version-2-0",
+		"errors",
+		"errors-1",
+		"errors-3",
+		"errors-2",
+		"小猫",
+		"котята",
+		"api-概要",
+		"हिन्दी-पाठ",
+	},
+}
+
+// headingIDs returns IDs of headings of a markdown document assigned in the slug style
+func headingIDs(markdown, style string) []string {
+	ast := parseMarkdown([]byte(markdown))
+	assignHeadingIDs(blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{}), ast, style)
+	var ids []string
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && node.Type == blackfriday.Heading {
+			ids = append(ids, node.HeadingID)
+		}
+		return blackfriday.GoToNext
+	})
+	return ids
+}
+
+func TestSlugStyles(t *testing.T) {
+	markdown := "# " + strings.Join(slugHeadings, "\n\n# ") + "\n"
+	for style, golden := range slugGolden {
+		ids := headingIDs(markdown, style)
+		if len(ids) != len(golden) {
+			t.Fatalf("%s: expected %d headings, got %v", style, len(golden), ids)
+		}
+		for i, id := range ids {
+			if id != golden[i] {
+				t.Errorf("%s: %q: expected %s, got %s", style, slugHeadings[i], golden[i], id)
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package slate
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// readSwagger converts a Swagger 2.0 document to apiSpec
+func readSwagger(doc *specDocument) (*apiSpec, error) {
+	spec := &apiSpec{}
+	info := specMap(specGet(doc.root, "info"))
+	spec.Title = specString(info, "title")
+	spec.Version = specString(info, "version")
+	spec.Description = specString(info, "description")
+	if host := specString(doc.root, "host"); host != "" {
+		schemes := specStrings(doc.root, "schemes")
+		if len(schemes) == 0 {
+			schemes = []string{"https"}
+		}
+		for _, scheme := range schemes {
+			spec.Servers = append(spec.Servers, strings.TrimSuffix(scheme+"://"+host+specString(doc.root, "basePath"), "/"))
+		}
+	} else if basePath := specString(doc.root, "basePath"); basePath != "" {
+		spec.Servers = append(spec.Servers, strings.TrimSuffix(basePath, "/"))
+	}
+	for _, t := range specList(specGet(doc.root, "tags")) {
+		t := specMap(t)
+		spec.Tags = append(spec.Tags, apiTag{
+			Name:        specString(t, "name"),
+			Description: specString(t, "description"),
+			Audience:    specAudience(t),
+		})
+	}
+	for _, item := range specMap(specGet(doc.root, "securityDefinitions")) {
+		m := specMap(item.Value)
+		sc := &apiSecurityScheme{
+			Name:        fmt.Sprint(item.Key),
+			Type:        specString(m, "type"),
+			In:          specString(m, "in"),
+			ParamName:   specString(m, "name"),
+			Description: specString(m, "description"),
+		}
+		if sc.Type == "basic" {
+			sc.Type, sc.Scheme = "http", "basic"
+		}
+		spec.Security = append(spec.Security, sc)
+	}
+	schemas := newSchemaReader(doc, "#/definitions/")
+	defaultSecurity := securityRequirements(specGet(doc.root, "security"))
+	consumes := specStrings(doc.root, "consumes")
+	produces := specStrings(doc.root, "produces")
+	for _, item := range specMap(specGet(doc.root, "paths")) {
+		path := fmt.Sprint(item.Key)
+		pathItem := doc.resolve(item.Value)
+		commonParams := specList(specGet(pathItem, "parameters"))
+		for _, method := range httpMethods {
+			m := specMap(specGet(pathItem, method))
+			if m == nil {
+				continue
+			}
+			op := &apiOperation{
+				ID:          specString(m, "operationId"),
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				Summary:     specString(m, "summary"),
+				Description: specString(m, "description"),
+				Tags:        specStrings(m, "tags"),
+				Deprecated:  specBool(m, "deprecated"),
+				Audience:    specAudience(m),
+				Security:    defaultSecurity,
+				Consumes:    consumes,
+				Produces:    produces,
+			}
+			if sec := specGet(m, "security"); sec != nil {
+				op.Security = securityRequirements(sec)
+			}
+			if specGet(m, "consumes") != nil {
+				op.Consumes = specStrings(m, "consumes")
+			}
+			if specGet(m, "produces") != nil {
+				op.Produces = specStrings(m, "produces")
+			}
+			readSwaggerParameters(doc, schemas, op, commonParams, specList(specGet(m, "parameters")))
+			for _, r := range specMap(specGet(m, "responses")) {
+				resp := doc.resolve(r.Value)
+				ar := &apiResponse{
+					Status:      fmt.Sprint(r.Key),
+					Description: specString(resp, "description"),
+					Schema:      schemas.schema(specGet(resp, "schema")),
+				}
+				if ar.Schema != nil && len(op.Produces) > 0 {
+					ar.ContentType = preferredContentType(op.Produces)
+				} else if ar.Schema != nil {
+					ar.ContentType = "application/json"
+				}
+				examples := specMap(specGet(resp, "examples"))
+				if ex := specGet(examples, ar.ContentType); ex != nil {
+					ar.Example = ex
+				} else if len(examples) > 0 {
+					ar.ContentType = fmt.Sprint(examples[0].Key)
+					ar.Example = examples[0].Value
+				}
+				op.Responses = append(op.Responses, ar)
+			}
+			spec.Operations = append(spec.Operations, op)
+		}
+	}
+	spec.Schemas = schemas.readAll()
+	return spec, nil
+}
+
+// readSwaggerParameters converts Swagger parameters, turning body
+// and formData parameters into the operation request body
+func readSwaggerParameters(doc *specDocument, schemas *schemaReader, op *apiOperation, lists ...[]interface{}) {
+	var form *apiSchema
+	multipart := false
+	index := make(map[string]int)
+	for _, list := range lists {
+		for _, p := range list {
+			m := doc.resolve(p)
+			in := specString(m, "in")
+			switch in {
+			case "body":
+				op.RequestBody = &apiBody{
+					Description: specString(m, "description"),
+					Required:    specBool(m, "required"),
+					ContentType: "application/json",
+					Schema:      schemas.schema(specGet(m, "schema")),
+					Example:     specGet(m, "x-example"),
+				}
+				if len(op.Consumes) > 0 {
+					op.RequestBody.ContentType = preferredContentType(op.Consumes)
+				}
+				continue
+			case "formData":
+				if form == nil {
+					form = &apiSchema{Type: "object"}
+				}
+				if specString(m, "type") == "file" {
+					multipart = true
+				}
+				form.Properties = append(form.Properties, &apiProperty{
+					Name:     specString(m, "name"),
+					Required: specBool(m, "required"),
+					Schema:   swaggerParameterSchema(schemas, m),
+				})
+				continue
+			}
+			param := &apiParameter{
+				Name:        specString(m, "name"),
+				In:          in,
+				Description: specString(m, "description"),
+				Required:    specBool(m, "required"),
+				Schema:      swaggerParameterSchema(schemas, m),
+				Example:     specGet(m, "x-example"),
+			}
+			key := param.In + ":" + param.Name
+			if n, ok := index[key]; ok {
+				op.Parameters[n] = param
+			} else {
+				index[key] = len(op.Parameters)
+				op.Parameters = append(op.Parameters, param)
+			}
+		}
+	}
+	if form != nil {
+		ct := "application/x-www-form-urlencoded"
+		for _, c := range op.Consumes {
+			if c == "multipart/form-data" {
+				multipart = true
+			}
+		}
+		if multipart {
+			ct = "multipart/form-data"
+		}
+		op.RequestBody = &apiBody{ContentType: ct, Schema: form}
+	}
+}
+
+// swaggerParameterSchema builds a schema from type related
+// fields of a non-body parameter
+func swaggerParameterSchema(schemas *schemaReader, m yaml.MapSlice) *apiSchema {
+	s := yaml.MapSlice{}
+	for _, key := range []string{"type", "format", "items", "enum", "default"} {
+		if v := specGet(m, key); v != nil {
+			s = append(s, yaml.MapItem{Key: key, Value: v})
+		}
+	}
+	ret := schemas.schema(s)
+	if ret.Type == "file" {
+		ret.Type, ret.Format = "string", "binary"
+	}
+	return ret
+}
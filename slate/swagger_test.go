@@ -0,0 +1,78 @@
+package slate
+
+import "testing"
+
+const swaggerTestSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "Kittn", "version": "1.0"},
+  "host": "api.kittn.com",
+  "basePath": "/v1/",
+  "schemes": ["https", "http"],
+  "produces": ["application/json"],
+  "securityDefinitions": {"basicAuth": {"type": "basic"}},
+  "security": [{"basicAuth": []}],
+  "paths": {
+    "/kittens/{id}": {
+      "parameters": [{"name": "id", "in": "path", "required": true, "type": "integer"}],
+      "put": {
+        "operationId": "updateKitten",
+        "parameters": [{"name": "kitten", "in": "body", "required": true, "schema": {"$ref": "#/definitions/Kitten"}}],
+        "responses": {"200": {"description": "The kitten", "schema": {"$ref": "#/definitions/Kitten"}}}
+      }
+    },
+    "/kittens/{id}/photo": {
+      "post": {
+        "operationId": "uploadPhoto",
+        "security": [],
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "type": "integer"},
+          {"name": "photo", "in": "formData", "required": true, "type": "file"}
+        ],
+        "responses": {"204": {"description": "Uploaded"}}
+      }
+    }
+  },
+  "definitions": {
+    "Kitten": {"type": "object", "properties": {"name": {"type": "string"}}}
+  }
+}`
+
+func TestReadSwagger(t *testing.T) {
+	spec, err := loadAPISpec([]byte(swaggerTestSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Servers) != 2 || spec.Servers[0] != "https://api.kittn.com/v1" || spec.Servers[1] != "http://api.kittn.com/v1" {
+		t.Errorf("unexpected servers %q", spec.Servers)
+	}
+	if len(spec.Security) != 1 || spec.Security[0].Type != "http" || spec.Security[0].Scheme != "basic" {
+		t.Errorf("expected basic authentication to become an http scheme, got %+v", spec.Security)
+	}
+	if len(spec.Operations) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(spec.Operations))
+	}
+	update := spec.Operations[0]
+	if len(update.Parameters) != 1 || update.Parameters[0].Name != "id" || update.Parameters[0].Schema.Type != "integer" {
+		t.Errorf("expected the path parameter id, got %+v", update.Parameters)
+	}
+	if b := update.RequestBody; b == nil || b.ContentType != "application/json" || b.Schema.Name != "Kitten" || !b.Required {
+		t.Errorf("expected the body parameter to become the request body, got %+v", b)
+	}
+	if r := update.Responses[0]; r.ContentType != "application/json" || r.Schema.Name != "Kitten" {
+		t.Errorf("unexpected response %+v", r)
+	}
+	if len(update.Security) != 1 || update.Security[0] != "basicAuth" {
+		t.Errorf("expected the default security, got %q", update.Security)
+	}
+	upload := spec.Operations[1]
+	if b := upload.RequestBody; b == nil || b.ContentType != "multipart/form-data" || len(b.Schema.Properties) != 1 ||
+		b.Schema.Properties[0].Schema.Type != "string" || b.Schema.Properties[0].Schema.Format != "binary" {
+		t.Errorf("expected a file form parameter to become a multipart body, got %+v", b)
+	}
+	if len(upload.Security) != 0 {
+		t.Errorf("expected no security, got %q", upload.Security)
+	}
+	if len(spec.Schemas) != 1 || spec.Schemas[0].Name != "Kitten" {
+		t.Errorf("expected the Kitten definition, got %+v", spec.Schemas)
+	}
+}
@@ -0,0 +1,99 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// varsEnvPrefix is the prefix of environment variables setting template
+// variables, SLATE_VAR_base_url sets base_url
+const varsEnvPrefix = "SLATE_VAR_"
+
+// markdownVars are template variables of markdown sources
+type markdownVars struct {
+	vars   map[string]string
+	strict bool // fail on undefined variables rather than render them empty
+}
+
+// envVars returns template variables set by environment variables
+func envVars() map[string]string {
+	ret := make(map[string]string)
+	for _, env := range os.Environ() {
+		if strings.HasPrefix(env, varsEnvPrefix) {
+			if i := strings.IndexByte(env, '='); i > len(varsEnvPrefix) {
+				ret[env[len(varsEnvPrefix):i]] = env[i+1:]
+			}
+		}
+	}
+	return ret
+}
+
+// newMarkdownVars returns variables of the preamble vars option, overridden by
+// environment variables, overridden by params. Markdown sources are not processed
+// as templates, returning nil, unless either the preamble or params set variables:
+// environment variables alone would change every {{ of code samples.
+func newMarkdownVars(content *ContentParams, params Params) *markdownVars {
+	env := envVars()
+	if content.Vars == nil && len(params.Vars) == 0 {
+		if len(env) > 0 {
+			log.Printf("%s environment variables are ignored, the preamble sets no vars", varsEnvPrefix)
+		}
+		return nil
+	}
+	v := &markdownVars{vars: make(map[string]string), strict: params.Strict}
+	for k, val := range content.Vars {
+		v.vars[k] = val
+	}
+	for k, val := range env {
+		v.vars[k] = val
+	}
+	for k, val := range params.Vars {
+		v.vars[k] = val
+	}
+	return v
+}
+
+// body returns the markdown body of a source file processed as a template, errors
+// refer to lines of the file
+func (v *markdownVars) body(name string, data []byte) ([]byte, error) {
+	preamble, body := splitPreamble(data)
+	if v == nil {
+		return body, nil
+	}
+	offset := 0
+	if bytes.HasPrefix(data, []byte("---\n")) || bytes.HasPrefix(data, []byte("---\r\n")) {
+		offset = bytes.Count(preamble, []byte("\n")) + 2
+	}
+	missing := "missingkey=zero"
+	if v.strict {
+		missing = "missingkey=error"
+	}
+	tmpl, err := template.New(name).Option(missing).Parse(string(body))
+	if err != nil {
+		return nil, fileLineError(err, name, offset)
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, v.vars); err != nil {
+		return nil, fileLineError(err, name, offset)
+	}
+	return buf.Bytes(), nil
+}
+
+// fileLineError shifts lines of the file a template error refers to, like index.html.md:12,
+// by lines of the preamble, since the body is parsed without it
+func fileLineError(err error, name string, offset int) error {
+	if offset == 0 {
+		return err
+	}
+	re := regexp.MustCompile(regexp.QuoteMeta(name) + `:(\d+)`)
+	return fmt.Errorf("%s", re.ReplaceAllStringFunc(err.Error(), func(m string) string {
+		line, _ := strconv.Atoi(m[len(name)+1:])
+		return fmt.Sprintf("%s:%d", name, line+offset)
+	}))
+}
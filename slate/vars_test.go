@@ -0,0 +1,45 @@
+package slate
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestVarsEnvironment(t *testing.T) {
+	os.Setenv(varsEnvPrefix+"product", "Kittn")
+	defer os.Unsetenv(varsEnvPrefix + "product")
+	doc := map[string]string{"index.html.md": "# Introduction\n\nWelcome to {{ .product }}.\n\n```handlebars\n<p>{{ kitten.name }}</p>\n```\n"}
+	html := renderTestDoc(t, doc, Params{})
+	if !strings.Contains(html, "Welcome to {{ .product }}.") || !strings.Contains(html, `<span class="cp">{{</span> <span class="nv">kitten</span>`) {
+		t.Errorf("expected no templating without vars in the preamble:\n%s", html)
+	}
+	doc["index.html.md"] = "---\nvars:\n  product: Puppr\n  base_url: https://api.example.com\n---\n\nWelcome to {{ .product }}, see {{ .base_url }}.\n"
+	html = renderTestDoc(t, doc, Params{})
+	if !strings.Contains(html, "Welcome to Kittn, see <a href=\"https://api.example.com\">") {
+		t.Errorf("expected the environment to override the preamble:\n%s", html)
+	}
+	html = renderTestDoc(t, doc, Params{Vars: map[string]string{"product": "Birdr"}})
+	if !strings.Contains(html, "Welcome to Birdr") {
+		t.Errorf("expected params to override the environment:\n%s", html)
+	}
+}
+
+func TestVarsBody(t *testing.T) {
+	v := &markdownVars{vars: map[string]string{"product": "Kittn"}, strict: true}
+	body, err := v.body("index.html.md", []byte("---\ntitle: API\n---\n{{- /* trimmed */ -}}\n# {{ .product }}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != "# Kittn\n" {
+		t.Errorf("expected # Kittn, got %q", body)
+	}
+	_, err = v.body("index.html.md", []byte("---\ntitle: API\n---\n\n# Intro\n\n{{ .missing }}\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "template: index.html.md:7:") {
+		t.Errorf("expected an error at line 7, got %v", err)
+	}
+	_, err = v.body("index.html.md", []byte("---\ntitle: API\n---\n# Intro\n{{ .product \n"))
+	if err == nil || !strings.HasSuffix(err.Error(), "unclosed action started at index.html.md:5") {
+		t.Errorf("expected an error of the action at line 5, got %v", err)
+	}
+}
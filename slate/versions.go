@@ -0,0 +1,138 @@
+package slate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/growler/go-slate/slate/internal/slate"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v2"
+)
+
+// Version is a documentation version rendered to a subdirectory named after it
+type Version struct {
+	Name   string `yaml:"name"`
+	Source string `yaml:"source"`        // source directory, relative to the documentation source directory
+	Ref    string `yaml:"ref,omitempty"` // read the source directory from this git ref instead of the work tree
+}
+
+// versionsConfig is the content of versions.yaml
+type versionsConfig struct {
+	Versions []Version `yaml:"versions"`
+	Latest   string    `yaml:"latest,omitempty"`
+}
+
+// latestAlias is the subdirectory holding a copy of the latest version
+const latestAlias = "latest"
+
+// placement describes where a rendered document is placed within
+// the site, available to the layout
+type placement struct {
+	Source   string // source directory, include_paths of the preamble are relative to it
+	Ref      string // git ref the source is read at, if any
+	Root     string // path from the document to the site root
+	Version  string
+	Versions []Version
+	Latest   string
+	Locale   string   // locale of a translated document, empty for the default locale
+	Locales  []string // locales of translated documents
+}
+
+// versionsIndex redirects from the site root to the latest version
+const versionsIndex = `<!doctype html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <meta http-equiv="refresh" content="0; url=%[1]s/index.html">
+</head>
+<body><a href="%[1]s/index.html">%[1]s</a></body>
+</html>
+`
+
+// readVersions returns documentation versions set by params or by versions.yaml
+// in the source directory, and the name of the latest version
+func readVersions(src string, params Params) ([]Version, string, error) {
+	config := versionsConfig{Versions: params.Versions, Latest: params.Latest}
+	if len(config.Versions) == 0 {
+		data, err := ioutil.ReadFile(filepath.Join(src, "versions.yaml"))
+		if os.IsNotExist(err) {
+			return nil, "", nil
+		} else if err != nil {
+			return nil, "", err
+		}
+		var file versionsConfig
+		if err = yaml.Unmarshal(data, &file); err != nil {
+			return nil, "", fmt.Errorf("error parsing versions.yaml: %s", err)
+		}
+		config.Versions = file.Versions
+		if config.Latest == "" {
+			config.Latest = file.Latest
+		}
+	}
+	if len(config.Versions) == 0 {
+		return nil, "", nil
+	}
+	seen := make(map[string]bool)
+	for _, v := range config.Versions {
+		switch {
+		case v.Name == "" || v.Name == "." || v.Name == ".." || v.Name == latestAlias || strings.ContainsAny(v.Name, `/\`):
+			return nil, "", fmt.Errorf("invalid version name %q", v.Name)
+		case seen[v.Name]:
+			return nil, "", fmt.Errorf("duplicate version %s", v.Name)
+		}
+		seen[v.Name] = true
+	}
+	if config.Latest == "" {
+		config.Latest = config.Versions[len(config.Versions)-1].Name
+	} else if !seen[config.Latest] {
+		return nil, "", fmt.Errorf("unknown latest version %s", config.Latest)
+	}
+	return config.Versions, config.Latest, nil
+}
+
+// slateficateVersions renders every version to a subdirectory of its own, the latest
+// one also to the latest/ subdirectory. Stylesheets and javascripts are shared by all
+// the versions and placed to the target root.
+func slateficateVersions(src string, versions []Version, latest string, target *afero.Afero, params Params) error {
+	if err := makeTargetDirs(target, "javascripts", "stylesheets", "fonts"); err != nil {
+		return err
+	}
+	scripts := make(map[bool]bool)
+	for _, v := range versions {
+		var fs slate.FileSystem
+		var err error
+		if v.Ref != "" {
+			fs, err = newGitFS(filepath.Join(src, v.Source), v.Ref)
+		} else {
+			fs, err = slate.NewUnionFS(filepath.Join(src, v.Source))
+		}
+		if err != nil {
+			return fmt.Errorf("version %s: %s", v.Name, err)
+		}
+		docs, err := loadLocales(fs, params, placement{Source: filepath.Join(src, v.Source), Ref: v.Ref, Root: "../", Version: v.Name, Versions: versions, Latest: latest})
+		if err != nil {
+			return fmt.Errorf("version %s: %s", v.Name, err)
+		}
+		dirs := []string{v.Name}
+		if v.Name == latest {
+			dirs = append(dirs, latestAlias)
+		}
+		for _, dir := range dirs {
+			if err = produceLocales(fs, docs, &afero.Afero{Fs: afero.NewBasePathFs(target.Fs, dir)}, params); err != nil {
+				return err
+			}
+		}
+		if err = produceScripts(fs, target, docs, params.MinifyJS, scripts); err != nil {
+			return err
+		}
+		if v.Name == latest {
+			if err = produceStylesheets(fs, target, docs, params); err != nil {
+				return err
+			}
+		}
+	}
+	return target.WriteFile("index.html", []byte(fmt.Sprintf(versionsIndex, latestAlias)), 0644)
+}
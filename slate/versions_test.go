@@ -0,0 +1,97 @@
+package slate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestVersionsOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"versions.yaml":    "versions:\n  - {name: v1, source: v1}\n  - {name: v2, source: v2}\n",
+		"v1/index.html.md": "---\ntitle: API v1\n---\n\n# Kittens v1\n",
+		"v2/index.html.md": "---\ntitle: API v2\n---\n\n# Kittens v2\n",
+	}
+	for name, text := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(name, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	target := &afero.Afero{Fs: afero.NewMemMapFs()}
+	if err = Slateficate(dir, target, Params{}); err != nil {
+		t.Fatal(err)
+	}
+	read := func(name string) string {
+		data, err := target.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	if index := read("index.html"); !strings.Contains(index, `url=latest/index.html`) {
+		t.Errorf("expected the site root to redirect to the latest version:\n%s", index)
+	}
+	v1, v2, latest := read("v1/index.html"), read("v2/index.html"), read("latest/index.html")
+	for _, s := range []string{
+		`<option value="../v1/index.html" selected>v1</option>`,
+		`<option value="../v2/index.html">v2 (latest)</option>`,
+		`<a href="../latest/index.html">v2</a>`,
+		"Kittens v1",
+	} {
+		if !strings.Contains(v1, s) {
+			t.Errorf("expected %s in v1/index.html:\n%s", s, v1)
+		}
+	}
+	if !strings.Contains(v2, `<option value="../v2/index.html" selected>v2 (latest)</option>`) || strings.Contains(v2, `class="warning"`) {
+		t.Errorf("expected v2 to be selected and not outdated:\n%s", v2)
+	}
+	if latest != v2 {
+		t.Errorf("expected latest/index.html to be a copy of v2/index.html")
+	}
+	// stylesheets are shared by the versions
+	if ok, _ := target.Exists("stylesheets/screen.css"); !ok {
+		t.Errorf("expected stylesheets in the site root")
+	}
+	if ok, _ := target.Exists("v1/stylesheets/screen.css"); ok {
+		t.Errorf("expected no stylesheets in the version directory")
+	}
+}
+
+func TestWatchPaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestFiles(t, dir, map[string]string{
+		"versions.yaml":       "versions:\n  - {name: v1, source: v1, ref: v1}\n  - {name: v2, source: v2}\n",
+		"v2/index.html.md":    "---\ninclude_paths: [../shared, /opt/includes]\nopenapi: api.yaml\n---\n# Kittens\n",
+		"v2/index.ja.html.md": "---\nasyncapi: /opt/events.yaml\n---\n# Kittens\n",
+	})
+	paths := WatchPaths(dir, Params{IncludePaths: []string{"/usr/include"}})
+	expected := []string{
+		dir,
+		"/usr/include",
+		filepath.Join(dir, "v2"),
+		filepath.Join(dir, "shared"),
+		"/opt/includes",
+		filepath.Join(dir, "v2", "api.yaml"),
+		"/opt/events.yaml",
+	}
+	if strings.Join(paths, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected paths\n%s\ngot\n%s", strings.Join(expected, "\n"), strings.Join(paths, "\n"))
+	}
+}
@@ -0,0 +1,199 @@
+package slate
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// xrefScheme prefixes destinations of cross-reference links, like [text](ref:Kittens/Get)
+const xrefScheme = "ref:"
+
+// xrefRE matches a cross-reference within text, like [[Get a Specific Kitten]]
+var xrefRE = regexp.MustCompile(`\[\[([^\[\]]+)\]\]`)
+
+// xrefLink returns the target of a cross-reference link
+func xrefLink(node *blackfriday.Node) (string, bool) {
+	if node.Type != blackfriday.Link || !bytes.HasPrefix(node.Destination, []byte(xrefScheme)) {
+		return "", false
+	}
+	return string(node.Destination[len(xrefScheme):]), true
+}
+
+// parseXrefs turns [[target]] of text into cross-reference links without
+// text, which get the title of the heading once resolved
+func parseXrefs(ast *blackfriday.Node) {
+	var texts []*blackfriday.Node
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering {
+			return blackfriday.GoToNext
+		}
+		switch node.Type {
+		case blackfriday.Link, blackfriday.Image:
+			return blackfriday.SkipChildren
+		case blackfriday.Text:
+			if xrefRE.Match(node.Literal) {
+				texts = append(texts, node)
+			}
+		}
+		return blackfriday.GoToNext
+	})
+	for _, node := range texts {
+		literal, last := node.Literal, 0
+		text := func(literal []byte) {
+			if len(literal) > 0 {
+				t := blackfriday.NewNode(blackfriday.Text)
+				t.Literal = literal
+				node.InsertBefore(t)
+			}
+		}
+		for _, m := range xrefRE.FindAllSubmatchIndex(literal, -1) {
+			text(literal[last:m[0]])
+			link := blackfriday.NewNode(blackfriday.Link)
+			link.Destination = append([]byte(xrefScheme), bytes.TrimSpace(literal[m[2]:m[3]])...)
+			node.InsertBefore(link)
+			last = m[1]
+		}
+		text(literal[last:])
+		node.Unlink()
+	}
+}
+
+// xrefHeading is a heading cross-references resolve to
+type xrefHeading struct {
+	title   string
+	id      string
+	level   int
+	parents []*xrefHeading // enclosing headings, outermost first
+}
+
+// path returns titles of the heading and of enclosing ones, like Kittens/Get All Kittens
+func (h *xrefHeading) path() string {
+	var titles []string
+	for _, p := range h.parents {
+		titles = append(titles, p.title)
+	}
+	return strings.Join(append(titles, h.title), "/")
+}
+
+// normalizeTitle makes titles and cross-reference targets comparable,
+// ignoring case and treating dashes and underscores as spaces
+func normalizeTitle(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' {
+			return ' '
+		}
+		return r
+	}, strings.ToLower(s))
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// matchTitle reports whether a target segment matches a heading title, either
+// exactly or, unless exact, as its leading words
+func matchTitle(segment, title string, exact bool) bool {
+	if segment == title {
+		return true
+	}
+	return !exact && strings.HasPrefix(title, segment+" ")
+}
+
+// match reports whether the heading matches target segments, the last one matching
+// the heading and former ones matching enclosing headings in order
+func (h *xrefHeading) match(segments []string, exact bool) bool {
+	if !matchTitle(segments[len(segments)-1], normalizeTitle(h.title), exact) {
+		return false
+	}
+	segments = segments[:len(segments)-1]
+	for i := len(h.parents) - 1; i >= 0 && len(segments) > 0; i-- {
+		if matchTitle(segments[len(segments)-1], normalizeTitle(h.parents[i].title), exact) {
+			segments = segments[:len(segments)-1]
+		}
+	}
+	return len(segments) == 0
+}
+
+// plainText returns text of inline nodes
+func plainText(node *blackfriday.Node) string {
+	var buf bytes.Buffer
+	node.Walk(func(n *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && (n.Type == blackfriday.Text || n.Type == blackfriday.Code) {
+			buf.Write(n.Literal)
+		}
+		return blackfriday.GoToNext
+	})
+	return buf.String()
+}
+
+// resolveXrefs resolves cross-references of a document to links to headings. A target
+// is a heading title, optionally preceded by titles of enclosing headings separated with
+// "/", like Kittens/Get a Specific Kitten. Titles are matched ignoring case, with dashes
+// and underscores matching spaces; leading words of a title match as well unless some
+// heading matches exactly, so Kittens/Get matches Get a Specific Kitten unless there is
+// Get All Kittens as well. Missing or ambiguous targets fail rendering.
+func (s linkSources) resolveXrefs(ast *blackfriday.Node) error {
+	var headings, stack []*xrefHeading
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering || node.Type != blackfriday.Heading || node.IsTitleblock || node.HeadingID == "" {
+			return blackfriday.GoToNext
+		}
+		h := &xrefHeading{title: plainText(node), id: node.HeadingID, level: node.Level}
+		for len(stack) > 0 && stack[len(stack)-1].level >= h.level {
+			stack = stack[:len(stack)-1]
+		}
+		h.parents = append([]*xrefHeading(nil), stack...)
+		stack = append(stack, h)
+		headings = append(headings, h)
+		return blackfriday.SkipChildren
+	})
+	var errs []string
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		target, ok := xrefLink(node)
+		if !entering || !ok {
+			return blackfriday.GoToNext
+		}
+		var segments []string
+		for _, s := range strings.Split(target, "/") {
+			segments = append(segments, normalizeTitle(s))
+		}
+		var found []*xrefHeading
+		for _, exact := range []bool{true, false} {
+			for _, h := range headings {
+				if h.match(segments, exact) {
+					found = append(found, h)
+				}
+			}
+			if len(found) > 0 {
+				break
+			}
+		}
+		src, ok := s[node]
+		if !ok {
+			src.file = "generated content"
+		}
+		switch len(found) {
+		case 0:
+			errs = append(errs, fmt.Sprintf("%s: no heading matches cross-reference %s", src, target))
+		case 1:
+			node.Destination = []byte("#" + found[0].id)
+			if node.FirstChild == nil {
+				text := blackfriday.NewNode(blackfriday.Text)
+				text.Literal = []byte(found[0].title)
+				node.AppendChild(text)
+			}
+		default:
+			var paths []string
+			for _, h := range found {
+				paths = append(paths, h.path())
+			}
+			errs = append(errs, fmt.Sprintf("%s: cross-reference %s is ambiguous, matches %s", src, target, strings.Join(paths, ", ")))
+		}
+		return blackfriday.SkipChildren
+	})
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
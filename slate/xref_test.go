@@ -0,0 +1,39 @@
+package slate
+
+import (
+	"strings"
+	"testing"
+)
+
+const xrefTestHeadings = "# Kittens\n\n## Get All Kittens\n\n## Get a Specific Kitten\n\n## Delete a Specific Kitten\n\n" +
+	"# Puppies\n\n## Get All Puppies\n\n# Errors\n\n# Errors and Warnings\n\n"
+
+func TestXrefs(t *testing.T) {
+	doc := map[string]string{
+		"index.html.md": xrefTestHeadings + "# Usage\n\n" +
+			"See [[ Get All Kittens ]], [[Kittens/Delete]], [the kitten](ref:get-a-specific-kitten) and [[Errors]].\n",
+	}
+	html := renderTestDoc(t, doc, Params{})
+	for _, link := range []string{
+		`<a href="#get-all-kittens">Get All Kittens</a>`,
+		`<a href="#delete-a-specific-kitten">Delete a Specific Kitten</a>`,
+		`<a href="#get-a-specific-kitten">the kitten</a>`,
+		`<a href="#errors">Errors</a>`,
+	} {
+		if !strings.Contains(html, link) {
+			t.Errorf("expected %s in:\n%s", link, html)
+		}
+	}
+}
+
+func TestXrefErrors(t *testing.T) {
+	doc := map[string]string{
+		"index.html.md": xrefTestHeadings + "# Usage\n\nSee [[Get All]].\n\nFeed with [[  Feeding ]].\n",
+	}
+	_, err := loadTestDoc(t, doc, Params{})
+	expected := "index.html.md:19: cross-reference Get All is ambiguous, matches Kittens/Get All Kittens, Puppies/Get All Puppies\n" +
+		"index.html.md:21: no heading matches cross-reference Feeding"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected\n%s\ngot\n%v", expected, err)
+	}
+}